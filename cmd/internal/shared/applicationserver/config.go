@@ -22,6 +22,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	fragmentationv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fragmentation/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 )
@@ -47,6 +49,13 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		QueueSize: 1024,
 		Workers:   1024,
 		Downlinks: web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
+		DeliveryQueue: web.DeliveryQueueConfig{
+			MaxLen:         100000,
+			Group:          "as",
+			NumConsumers:   64,
+			MaxAge:         24 * time.Hour,
+			MaxDeadLetters: 1000,
+		},
 	},
 	EndDeviceMetadataStorage: applicationserver.EndDeviceMetadataStorageConfig{
 		Location: applicationserver.EndDeviceLocationStorageConfig{
@@ -80,8 +89,10 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 	},
 	PubSub: applicationserver.PubSubConfig{
 		Providers: map[string]string{
-			"mqtt": "enabled",
-			"nats": "enabled",
+			"awsiot": "enabled",
+			"kafka":  "enabled",
+			"mqtt":   "enabled",
+			"nats":   "enabled",
 		},
 	},
 	Packages: applicationserver.ApplicationPackagesConfig{
@@ -89,6 +100,14 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 			Workers: 1024,
 			Timeout: 10 * time.Second,
 		},
+		Storage: storage.Config{
+			Retention:       30 * 24 * time.Hour,
+			CleanupInterval: time.Hour,
+		},
+		Fragmentation: fragmentationv1.Config{
+			Bucket:     "fragmentation",
+			Redundancy: 0.25,
+		},
 	},
 	Formatters: applicationserver.FormattersConfig{
 		MaxParameterLength: 40960,
@@ -17,9 +17,11 @@ package shared
 import (
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/bolt"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/pkcs11"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbroker"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
@@ -104,6 +106,15 @@ var DefaultCacheConfig = config.Cache{
 	Redis: DefaultRedisConfig,
 }
 
+// DefaultRegistryConfig is the default registry configuration.
+var DefaultRegistryConfig = config.Registry{
+	Backend: "redis",
+	Bolt: bolt.Config{
+		Path:    "./data/registries.db",
+		Timeout: 5 * time.Second,
+	},
+}
+
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = func() config.Events {
 	c := config.Events{
@@ -118,6 +129,10 @@ var DefaultEventsConfig = func() config.Events {
 	c.Redis.Workers = 16
 	c.Redis.Publish.QueueSize = 8192
 	c.Redis.Publish.MaxWorkers = 1024
+	c.Postgres.Store.Retention = 14 * 24 * time.Hour
+	c.Postgres.Store.PartitionsAhead = 2
+	c.Postgres.Store.HistoryCount = 1000
+	c.Postgres.Store.CorrelationIDCount = 100
 	c.Batch.TargetSize = 64
 	c.Batch.Delay = 32 * time.Millisecond
 	return c
@@ -145,6 +160,9 @@ var DefaultRightsConfig = config.Rights{
 // DefaultKeyVaultConfig is the default config for key vaults.
 var DefaultKeyVaultConfig = config.KeyVault{
 	Provider: "static",
+	PKCS11: config.KeyVaultPKCS11{
+		Sessions: pkcs11.DefaultSessions,
+	},
 }
 
 // DefaultRateLimitingConfig is the default config for rate limiting.
@@ -199,6 +217,7 @@ var DefaultServiceBase = config.ServiceBase{
 	Cluster:        DefaultClusterConfig,
 	Cache:          DefaultCacheConfig,
 	Redis:          DefaultRedisConfig,
+	Registry:       DefaultRegistryConfig,
 	Events:         DefaultEventsConfig,
 	GRPC:           DefaultGRPCConfig,
 	HTTP:           DefaultHTTPConfig,
@@ -19,6 +19,8 @@ import (
 	"crypto/tls"
 	"fmt"
 
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
@@ -26,9 +28,11 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events/basic"
 	"go.thethings.network/lorawan-stack/v3/pkg/events/cloud"
 	"go.thethings.network/lorawan-stack/v3/pkg/events/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/postgres"
 	"go.thethings.network/lorawan-stack/v3/pkg/events/redis"
 	managedclient "go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver/managed/client"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/workerpool"
 	_ "gocloud.dev/pubsub/awssnssqs" // AWS backend for PubSub.
 	_ "gocloud.dev/pubsub/gcppubsub" // GCP backend for PubSub.
@@ -51,6 +55,18 @@ func InitializeEvents(ctx context.Context, component Component, conf config.Serv
 		ps = basic.NewPubSub()
 	case "redis":
 		ps = redis.NewPubSub(ctx, component, conf.Events.Redis, conf.Events.Batch)
+	case "postgres":
+		sqlDB, err := storeutil.OpenDB(ctx, conf.Events.Postgres.DatabaseURI)
+		if err != nil {
+			return err
+		}
+		postgresPS, err := postgres.NewPubSub(
+			ctx, component, bun.NewDB(sqlDB, pgdialect.New()), conf.Events.Postgres, conf.Events.Batch,
+		)
+		if err != nil {
+			return err
+		}
+		ps = postgresPS
 	case "cloud":
 		cloudPS, err := cloud.NewPubSub(ctx, component, conf.Events.Cloud.PublishURL, conf.Events.Cloud.SubscribeURL)
 		if err != nil {
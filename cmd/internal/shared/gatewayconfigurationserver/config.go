@@ -15,6 +15,8 @@
 package shared
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	gs "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/gatewayserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
@@ -30,4 +32,6 @@ func init() {
 	DefaultGatewayConfigurationServerConfig.TheThingsKickstarterGateway.Default.MQTTServer = "mqtts://" + gs.DefaultGatewayServerConfig.MQTTV2.PublicTLSAddress
 	DefaultGatewayConfigurationServerConfig.TheThingsKickstarterGateway.Default.FirmwareURL = "https://ttkg-fw.thethingsindustries.com/v1"
 	DefaultGatewayConfigurationServerConfig.BasicStation.Default.LNSURI = "wss://" + shared.DefaultPublicHost + gs.DefaultGatewayServerConfig.BasicStation.ListenTLS
+	DefaultGatewayConfigurationServerConfig.BasicStation.Firmware.Manifest = "manifest.json"
+	DefaultGatewayConfigurationServerConfig.BasicStation.Firmware.ManifestTTL = time.Minute
 }
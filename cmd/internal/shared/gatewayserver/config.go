@@ -38,6 +38,17 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 	ConnectionStatsTTL:                12 * time.Hour,
 	ConnectionStatsDisconnectTTL:      48 * time.Hour,
 	UpdateVersionInfoDelay:            5 * time.Second,
+	Airtime: gatewayserver.AirtimeConfig{
+		TTL: 5 * time.Minute,
+	},
+	Capture: gatewayserver.CaptureConfig{
+		BufferSize: 256,
+		TTL:        time.Hour,
+	},
+	ConnectionSessions: gatewayserver.ConnectionSessionsConfig{
+		Max: 1000,
+		TTL: 30 * 24 * time.Hour,
+	},
 	Forward: map[string][]string{
 		"": {"00000000/0"},
 	},
@@ -79,4 +79,10 @@ func init() {
 	DefaultIdentityServerConfig.LoginTokens.TokenTTL = time.Hour
 	DefaultIdentityServerConfig.Delete.Restore = 24 * time.Hour
 	DefaultIdentityServerConfig.Gateways.TokenValidity = 5 * time.Second
+	DefaultIdentityServerConfig.LDAPSync.Interval = time.Hour
+	DefaultIdentityServerConfig.LDAPSync.Timeout = 10 * time.Second
+	DefaultIdentityServerConfig.LDAPSync.GroupFilter = "(|(objectClass=group)(objectClass=groupOfNames))"
+	DefaultIdentityServerConfig.LDAPSync.GroupNameAttribute = "cn"
+	DefaultIdentityServerConfig.LDAPSync.MemberAttribute = "member"
+	DefaultIdentityServerConfig.LDAPSync.UserIDAttribute = "uid"
 }
@@ -37,6 +37,7 @@ var (
 	mqttProviderApplicationPubSubFlags   = util.NormalizedFlagSet()
 	awsiotProviderApplicationPubSubFlags = util.NormalizedFlagSet()
 	awsiotDefaultIntegrationPubSubFlags  = util.NormalizedFlagSet()
+	kafkaProviderApplicationPubSubFlags  = util.NormalizedFlagSet()
 
 	selectAllApplicationPubSubFlags = util.SelectAllFlagSet("application pub/sub")
 )
@@ -63,6 +64,12 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	util.HideFlag(flagSet, "aws-iot")
 	flagSet.AddFlagSet(awsiotProviderApplicationPubSubFlags)
 	flagSet.AddFlagSet(awsiotDefaultIntegrationPubSubFlags)
+	flagSet.Bool("kafka", false, "use the Kafka provider")
+	util.HideFlag(flagSet, "kafka")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("kafka.tls-ca", "")))
+	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("kafka.tls-client-cert", "")))
+	flagSet.AddFlagSet(util.HideFlagSet(dataFlags("kafka.tls-client-key", "")))
 	addDeprecatedProviderFlags(flagSet)
 	return flagSet
 }
@@ -307,6 +314,39 @@ var (
 				}
 			}
 
+			if kafka, _ := cmd.Flags().GetBool("kafka"); kafka {
+				if pubsub.GetKafka() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Kafka{
+						Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), kafkaProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if useTLS, _ := cmd.Flags().GetBool("kafka.use-tls"); useTLS {
+					for _, name := range []string{
+						"kafka.tls-ca",
+						"kafka.tls-client-cert",
+						"kafka.tls-client-key",
+					} {
+						data, err := getDataBytes(name, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						err = cmd.Flags().Set(name, hex.EncodeToString(data))
+						if err != nil {
+							return err
+						}
+					}
+				}
+				_, err := pubsub.GetKafka().SetFromFlags(cmd.Flags(), "kafka")
+				if err != nil {
+					return err
+				}
+			}
+
 			res, err := ttnpb.NewApplicationPubSubRegistryClient(as).Set(ctx, &ttnpb.SetApplicationPubSubRequest{
 				Pubsub:    pubsub,
 				FieldMask: ttnpb.FieldMask(paths...),
@@ -347,6 +387,7 @@ func init() {
 	ttnpb.AddSetFlagsForApplicationPubSub_MQTTProvider(mqttProviderApplicationPubSubFlags, "mqtt", true)
 	ttnpb.AddSetFlagsForApplicationPubSub_AWSIoTProvider(awsiotProviderApplicationPubSubFlags, "aws-iot", true)
 	ttnpb.AddSetFlagsForApplicationPubSub_AWSIoTProvider_DefaultIntegration(awsiotDefaultIntegrationPubSubFlags, "aws-iot.deployment.default", true)
+	ttnpb.AddSetFlagsForApplicationPubSub_KafkaProvider(kafkaProviderApplicationPubSubFlags, "kafka", true)
 	ttnpb.AddSetFlagsForApplicationPubSub(setApplicationPubSubFlags, "", false)
 	ttnpb.AddSelectFlagsForApplicationPubSub(selectApplicationPubSubFlags, "", false)
 	applicationsPubSubsCommand.AddCommand(applicationsPubSubsGetFormatsCommand)
@@ -212,6 +212,62 @@ var (
 			return nil
 		},
 	}
+	applicationsWebhooksDeadLettersCommand = &cobra.Command{
+		Use:     "dead-letters",
+		Aliases: []string{"dead-letter"},
+		Short:   "Application webhook dead letters commands (Application Server)",
+	}
+	applicationsWebhooksDeadLettersListCommand = &cobra.Command{
+		Use:     "list [application-id] [webhook-id]",
+		Aliases: []string{"ls"},
+		Short:   "List the dead letters of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ListDeadLetters(
+				ctx, &ttnpb.ListApplicationWebhookDeadLettersRequest{
+					Ids:   webhookID,
+					Limit: limit,
+					Page:  page,
+				}, opt,
+			)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsWebhooksDeadLettersReplayCommand = &cobra.Command{
+		Use:   "replay [application-id] [webhook-id]",
+		Short: "Replay the dead letters of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ReplayDeadLetters(ctx, webhookID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 )
 
 func init() {
@@ -231,5 +287,11 @@ func init() {
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksSetCommand)
 	applicationsWebhooksDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeleteCommand)
+	applicationsWebhooksDeadLettersListCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksDeadLettersListCommand.Flags().AddFlagSet(paginationFlags())
+	applicationsWebhooksDeadLettersCommand.AddCommand(applicationsWebhooksDeadLettersListCommand)
+	applicationsWebhooksDeadLettersReplayCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksDeadLettersCommand.AddCommand(applicationsWebhooksDeadLettersReplayCommand)
+	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeadLettersCommand)
 	applicationsCommand.AddCommand(applicationsWebhooksCommand)
 }
@@ -0,0 +1,87 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	auditLogCommand = &cobra.Command{
+		Use:     "audit-log",
+		Aliases: []string{"audit"},
+		Short:   "Audit log commands",
+	}
+	auditLogListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List entries of the audit log",
+		Long: `List entries of the audit log
+
+The audit log records the changes that are made to applications, OAuth clients,
+gateways, organizations and users, together with who made the change and how
+they authenticated. Each entry contains the changed fields and their values
+before and after the change. Secrets are not recorded. The most recent entries
+are listed first.
+
+Listing the audit log of an entity requires the rights to manage its settings,
+API keys and collaborators. Listing the audit log of all entities requires
+admin rights.`,
+		Example: `  List the changes to an application of the last day:
+    $ ttn-lw-cli audit-log list --application-id app1 --since 24h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			actions, _ := cmd.Flags().GetStringSlice("actions")
+			since, _ := cmd.Flags().GetDuration("since")
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			req := &ttnpb.ListAuditEntriesRequest{
+				EntityIds: getEntityIdentifiersSlice(cmd.Flags()),
+				Actions:   actions,
+				Limit:     limit,
+				Page:      page,
+			}
+			if since > 0 {
+				req.After = timestamppb.New(time.Now().Add(-since))
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAuditLogClient(is).ListAuditEntries(ctx, req, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.GetEntries())
+		},
+	}
+)
+
+func init() {
+	auditLogListCommand.Flags().AddFlagSet(entityIdentifiersSliceFlags())
+	auditLogListCommand.Flags().StringSlice("actions", nil, "only list entries of these actions (e.g. application.update)")
+	auditLogListCommand.Flags().Duration("since", 0, "only list entries of changes within this duration")
+	auditLogListCommand.Flags().AddFlagSet(paginationFlags())
+	auditLogCommand.AddCommand(auditLogListCommand)
+	Root.AddCommand(auditLogCommand)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errCaptureFormat = errors.DefineInvalidArgument("capture_format", "unknown capture format `{format}`")
+
+var gatewaysCaptureCommand = &cobra.Command{
+	Use:   "capture [gateway-id]",
+	Short: "Capture the traffic of a gateway",
+	Long: `Capture the traffic of a gateway
+
+The uplink, downlink and transmission acknowledgment traffic of the gateway is
+written in PCAP or PCAPNG format with a LoRaTap link-layer header, which can be
+opened in Wireshark. The capture starts with the traffic recorded by the
+Gateway Server and continues until the duration passes or the command is
+interrupted.
+
+Traffic capture must be enabled in the Gateway Server.`,
+	Example: `  Capture the traffic of a gateway for 10 minutes:
+    $ ttn-lw-cli gateways capture gtw1 --output gtw1.pcapng --duration 10m`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+		duration, _ := cmd.Flags().GetDuration("duration")
+
+		var w io.Writer = os.Stdout
+		if output != "" {
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		bw := bufio.NewWriter(w)
+		defer bw.Flush()
+
+		var writer capture.Writer
+		switch format {
+		case "pcap":
+			writer, err = capture.NewPCAPWriter(bw)
+		case "pcapng":
+			writer, err = capture.NewPCAPNGWriter(bw)
+		default:
+			return errCaptureFormat.WithAttributes("format", format)
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx := ctx
+		if duration > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, duration)
+			defer cancel()
+		}
+		gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		stream, err := ttnpb.NewGsCaptureClient(gs).CaptureGatewayTraffic(ctx, gtwID)
+		if err != nil {
+			return err
+		}
+		for {
+			pb, err := stream.Recv()
+			if err != nil {
+				if errors.IsCanceled(err) || errors.IsDeadlineExceeded(err) || errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			rec, err := capture.RecordFromProto(pb)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to decode captured record")
+				continue
+			}
+			if err := writer.WriteRecord(rec); err != nil {
+				return err
+			}
+			logger.WithField("direction", rec.Direction()).Debug("Captured record")
+			// Flush every record so that captures can be followed live.
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+		}
+	},
+}
+
+func init() {
+	gatewaysCaptureCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureCommand.Flags().String("format", "pcapng", "capture format (pcap, pcapng)")
+	gatewaysCaptureCommand.Flags().String("output", "", "output file (default stdout)")
+	gatewaysCaptureCommand.Flags().Duration("duration", 0, "capture duration (default until interrupted)")
+	gatewaysCommand.AddCommand(gatewaysCaptureCommand)
+}
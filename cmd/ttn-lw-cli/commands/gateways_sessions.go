@@ -0,0 +1,100 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/sessions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var gatewaysSessionsCommand = &cobra.Command{
+	Use:     "list-connection-sessions [gateway-id]",
+	Aliases: []string{"connection-sessions", "sessions"},
+	Short:   "List the past connection sessions of a gateway",
+	Long: `List the past connection sessions of a gateway
+
+The sessions are listed from the most recent to the oldest. Each session
+contains the connect and disconnect time, the frontend protocol, the remote
+address, the message counts and the round-trip times.
+
+With the --summary flag, the number of sessions, the mean session duration,
+the mean reconnect delay and the number of short sessions of all pages are
+shown instead. Many short sessions indicate a flapping gateway.
+
+Storing connection sessions must be enabled in the Gateway Server.`,
+	Example: `  Summarize the connection sessions of a gateway of the last week:
+    $ ttn-lw-cli gateways list-connection-sessions gtw1 --since 168h --summary`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		since, _ := cmd.Flags().GetDuration("since")
+		limit, page, opt, getTotal := withPagination(cmd.Flags())
+		summary, _ := cmd.Flags().GetBool("summary")
+		shortSession, _ := cmd.Flags().GetDuration("short-session")
+
+		gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		req := &ttnpb.ListGatewayConnectionSessionsRequest{
+			GatewayIds: gtwID,
+			Limit:      limit,
+			Page:       page,
+		}
+		if since > 0 {
+			req.DisconnectedAfter = timestamppb.New(time.Now().Add(-since))
+		}
+		client := ttnpb.NewGsSessionsClient(gs)
+		if !summary {
+			res, err := client.ListGatewayConnectionSessions(ctx, req, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+			return io.Write(os.Stdout, config.OutputFormat, res.Sessions)
+		}
+		// The summary covers all sessions, so all pages are requested.
+		var res []*ttnpb.GatewayConnectionSession
+		for req.Page = 1; ; req.Page++ {
+			paged, err := client.ListGatewayConnectionSessions(ctx, req)
+			if err != nil {
+				return err
+			}
+			res = append(res, paged.Sessions...)
+			if len(paged.Sessions) == 0 || req.Limit > 0 && len(paged.Sessions) < int(req.Limit) {
+				break
+			}
+		}
+		return io.Write(os.Stdout, config.OutputFormat, sessions.Summarize(res, shortSession))
+	},
+}
+
+func init() {
+	gatewaysSessionsCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysSessionsCommand.Flags().Duration("since", 0, "only list sessions that ended within this duration")
+	gatewaysSessionsCommand.Flags().AddFlagSet(paginationFlags())
+	gatewaysSessionsCommand.Flags().Bool("summary", false, "summarize the sessions")
+	gatewaysSessionsCommand.Flags().Duration("short-session", 5*time.Minute, "duration below which sessions are short")
+	gatewaysCommand.AddCommand(gatewaysSessionsCommand)
+}
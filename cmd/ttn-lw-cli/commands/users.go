@@ -486,6 +486,22 @@ var (
 			return nil
 		},
 	}
+	usersResetMFACommand = &cobra.Command{
+		Use:   "reset-mfa [user-id]",
+		Short: "Reset multi-factor authentication of a user (admin only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID.New()
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewUserRegistryClient(is).ResetMFA(ctx, usrID)
+			return err
+		},
+	}
 )
 
 func init() {
@@ -530,5 +546,7 @@ func init() {
 	usersPurgeCommand.Flags().AddFlagSet(userIDFlags())
 	usersPurgeCommand.Flags().AddFlagSet(forceFlags())
 	usersCommand.AddCommand(usersPurgeCommand)
+	usersResetMFACommand.Flags().AddFlagSet(userIDFlags())
+	usersCommand.AddCommand(usersResetMFACommand)
 	Root.AddCommand(usersCommand)
 }
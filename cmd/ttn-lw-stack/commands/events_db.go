@@ -0,0 +1,78 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	eventspostgres "go.thethings.network/lorawan-stack/v3/pkg/events/postgres"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+var (
+	errEventsBackend = errors.DefineInvalidArgument("events_backend", "invalid events backend `{backend}`")
+
+	eventsDBCommand = &cobra.Command{
+		Use:   "events-db",
+		Short: "Manage the events database",
+	}
+	eventsDBInitCommand = &cobra.Command{
+		Use:   "init",
+		Short: "Initialize the events database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrateEventsDB(cmd.Context(), false)
+		},
+	}
+	eventsDBMigrateCommand = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the events database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rollback, _ := cmd.Flags().GetBool("rollback")
+			return migrateEventsDB(cmd.Context(), rollback)
+		},
+	}
+)
+
+func openEventsDB(ctx context.Context) (*bun.DB, error) {
+	if backend := config.Events.Backend; backend != "postgres" {
+		return nil, errEventsBackend.WithAttributes("backend", backend)
+	}
+	logger.Info("Connecting to events database...")
+	sqlDB, err := storeutil.OpenDB(ctx, config.Events.Postgres.DatabaseURI)
+	if err != nil {
+		return nil, err
+	}
+	return bun.NewDB(sqlDB, pgdialect.New()), nil
+}
+
+func migrateEventsDB(ctx context.Context, rollback bool) error {
+	db, err := openEventsDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return runMigrations(ctx, eventspostgres.NewMigrator(db), rollback)
+}
+
+func init() {
+	Root.AddCommand(eventsDBCommand)
+	eventsDBCommand.AddCommand(eventsDBInitCommand)
+	eventsDBMigrateCommand.Flags().Bool("rollback", false, "Rollback most recent migration group")
+	eventsDBCommand.AddCommand(eventsDBMigrateCommand)
+}
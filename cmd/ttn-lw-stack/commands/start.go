@@ -21,14 +21,21 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	asbolt "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/bolt"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
+	asiopsbolt "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/bolt"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
+	asiowebbolt "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/bolt"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asmetaredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata/redis"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
+	cupsbolt "go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/bolt"
+	cupsredis "go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/redis"
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
@@ -37,13 +44,18 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	events_grpc "go.thethings.network/lorawan-stack/v3/pkg/events/grpc"
+	airtimebolt "go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime/bolt"
+	airtimeredis "go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	gsbolt "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/bolt"
 	gsredis "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	jsbolt "go.thethings.network/lorawan-stack/v3/pkg/joinserver/bolt"
 	jsredis "go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	nsbolt "go.thethings.network/lorawan-stack/v3/pkg/networkserver/bolt"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
@@ -111,7 +123,10 @@ func NewRateLimitingRedis(conf *Config) *redis.Client {
 	return redis.New(conf.Cache.Redis.WithNamespace("rate-limiting"))
 }
 
-var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+var (
+	errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+	errRegistryBackend  = errors.DefineInvalidArgument("registry_backend", "invalid registry backend `{backend}`")
+)
 
 var startCommand = &cobra.Command{
 	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
@@ -239,6 +254,21 @@ var startCommand = &cobra.Command{
 		c.RegisterGRPC(events_grpc.NewEventsServer(c.Context(), events.DefaultPubSub()))
 		c.RegisterGRPC(component.NewConfigurationServer(c))
 
+		// When the bolt registry backend is used, the registries are stored in an embedded database.
+		// The queues, deduplication and caches still use Redis.
+		var registryDB *bbolt.DB
+		switch config.Registry.Backend {
+		case "", "redis":
+		case "bolt":
+			registryDB, err = ttnbolt.Open(config.Registry.Bolt)
+			if err != nil {
+				return err
+			}
+			defer registryDB.Close()
+		default:
+			return errRegistryBackend.WithAttributes("backend", config.Registry.Backend)
+		}
+
 		if start.IdentityServer {
 			logger.Info("Setting up Identity Server")
 			if config.IS.OAuth.UI.TemplateData.SentryDSN == "" {
@@ -279,8 +309,16 @@ var startCommand = &cobra.Command{
 
 		if start.GatewayServer {
 			logger.Info("Setting up Gateway Server")
-			switch config.Cache.Service {
-			case "redis":
+			switch {
+			case registryDB != nil:
+				gatewayConnectionStatsRegistry := &gsbolt.GatewayConnectionStatsRegistry{
+					Bolt: ttnbolt.New(registryDB, "gs", "connstats"),
+				}
+				if err := gatewayConnectionStatsRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeGatewayServer.WithCause(err)
+				}
+				config.GS.Stats = gatewayConnectionStatsRegistry
+			case config.Cache.Service == "redis":
 				gatewayConnectionStatsRegistry := &gsredis.GatewayConnectionStatsRegistry{
 					Redis:   redis.New(config.Cache.Redis.WithNamespace("gs", "cache", "connstats")),
 					LockTTL: defaultLockTTL,
@@ -290,6 +328,51 @@ var startCommand = &cobra.Command{
 				}
 				config.GS.Stats = gatewayConnectionStatsRegistry
 			}
+			if config.GS.Airtime.Publish {
+				if registryDB != nil {
+					airtimeRegistry := &airtimebolt.Registry{
+						Bolt: ttnbolt.New(registryDB, "gs", "airtime"),
+					}
+					if err := airtimeRegistry.Init(ctx); err != nil {
+						return shared.ErrInitializeGatewayServer.WithCause(err)
+					}
+					config.GS.Airtime.Registry = airtimeRegistry
+				} else {
+					config.GS.Airtime.Registry = &airtimeredis.Registry{
+						Redis: redis.New(config.Redis.WithNamespace("gs", "airtime")),
+					}
+				}
+			}
+			if config.GS.ConnectionSessions.Enable {
+				if registryDB != nil {
+					sessionRegistry := &gsbolt.GatewayConnectionSessionRegistry{
+						Bolt: ttnbolt.New(registryDB, "gs", "sessions"),
+					}
+					if err := sessionRegistry.Init(ctx); err != nil {
+						return shared.ErrInitializeGatewayServer.WithCause(err)
+					}
+					config.GS.ConnectionSessions.Registry = sessionRegistry
+				} else {
+					config.GS.ConnectionSessions.Registry = &gsredis.GatewayConnectionSessionRegistry{
+						Redis: redis.New(config.Redis.WithNamespace("gs", "sessions")),
+					}
+				}
+			}
+			if config.GS.UDP.AddrChangeBlockShared.Enable {
+				if registryDB != nil {
+					addrRegistry := &gsbolt.UDPAddrRegistry{
+						Bolt: ttnbolt.New(registryDB, "gs", "udp", "addr"),
+					}
+					if err := addrRegistry.Init(ctx); err != nil {
+						return shared.ErrInitializeGatewayServer.WithCause(err)
+					}
+					config.GS.UDP.AddrChangeBlockShared.Registry = addrRegistry
+				} else {
+					config.GS.UDP.AddrChangeBlockShared.Registry = &gsredis.UDPAddrRegistry{
+						Redis: redis.New(config.Redis.WithNamespace("gs", "udp", "addr")),
+					}
+				}
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -317,14 +400,24 @@ var startCommand = &cobra.Command{
 			}
 			defer applicationUplinkQueue.Close(ctx)
 			config.NS.ApplicationUplinkQueue.Queue = applicationUplinkQueue
-			devices := &nsredis.DeviceRegistry{
-				Redis:   NewNetworkServerDeviceRegistryRedis(config),
-				LockTTL: defaultLockTTL,
-			}
-			if err := devices.Init(ctx); err != nil {
-				return shared.ErrInitializeNetworkServer.WithCause(err)
+			if registryDB != nil {
+				devices := &nsbolt.DeviceRegistry{
+					Bolt: ttnbolt.New(registryDB, "ns", "devices"),
+				}
+				if err := devices.Init(ctx); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = devices
+			} else {
+				devices := &nsredis.DeviceRegistry{
+					Redis:   NewNetworkServerDeviceRegistryRedis(config),
+					LockTTL: defaultLockTTL,
+				}
+				if err := devices.Init(ctx); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = devices
 			}
-			config.NS.Devices = devices
 			config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
 			}
@@ -342,6 +435,21 @@ var startCommand = &cobra.Command{
 			config.NS.ScheduledDownlinkMatcher = &nsredis.ScheduledDownlinkMatcher{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "scheduled-downlinks")),
 			}
+			if config.NS.GatewayAirtime.Prefer {
+				if registryDB != nil {
+					airtimeRegistry := &airtimebolt.Registry{
+						Bolt: ttnbolt.New(registryDB, "gs", "airtime"),
+					}
+					if err := airtimeRegistry.Init(ctx); err != nil {
+						return shared.ErrInitializeNetworkServer.WithCause(err)
+					}
+					config.NS.GatewayAirtime.Registry = airtimeRegistry
+				} else {
+					config.NS.GatewayAirtime.Registry = &airtimeredis.Registry{
+						Redis: redis.New(config.Redis.WithNamespace("gs", "airtime")),
+					}
+				}
+			}
 			ns, err := networkserver.New(c, &config.NS)
 			if err != nil {
 				return shared.ErrInitializeNetworkServer.WithCause(err)
@@ -351,33 +459,60 @@ var startCommand = &cobra.Command{
 
 		if start.ApplicationServer {
 			logger.Info("Setting up Application Server")
-			linkRegistry := &asredis.LinkRegistry{
-				Redis:   redis.New(config.Redis.WithNamespace("as", "links")),
-				LockTTL: defaultLockTTL,
-			}
-			if err := linkRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeApplicationServer.WithCause(err)
-			}
-			config.AS.Links = linkRegistry
-			deviceRegistry := &asredis.DeviceRegistry{
-				Redis:   NewApplicationServerDeviceRegistryRedis(config),
-				LockTTL: defaultLockTTL,
-			}
-			if err := deviceRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeApplicationServer.WithCause(err)
+			if registryDB != nil {
+				linkRegistry := &asbolt.LinkRegistry{
+					Bolt: ttnbolt.New(registryDB, "as", "links"),
+				}
+				if err := linkRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Links = linkRegistry
+				deviceRegistry := &asbolt.DeviceRegistry{
+					Bolt: ttnbolt.New(registryDB, "as", "devices"),
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Devices = deviceRegistry
+			} else {
+				linkRegistry := &asredis.LinkRegistry{
+					Redis:   redis.New(config.Redis.WithNamespace("as", "links")),
+					LockTTL: defaultLockTTL,
+				}
+				if err := linkRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Links = linkRegistry
+				deviceRegistry := &asredis.DeviceRegistry{
+					Redis:   NewApplicationServerDeviceRegistryRedis(config),
+					LockTTL: defaultLockTTL,
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Devices = deviceRegistry
 			}
-			config.AS.Devices = deviceRegistry
 			config.AS.Distribution.Global.PubSub = &asdistribredis.PubSub{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("as", "traffic")),
 			}
-			pubsubRegistry := &asiopsredis.PubSubRegistry{
-				Redis:   redis.New(config.Redis.WithNamespace("as", "io", "pubsub")),
-				LockTTL: defaultLockTTL,
-			}
-			if err := pubsubRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeApplicationServer.WithCause(err)
+			if registryDB != nil {
+				pubsubRegistry := &asiopsbolt.PubSubRegistry{
+					Bolt: ttnbolt.New(registryDB, "as", "io", "pubsub"),
+				}
+				if err := pubsubRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.PubSub.Registry = pubsubRegistry
+			} else {
+				pubsubRegistry := &asiopsredis.PubSubRegistry{
+					Redis:   redis.New(config.Redis.WithNamespace("as", "io", "pubsub")),
+					LockTTL: defaultLockTTL,
+				}
+				if err := pubsubRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.PubSub.Registry = pubsubRegistry
 			}
-			config.AS.PubSub.Registry = pubsubRegistry
 			applicationPackagesRegistry, err := asioapredis.NewApplicationPackagesRegistry(
 				ctx,
 				redis.New(config.Redis.WithNamespace("as", "io", "applicationpackages")),
@@ -388,14 +523,38 @@ var startCommand = &cobra.Command{
 			}
 			config.AS.Packages.Registry = applicationPackagesRegistry
 			if config.AS.Webhooks.Target != "" {
-				webhookRegistry := &asiowebredis.WebhookRegistry{
-					Redis:   redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
-					LockTTL: defaultLockTTL,
+				if registryDB != nil {
+					webhookRegistry := &asiowebbolt.WebhookRegistry{
+						Bolt: ttnbolt.New(registryDB, "as", "io", "webhooks"),
+					}
+					if err := webhookRegistry.Init(ctx); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					config.AS.Webhooks.Registry = webhookRegistry
+				} else {
+					webhookRegistry := &asiowebredis.WebhookRegistry{
+						Redis:   redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
+						LockTTL: defaultLockTTL,
+					}
+					if err := webhookRegistry.Init(ctx); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					config.AS.Webhooks.Registry = webhookRegistry
 				}
-				if err := webhookRegistry.Init(ctx); err != nil {
-					return shared.ErrInitializeApplicationServer.WithCause(err)
+				if config.AS.Webhooks.DeliveryQueue.Enable {
+					deliveryQueue := asiowebredis.NewDeliveryQueue(
+						redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "deliveries")),
+						config.AS.Webhooks.DeliveryQueue.MaxLen,
+						config.AS.Webhooks.DeliveryQueue.Group,
+						redis.DefaultStreamBlockLimit,
+						config.AS.Webhooks.DeliveryQueue.MaxDeadLetters,
+					)
+					if err := deliveryQueue.Init(ctx); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					defer deliveryQueue.Close(ctx)
+					config.AS.Webhooks.DeliveryQueue.Queue = deliveryQueue
 				}
-				config.AS.Webhooks.Registry = webhookRegistry
 			}
 			if cache := &config.AS.EndDeviceMetadataStorage.Location.Cache; cache.Enable {
 				switch config.Cache.Service {
@@ -421,31 +580,56 @@ var startCommand = &cobra.Command{
 
 		if start.JoinServer {
 			logger.Info("Setting up Join Server")
-			deviceRegistry := &jsredis.DeviceRegistry{
-				Redis:   NewJoinServerDeviceRegistryRedis(config),
-				LockTTL: defaultLockTTL,
-			}
-			if err := deviceRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeJoinServer.WithCause(err)
-			}
-			config.JS.Devices = deviceRegistry
-			keyRegistry := &jsredis.KeyRegistry{
-				Redis:   NewJoinServerSessionKeyRegistryRedis(config),
-				LockTTL: defaultLockTTL,
-				Limit:   config.JS.SessionKeyLimit,
-			}
-			if err := keyRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeJoinServer.WithCause(err)
-			}
-			config.JS.Keys = keyRegistry
-			applicationActivationSettingRegistry := &jsredis.ApplicationActivationSettingRegistry{
-				Redis:   redis.New(config.Redis.WithNamespace("js", "application-activation-settings")),
-				LockTTL: defaultLockTTL,
-			}
-			if err := applicationActivationSettingRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeJoinServer.WithCause(err)
+			if registryDB != nil {
+				deviceRegistry := &jsbolt.DeviceRegistry{
+					Bolt: ttnbolt.New(registryDB, "js", "devices"),
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Devices = deviceRegistry
+				keyRegistry := &jsbolt.KeyRegistry{
+					Bolt:  ttnbolt.New(registryDB, "js", "keys"),
+					Limit: config.JS.SessionKeyLimit,
+				}
+				if err := keyRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Keys = keyRegistry
+				applicationActivationSettingRegistry := &jsbolt.ApplicationActivationSettingRegistry{
+					Bolt: ttnbolt.New(registryDB, "js", "application-activation-settings"),
+				}
+				if err := applicationActivationSettingRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.ApplicationActivationSettings = applicationActivationSettingRegistry
+			} else {
+				deviceRegistry := &jsredis.DeviceRegistry{
+					Redis:   NewJoinServerDeviceRegistryRedis(config),
+					LockTTL: defaultLockTTL,
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Devices = deviceRegistry
+				keyRegistry := &jsredis.KeyRegistry{
+					Redis:   NewJoinServerSessionKeyRegistryRedis(config),
+					LockTTL: defaultLockTTL,
+					Limit:   config.JS.SessionKeyLimit,
+				}
+				if err := keyRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Keys = keyRegistry
+				applicationActivationSettingRegistry := &jsredis.ApplicationActivationSettingRegistry{
+					Redis:   redis.New(config.Redis.WithNamespace("js", "application-activation-settings")),
+					LockTTL: defaultLockTTL,
+				}
+				if err := applicationActivationSettingRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.ApplicationActivationSettings = applicationActivationSettingRegistry
 			}
-			config.JS.ApplicationActivationSettings = applicationActivationSettingRegistry
 			js, err := joinserver.New(c, &config.JS)
 			if err != nil {
 				return shared.ErrInitializeJoinServer.WithCause(err)
@@ -473,6 +657,21 @@ var startCommand = &cobra.Command{
 
 		if start.GatewayConfigurationServer {
 			logger.Info("Setting up Gateway Configuration Server")
+			if config.GCS.BasicStation.Firmware.Blob.Bucket != "" {
+				if registryDB != nil {
+					firmwareUpdateRegistry := &cupsbolt.FirmwareUpdateRegistry{
+						Bolt: ttnbolt.New(registryDB, "gcs", "cups", "firmware"),
+					}
+					if err := firmwareUpdateRegistry.Init(ctx); err != nil {
+						return shared.ErrInitializeGatewayConfigurationServer.WithCause(err)
+					}
+					config.GCS.BasicStation.Firmware.Registry = firmwareUpdateRegistry
+				} else {
+					config.GCS.BasicStation.Firmware.Registry = &cupsredis.FirmwareUpdateRegistry{
+						Redis: redis.New(config.Redis.WithNamespace("gcs", "cups", "firmware")),
+					}
+				}
+			}
 			gcs, err := gatewayconfigurationserver.New(c, &config.GCS)
 			if err != nil {
 				return shared.ErrInitializeGatewayConfigurationServer.WithCause(err)
@@ -15,26 +15,132 @@
 package commands
 
 import (
-	"fmt"
+	"context"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/migrate"
+	storagepostgres "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/postgres"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
 )
 
 var (
-	errStorageIntegrationNotAvailable = errors.DefineUnimplemented("storage_integration_not_available", "Storage Integration not available")
+	errStorageProvider  = errors.DefineInvalidArgument("storage_provider", "invalid storage provider `{provider}`")
+	errStorageRetention = errors.DefineInvalidArgument("storage_retention", "invalid retention `{retention}`")
 
 	storageDBCommand = &cobra.Command{
 		Use:   "storage-db",
 		Short: "Manage the Storage Integration database",
+	}
+	storageDBInitCommand = &cobra.Command{
+		Use:   "init",
+		Short: "Initialize the Storage Integration database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrateStorageDB(cmd.Context(), false)
+		},
+	}
+	storageDBMigrateCommand = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the Storage Integration database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rollback, _ := cmd.Flags().GetBool("rollback")
+			return migrateStorageDB(cmd.Context(), rollback)
+		},
+	}
+	storageDBCleanupCommand = &cobra.Command{
+		Use:   "cleanup",
+		Short: "Delete expired messages from the Storage Integration database",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fmt.Println("The storage integration is not available in the open-source version of The Things Stack.")
-			fmt.Println("For more information, see https://www.thethingsindustries.com/docs/integrations/storage/")
-			return errStorageIntegrationNotAvailable.New()
+			retention := config.AS.Packages.Storage.Retention
+			if cmd.Flags().Changed("retention") {
+				retention, _ = cmd.Flags().GetDuration("retention")
+			}
+			if retention <= 0 {
+				return errStorageRetention.WithAttributes("retention", retention)
+			}
+
+			db, err := openStorageDB(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			logger.WithField("retention", retention).Info("Deleting expired messages...")
+			n, err := storagepostgres.NewStore(db).DeleteBefore(cmd.Context(), time.Now().Add(-retention))
+			if err != nil {
+				return err
+			}
+			logger.WithField("count", n).Info("Expired messages deleted")
+			return nil
 		},
 	}
 )
 
+func openStorageDB(ctx context.Context) (*bun.DB, error) {
+	if provider := config.AS.Packages.Storage.Provider; provider != "postgres" {
+		return nil, errStorageProvider.WithAttributes("provider", provider)
+	}
+	logger.Info("Connecting to Storage Integration database...")
+	sqlDB, err := storeutil.OpenDB(ctx, config.AS.Packages.Storage.Postgres.DatabaseURI)
+	if err != nil {
+		return nil, err
+	}
+	return bun.NewDB(sqlDB, pgdialect.New()), nil
+}
+
+func migrateStorageDB(ctx context.Context, rollback bool) error {
+	db, err := openStorageDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return runMigrations(ctx, storagepostgres.NewMigrator(db), rollback)
+}
+
+// runMigrations initializes the migrator and applies the unapplied migrations, or rolls back the last group.
+func runMigrations(ctx context.Context, migrator *migrate.Migrator, rollback bool) error {
+	if err := migrator.Init(ctx); err != nil {
+		return err
+	}
+
+	status, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if migrations := status.Unapplied(); len(migrations) > 0 {
+		logger.Infof("Unapplied: %s", migrations)
+	}
+
+	var group *migrate.MigrationGroup
+	if rollback {
+		group, err = migrator.Rollback(ctx)
+	} else {
+		group, err = migrator.Migrate(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if group.IsZero() {
+		logger.Info("Database is up to date")
+		return nil
+	}
+	if rollback {
+		logger.WithField("group", group.ID).Info("Database rollback done")
+	} else {
+		logger.WithField("group", group.ID).Info("Database migration done")
+	}
+	return nil
+}
+
 func init() {
 	Root.AddCommand(storageDBCommand)
+	storageDBCommand.AddCommand(storageDBInitCommand)
+	storageDBMigrateCommand.Flags().Bool("rollback", false, "Rollback most recent migration group")
+	storageDBCommand.AddCommand(storageDBMigrateCommand)
+	storageDBCleanupCommand.Flags().Duration("retention", 0, "Delete messages older than the given duration (default from configuration)")
+	storageDBCommand.AddCommand(storageDBCleanupCommand)
 }
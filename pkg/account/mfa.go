@@ -0,0 +1,259 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	qrcodegen "github.com/skip2/go-qrcode"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/mfa"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+)
+
+const mfaQRCodeSize = 256
+
+var (
+	errMFAAlreadyEnabled = errors.DefineAlreadyExists(
+		"mfa_already_enabled", "multi-factor authentication is already enabled",
+	)
+	errMFANotEnabled = errors.DefineFailedPrecondition(
+		"mfa_not_enabled", "multi-factor authentication is not enabled",
+	)
+	errMFANoEnrollment = errors.DefineFailedPrecondition(
+		"mfa_no_enrollment", "no pending multi-factor authentication enrollment",
+	)
+	errMFANoEncryptionKey = errors.DefineFailedPrecondition(
+		"mfa_no_encryption_key", "no encryption key configured for multi-factor authentication secrets",
+	)
+	errMissingMFACode = errors.DefineInvalidArgument("missing_mfa_code", "missing multi-factor authentication code")
+)
+
+type mfaCodeRequest struct {
+	Code string `json:"code" schema:"code"`
+}
+
+func (s *server) decodeMFACodeRequest(r *http.Request) (*mfaCodeRequest, error) {
+	req := &mfaCodeRequest{}
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, errParse.WithCause(err)
+		}
+	default:
+		if err := r.ParseForm(); err != nil {
+			return nil, errParse.WithCause(err)
+		}
+		if err := s.schemaDecoder.Decode(req, r.Form); err != nil {
+			return nil, errParse.WithCause(err)
+		}
+	}
+	if req.Code == "" {
+		return nil, errMissingMFACode.New()
+	}
+	return req, nil
+}
+
+func (s *server) getUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (userMFA *is.UserMFA, err error) {
+	err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) (err error) {
+		userMFA, err = st.GetUserMFA(ctx, userIDs)
+		return err
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return userMFA, nil
+}
+
+// MFAStatus returns the multi-factor authentication status of the current user.
+func (s *server) MFAStatus(w http.ResponseWriter, r *http.Request) {
+	r, session, err := s.session.Get(w, r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	userMFA, err := s.getUserMFA(r.Context(), session.GetUserIds())
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	var recoveryCodesRemaining int
+	if userMFA != nil {
+		recoveryCodesRemaining = len(userMFA.RecoveryCodes)
+	}
+	webhandlers.JSON(w, r, struct {
+		Enabled                bool `json:"enabled"`
+		RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+	}{
+		Enabled:                mfa.Enabled(userMFA),
+		RecoveryCodesRemaining: recoveryCodesRemaining,
+	})
+}
+
+// EnrollTOTP starts the enrollment of TOTP multi-factor authentication for the current user.
+// The enrollment is pending until it is confirmed with a code.
+func (s *server) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	r, session, err := s.session.Get(w, r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	config := s.configFromContext(ctx)
+	if config.MFA.EncryptionKeyID == "" {
+		webhandlers.Error(w, r, errMFANoEncryptionKey.New())
+		return
+	}
+	userIDs := session.GetUserIds()
+	userMFA, secret, err := mfa.NewTOTP(ctx, s.c.KeyService(), config.MFA.EncryptionKeyID)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+		existing, err := st.GetUserMFA(ctx, userIDs)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if mfa.Enabled(existing) {
+			return errMFAAlreadyEnabled.New()
+		}
+		_, err = st.SetUserMFA(ctx, userIDs, userMFA)
+		return err
+	})
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	issuer := config.MFA.Issuer
+	if issuer == "" {
+		issuer = config.UI.SiteName
+	}
+	uri := totp.URI(issuer, userIDs.GetUserId(), secret)
+	png, err := qrcodegen.Encode(uri, qrcodegen.Medium, mfaQRCodeSize)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	webhandlers.JSON(w, r, struct {
+		Secret string `json:"secret"`
+		URI    string `json:"uri"`
+		QRCode string `json:"qr_code"`
+	}{
+		Secret: totp.EncodeSecret(secret),
+		URI:    uri,
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	})
+}
+
+// ConfirmTOTP confirms the pending TOTP enrollment of the current user with a code, and returns recovery codes.
+func (s *server) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	r, session, err := s.session.Get(w, r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	req, err := s.decodeMFACodeRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	userIDs := session.GetUserIds()
+	codes, hashes, err := mfa.GenerateRecoveryCodes(ctx)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+		userMFA, err := st.GetUserMFA(ctx, userIDs)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return errMFANoEnrollment.New()
+			}
+			return err
+		}
+		if mfa.Enabled(userMFA) {
+			return errMFAAlreadyEnabled.New()
+		}
+		// Only TOTP codes confirm the enrollment, as there are no recovery codes yet.
+		now := time.Now()
+		if err := mfa.Verify(ctx, s.c.KeyService(), userMFA, req.Code, now); err != nil {
+			return err
+		}
+		userMFA.EnabledAt = &now
+		userMFA.RecoveryCodes = hashes
+		_, err = st.SetUserMFA(ctx, userIDs, userMFA)
+		return err
+	})
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	events.Publish(oauth.EvtUserMFAEnable.NewWithIdentifiersAndData(ctx, userIDs, nil))
+	webhandlers.JSON(w, r, struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}{
+		RecoveryCodes: codes,
+	})
+}
+
+// DisableMFA disables multi-factor authentication of the current user. This requires a TOTP code or a recovery code.
+func (s *server) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	r, session, err := s.session.Get(w, r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	req, err := s.decodeMFACodeRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	userIDs := session.GetUserIds()
+	err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+		userMFA, err := st.GetUserMFA(ctx, userIDs)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if !mfa.Enabled(userMFA) {
+			return errMFANotEnabled.New()
+		}
+		if err := mfa.Verify(ctx, s.c.KeyService(), userMFA, req.Code, time.Now()); err != nil {
+			return err
+		}
+		return st.DeleteUserMFA(ctx, userIDs)
+	})
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	events.Publish(oauth.EvtUserMFADisable.NewWithIdentifiersAndData(ctx, userIDs, nil))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,149 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mfa implements multi-factor authentication of users with TOTP codes and recovery codes.
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+)
+
+const (
+	// RecoveryCodeCount is the number of recovery codes that are generated.
+	RecoveryCodeCount = 10
+	// recoveryCodeLength is the length of recovery codes, excluding the separator.
+	recoveryCodeLength = 10
+	// skew is the number of TOTP time steps of allowed clock skew.
+	skew = 1
+)
+
+// recoveryCodeHashSettings are the settings for hashing recovery codes.
+// Recovery codes are random, so fewer iterations are needed than for passwords.
+var recoveryCodeHashSettings auth.HashValidator = pbkdf2.PBKDF2{
+	Iterations: 1000,
+	KeyLength:  32,
+	Algorithm:  pbkdf2.Sha256,
+	SaltLength: 16,
+}
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+var errInvalidCode = errors.DefineInvalidArgument("invalid_code", "invalid multi-factor authentication code")
+
+// Enabled returns whether multi-factor authentication is enabled in the configuration.
+// Pending enrollments are not enabled.
+func Enabled(mfa *store.UserMFA) bool {
+	return mfa != nil && mfa.EnabledAt != nil
+}
+
+// NewTOTP returns a pending multi-factor authentication configuration with a new TOTP secret.
+// The secret is encrypted with the key with the given ID, unless the ID is empty.
+func NewTOTP(ctx context.Context, keyService crypto.KeyService, keyID string) (*store.UserMFA, []byte, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, nil, err
+	}
+	stored := secret
+	if keyID != "" {
+		stored, err = keyService.Encrypt(ctx, secret, keyID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return &store.UserMFA{
+		TOTPSecret:      stored,
+		TOTPSecretKeyID: keyID,
+	}, secret, nil
+}
+
+// TOTPSecret returns the decrypted TOTP secret of the configuration.
+func TOTPSecret(ctx context.Context, keyService crypto.KeyService, mfa *store.UserMFA) ([]byte, error) {
+	if mfa.TOTPSecretKeyID == "" {
+		return mfa.TOTPSecret, nil
+	}
+	return keyService.Decrypt(ctx, mfa.TOTPSecret, mfa.TOTPSecretKeyID)
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
+
+// GenerateRecoveryCodes generates recovery codes. It returns the codes and the hashes of the codes.
+func GenerateRecoveryCodes(ctx context.Context) (codes []string, hashes []string, err error) {
+	ctx = auth.NewContextWithHashValidator(ctx, recoveryCodeHashSettings)
+	codes, hashes = make([]string, RecoveryCodeCount), make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, recoveryCodeLength*5/8)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := recoveryCodeEncoding.EncodeToString(b)
+		hashes[i], err = auth.Hash(ctx, code)
+		if err != nil {
+			return nil, nil, err
+		}
+		codes[i] = strings.ToLower(code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:])
+	}
+	return codes, hashes, nil
+}
+
+// Verify verifies the TOTP code or the recovery code against the configuration.
+// On success, the configuration is updated so that the code cannot be used again, and the caller must store it.
+func Verify(ctx context.Context, keyService crypto.KeyService, mfa *store.UserMFA, code string, now time.Time) error {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		secret, err := TOTPSecret(ctx, keyService, mfa)
+		if err != nil {
+			return err
+		}
+		step, ok := totp.Validate(secret, code, now, skew, mfa.LastUsedStep)
+		if !ok {
+			return errInvalidCode.New()
+		}
+		mfa.LastUsedStep = step
+		return nil
+	}
+	code = normalizeRecoveryCode(code)
+	if len(code) != recoveryCodeLength {
+		return errInvalidCode.New()
+	}
+	for i, hash := range mfa.RecoveryCodes {
+		ok, err := auth.Validate(hash, code)
+		if err != nil {
+			return err
+		}
+		if ok {
+			mfa.RecoveryCodes = append(mfa.RecoveryCodes[:i:i], mfa.RecoveryCodes[i+1:]...)
+			return nil
+		}
+	}
+	return errInvalidCode.New()
+}
@@ -0,0 +1,102 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mfa_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/account/mfa"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestTOTP(t *testing.T) {
+	t.Parallel()
+	keyService := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	}))
+
+	for _, keyID := range []string{"", "test"} {
+		keyID := keyID
+		t.Run(keyID, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+
+			userMFA, secret, err := mfa.NewTOTP(ctx, keyService, keyID)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(mfa.Enabled(userMFA), should.BeFalse)
+			a.So(userMFA.TOTPSecretKeyID, should.Equal, keyID)
+			if keyID != "" {
+				a.So(userMFA.TOTPSecret, should.NotResemble, secret)
+			}
+
+			stored, err := mfa.TOTPSecret(ctx, keyService, userMFA)
+			a.So(err, should.BeNil)
+			a.So(stored, should.Resemble, secret)
+
+			now := time.Now()
+			code := totp.Code(secret, totp.Step(now))
+			a.So(mfa.Verify(ctx, keyService, userMFA, code, now), should.BeNil)
+			a.So(userMFA.LastUsedStep, should.Equal, totp.Step(now))
+
+			// Codes can not be replayed.
+			err = mfa.Verify(ctx, keyService, userMFA, code, now)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	codes, hashes, err := mfa.GenerateRecoveryCodes(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(codes, should.HaveLength, mfa.RecoveryCodeCount)
+	a.So(hashes, should.HaveLength, mfa.RecoveryCodeCount)
+
+	userMFA, _, err := mfa.NewTOTP(ctx, nil, "")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	userMFA.RecoveryCodes = hashes
+
+	for _, code := range []string{"", "abcde-fghij", "12345"} {
+		err := mfa.Verify(ctx, nil, userMFA, code, time.Now())
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+	a.So(userMFA.RecoveryCodes, should.HaveLength, mfa.RecoveryCodeCount)
+
+	// Recovery codes are case insensitive and the separator is optional.
+	a.So(mfa.Verify(ctx, nil, userMFA, codes[0], time.Now()), should.BeNil)
+	a.So(mfa.Verify(ctx, nil, userMFA, " "+strings.ToUpper(codes[1])+" ", time.Now()), should.BeNil)
+	a.So(mfa.Verify(ctx, nil, userMFA, strings.ReplaceAll(codes[2], "-", ""), time.Now()), should.BeNil)
+	a.So(userMFA.RecoveryCodes, should.HaveLength, mfa.RecoveryCodeCount-3)
+
+	// Recovery codes can only be used once.
+	err = mfa.Verify(ctx, nil, userMFA, codes[0], time.Now())
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	a.So(userMFA.RecoveryCodes, should.HaveLength, mfa.RecoveryCodeCount-3)
+}
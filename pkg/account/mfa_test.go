@@ -0,0 +1,254 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/account"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/mfa"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/webui"
+	"golang.org/x/net/publicsuffix"
+)
+
+func TestMFA(t *testing.T) {
+	store := &mockStore{}
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+			KeyVault: config.KeyVault{
+				Provider: "static",
+				Static: map[string][]byte{
+					"mfa-test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F},
+				},
+			},
+		},
+	})
+	s, err := account.NewServer(c, store, oauth.Config{
+		Mount:       "/oauth",
+		CSRFAuthKey: []byte("12345678123456781234567812345678"),
+		MFA: oauth.MFAConfig{
+			EncryptionKeyID: "mfa-test",
+		},
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "Account",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+	}, identityserver.GenerateCSPString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	var csrfToken string
+	serve := func(t *testing.T, method, target string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		var b []byte
+		if body != nil {
+			var err error
+			if b, err = json.Marshal(body); err != nil {
+				t.Fatal(err)
+			}
+		}
+		req := httptest.NewRequest(method, target, bytes.NewReader(b))
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		req.Header.Set("X-CSRF-Token", csrfToken)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for _, c := range jar.Cookies(req.URL) {
+			req.AddCookie(c)
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		if cookies := res.Result().Cookies(); len(cookies) > 0 {
+			jar.SetCookies(req.URL, cookies)
+		}
+		return res
+	}
+	csrfToken = serve(t, http.MethodGet, "/oauth/login", nil).Header().Get("X-CSRF-Token")
+
+	type loginRequest struct {
+		UserID   string `json:"user_id"`
+		Password string `json:"password"`
+		MFACode  string `json:"mfa_code,omitempty"`
+	}
+	type tokenLoginRequest struct {
+		Token   string `json:"token"`
+		MFACode string `json:"mfa_code,omitempty"`
+	}
+	type codeRequest struct {
+		Code string `json:"code"`
+	}
+
+	var recoveryCodes []string
+
+	t.Run("Enroll", func(t *testing.T) {
+		a := assertions.New(t)
+
+		store.reset()
+		store.res.user, store.res.session = mockUser, mockSession
+		res := serve(t, http.MethodPost, "/oauth/api/auth/login", loginRequest{UserID: "user", Password: "pass"})
+		if !a.So(res.Code, should.Equal, http.StatusNoContent) {
+			t.FailNow()
+		}
+
+		res = serve(t, http.MethodGet, "/oauth/api/mfa", nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+		a.So(res.Body.String(), should.ContainSubstring, `"enabled":false`)
+
+		res = serve(t, http.MethodPost, "/oauth/api/mfa/totp", nil)
+		if !a.So(res.Code, should.Equal, http.StatusOK) {
+			t.FailNow()
+		}
+		var enrollment struct {
+			Secret string `json:"secret"`
+			URI    string `json:"uri"`
+			QRCode string `json:"qr_code"`
+		}
+		if err := json.Unmarshal(res.Body.Bytes(), &enrollment); err != nil {
+			t.Fatal(err)
+		}
+		a.So(store.calls, should.Contain, "SetUserMFA")
+		userMFA := store.req.mfa
+		if !a.So(userMFA, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(userMFA.TOTPSecretKeyID, should.Equal, "mfa-test")
+		secret, err := mfa.TOTPSecret(c.Context(), c.KeyService(), userMFA)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(enrollment.Secret, should.Equal, totp.EncodeSecret(secret))
+		a.So(enrollment.URI, should.StartWith, "otpauth://totp/")
+		a.So(enrollment.QRCode, should.StartWith, "data:image/png;base64,")
+
+		store.res.mfa = userMFA
+		res = serve(t, http.MethodPost, "/oauth/api/mfa/totp/confirm", codeRequest{Code: "000000"})
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(userMFA.EnabledAt, should.BeNil)
+
+		res = serve(t, http.MethodPost, "/oauth/api/mfa/totp/confirm", codeRequest{
+			Code: totp.Code(secret, totp.Step(time.Now())),
+		})
+		if !a.So(res.Code, should.Equal, http.StatusOK) {
+			t.FailNow()
+		}
+		var confirmation struct {
+			RecoveryCodes []string `json:"recovery_codes"`
+		}
+		if err := json.Unmarshal(res.Body.Bytes(), &confirmation); err != nil {
+			t.Fatal(err)
+		}
+		recoveryCodes = confirmation.RecoveryCodes
+		a.So(recoveryCodes, should.HaveLength, 10)
+		a.So(userMFA.EnabledAt, should.NotBeNil)
+		a.So(userMFA.RecoveryCodes, should.HaveLength, 10)
+
+		res = serve(t, http.MethodPost, "/oauth/api/mfa/totp", nil)
+		a.So(res.Code, should.Equal, http.StatusConflict)
+	})
+
+	t.Run("Login", func(t *testing.T) {
+		a := assertions.New(t)
+		userMFA := store.res.mfa
+
+		store.reset()
+		store.res.user, store.res.session, store.res.mfa = mockUser, mockSession, userMFA
+		res := serve(t, http.MethodPost, "/oauth/api/auth/login", loginRequest{UserID: "user", Password: "pass"})
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+		a.So(res.Body.String(), should.ContainSubstring, "mfa_required")
+
+		res = serve(t, http.MethodPost, "/oauth/api/auth/login", loginRequest{
+			UserID: "user", Password: "pass", MFACode: "aaaaa-aaaaa",
+		})
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+
+		res = serve(t, http.MethodPost, "/oauth/api/auth/login", loginRequest{
+			UserID: "user", Password: "pass", MFACode: recoveryCodes[0],
+		})
+		a.So(res.Code, should.Equal, http.StatusNoContent)
+		a.So(store.calls, should.Contain, "SetUserMFA")
+		a.So(userMFA.RecoveryCodes, should.HaveLength, 9)
+
+		res = serve(t, http.MethodGet, "/oauth/api/mfa", nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+		a.So(res.Body.String(), should.ContainSubstring, `"enabled":true`)
+		a.So(res.Body.String(), should.ContainSubstring, `"recovery_codes_remaining":9`)
+	})
+
+	t.Run("TokenLogin", func(t *testing.T) {
+		a := assertions.New(t)
+		userMFA := store.res.mfa
+
+		store.reset()
+		store.res.user, store.res.session, store.res.mfa = mockUser, mockSession, userMFA
+		store.res.loginToken = &ttnpb.LoginToken{UserIds: mockUser.GetIds()}
+		res := serve(t, http.MethodPost, "/oauth/api/auth/token-login", tokenLoginRequest{Token: "token"})
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+		a.So(res.Body.String(), should.ContainSubstring, "mfa_required")
+		a.So(store.calls, should.NotContain, "CreateSession")
+
+		res = serve(t, http.MethodPost, "/oauth/api/auth/token-login", tokenLoginRequest{
+			Token: "token", MFACode: recoveryCodes[1],
+		})
+		a.So(res.Code, should.Equal, http.StatusNoContent)
+		a.So(store.calls, should.Contain, "CreateSession")
+		a.So(userMFA.RecoveryCodes, should.HaveLength, 8)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		a := assertions.New(t)
+		userMFA := store.res.mfa
+
+		store.reset()
+		store.res.user, store.res.session, store.res.mfa = mockUser, mockSession, userMFA
+		res := serve(t, http.MethodPost, "/oauth/api/mfa/disable", codeRequest{Code: recoveryCodes[1]})
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(store.calls, should.NotContain, "DeleteUserMFA")
+
+		res = serve(t, http.MethodPost, "/oauth/api/mfa/disable", codeRequest{Code: recoveryCodes[2]})
+		a.So(res.Code, should.Equal, http.StatusNoContent)
+		a.So(store.calls, should.Contain, "DeleteUserMFA")
+	})
+}
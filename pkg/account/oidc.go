@@ -0,0 +1,478 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/oidc"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web/cookie"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	oidcStateCookieName = "_oidc_state"
+	oidcStateTTL        = 10 * time.Minute
+	oidcMFACookieName   = "_oidc_mfa"
+	oidcMFATTL          = 5 * time.Minute
+	oidcLinkKey         = "link"
+)
+
+// oidcProviderIDRegexp matches the IDs of OpenID Connect providers, which are used in paths and stored with links.
+var oidcProviderIDRegexp = regexp.MustCompile(`^[a-z0-9](?:[-]?[a-z0-9]){2,35}$`)
+
+var (
+	errOIDCProviderNotFound = errors.DefineNotFound(
+		"oidc_provider_not_found", "OpenID Connect provider `{provider}` not found",
+	)
+	errOIDCProviderConfig = errors.DefineInvalidArgument(
+		"oidc_provider_config", "invalid configuration of OpenID Connect provider `{provider}`",
+	)
+	errOIDCState         = errors.DefineInvalidArgument("oidc_state", "invalid OpenID Connect state")
+	errOIDCAuthorization = errors.DefinePermissionDenied(
+		"oidc_authorization", "OpenID Connect provider returned error `{error}`", "description",
+	)
+	errOIDCMissingCode   = errors.DefineInvalidArgument("oidc_missing_code", "missing authorization code")
+	errOIDCLinkedToOther = errors.DefineAlreadyExists(
+		"oidc_linked_to_other", "account of OpenID Connect provider `{provider}` is linked to another user",
+	)
+	errOIDCAlreadyLinked = errors.DefineAlreadyExists(
+		"oidc_already_linked", "user is already linked to another account of OpenID Connect provider `{provider}`",
+	)
+	errOIDCNotLinked = errors.DefineFailedPrecondition(
+		"oidc_not_linked",
+		"account of OpenID Connect provider `{provider}` is not linked to a user; log in and link the account first",
+	)
+	errOIDCRegistrationDisabled = errors.DefinePermissionDenied(
+		"oidc_registration_disabled", "registration of users with OpenID Connect provider `{provider}` is disabled",
+	)
+	errOIDCMissingEmail = errors.DefineInvalidArgument(
+		"oidc_missing_email", "OpenID Connect provider `{provider}` did not return an email address",
+	)
+	errOIDCUserID = errors.DefineInvalidArgument(
+		"oidc_user_id", "could not derive an available user ID from the OpenID Connect claims",
+	)
+	errOIDCMFAState = errors.DefineUnauthenticated(
+		"oidc_mfa_state", "no pending OpenID Connect login that requires multi-factor authentication",
+	)
+)
+
+// oidcState is the state of an OpenID Connect login that is kept in a cookie between the login and the callback.
+type oidcState struct {
+	Provider string
+	State    string
+	Nonce    string
+	Verifier string
+	Next     string
+	// LinkUserID is the ID of the logged in user that links the upstream account.
+	LinkUserID string
+}
+
+func (s *server) oidcStateCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     oidcStateCookieName,
+		Path:     strings.TrimSuffix(s.config.Mount, "/") + "/oidc",
+		MaxAge:   oidcStateTTL,
+		HTTPOnly: true,
+	}
+}
+
+// oidcMFAState is the state of an OpenID Connect login that waits for the multi-factor authentication code.
+type oidcMFAState struct {
+	UserID string
+	Next   string
+}
+
+func (s *server) oidcMFACookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     oidcMFACookieName,
+		Path:     strings.TrimSuffix(s.config.Mount, "/") + "/api/auth/oidc",
+		MaxAge:   oidcMFATTL,
+		HTTPOnly: true,
+	}
+}
+
+func (s *server) oidcRedirectURL(r *http.Request, providerID string) string {
+	config := s.configFromContext(r.Context())
+	return fmt.Sprintf("%s/oidc/%s/callback", strings.TrimSuffix(config.UI.CanonicalURL, "/"), providerID)
+}
+
+// oidcNext returns the relative path to redirect to after logging in.
+func (s *server) oidcNext(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return s.config.Mount
+	}
+	return next
+}
+
+func (s *server) oidcProvider(r *http.Request) (*oidc.Provider, error) {
+	providerID := mux.Vars(r)["provider"]
+	provider, ok := s.oidcProviders[providerID]
+	if !ok {
+		return nil, errOIDCProviderNotFound.WithAttributes("provider", providerID)
+	}
+	return provider, nil
+}
+
+// OIDCLogin redirects the user to the authorization endpoint of the OpenID Connect provider.
+func (s *server) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	provider, err := s.oidcProvider(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	providerID := provider.Config().ID
+	query := r.URL.Query()
+	state := &oidcState{
+		Provider: providerID,
+		State:    random.String(32),
+		Nonce:    random.String(32),
+		Verifier: oauth2.GenerateVerifier(),
+		Next:     s.oidcNext(query.Get(nextKey)),
+	}
+	if query.Get(oidcLinkKey) == "true" {
+		var session *ttnpb.UserSession
+		r, session, err = s.session.Get(w, r)
+		if err != nil {
+			webhandlers.Error(w, r, errUnauthenticated.WithCause(err))
+			return
+		}
+		state.LinkUserID = session.GetUserIds().GetUserId()
+	}
+	url, err := provider.AuthCodeURL(
+		r.Context(), s.oidcRedirectURL(r, providerID), state.State, state.Nonce, state.Verifier,
+	)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := s.oidcStateCookie().Set(w, r, state); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+// OIDCCallback handles the redirect of the OpenID Connect provider after the user authorized the login.
+func (s *server) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+	provider, err := s.oidcProvider(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	providerID := provider.Config().ID
+	stateCookie := s.oidcStateCookie()
+	var state oidcState
+	ok, err := stateCookie.Get(w, r, &state)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	stateCookie.Remove(w, r)
+	query := r.URL.Query()
+	if !ok || state.Provider != providerID || state.State == "" || query.Get("state") != state.State {
+		webhandlers.Error(w, r, errOIDCState.New())
+		return
+	}
+	if errCode := query.Get("error"); errCode != "" {
+		webhandlers.Error(w, r, errOIDCAuthorization.WithAttributes(
+			"error", errCode,
+			"description", query.Get("error_description"),
+		))
+		return
+	}
+	code := query.Get("code")
+	if code == "" {
+		webhandlers.Error(w, r, errOIDCMissingCode.New())
+		return
+	}
+
+	ctx := r.Context()
+	claims, err := provider.Exchange(ctx, s.oidcRedirectURL(r, providerID), code, state.Verifier, state.Nonce)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+
+	var userIDs *ttnpb.UserIdentifiers
+	if state.LinkUserID != "" {
+		var session *ttnpb.UserSession
+		r, session, err = s.session.Get(w, r)
+		if err != nil {
+			webhandlers.Error(w, r, errUnauthenticated.WithCause(err))
+			return
+		}
+		if session.GetUserIds().GetUserId() != state.LinkUserID {
+			webhandlers.Error(w, r, errOIDCState.New())
+			return
+		}
+		userIDs, err = s.linkOIDCUser(ctx, providerID, claims, session.GetUserIds())
+	} else {
+		userIDs, err = s.findOrCreateOIDCUser(ctx, providerID, claims)
+	}
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"provider", providerID,
+		"user_uid", userIDs.GetUserId(),
+	))
+	if state.LinkUserID == "" {
+		// The upstream account replaces the password, not the second factor.
+		mfaEnabled, err := s.session.MFAEnabled(ctx, userIDs)
+		if err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		if mfaEnabled {
+			mfaState := &oidcMFAState{UserID: userIDs.GetUserId(), Next: state.Next}
+			if err := s.oidcMFACookie().Set(w, r, mfaState); err != nil {
+				webhandlers.Error(w, r, err)
+				return
+			}
+			logger.Debug("Require multi-factor authentication for login with OpenID Connect provider")
+			http.Redirect(w, r, fmt.Sprintf(
+				"%s/login?%s", strings.TrimSuffix(s.config.Mount, "/"), url.Values{
+					"mfa":   {"oidc"},
+					nextKey: {state.Next},
+				}.Encode(),
+			), http.StatusFound)
+			return
+		}
+	}
+	logger.Debug("Log in with OpenID Connect provider")
+	if err := s.CreateUserSession(w, r, userIDs); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	http.Redirect(w, r, state.Next, http.StatusFound)
+}
+
+// OIDCMFA completes an OpenID Connect login of a user that enabled multi-factor authentication.
+func (s *server) OIDCMFA(w http.ResponseWriter, r *http.Request) {
+	req, err := s.decodeMFACodeRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	mfaCookie := s.oidcMFACookie()
+	var state oidcMFAState
+	ok, err := mfaCookie.Get(w, r, &state)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if !ok || state.UserID == "" {
+		webhandlers.Error(w, r, errOIDCMFAState.New())
+		return
+	}
+	ctx := r.Context()
+	userIDs := &ttnpb.UserIdentifiers{UserId: state.UserID}
+	if err := s.session.VerifyMFA(ctx, userIDs, strings.TrimSpace(req.Code)); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	mfaCookie.Remove(w, r)
+	if err := s.CreateUserSession(w, r, userIDs); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// linkOIDCUser links the upstream account to the given user.
+func (s *server) linkOIDCUser(
+	ctx context.Context, providerID string, claims *oidc.Claims, userIDs *ttnpb.UserIdentifiers,
+) (*ttnpb.UserIdentifiers, error) {
+	err := s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+		linked, err := st.GetUserIDsByOIDCSubject(ctx, providerID, claims.Subject)
+		switch {
+		case err == nil:
+			if linked.GetUserId() != userIDs.GetUserId() {
+				return errOIDCLinkedToOther.WithAttributes("provider", providerID)
+			}
+			return nil
+		case !errors.IsNotFound(err):
+			return err
+		}
+		if err := st.CreateUserOIDCLink(ctx, userIDs, providerID, claims.Subject); err != nil {
+			if errors.IsAlreadyExists(err) {
+				return errOIDCAlreadyLinked.WithAttributes("provider", providerID)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// findOrCreateOIDCUser returns the user that is linked to the upstream account.
+// If there is no such user, the user is created if provisioning is enabled.
+func (s *server) findOrCreateOIDCUser(
+	ctx context.Context, providerID string, claims *oidc.Claims,
+) (userIDs *ttnpb.UserIdentifiers, err error) {
+	config := s.configFromContext(ctx).OIDC
+	err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+		linked, err := st.GetUserIDsByOIDCSubject(ctx, providerID, claims.Subject)
+		switch {
+		case err == nil:
+			userIDs = linked
+			return nil
+		case !errors.IsNotFound(err):
+			return err
+		}
+		if claims.Email == "" {
+			return errOIDCMissingEmail.WithAttributes("provider", providerID)
+		}
+		if _, err := st.GetUserByPrimaryEmailAddress(ctx, claims.Email, []string{"ids"}); err == nil {
+			return errOIDCNotLinked.WithAttributes("provider", providerID)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+		registration := config.UserRegistration
+		if !config.Provision || !registration.Enabled || registration.InvitationRequired {
+			return errOIDCRegistrationDisabled.WithAttributes("provider", providerID)
+		}
+		userID, err := s.availableOIDCUserID(ctx, st, claims)
+		if err != nil {
+			return err
+		}
+		hashedPassword, err := auth.Hash(ctx, random.String(64))
+		if err != nil {
+			return err
+		}
+		now := timestamppb.Now()
+		usr := &ttnpb.User{
+			Ids:                 &ttnpb.UserIdentifiers{UserId: userID},
+			Name:                claims.Name,
+			PrimaryEmailAddress: claims.Email,
+			Password:            hashedPassword,
+			PasswordUpdatedAt:   now,
+			State:               ttnpb.State_STATE_APPROVED,
+		}
+		if registration.AdminApprovalRequired {
+			usr.State = ttnpb.State_STATE_REQUESTED
+			usr.StateDescription = "admin approval required"
+		}
+		if claims.EmailVerified {
+			usr.PrimaryEmailAddressValidatedAt = now
+		}
+		if err := usr.ValidateFields(
+			"ids", "name", "primary_email_address",
+		); err != nil {
+			return err
+		}
+		usr, err = st.CreateUser(ctx, usr)
+		if err != nil {
+			return err
+		}
+		if err := st.CreateUserOIDCLink(ctx, usr.GetIds(), providerID, claims.Subject); err != nil {
+			if errors.IsAlreadyExists(err) {
+				return errOIDCLinkedToOther.WithAttributes("provider", providerID)
+			}
+			return err
+		}
+		userIDs = usr.GetIds()
+		log.FromContext(ctx).WithFields(log.Fields(
+			"provider", providerID,
+			"user_uid", userID,
+		)).Info("Created user for OpenID Connect provider")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+const maxOIDCUserIDAttempts = 10
+
+// availableOIDCUserID derives a user ID from the preferred username or the email address in the claims,
+// and appends a number if the user ID is taken.
+func (*server) availableOIDCUserID(ctx context.Context, st store.Interface, claims *oidc.Claims) (string, error) {
+	base := sanitizeUserID(claims.PreferredUsername)
+	if base == "" {
+		localPart, _, _ := strings.Cut(claims.Email, "@")
+		base = sanitizeUserID(localPart)
+	}
+	if base == "" {
+		return "", errOIDCUserID.New()
+	}
+	ctx = store.WithSoftDeleted(ctx, false)
+	for i := 1; i <= maxOIDCUserIDAttempts; i++ {
+		userID := base
+		if i > 1 {
+			suffix := fmt.Sprintf("-%d", i)
+			userID = strings.TrimSuffix(base[:min(len(base), maxUserIDLength-len(suffix))], "-") + suffix
+		}
+		ids := &ttnpb.UserIdentifiers{UserId: userID}
+		if err := ids.ValidateFields("user_id"); err != nil {
+			return "", errOIDCUserID.WithCause(err)
+		}
+		_, err := st.GetUser(ctx, ids, []string{"ids"})
+		if errors.IsNotFound(err) {
+			return userID, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", errOIDCUserID.New()
+}
+
+const maxUserIDLength = 36
+
+// sanitizeUserID converts the given value to a valid user ID, or returns an empty string if that is not possible.
+func sanitizeUserID(v string) string {
+	var b strings.Builder
+	dash := false
+	runes := []rune(strings.ToLower(v))
+	for i := 0; i < len(runes) && b.Len() < maxUserIDLength; i++ {
+		switch r := runes[i]; {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+	id := strings.TrimSuffix(b.String()[:min(b.Len(), maxUserIDLength)], "-")
+	if len(id) < 2 {
+		return ""
+	}
+	return id
+}
@@ -0,0 +1,290 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oidc implements an OpenID Connect relying party, for logging in with external identity providers.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// DefaultScopes are the scopes that are requested when no scopes are configured.
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// keySetRefreshInterval is the minimum interval between refreshes of the key set of a provider.
+const keySetRefreshInterval = time.Minute
+
+// clockSkew is the allowed clock skew when validating ID tokens.
+const clockSkew = time.Minute
+
+// ProviderConfig is the configuration of an OpenID Connect provider.
+type ProviderConfig struct {
+	// ID is the identifier of the provider.
+	ID string
+	// Name is the display name of the provider.
+	Name string
+	// Issuer is the issuer URL of the provider. The provider configuration is discovered from the issuer.
+	Issuer string
+	// ClientID is the OAuth client ID at the provider.
+	ClientID string
+	// ClientSecret is the OAuth client secret at the provider.
+	ClientSecret string
+	// Scopes are the requested scopes. DefaultScopes are requested if empty.
+	Scopes []string
+}
+
+// Claims are the claims of an ID token.
+type Claims struct {
+	jwt.Claims
+	AuthorizedParty   string `json:"azp,omitempty"`
+	Nonce             string `json:"nonce,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect provider.
+type Provider struct {
+	config     ProviderConfig
+	httpClient *http.Client
+
+	mu              sync.Mutex
+	discovery       *discoveryDocument
+	keySet          *jose.JSONWebKeySet
+	keySetFetchedAt time.Time
+}
+
+// NewProvider returns a new OpenID Connect provider. The provider configuration is discovered on first use.
+func NewProvider(config ProviderConfig, httpClient *http.Client) *Provider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+	return &Provider{
+		config:     config,
+		httpClient: httpClient,
+	}
+}
+
+// Config returns the configuration of the provider.
+func (p *Provider) Config() ProviderConfig {
+	return p.config
+}
+
+const (
+	wellKnownPath  = "/.well-known/openid-configuration"
+	maxPayloadSize = 1 << 20
+)
+
+// allowedAlgorithms are the allowed ID token signing algorithms.
+var allowedAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+var (
+	errDiscovery  = errors.DefineUnavailable("discovery", "discover OpenID Connect provider `{issuer}`")
+	errIssuer     = errors.DefineUnavailable("issuer", "OpenID Connect provider issuer `{issuer}` does not match")
+	errKeySet     = errors.DefineUnavailable("key_set", "fetch key set of OpenID Connect provider `{issuer}`")
+	errHTTPStatus = errors.DefineUnavailable("http_status", "unexpected HTTP status `{status}`")
+	errExchange   = errors.DefinePermissionDenied("exchange", "token exchange refused by OpenID Connect provider")
+	errNoIDToken  = errors.DefinePermissionDenied("no_id_token", "no ID token in token response")
+	errIDToken    = errors.DefinePermissionDenied("id_token", "invalid ID token")
+	errAlgorithm  = errors.DefinePermissionDenied("algorithm", "ID token signing algorithm `{alg}` is not allowed")
+	errSigningKey = errors.DefinePermissionDenied("signing_key", "unknown ID token signing key `{kid}`")
+	errAuthorized = errors.DefinePermissionDenied("authorized_party", "ID token is not issued to this client")
+	errNonce      = errors.DefinePermissionDenied("nonce", "ID token nonce does not match")
+	errNoSubject  = errors.DefinePermissionDenied("no_subject", "no subject in ID token")
+)
+
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errHTTPStatus.WithAttributes("status", res.Status)
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, maxPayloadSize)).Decode(v)
+}
+
+// discover returns the discovery document of the provider. The caller must hold the lock.
+func (p *Provider) discover(ctx context.Context) (*discoveryDocument, error) {
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	issuer := strings.TrimSuffix(p.config.Issuer, "/")
+	doc := &discoveryDocument{}
+	if err := p.getJSON(ctx, issuer+wellKnownPath, doc); err != nil {
+		return nil, errDiscovery.WithAttributes("issuer", p.config.Issuer).WithCause(err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, errIssuer.WithAttributes("issuer", doc.Issuer)
+	}
+	p.discovery = doc
+	return doc, nil
+}
+
+func (p *Provider) oauth2Config(ctx context.Context, redirectURL string) (*oauth2.Config, error) {
+	p.mu.Lock()
+	doc, err := p.discover(ctx)
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  doc.AuthorizationEndpoint,
+			TokenURL: doc.TokenEndpoint,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      p.config.Scopes,
+	}, nil
+}
+
+// key returns the issuer and the signing key with the given key ID.
+// The key set is refreshed if the key is not found, at most once per keySetRefreshInterval.
+func (p *Provider) key(ctx context.Context, kid string) (string, *jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if p.keySet != nil {
+		if keys := p.keySet.Key(kid); len(keys) > 0 {
+			return doc.Issuer, &keys[0], nil
+		}
+		if time.Since(p.keySetFetchedAt) < keySetRefreshInterval {
+			return "", nil, errSigningKey.WithAttributes("kid", kid)
+		}
+	}
+	keySet := &jose.JSONWebKeySet{}
+	if err := p.getJSON(ctx, doc.JWKSURI, keySet); err != nil {
+		return "", nil, errKeySet.WithAttributes("issuer", p.config.Issuer).WithCause(err)
+	}
+	p.keySet, p.keySetFetchedAt = keySet, time.Now()
+	if keys := p.keySet.Key(kid); len(keys) > 0 {
+		return doc.Issuer, &keys[0], nil
+	}
+	return "", nil, errSigningKey.WithAttributes("kid", kid)
+}
+
+// AuthCodeURL returns the URL of the authorization endpoint of the provider.
+// The verifier is the PKCE code verifier, of which the S256 challenge is sent to the provider.
+func (p *Provider) AuthCodeURL(ctx context.Context, redirectURL, state, nonce, verifier string) (string, error) {
+	conf, err := p.oauth2Config(ctx, redirectURL)
+	if err != nil {
+		return "", err
+	}
+	return conf.AuthCodeURL(
+		state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.S256ChallengeOption(verifier),
+	), nil
+}
+
+// Exchange exchanges the authorization code for tokens, and returns the claims of the verified ID token.
+func (p *Provider) Exchange(ctx context.Context, redirectURL, code, verifier, nonce string) (*Claims, error) {
+	conf, err := p.oauth2Config(ctx, redirectURL)
+	if err != nil {
+		return nil, err
+	}
+	token, err := conf.Exchange(
+		context.WithValue(ctx, oauth2.HTTPClient, p.httpClient), code, oauth2.VerifierOption(verifier),
+	)
+	if err != nil {
+		return nil, errExchange.WithCause(err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errNoIDToken.New()
+	}
+	return p.Verify(ctx, rawIDToken, nonce)
+}
+
+func isAllowedAlgorithm(alg string) bool {
+	for _, allowed := range allowedAlgorithms {
+		if alg == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Verify verifies the ID token and returns its claims.
+func (p *Provider) Verify(ctx context.Context, rawIDToken, nonce string) (*Claims, error) {
+	token, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, errIDToken.WithCause(err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, errIDToken.New()
+	}
+	header := token.Headers[0]
+	if !isAllowedAlgorithm(header.Algorithm) {
+		return nil, errAlgorithm.WithAttributes("alg", header.Algorithm)
+	}
+	issuer, key, err := p.key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	claims := &Claims{}
+	if err := token.Claims(key.Key, claims); err != nil {
+		return nil, errIDToken.WithCause(err)
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:   issuer,
+		Audience: jwt.Audience{p.config.ClientID},
+		Time:     time.Now(),
+	}, clockSkew); err != nil {
+		return nil, errIDToken.WithCause(err)
+	}
+	if claims.Expiry == nil {
+		return nil, errIDToken.New()
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, errAuthorized.New()
+	}
+	if claims.Nonce != nonce {
+		return nil, errNonce.New()
+	}
+	if claims.Subject == "" {
+		return nil, errNoSubject.New()
+	}
+	return claims, nil
+}
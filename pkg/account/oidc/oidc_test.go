@@ -0,0 +1,325 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/oidc"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/oidc/oidctest"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const redirectURL = "https://example.com/oauth/oidc/mock/callback"
+
+func newProvider(t *testing.T) (*oidctest.Provider, *oidc.Provider) {
+	t.Helper()
+	mock, err := oidctest.New("client", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mock.Close)
+	return mock, oidc.NewProvider(oidc.ProviderConfig{
+		ID:           "mock",
+		Issuer:       mock.Issuer(),
+		ClientID:     "client",
+		ClientSecret: "secret",
+	}, mock.Client())
+}
+
+// authorize follows the authorization URL and returns the authorization code.
+func authorize(t *testing.T, mock *oidctest.Provider, authCodeURL, state string) string {
+	t.Helper()
+	a := assertions.New(t)
+	client := mock.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	res, err := client.Get(authCodeURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if !a.So(res.StatusCode, should.Equal, http.StatusFound) {
+		t.FailNow()
+	}
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.So(location.Query().Get("state"), should.Equal, state)
+	return location.Query().Get("code")
+}
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	mock, provider := newProvider(t)
+	mock.SetUser(oidctest.User{
+		Subject:           "upstream-user",
+		Email:             "user@example.com",
+		EmailVerified:     true,
+		Name:              "Upstream User",
+		PreferredUsername: "user",
+	})
+
+	verifier := oauth2.GenerateVerifier()
+	authCodeURL, err := provider.AuthCodeURL(ctx, redirectURL, "state", "nonce", verifier)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	u, err := url.Parse(authCodeURL)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	query := u.Query()
+	a.So(query.Get("client_id"), should.Equal, "client")
+	a.So(query.Get("redirect_uri"), should.Equal, redirectURL)
+	a.So(query.Get("scope"), should.Equal, "openid email profile")
+	a.So(query.Get("nonce"), should.Equal, "nonce")
+	a.So(query.Get("code_challenge"), should.Equal, oauth2.S256ChallengeFromVerifier(verifier))
+	a.So(query.Get("code_challenge_method"), should.Equal, "S256")
+
+	t.Run("Exchange", func(t *testing.T) {
+		a := assertions.New(t)
+		code := authorize(t, mock, authCodeURL, "state")
+		claims, err := provider.Exchange(ctx, redirectURL, code, verifier, "nonce")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(claims.Subject, should.Equal, "upstream-user")
+		a.So(claims.Email, should.Equal, "user@example.com")
+		a.So(claims.EmailVerified, should.BeTrue)
+		a.So(claims.Name, should.Equal, "Upstream User")
+		a.So(claims.PreferredUsername, should.Equal, "user")
+	})
+
+	t.Run("ExchangeWrongVerifier", func(t *testing.T) {
+		a := assertions.New(t)
+		code := authorize(t, mock, authCodeURL, "state")
+		_, err := provider.Exchange(ctx, redirectURL, code, oauth2.GenerateVerifier(), "nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("ExchangeWrongNonce", func(t *testing.T) {
+		a := assertions.New(t)
+		code := authorize(t, mock, authCodeURL, "state")
+		_, err := provider.Exchange(ctx, redirectURL, code, verifier, "other-nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	_, ctx := test.New(t)
+	mock, provider := newProvider(t)
+	user := oidctest.User{Subject: "upstream-user"}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSigner, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: otherKey},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "other-key"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hmacSigner, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.HS256, Key: []byte("secret")},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", oidctest.KeyID),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		Token       func(t *testing.T) string
+		Nonce       string
+		ErrorAssert func(error) bool
+	}{
+		{
+			Name: "Valid",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				token, err := mock.Sign(mock.IDTokenClaims(user, "nonce"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce: "nonce",
+		},
+		{
+			Name: "WrongAudience",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				claims := mock.IDTokenClaims(user, "nonce")
+				claims["aud"] = "other-client"
+				token, err := mock.Sign(claims)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "OtherAuthorizedParty",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				claims := mock.IDTokenClaims(user, "nonce")
+				claims["aud"] = []string{"client", "other-client"}
+				claims["azp"] = "other-client"
+				token, err := mock.Sign(claims)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "WrongIssuer",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				claims := mock.IDTokenClaims(user, "nonce")
+				claims["iss"] = "https://attacker.example.com"
+				token, err := mock.Sign(claims)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "Expired",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				claims := mock.IDTokenClaims(user, "nonce")
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+				token, err := mock.Sign(claims)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "WrongNonce",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				token, err := mock.Sign(mock.IDTokenClaims(user, "other-nonce"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "NoSubject",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				token, err := mock.Sign(mock.IDTokenClaims(oidctest.User{}, "nonce"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "UnknownKey",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				token, err := jwt.Signed(otherSigner).Claims(mock.IDTokenClaims(user, "nonce")).CompactSerialize()
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "SymmetricAlgorithm",
+			Token: func(t *testing.T) string {
+				t.Helper()
+				token, err := jwt.Signed(hmacSigner).Claims(mock.IDTokenClaims(user, "nonce")).CompactSerialize()
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+		{
+			Name: "Malformed",
+			Token: func(*testing.T) string {
+				return "not-a-token"
+			},
+			Nonce:       "nonce",
+			ErrorAssert: errors.IsPermissionDenied,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			claims, err := provider.Verify(ctx, tc.Token(t), tc.Nonce)
+			if tc.ErrorAssert != nil {
+				a.So(tc.ErrorAssert(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(claims.Subject, should.Equal, "upstream-user")
+		})
+	}
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	mock, _ := newProvider(t)
+	provider := oidc.NewProvider(oidc.ProviderConfig{
+		ID:       "mock",
+		Issuer:   mock.Issuer() + "/other",
+		ClientID: "client",
+	}, mock.Client())
+	_, err := provider.AuthCodeURL(ctx, redirectURL, "state", "nonce", oauth2.GenerateVerifier())
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+}
@@ -0,0 +1,250 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oidctest implements a mock OpenID Connect provider for testing.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// KeyID is the ID of the signing key of the mock provider.
+const KeyID = "mock-key"
+
+// User is the user that is logged in at the mock provider.
+type User struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+type authorization struct {
+	redirectURI string
+	nonce       string
+	challenge   string
+	user        User
+}
+
+// Provider is a mock OpenID Connect provider.
+// Authorization requests are granted immediately for the current user.
+type Provider struct {
+	*httptest.Server
+
+	ClientID     string
+	ClientSecret string
+
+	key *rsa.PrivateKey
+
+	mu             sync.Mutex
+	user           User
+	authorizations map[string]authorization
+}
+
+// New starts a new mock OpenID Connect provider. The caller must close the provider.
+func New(clientID, clientSecret string) (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	p := &Provider{
+		ClientID:       clientID,
+		ClientSecret:   clientSecret,
+		key:            key,
+		authorizations: make(map[string]authorization),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/jwks", p.handleJWKS)
+	mux.HandleFunc("/authorize", p.handleAuthorize)
+	mux.HandleFunc("/token", p.handleToken)
+	p.Server = httptest.NewServer(mux)
+	return p, nil
+}
+
+// Issuer returns the issuer URL of the provider.
+func (p *Provider) Issuer() string {
+	return p.URL
+}
+
+// SetUser sets the user that is logged in at the provider.
+func (p *Provider) SetUser(user User) {
+	p.mu.Lock()
+	p.user = user
+	p.mu.Unlock()
+}
+
+// IDTokenClaims returns the claims of an ID token for the given user.
+func (p *Provider) IDTokenClaims(user User, nonce string) map[string]any {
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   p.Issuer(),
+		"sub":   user.Subject,
+		"aud":   p.ClientID,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"nonce": nonce,
+	}
+	if user.Email != "" {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.EmailVerified
+	}
+	if user.Name != "" {
+		claims["name"] = user.Name
+	}
+	if user.PreferredUsername != "" {
+		claims["preferred_username"] = user.PreferredUsername
+	}
+	return claims
+}
+
+// Sign signs the claims with the signing key of the provider.
+func (p *Provider) Sign(claims any) (string, error) {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: p.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", KeyID),
+	)
+	if err != nil {
+		return "", err
+	}
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+func (p *Provider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                p.Issuer(),
+		"authorization_endpoint":                p.URL + "/authorize",
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *Provider) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{
+			Key:       &p.key.PublicKey,
+			KeyID:     KeyID,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}},
+	})
+}
+
+func (p *Provider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("response_type") != "code" || query.Get("client_id") != p.ClientID {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	if query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "PKCE required", http.StatusBadRequest)
+		return
+	}
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		http.Error(w, "invalid redirect URI", http.StatusBadRequest)
+		return
+	}
+	code := fmt.Sprintf("code-%d", time.Now().UnixNano())
+	p.mu.Lock()
+	p.authorizations[code] = authorization{
+		redirectURI: redirectURI.String(),
+		nonce:       query.Get("nonce"),
+		challenge:   query.Get("code_challenge"),
+		user:        p.user,
+	}
+	p.mu.Unlock()
+	redirectQuery := redirectURI.Query()
+	redirectQuery.Set("code", code)
+	redirectQuery.Set("state", query.Get("state"))
+	redirectURI.RawQuery = redirectQuery.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": code}) //nolint:errcheck
+}
+
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request")
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != p.ClientID || clientSecret != p.ClientSecret {
+		tokenError(w, "invalid_client")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type")
+		return
+	}
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	auth, ok := p.authorizations[code]
+	delete(p.authorizations, code)
+	p.mu.Unlock()
+	if !ok || auth.redirectURI != r.PostForm.Get("redirect_uri") {
+		tokenError(w, "invalid_grant")
+		return
+	}
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(challenge[:]) != auth.challenge {
+		tokenError(w, "invalid_grant")
+		return
+	}
+	idToken, err := p.Sign(p.IDTokenClaims(auth.user, auth.nonce))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, map[string]any{
+		"access_token": "mock-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
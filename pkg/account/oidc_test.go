@@ -0,0 +1,292 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/account"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/oidc/oidctest"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
+	is_store "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/webui"
+	"golang.org/x/net/publicsuffix"
+)
+
+func TestOIDC(t *testing.T) {
+	mock, err := oidctest.New("client", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.Close()
+	mock.SetUser(oidctest.User{
+		Subject:           "upstream-user",
+		Email:             "upstream@example.com",
+		EmailVerified:     true,
+		Name:              "Upstream User",
+		PreferredUsername: "Upstream.User",
+	})
+	mockClient := mock.Client()
+	mockClient.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+
+	store := &mockStore{}
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s, err := account.NewServer(c, store, oauth.Config{
+		Mount:       "/oauth",
+		CSRFAuthKey: []byte("12345678123456781234567812345678"),
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "Account",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		OIDC: oauth.OIDCConfig{
+			Issuers:       map[string]string{"mock": mock.Issuer()},
+			ClientIDs:     map[string]string{"mock": "client"},
+			ClientSecrets: map[string]string{"mock": "secret"},
+			Provision:     true,
+			UserRegistration: oauth.OIDCUserRegistrationConfig{
+				Enabled: true,
+			},
+		},
+	}, identityserver.GenerateCSPString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	serve := func(t *testing.T, target string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		for _, c := range jar.Cookies(req.URL) {
+			req.AddCookie(c)
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		if cookies := res.Result().Cookies(); len(cookies) > 0 {
+			jar.SetCookies(req.URL, cookies)
+		}
+		return res
+	}
+
+	// login starts the login at the account app, authorizes at the provider and returns the callback response.
+	login := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		a := assertions.New(t)
+		res := serve(t, "/oauth/oidc/mock/login?"+query)
+		if !a.So(res.Code, should.Equal, http.StatusFound) {
+			t.FailNow()
+		}
+		authorizeRes, err := mockClient.Get(res.Header().Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		authorizeRes.Body.Close()
+		if !a.So(authorizeRes.StatusCode, should.Equal, http.StatusFound) {
+			t.FailNow()
+		}
+		callback, err := url.Parse(authorizeRes.Header.Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.So(callback.Path, should.Equal, "/oauth/oidc/mock/callback")
+		return serve(t, callback.RequestURI())
+	}
+
+	t.Run("UnknownProvider", func(t *testing.T) {
+		store.reset()
+		res := serve(t, "/oauth/oidc/other/login")
+		assertions.New(t).So(res.Code, should.Equal, http.StatusNotFound)
+	})
+
+	t.Run("InvalidState", func(t *testing.T) {
+		store.reset()
+		a := assertions.New(t)
+		res := serve(t, "/oauth/oidc/mock/login")
+		a.So(res.Code, should.Equal, http.StatusFound)
+		res = serve(t, "/oauth/oidc/mock/callback?code=code&state=other")
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(store.calls, should.BeEmpty)
+	})
+
+	t.Run("EmailNotLinked", func(t *testing.T) {
+		store.reset()
+		store.err.getOIDCLink = mockErrNotFound
+		store.res.emailUser = mockUser
+		a := assertions.New(t)
+		res := login(t, "")
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(store.req.email, should.Equal, "upstream@example.com")
+		a.So(store.calls, should.NotContain, "CreateUser")
+		a.So(store.calls, should.NotContain, "CreateSession")
+	})
+
+	t.Run("Provision", func(t *testing.T) {
+		store.reset()
+		store.err.getOIDCLink = mockErrNotFound
+		store.err.getByEmail = mockErrNotFound
+		store.err.getUser = mockErrNotFound
+		store.res.session = &ttnpb.UserSession{
+			UserIds:   &ttnpb.UserIdentifiers{UserId: "upstream-user"},
+			SessionId: "session_id",
+		}
+		a := assertions.New(t)
+		res := login(t, "n=/oauth/next")
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("Location"), should.Equal, "/oauth/next")
+		a.So(store.calls, should.Contain, "CreateUser")
+		if usr := store.req.user; a.So(usr, should.NotBeNil) {
+			a.So(usr.GetIds().GetUserId(), should.Equal, "upstream-user")
+			a.So(usr.Name, should.Equal, "Upstream User")
+			a.So(usr.PrimaryEmailAddress, should.Equal, "upstream@example.com")
+			a.So(usr.PrimaryEmailAddressValidatedAt, should.NotBeNil)
+			a.So(usr.Password, should.NotBeEmpty)
+			a.So(usr.State, should.Equal, ttnpb.State_STATE_APPROVED)
+			a.So(usr.Attributes, should.BeEmpty)
+		}
+		a.So(store.calls, should.Contain, "CreateUserOIDCLink")
+		a.So(store.req.provider, should.Equal, "mock")
+		a.So(store.req.subject, should.Equal, "upstream-user")
+		a.So(store.calls, should.Contain, "CreateSession")
+	})
+
+	t.Run("Login", func(t *testing.T) {
+		store.reset()
+		store.res.oidcUserIDs = mockUser.GetIds()
+		store.res.session = mockSession
+		a := assertions.New(t)
+		res := login(t, "n=https://attacker.example.com")
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("Location"), should.Equal, "/oauth")
+		a.So(store.req.session.GetUserIds().GetUserId(), should.Equal, "user")
+		a.So(store.calls, should.NotContain, "CreateUser")
+	})
+
+	t.Run("Link", func(t *testing.T) {
+		store.reset()
+		store.err.getOIDCLink = mockErrNotFound
+		store.res.session = mockSession
+		a := assertions.New(t)
+		res := login(t, "link=true")
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(store.calls, should.Contain, "CreateUserOIDCLink")
+		a.So(store.req.userIDs.GetUserId(), should.Equal, "user")
+		a.So(store.req.provider, should.Equal, "mock")
+		a.So(store.req.subject, should.Equal, "upstream-user")
+		a.So(store.calls, should.NotContain, "UpdateUser")
+	})
+
+	t.Run("AlreadyLinked", func(t *testing.T) {
+		store.reset()
+		store.err.getOIDCLink = mockErrNotFound
+		store.err.createOIDCLink = mockErrAlreadyExists
+		store.res.session = mockSession
+		a := assertions.New(t)
+		res := login(t, "link=true")
+		a.So(res.Code, should.Equal, http.StatusConflict)
+	})
+
+	t.Run("LinkedToOther", func(t *testing.T) {
+		store.reset()
+		store.res.session = mockSession
+		store.res.oidcUserIDs = &ttnpb.UserIdentifiers{UserId: "other-user"}
+		a := assertions.New(t)
+		res := login(t, "link=true")
+		a.So(res.Code, should.Equal, http.StatusConflict)
+		a.So(store.calls, should.NotContain, "CreateUserOIDCLink")
+	})
+
+	t.Run("MFA", func(t *testing.T) {
+		store.reset()
+		enabledAt := time.Now()
+		secret := []byte("12345678901234567890")
+		store.res.oidcUserIDs = mockUser.GetIds()
+		store.res.session = mockSession
+		store.res.mfa = &is_store.UserMFA{TOTPSecret: secret, EnabledAt: &enabledAt}
+		a := assertions.New(t)
+		res := login(t, "n=/oauth/next")
+		a.So(res.Code, should.Equal, http.StatusFound)
+		location, err := url.Parse(res.Header().Get("Location"))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(location.Path, should.Equal, "/oauth/login")
+		a.So(location.Query().Get("mfa"), should.Equal, "oidc")
+		a.So(location.Query().Get("n"), should.Equal, "/oauth/next")
+		a.So(store.calls, should.NotContain, "CreateSession")
+
+		mfa := func(t *testing.T, code string) *httptest.ResponseRecorder {
+			t.Helper()
+			body, err := json.Marshal(map[string]string{"code": code})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/oauth/api/auth/oidc/mfa", bytes.NewReader(body))
+			req.URL.Scheme, req.URL.Host = "http", req.Host
+			req.Header.Set("Content-Type", "application/json")
+			for _, c := range jar.Cookies(req.URL) {
+				req.AddCookie(c)
+			}
+			res := httptest.NewRecorder()
+			c.ServeHTTP(res, req)
+			if cookies := res.Result().Cookies(); len(cookies) > 0 {
+				jar.SetCookies(req.URL, cookies)
+			}
+			return res
+		}
+
+		res = mfa(t, "000000")
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(store.calls, should.NotContain, "CreateSession")
+
+		res = mfa(t, totp.Code(secret, totp.Step(time.Now())))
+		a.So(res.Code, should.Equal, http.StatusNoContent)
+		a.So(store.req.session.GetUserIds().GetUserId(), should.Equal, "user")
+
+		res = mfa(t, totp.Code(secret, totp.Step(time.Now())))
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+	})
+}
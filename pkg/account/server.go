@@ -17,10 +17,13 @@ package account
 import (
 	"context"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/gorilla/csrf"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/oidc"
 	sess "go.thethings.network/lorawan-stack/v3/pkg/account/session"
 	account_store "go.thethings.network/lorawan-stack/v3/pkg/account/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -49,6 +52,7 @@ type server struct {
 	session       sess.Session
 	generateCSP   func(config *oauth.Config, nonce string) string
 	schemaDecoder *schema.Decoder
+	oidcProviders map[string]*oidc.Provider
 }
 
 type sessionStore struct {
@@ -66,7 +70,7 @@ func NewServer(c *component.Component, store account_store.TransactionalInterfac
 		c:             c,
 		config:        config,
 		store:         store,
-		session:       sess.Session{Store: &sessionStore{store}},
+		session:       sess.Session{Store: &sessionStore{store}, KeyService: c.KeyService()},
 		generateCSP:   cspFunc,
 		schemaDecoder: schema.NewDecoder(),
 	}
@@ -76,9 +80,50 @@ func NewServer(c *component.Component, store account_store.TransactionalInterfac
 		s.config.Mount = s.config.UI.MountPath()
 	}
 
+	if err := s.initOIDCProviders(); err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
+func (s *server) initOIDCProviders() error {
+	issuers := s.config.OIDC.Issuers
+	if len(issuers) == 0 {
+		return nil
+	}
+	httpClient, err := s.c.HTTPClient(s.c.Context())
+	if err != nil {
+		return err
+	}
+	s.oidcProviders = make(map[string]*oidc.Provider, len(issuers))
+	providers := make([]oauth.OIDCProvider, 0, len(issuers))
+	for id, issuer := range issuers {
+		if !oidcProviderIDRegexp.MatchString(id) {
+			return errOIDCProviderConfig.WithAttributes("provider", id)
+		}
+		config := oidc.ProviderConfig{
+			ID:           id,
+			Name:         s.config.OIDC.Names[id],
+			Issuer:       issuer,
+			ClientID:     s.config.OIDC.ClientIDs[id],
+			ClientSecret: s.config.OIDC.ClientSecrets[id],
+			Scopes:       strings.Fields(s.config.OIDC.Scopes[id]),
+		}
+		if config.Issuer == "" || config.ClientID == "" {
+			return errOIDCProviderConfig.WithAttributes("provider", id)
+		}
+		if config.Name == "" {
+			config.Name = id
+		}
+		s.oidcProviders[id] = oidc.NewProvider(config, httpClient)
+		providers = append(providers, oauth.OIDCProvider{ID: id, Name: config.Name})
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].ID < providers[j].ID })
+	s.config.UI.FrontendConfig.OIDCProviders = providers
+	return nil
+}
+
 type ctxKeyType struct{}
 
 var ctxKey ctxKeyType
@@ -138,11 +183,18 @@ func (s *server) RegisterRoutes(server *web.Server) {
 	api.Path("/auth/login").HandlerFunc(s.Login).Methods(http.MethodPost)
 	api.Path("/auth/token-login").HandlerFunc(s.TokenLogin).Methods(http.MethodPost)
 	api.Path("/auth/logout").Handler(logoutHandler).Methods(http.MethodPost)
+	api.Path("/auth/oidc/mfa").HandlerFunc(s.OIDCMFA).Methods(http.MethodPost)
 	api.Path("/me").Handler(currentUserHandler).Methods(http.MethodGet)
+	api.Path("/mfa").Handler(s.requireLogin(http.HandlerFunc(s.MFAStatus))).Methods(http.MethodGet)
+	api.Path("/mfa/totp").Handler(s.requireLogin(http.HandlerFunc(s.EnrollTOTP))).Methods(http.MethodPost)
+	api.Path("/mfa/totp/confirm").Handler(s.requireLogin(http.HandlerFunc(s.ConfirmTOTP))).Methods(http.MethodPost)
+	api.Path("/mfa/disable").Handler(s.requireLogin(http.HandlerFunc(s.DisableMFA))).Methods(http.MethodPost)
 
 	loginHandler := s.redirectToNext(webui.Template)
 	page := router.NewRoute().Subrouter()
 	page.Path("/login").Handler(loginHandler).Methods(http.MethodGet)
 	page.Path("/token-login").Handler(loginHandler).Methods(http.MethodGet)
+	page.Path("/oidc/{provider}/login").HandlerFunc(s.OIDCLogin).Methods(http.MethodGet)
+	page.Path("/oidc/{provider}/callback").HandlerFunc(s.OIDCCallback).Methods(http.MethodGet)
 	page.NewRoute().Handler(webui.Template)
 }
@@ -20,7 +20,9 @@ import (
 	"runtime/trace"
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/account/mfa"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
@@ -30,11 +32,16 @@ import (
 
 const authCookieName = "_session"
 
-var errIncorrectPasswordOrUserID = errors.DefineInvalidArgument("no_user_id_password_match", "incorrect password or user ID")
+var (
+	errIncorrectPasswordOrUserID = errors.DefineInvalidArgument("no_user_id_password_match", "incorrect password or user ID")
+	errMFARequired               = errors.DefineUnauthenticated("mfa_required", "multi-factor authentication code required")
+)
 
 // Session is the session helper.
 type Session struct {
 	Store TransactionalStore
+	// KeyService is used to decrypt the TOTP secrets of users.
+	KeyService crypto.KeyService
 }
 
 // Store used by the account app server.
@@ -42,6 +49,8 @@ type Store interface {
 	// UserStore and UserSessionStore are needed for user login/logout.
 	store.UserStore
 	store.UserSessionStore
+	// UserMFAStore is needed for multi-factor authentication on login.
+	store.UserMFAStore
 }
 
 // TransactionalStore is Store, but with a method that uses a transaction.
@@ -159,7 +168,8 @@ func (s *Session) GetUser(w http.ResponseWriter, r *http.Request) (*http.Request
 }
 
 // DoLogin performs the authentication using user id and password.
-func (s *Session) DoLogin(ctx context.Context, userID, password string) error {
+// If the user enabled multi-factor authentication, the MFA code is verified as well.
+func (s *Session) DoLogin(ctx context.Context, userID, password, mfaCode string) error {
 	ids := &ttnpb.UserIdentifiers{UserId: userID}
 	if err := ids.ValidateContext(ctx); err != nil {
 		return err
@@ -186,5 +196,62 @@ func (s *Session) DoLogin(ctx context.Context, userID, password string) error {
 		events.Publish(evtUserLoginFailed.NewWithIdentifiersAndData(ctx, user.GetIds(), nil))
 		return errIncorrectPasswordOrUserID.New()
 	}
+	return s.VerifyMFA(ctx, ids, mfaCode)
+}
+
+// VerifyMFA verifies the multi-factor authentication code of a user that is otherwise authenticated.
+// If the user did not enable multi-factor authentication, the code is not checked.
+func (s *Session) VerifyMFA(ctx context.Context, ids *ttnpb.UserIdentifiers, mfaCode string) error {
+	return s.Store.Transact(ctx, func(ctx context.Context, st Store) error {
+		return s.VerifyMFAInStore(ctx, st, ids, mfaCode)
+	})
+}
+
+// VerifyMFAInStore is VerifyMFA in the given store, so that it can be part of the transaction of the caller.
+func (s *Session) VerifyMFAInStore(ctx context.Context, st Store, ids *ttnpb.UserIdentifiers, mfaCode string) error {
+	if err := s.verifyMFA(ctx, st, ids, mfaCode); err != nil {
+		if !errors.Resemble(err, errMFARequired) {
+			events.Publish(evtUserLoginFailed.NewWithIdentifiersAndData(ctx, ids, nil))
+		}
+		return err
+	}
 	return nil
 }
+
+func (s *Session) verifyMFA(ctx context.Context, st Store, ids *ttnpb.UserIdentifiers, code string) error {
+	userMFA, err := st.GetUserMFA(ctx, ids)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !mfa.Enabled(userMFA) {
+		return nil
+	}
+	if code == "" {
+		return errMFARequired.New()
+	}
+	if err := mfa.Verify(ctx, s.KeyService, userMFA, code, time.Now()); err != nil {
+		return err
+	}
+	_, err = st.SetUserMFA(ctx, ids, userMFA)
+	return err
+}
+
+// MFAEnabled returns whether the user enabled multi-factor authentication.
+func (s *Session) MFAEnabled(ctx context.Context, ids *ttnpb.UserIdentifiers) (bool, error) {
+	var enabled bool
+	err := s.Store.Transact(ctx, func(ctx context.Context, st Store) error {
+		userMFA, err := st.GetUserMFA(ctx, ids)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		enabled = mfa.Enabled(userMFA)
+		return nil
+	})
+	return enabled, err
+}
@@ -26,6 +26,8 @@ type Interface interface {
 	store.UserStore
 	store.LoginTokenStore
 	store.UserSessionStore
+	store.UserMFAStore
+	store.UserOIDCLinkStore
 }
 
 // TransactionalStore is Interface, but with a method that uses a transaction.
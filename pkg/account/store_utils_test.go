@@ -33,18 +33,32 @@ type mockStoreContents struct {
 		sessionID string
 		userIDs   *ttnpb.UserIdentifiers
 		token     string
+		user      *ttnpb.User
+		provider  string
+		subject   string
+		email     string
+		mfa       *store.UserMFA
 	}
 	res struct {
 		session    *ttnpb.UserSession
 		user       *ttnpb.User
 		loginToken *ttnpb.LoginToken
+		// oidcUserIDs is returned by GetUserIDsByOIDCSubject, emailUser by GetUserByPrimaryEmailAddress.
+		oidcUserIDs *ttnpb.UserIdentifiers
+		emailUser   *ttnpb.User
+		mfa         *store.UserMFA
 	}
 	err struct {
-		getUser       error
-		createSession error
-		getSession    error
-		deleteSession error
-		loginToken    error
+		getUser        error
+		createSession  error
+		getSession     error
+		deleteSession  error
+		loginToken     error
+		getOIDCLink    error
+		createOIDCLink error
+		getByEmail     error
+		createUser     error
+		getMFA         error
 	}
 }
 
@@ -63,6 +77,7 @@ func (s *mockStore) reset() {
 var (
 	mockErrUnauthenticated = grpc.Errorf(codes.Unauthenticated, "Unauthenticated")
 	mockErrNotFound        = grpc.Errorf(codes.NotFound, "NotFound")
+	mockErrAlreadyExists   = grpc.Errorf(codes.AlreadyExists, "AlreadyExists")
 )
 
 func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask store.FieldMask) (*ttnpb.User, error) {
@@ -71,6 +86,54 @@ func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fiel
 	return s.res.user, s.err.getUser
 }
 
+func (s *mockStore) GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*store.UserMFA, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "GetUserMFA")
+	return s.res.mfa, s.err.getMFA
+}
+
+func (s *mockStore) SetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers, mfa *store.UserMFA) (*store.UserMFA, error) {
+	s.req.ctx, s.req.userIDs, s.req.mfa = ctx, userIDs, mfa
+	s.calls = append(s.calls, "SetUserMFA")
+	return mfa, nil
+}
+
+func (s *mockStore) DeleteUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "DeleteUserMFA")
+	return nil
+}
+
+func (s *mockStore) GetUserIDsByOIDCSubject(ctx context.Context, provider, subject string) (*ttnpb.UserIdentifiers, error) {
+	s.req.ctx, s.req.provider, s.req.subject = ctx, provider, subject
+	s.calls = append(s.calls, "GetUserIDsByOIDCSubject")
+	return s.res.oidcUserIDs, s.err.getOIDCLink
+}
+
+func (s *mockStore) CreateUserOIDCLink(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, subject string) error {
+	s.req.ctx, s.req.userIDs, s.req.provider, s.req.subject = ctx, userIDs, provider, subject
+	s.calls = append(s.calls, "CreateUserOIDCLink")
+	return s.err.createOIDCLink
+}
+
+func (s *mockStore) DeleteUserOIDCLinks(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "DeleteUserOIDCLinks")
+	return nil
+}
+
+func (s *mockStore) GetUserByPrimaryEmailAddress(ctx context.Context, email string, fieldMask store.FieldMask) (*ttnpb.User, error) {
+	s.req.ctx, s.req.email, s.req.fieldMask = ctx, email, fieldMask
+	s.calls = append(s.calls, "GetUserByPrimaryEmailAddress")
+	return s.res.emailUser, s.err.getByEmail
+}
+
+func (s *mockStore) CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+	s.req.ctx, s.req.user = ctx, usr
+	s.calls = append(s.calls, "CreateUser")
+	return usr, s.err.createUser
+}
+
 func (s *mockStore) CreateSession(ctx context.Context, sess *ttnpb.UserSession) (*ttnpb.UserSession, error) {
 	s.req.ctx, s.req.session = ctx, sess
 	s.calls = append(s.calls, "CreateSession")
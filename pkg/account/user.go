@@ -76,6 +76,7 @@ var (
 type loginRequest struct {
 	UserID   string `json:"user_id" schema:"user_id"`
 	Password string `json:"password" schema:"password"`
+	MFACode  string `json:"mfa_code" schema:"mfa_code"`
 }
 
 // ValidateContext validates the login request.
@@ -116,7 +117,7 @@ func (s *server) Login(w http.ResponseWriter, r *http.Request) {
 		webhandlers.Error(w, r, err)
 		return
 	}
-	if err := s.session.DoLogin(ctx, loginRequest.UserID, loginRequest.Password); err != nil {
+	if err := s.session.DoLogin(ctx, loginRequest.UserID, loginRequest.Password, loginRequest.MFACode); err != nil {
 		webhandlers.Error(w, r, err)
 		return
 	}
@@ -128,7 +129,8 @@ func (s *server) Login(w http.ResponseWriter, r *http.Request) {
 }
 
 type tokenLoginRequest struct {
-	Token string `json:"token" schema:"token"`
+	Token   string `json:"token" schema:"token"`
+	MFACode string `json:"mfa_code" schema:"mfa_code"`
 }
 
 var errMissingToken = errors.DefineInvalidArgument("missing_token", "missing token")
@@ -167,7 +169,14 @@ func (s *server) TokenLogin(w http.ResponseWriter, r *http.Request) {
 	var loginToken *ttnpb.LoginToken
 	err := s.store.Transact(ctx, func(ctx context.Context, st store.Interface) (err error) {
 		loginToken, err = st.ConsumeLoginToken(ctx, tokenLoginRequest.Token)
-		return err
+		if err != nil {
+			return err
+		}
+		// The token is only consumed when the multi-factor authentication succeeds, so that the login can be retried
+		// with the authentication code.
+		return s.session.VerifyMFAInStore(
+			ctx, st, loginToken.GetUserIds(), strings.TrimSpace(tokenLoginRequest.MFACode),
+		)
 	})
 	if err != nil {
 		webhandlers.Error(w, r, err)
@@ -30,8 +30,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/awsiot" // The AWS IoT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka"  // The Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"   // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats"   // The NATS integration provider
 	ioweb "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/lastseen"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata"
@@ -285,7 +287,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}
 
-	if as.webhooks, err = conf.Webhooks.NewWebhooks(ctx, as); err != nil {
+	if as.webhooks, err = conf.Webhooks.NewWebhooks(ctx, as, as.KeyService()); err != nil {
 		return nil, err
 	}
 
@@ -297,7 +299,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		return nil, err
 	}
 
-	if as.appPackages, err = conf.Packages.NewApplicationPackages(ctx, as); err != nil {
+	if as.appPackages, err = conf.Packages.NewApplicationPackages(ctx, as, as.KeyService(), conf.DeviceKEKLabel); err != nil {
 		return nil, err
 	}
 
@@ -320,14 +322,20 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			"/ttn.lorawan.v3.AppAs",
 			"/ttn.lorawan.v3.ApplicationWebhookRegistry",
 			"/ttn.lorawan.v3.ApplicationPubSubRegistry",
+			"/ttn.lorawan.v3.ApplicationUpStorage",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
 	}
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.NsAs", cluster.HookName, c.ClusterAuthUnaryHook())
 
-	c.GRPC.RegisterStreamHook("/ttn.lorawan.v3.AppAs", rpctracer.TracerHook, rpctracer.StreamTracerHook(tracerNamespace))
-	c.GRPC.RegisterStreamHook("/ttn.lorawan.v3.AppAs", rpclog.NamespaceHook, rpclog.StreamNamespaceHook(logNamespace))
+	for _, filter := range []string{
+		"/ttn.lorawan.v3.AppAs",
+		"/ttn.lorawan.v3.ApplicationUpStorage",
+	} {
+		c.GRPC.RegisterStreamHook(filter, rpctracer.TracerHook, rpctracer.StreamTracerHook(tracerNamespace))
+		c.GRPC.RegisterStreamHook(filter, rpclog.NamespaceHook, rpclog.StreamNamespaceHook(logNamespace))
+	}
 
 	c.RegisterGRPC(as)
 	c.RegisterWeb(as)
@@ -341,7 +349,10 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, as.grpc.asDevices)
 	ttnpb.RegisterAppAsServer(s, as.grpc.appAs)
 	if wh := as.webhooks; wh != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, ioweb.NewWebhookRegistryRPC(wh.Registry(), as.webhookTemplates))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, ioweb.NewWebhookRegistryRPC(
+			wh.Registry(), as.webhookTemplates, as.config.Webhooks.SigningSecrets(as.KeyService()),
+			as.config.Webhooks.DeliveryQueue.Queue,
+		))
 	}
 	if ps := as.pubsub; ps != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, ps)
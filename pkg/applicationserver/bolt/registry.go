@@ -0,0 +1,422 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides Application Server registries backed by an embedded bbolt database.
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"runtime/trace"
+	"strings"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errApplicationUID     = errors.DefineCorruption("application_uid", "invalid application UID `{application_uid}`")
+)
+
+// DeviceRegistry is a bbolt device registry.
+type DeviceRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the DeviceRegistry.
+func (r *DeviceRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (r *DeviceRegistry) uidKey(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
+	return ttnbolt.Key("eui", devEUI.String(), joinEUI.String())
+}
+
+// Get returns the end device by its identifiers.
+func (r *DeviceRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device").End()
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.GetProto(r.uidKey(unique.ID(ctx, ids)), pb); err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// Set creates, updates or deletes the end device by its identifiers.
+func (r *DeviceRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	gets []string,
+	f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	defer trace.StartRegion(ctx, "set end device").End()
+
+	var pb *ttnpb.EndDevice
+	err := r.Bolt.LockedWatch(ctx, uk, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.EndDevice{}
+		if err := tx.GetProto(uk, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		if stored != nil {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			if err != nil {
+				return err
+			}
+		} else {
+			pb = nil
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return errInvalidFieldmask.WithCause(err)
+		}
+
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			return err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			tx.Del(uk)
+			if stored.Ids.JoinEui != nil && stored.Ids.DevEui != nil {
+				tx.Del(r.euiKey(
+					types.MustEUI64(stored.Ids.JoinEui).OrZero(),
+					types.MustEUI64(stored.Ids.DevEui).OrZero(),
+				))
+			}
+			return nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.EndDevice{}
+		}
+		if pb.GetIds().GetApplicationIds().GetApplicationId() != ids.ApplicationIds.ApplicationId ||
+			pb.GetIds().GetDeviceId() != ids.DeviceId {
+			return errInvalidIdentifiers.New()
+		}
+
+		pb.UpdatedAt = timestamppb.Now()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.EndDevice{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.device_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+			if err != nil {
+				return err
+			}
+			if updated.Ids.ApplicationIds.ApplicationId != ids.ApplicationIds.ApplicationId ||
+				updated.Ids.DeviceId != ids.DeviceId {
+				return errInvalidIdentifiers.New()
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+				pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+				return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.device_id") && pb.Ids.DeviceId != stored.Ids.DeviceId {
+				return errReadOnlyField.WithAttributes("field", "ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.join_eui") && !bytes.Equal(pb.Ids.JoinEui, stored.Ids.JoinEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.join_eui")
+			}
+			if ttnpb.HasAnyField(sets, "ids.dev_eui") && !bytes.Equal(pb.Ids.DevEui, stored.Ids.DevEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+			}
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(stored, pb, sets...)
+			if err != nil {
+				return err
+			}
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+
+		if stored == nil && updated.Ids.JoinEui != nil && updated.Ids.DevEui != nil {
+			joinEUI := types.MustEUI64(updated.Ids.JoinEui).OrZero()
+			devEUI := types.MustEUI64(updated.Ids.DevEui).OrZero()
+			ek := r.euiKey(joinEUI, devEUI)
+			storedUID, err := tx.Get(ek)
+			switch {
+			case errors.IsNotFound(err):
+				tx.Set(ek, []byte(uid), 0)
+			case err != nil:
+				return err
+			default:
+				return registry.UniqueEUIViolationErr(ctx, joinEUI, devEUI, string(storedUID))
+			}
+		}
+		if err := tx.SetProto(uk, updated, 0); err != nil {
+			return err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Range ranges over the end devices and calls the callback function, until false is returned.
+func (r *DeviceRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	return r.Bolt.Range(r.uidKey(""), func(_ string, v []byte) (bool, error) {
+		dev := &ttnpb.EndDevice{}
+		if err := ttnbolt.UnmarshalProto(v, dev); err != nil {
+			return false, err
+		}
+		dev, err := ttnpb.FilterGetEndDevice(dev, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, dev.Ids, dev), nil
+	})
+}
+
+// BatchDelete implements Registry.
+func (r *DeviceRegistry) BatchDelete(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	deviceIDs []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	uidKeys := make([]string, 0, len(deviceIDs))
+	for _, devID := range deviceIDs {
+		uidKeys = append(uidKeys, r.uidKey(unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       devID,
+		})))
+	}
+
+	defer trace.StartRegion(ctx, "batch delete end device").End()
+
+	var ret []*ttnpb.EndDeviceIdentifiers
+	err := r.Bolt.Watch(ctx, func(tx *ttnbolt.Tx) error {
+		ret = make([]*ttnpb.EndDeviceIdentifiers, 0, len(uidKeys))
+		values, err := tx.MGet(uidKeys...)
+		if err != nil {
+			return err
+		}
+		for _, val := range values {
+			if val == nil {
+				continue
+			}
+			dev := &ttnpb.EndDevice{}
+			if err := ttnbolt.UnmarshalProto(val, dev); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decode stored end device")
+				continue
+			}
+			ret = append(ret, dev.Ids)
+			if dev.Ids.JoinEui != nil && dev.Ids.DevEui != nil {
+				tx.Del(r.euiKey(
+					types.MustEUI64(dev.GetIds().GetJoinEui()).OrZero(),
+					types.MustEUI64(dev.GetIds().GetDevEui()).OrZero(),
+				))
+			}
+		}
+		tx.Del(uidKeys...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func applyLinkFieldMask(dst, src *ttnpb.ApplicationLink, paths ...string) (*ttnpb.ApplicationLink, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationLink{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// LinkRegistry is a store for application links.
+type LinkRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the LinkRegistry.
+func (r *LinkRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (r *LinkRegistry) appKey(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+// Get returns the link by the application identifiers.
+func (r *LinkRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string,
+) (*ttnpb.ApplicationLink, error) {
+	defer trace.StartRegion(ctx, "get link").End()
+
+	pb := &ttnpb.ApplicationLink{}
+	if err := r.Bolt.GetProto(r.appKey(unique.ID(ctx, ids)), pb); err != nil {
+		return nil, err
+	}
+	return applyLinkFieldMask(nil, pb, paths...)
+}
+
+// Range ranges the links and calls the callback function, until false is returned.
+func (r *LinkRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationLink) bool,
+) error {
+	defer trace.StartRegion(ctx, "range links").End()
+
+	prefix := r.appKey("")
+	return r.Bolt.Range(prefix, func(k string, v []byte) (bool, error) {
+		uid := strings.TrimPrefix(k, prefix)
+		ctx, err := unique.WithContext(ctx, uid)
+		if err != nil {
+			return false, errApplicationUID.WithCause(err).WithAttributes("application_uid", uid)
+		}
+		ids, err := unique.ToApplicationID(uid)
+		if err != nil {
+			return false, errApplicationUID.WithCause(err).WithAttributes("application_uid", uid)
+		}
+		pb := &ttnpb.ApplicationLink{}
+		if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+			return false, err
+		}
+		pb, err = applyLinkFieldMask(nil, pb, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, ids, pb), nil
+	})
+}
+
+// Set creates, updates or deletes the link by the application identifiers.
+func (r *LinkRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	gets []string,
+	f func(*ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error),
+) (*ttnpb.ApplicationLink, error) {
+	uk := r.appKey(unique.ID(ctx, ids))
+
+	defer trace.StartRegion(ctx, "set link").End()
+
+	var pb *ttnpb.ApplicationLink
+	err := r.Bolt.LockedWatch(ctx, uk, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.ApplicationLink{}
+		if err := tx.GetProto(uk, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb = nil
+		if stored != nil {
+			pb, err = applyLinkFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyLinkFieldMask(nil, stored, gets...)
+			return err
+		}
+		if pb == nil && len(sets) == 0 {
+			tx.Del(uk)
+			return nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationLink{}
+		}
+		updated, err := applyLinkFieldMask(stored, pb, sets...)
+		if err != nil {
+			return err
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+		if err := tx.SetProto(uk, updated, 0); err != nil {
+			return err
+		}
+		pb, err = applyLinkFieldMask(nil, updated, gets...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
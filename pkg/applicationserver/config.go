@@ -18,12 +18,19 @@ import (
 	"context"
 	"time"
 
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	fragmentationv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fragmentation/v1"
+	localgeolocationv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/localgls/v1"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
 	loracloudgeolocationv3 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loragls/v3"
+	multicastsetupv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/multicastsetup/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	storagepostgres "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/postgres"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/sink"
@@ -31,8 +38,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -145,15 +154,17 @@ type UplinkStorageConfig struct {
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry                   web.WebhookRegistry `name:"-"`
-	Target                     string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout                    time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize                  int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers                    int                 `name:"workers" description:"Number of workers to process requests"`
-	UnhealthyAttemptsThreshold int                 `name:"unhealthy-attempts-threshold" description:"Number of failed webhook attempts before the webhook is disabled"`
-	UnhealthyRetryInterval     time.Duration       `name:"unhealthy-retry-interval" description:"Time interval after which disabled webhooks may execute again"`
-	Templates                  web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
-	Downlinks                  web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
+	Registry                   web.WebhookRegistry     `name:"-"`
+	Target                     string                  `name:"target" description:"Target of the integration (direct)"`
+	Timeout                    time.Duration           `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize                  int                     `name:"queue-size" description:"Number of requests to queue"`
+	Workers                    int                     `name:"workers" description:"Number of workers to process requests"`
+	UnhealthyAttemptsThreshold int                     `name:"unhealthy-attempts-threshold" description:"Number of failed webhook attempts before the webhook is disabled"`
+	UnhealthyRetryInterval     time.Duration           `name:"unhealthy-retry-interval" description:"Time interval after which disabled webhooks may execute again"`
+	Templates                  web.TemplatesConfig     `name:"templates" description:"The store of the webhook templates"`
+	Downlinks                  web.DownlinksConfig     `name:"downlink" description:"The downlink queue operations configuration"`
+	EncryptionKeyID            string                  `name:"encryption-key-id" description:"ID of the key used to encrypt webhook signing secrets at rest"` //nolint:lll
+	DeliveryQueue              web.DeliveryQueueConfig `name:"delivery-queue" description:"The durable delivery queue configuration"`
 }
 
 func (c WebhooksConfig) toProto() *ttnpb.AsConfiguration_Webhooks {
@@ -229,6 +240,12 @@ func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
 	if status, ok := c.Providers["nats"]; ok {
 		providers.Nats = toStatus(status)
 	}
+	if status, ok := c.Providers["kafka"]; ok {
+		providers.Kafka = toStatus(status)
+	}
+	if status, ok := c.Providers["awsiot"]; ok {
+		providers.AwsIot = toStatus(status)
+	}
 	return &ttnpb.AsConfiguration_PubSub{
 		Providers: providers,
 	}
@@ -237,12 +254,46 @@ func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
 // ApplicationPackagesConfig contains application packages associations configuration.
 type ApplicationPackagesConfig struct {
 	packages.Config `name:",squash"`
-	Registry        packages.Registry `name:"-"`
+	Registry        packages.Registry      `name:"-"`
+	Storage         storage.Config         `name:"storage" description:"Storage Integration configuration"`
+	Fragmentation   fragmentationv1.Config `name:"fragmentation" description:"Fragmented Data Block Transport configuration"` //nolint:lll
+}
+
+var errStorageProvider = errors.DefineInvalidArgument("storage_provider", "invalid storage provider `{provider}`")
+
+// newStorageStore returns a new store for the Storage Integration based on the configuration.
+// If no provider is configured, this method returns nil.
+func (c ApplicationPackagesConfig) newStorageStore(ctx context.Context) (storage.Store, error) {
+	if c.Storage.Store != nil {
+		return c.Storage.Store, nil
+	}
+	switch c.Storage.Provider {
+	case "":
+		return nil, nil
+	case "postgres":
+		sqlDB, err := storeutil.OpenDB(ctx, c.Storage.Postgres.DatabaseURI)
+		if err != nil {
+			return nil, err
+		}
+		return storagepostgres.NewStore(bun.NewDB(sqlDB, pgdialect.New())), nil
+	default:
+		return nil, errStorageProvider.WithAttributes("provider", c.Storage.Provider)
+	}
+}
+
+// SigningSecrets returns the web.SigningSecrets based on the configuration.
+func (c WebhooksConfig) SigningSecrets(keyService crypto.KeyService) web.SigningSecrets {
+	return web.SigningSecrets{
+		KeyService:      keyService,
+		EncryptionKeyID: c.EncryptionKeyID,
+	}
 }
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
 // If Target is empty, this method returns nil.
-func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.Webhooks, error) {
+func (c WebhooksConfig) NewWebhooks(
+	ctx context.Context, server io.Server, keyService crypto.KeyService,
+) (web.Webhooks, error) {
 	var target sink.Sink
 	switch c.Target {
 	case "":
@@ -265,10 +316,18 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 		registry = web.NewCachedHealthStatusRegistry(registry)
 		target = sink.NewHealthCheckSink(target, registry, c.UnhealthyAttemptsThreshold, c.UnhealthyRetryInterval)
 	}
+	if c.DeliveryQueue.Queue != nil {
+		// The delivery queue consumers process the requests in parallel and retry failed requests.
+		return web.NewWebhooks(
+			ctx, server, c.Registry, target, c.Downlinks, c.SigningSecrets(keyService), c.DeliveryQueue,
+		)
+	}
 	if c.QueueSize > 0 || c.Workers > 0 {
 		target = sink.NewPooledSink(ctx, server, target, c.Workers, c.QueueSize)
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, target, c.Downlinks)
+	return web.NewWebhooks(
+		ctx, server, c.Registry, target, c.Downlinks, c.SigningSecrets(keyService), web.DeliveryQueueConfig{},
+	)
 }
 
 // NewPubSub returns a new pubsub.PubSub based on the configuration.
@@ -286,7 +345,10 @@ func (c PubSubConfig) NewPubSub(comp *component.Component, server io.Server) (*p
 
 // NewApplicationPackages returns a new applications packages frontend based on the configuration.
 // If the registry is nil, it returns nil.
-func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, server io.Server) (packages.Server, error) {
+// The packages wrap the device keys that they store with the KEK with the given label.
+func (c ApplicationPackagesConfig) NewApplicationPackages(
+	ctx context.Context, server io.Server, keyService crypto.KeyService, kekLabel string,
+) (packages.Server, error) {
 	if c.Registry == nil {
 		return nil, nil
 	}
@@ -298,9 +360,31 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	// Initialize LoRa Cloud Geolocation v3 package handler
 	handlers[loracloudgeolocationv3.PackageName] = loracloudgeolocationv3.New(server, c.Registry)
 
+	// Initialize Local Geolocation v1 package handler.
+	handlers[localgeolocationv1.PackageName] = localgeolocationv1.New(server, c.Registry)
+
 	// Initialize LoRa Application Layer Clock Synchronization v1 package handler.
 	handlers[alcsyncv1.PackageName] = alcsyncv1.New(server, c.Registry)
 
+	// Initialize LoRaWAN Fragmented Data Block Transport v1 package handler.
+	handlers[fragmentationv1.PackageName] = fragmentationv1.New(server, c.Registry, c.Fragmentation)
+
+	// Initialize LoRaWAN Remote Multicast Setup v1 package handler.
+	handlers[multicastsetupv1.PackageName] = multicastsetupv1.New(server, c.Registry, keyService, kekLabel)
+
+	// Initialize Storage Integration package handler.
+	store, err := c.newStorageStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if store != nil {
+		storageConfig := c.Storage
+		storageConfig.Store = store
+		if handlers[storage.PackageName], err = storage.New(ctx, server, storageConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	return packages.New(ctx, server, c.Registry, handlers, c.Workers, c.Timeout)
 }
 
@@ -0,0 +1,224 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fragmentationv1 provides the LoRaWAN Fragmented Data Block Transport Package (TS004 v1.0.0).
+package fragmentationv1
+
+import "encoding/binary"
+
+// commandID is the identifier of a fragmentation command.
+type commandID uint8
+
+const (
+	cidPackageVersion    commandID = 0x00
+	cidFragSessionStatus commandID = 0x01
+	cidFragSessionSetup  commandID = 0x02
+	cidFragSessionDelete commandID = 0x03
+	cidDataFragment      commandID = 0x08
+)
+
+const (
+	packageIdentifier = 3
+	packageVersion    = 1
+
+	// maxFragIndex is the maximum fragmentation session index.
+	maxFragIndex = 3
+	// maxFragments is the maximum number of fragments in a session, as the fragment counter has 14 bits.
+	maxFragments = 1<<14 - 1
+)
+
+// FragSessionSetupReq is the FragSessionSetupReq command.
+type FragSessionSetupReq struct {
+	FragIndex      uint8
+	McGroupBitMask uint8
+	NbFrag         uint16
+	FragSize       uint8
+	BlockAckDelay  uint8
+	Padding        uint8
+	Descriptor     uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r FragSessionSetupReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// FragSession - byte 1 (bits: RFU [7:6]; FragIndex [5:4]; McGroupBitMask [3:0]).
+	// NbFrag - bytes [2, 3].
+	// FragSize - byte 4.
+	// Control - byte 5 (bits: RFU [7:6]; FragmentationMatrix [5:3]; BlockAckDelay [2:0]).
+	// Padding - byte 6.
+	// Descriptor - bytes [7, 10].
+	b := make([]byte, 11)
+	b[0] = byte(cidFragSessionSetup)
+	b[1] = (r.FragIndex&0x03)<<4 | r.McGroupBitMask&0x0F
+	binary.LittleEndian.PutUint16(b[2:4], r.NbFrag)
+	b[4] = r.FragSize
+	b[5] = r.BlockAckDelay & 0x07
+	b[6] = r.Padding
+	binary.LittleEndian.PutUint32(b[7:11], r.Descriptor)
+	return b, nil
+}
+
+// FragSessionStatusReq is the FragSessionStatusReq command.
+type FragSessionStatusReq struct {
+	FragIndex    uint8
+	Participants bool
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r FragSessionStatusReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// FragStatusReqParam - byte 1 (bits: RFU [7:3]; FragIndex [2:1]; Participants 0).
+	b := []byte{byte(cidFragSessionStatus), (r.FragIndex & 0x03) << 1}
+	if r.Participants {
+		b[1] |= 0x01
+	}
+	return b, nil
+}
+
+// FragSessionDeleteReq is the FragSessionDeleteReq command.
+type FragSessionDeleteReq struct {
+	FragIndex uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r FragSessionDeleteReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// Param - byte 1 (bits: RFU [7:2]; FragIndex [1:0]).
+	return []byte{byte(cidFragSessionDelete), r.FragIndex & 0x03}, nil
+}
+
+// DataFragment is the DataFragment command.
+type DataFragment struct {
+	FragIndex uint8
+	// N is the fragment counter, starting at 1.
+	N       uint16
+	Payload []byte
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r DataFragment) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// IndexAndN - bytes [1, 2] (bits: FragIndex [15:14]; N [13:0]).
+	// Payload - bytes [3, ...].
+	b := make([]byte, 3+len(r.Payload))
+	b[0] = byte(cidDataFragment)
+	binary.LittleEndian.PutUint16(b[1:3], uint16(r.FragIndex&0x03)<<14|r.N&maxFragments)
+	copy(b[3:], r.Payload)
+	return b, nil
+}
+
+// PackageVersionAns is the PackageVersionAns command.
+type PackageVersionAns struct {
+	PackageIdentifier uint8 `json:"package_identifier"`
+	PackageVersion    uint8 `json:"package_version"`
+}
+
+// FragSessionStatusAns is the FragSessionStatusAns command.
+type FragSessionStatusAns struct {
+	FragIndex             uint8  `json:"frag_index"`
+	NbFragReceived        uint16 `json:"nb_frag_received"`
+	MissingFrag           uint8  `json:"missing_frag"`
+	NotEnoughMatrixMemory bool   `json:"not_enough_matrix_memory,omitempty"`
+}
+
+// FragSessionSetupAns is the FragSessionSetupAns command.
+type FragSessionSetupAns struct {
+	FragIndex                    uint8 `json:"frag_index"`
+	EncodingUnsupported          bool  `json:"encoding_unsupported,omitempty"`
+	NotEnoughMemory              bool  `json:"not_enough_memory,omitempty"`
+	FragSessionIndexNotSupported bool  `json:"frag_session_index_not_supported,omitempty"`
+	WrongDescriptor              bool  `json:"wrong_descriptor,omitempty"`
+}
+
+// Accepted returns whether the end device accepted the fragmentation session.
+func (a FragSessionSetupAns) Accepted() bool {
+	return !a.EncodingUnsupported && !a.NotEnoughMemory && !a.FragSessionIndexNotSupported && !a.WrongDescriptor
+}
+
+// FragSessionDeleteAns is the FragSessionDeleteAns command.
+type FragSessionDeleteAns struct {
+	FragIndex           uint8 `json:"frag_index"`
+	SessionDoesNotExist bool  `json:"session_does_not_exist,omitempty"`
+}
+
+// answerLength returns the length of the payload of the answer with the given command identifier.
+func answerLength(cid commandID) (int, bool) {
+	switch cid {
+	case cidPackageVersion:
+		return 2, true
+	case cidFragSessionStatus:
+		return 4, true
+	case cidFragSessionSetup, cidFragSessionDelete:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// parseAnswers parses the answers in the uplink frame payload.
+// The answers are one of PackageVersionAns, FragSessionStatusAns, FragSessionSetupAns or FragSessionDeleteAns.
+func parseAnswers(b []byte) ([]any, error) {
+	var answers []any
+	for len(b) > 0 {
+		cid := commandID(b[0])
+		n, ok := answerLength(cid)
+		if !ok {
+			return answers, errUnknownCommand.WithAttributes("command_id", cid)
+		}
+		if len(b) < 1+n {
+			return answers, errInsufficientLength.WithAttributes(
+				"command_id", cid,
+				"expected_length", n,
+				"actual_length", len(b)-1,
+			)
+		}
+		p := b[1 : 1+n]
+		b = b[1+n:]
+		switch cid {
+		case cidPackageVersion:
+			answers = append(answers, &PackageVersionAns{
+				PackageIdentifier: p[0],
+				PackageVersion:    p[1],
+			})
+		case cidFragSessionStatus:
+			// ReceivedAndIndex - bytes [0, 1] (bits: FragIndex [15:14]; NbFragReceived [13:0]).
+			// MissingFrag - byte 2.
+			// Status - byte 3 (bits: RFU [7:1]; NotEnoughMatrixMemory 0).
+			receivedAndIndex := binary.LittleEndian.Uint16(p[0:2])
+			answers = append(answers, &FragSessionStatusAns{
+				FragIndex:             uint8(receivedAndIndex >> 14),
+				NbFragReceived:        receivedAndIndex & maxFragments,
+				MissingFrag:           p[2],
+				NotEnoughMatrixMemory: p[3]&0x01 != 0,
+			})
+		case cidFragSessionSetup:
+			// StatusBitMask - byte 0 (bits: FragIndex [7:6]; RFU [5:4]; WrongDescriptor 3;
+			// FragSessionIndexNotSupported 2; NotEnoughMemory 1; EncodingUnsupported 0).
+			answers = append(answers, &FragSessionSetupAns{
+				FragIndex:                    p[0] >> 6,
+				EncodingUnsupported:          p[0]&0x01 != 0,
+				NotEnoughMemory:              p[0]&0x02 != 0,
+				FragSessionIndexNotSupported: p[0]&0x04 != 0,
+				WrongDescriptor:              p[0]&0x08 != 0,
+			})
+		case cidFragSessionDelete:
+			// Status - byte 0 (bits: RFU [7:3]; SessionDoesNotExist 2; FragIndex [1:0]).
+			answers = append(answers, &FragSessionDeleteAns{
+				FragIndex:           p[0] & 0x03,
+				SessionDoesNotExist: p[0]&0x04 != 0,
+			})
+		}
+	}
+	return answers, nil
+}
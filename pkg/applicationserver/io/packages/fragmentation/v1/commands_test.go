@@ -0,0 +1,133 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarshalCommands(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name     string
+		Command  interface{ MarshalBinary() ([]byte, error) }
+		Expected []byte
+	}{
+		{
+			Name: "FragSessionSetupReq",
+			Command: FragSessionSetupReq{
+				FragIndex:      2,
+				McGroupBitMask: 0x05,
+				NbFrag:         0x0102,
+				FragSize:       50,
+				BlockAckDelay:  3,
+				Padding:        7,
+				Descriptor:     0x04030201,
+			},
+			Expected: []byte{0x02, 0x25, 0x02, 0x01, 50, 0x03, 7, 0x01, 0x02, 0x03, 0x04},
+		},
+		{
+			Name:     "FragSessionStatusReq",
+			Command:  FragSessionStatusReq{FragIndex: 3, Participants: true},
+			Expected: []byte{0x01, 0x07},
+		},
+		{
+			Name:     "FragSessionDeleteReq",
+			Command:  FragSessionDeleteReq{FragIndex: 1},
+			Expected: []byte{0x03, 0x01},
+		},
+		{
+			Name:     "DataFragment",
+			Command:  DataFragment{FragIndex: 1, N: 0x0102, Payload: []byte{0xaa, 0xbb}},
+			Expected: []byte{0x08, 0x02, 0x41, 0xaa, 0xbb},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			b, err := tc.Command.MarshalBinary()
+			if a.So(err, should.BeNil) {
+				a.So(b, should.Resemble, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestParseAnswers(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name           string
+		Payload        []byte
+		Expected       []any
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:    "PackageVersionAns",
+			Payload: []byte{0x00, 0x03, 0x01},
+			Expected: []any{
+				&PackageVersionAns{PackageIdentifier: 3, PackageVersion: 1},
+			},
+		},
+		{
+			Name:    "FragSessionStatusAns",
+			Payload: []byte{0x01, 0x0a, 0x80, 0x02, 0x01},
+			Expected: []any{
+				&FragSessionStatusAns{
+					FragIndex:             2,
+					NbFragReceived:        10,
+					MissingFrag:           2,
+					NotEnoughMatrixMemory: true,
+				},
+			},
+		},
+		{
+			Name:    "MultipleAnswers",
+			Payload: []byte{0x02, 0x05, 0x03, 0x05},
+			Expected: []any{
+				&FragSessionSetupAns{FragIndex: 0, EncodingUnsupported: true, FragSessionIndexNotSupported: true},
+				&FragSessionDeleteAns{FragIndex: 1, SessionDoesNotExist: true},
+			},
+		},
+		{
+			Name:           "UnknownCommand",
+			Payload:        []byte{0x02, 0x00, 0x7f},
+			Expected:       []any{&FragSessionSetupAns{}},
+			ErrorAssertion: errors.IsNotFound,
+		},
+		{
+			Name:           "InsufficientLength",
+			Payload:        []byte{0x01, 0x0a, 0x80},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			answers, err := parseAnswers(tc.Payload)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+			a.So(answers, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// SessionState is the state of a fragmentation session.
+type SessionState string
+
+const (
+	// SessionStateSetup means that the session setup is sent to the end device.
+	SessionStateSetup SessionState = "setup"
+	// SessionStateTransfer means that the end device accepted the session and the fragments are enqueued in windows.
+	SessionStateTransfer SessionState = "transfer"
+	// SessionStateComplete means that the end device reconstructed the data block.
+	SessionStateComplete SessionState = "complete"
+	// SessionStateFailed means that the end device rejected the session.
+	SessionStateFailed SessionState = "failed"
+	// SessionStateDeleting means that the session deletion is sent to the end device.
+	SessionStateDeleting SessionState = "deleting"
+)
+
+// Session is a fragmentation session of an end device.
+type Session struct {
+	FragIndex      uint8        `json:"frag_index"`
+	State          SessionState `json:"state"`
+	BlobPath       string       `json:"blob_path"`
+	Size           int          `json:"size"`
+	NbFrag         uint16       `json:"nb_frag"`
+	Redundancy     uint16       `json:"redundancy"`
+	FragSize       uint8        `json:"frag_size"`
+	Padding        uint8        `json:"padding"`
+	BlockAckDelay  uint8        `json:"block_ack_delay"`
+	McGroupBitMask uint8        `json:"mc_group_bit_mask"`
+	Descriptor     uint32       `json:"descriptor"`
+	// NextFrag is the number of fragments that are pushed to the downlink queue.
+	NextFrag uint16 `json:"next_frag"`
+
+	NbFragReceived        uint16 `json:"nb_frag_received"`
+	MissingFrag           uint8  `json:"missing_frag"`
+	NotEnoughMatrixMemory bool   `json:"not_enough_matrix_memory,omitempty"`
+
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// setupReq returns the FragSessionSetupReq of the session.
+func (s *Session) setupReq() FragSessionSetupReq {
+	return FragSessionSetupReq{
+		FragIndex:      s.FragIndex,
+		McGroupBitMask: s.McGroupBitMask,
+		NbFrag:         s.NbFrag,
+		FragSize:       s.FragSize,
+		BlockAckDelay:  s.BlockAckDelay,
+		Padding:        s.Padding,
+		Descriptor:     s.Descriptor,
+	}
+}
+
+// active returns whether the session occupies the fragmentation session index on the end device.
+func (s *Session) active() bool {
+	return s.State != SessionStateFailed
+}
+
+// associationData is the data of the package association of an end device.
+type associationData struct {
+	Sessions []*Session `json:"sessions,omitempty"`
+}
+
+func (d *associationData) fromStruct(st *structpb.Struct) error {
+	if len(st.GetFields()) == 0 {
+		return nil
+	}
+	b, err := protojson.Marshal(st)
+	if err != nil {
+		return errDecodeData.WithCause(err)
+	}
+	if err := json.Unmarshal(b, d); err != nil {
+		return errDecodeData.WithCause(err)
+	}
+	return nil
+}
+
+func (d *associationData) toStruct() (*structpb.Struct, error) {
+	return toStruct(d)
+}
+
+// session returns the session with the given index.
+func (d *associationData) session(fragIndex uint8) *Session {
+	for _, s := range d.Sessions {
+		if s.FragIndex == fragIndex {
+			return s
+		}
+	}
+	return nil
+}
+
+// removeSession removes the session with the given index.
+func (d *associationData) removeSession(fragIndex uint8) {
+	sessions := d.Sessions[:0]
+	for _, s := range d.Sessions {
+		if s.FragIndex != fragIndex {
+			sessions = append(sessions, s)
+		}
+	}
+	d.Sessions = sessions
+}
+
+// toStruct converts the JSON representation of v to a Struct.
+func toStruct(v any) (*structpb.Struct, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, errEncodeData.WithCause(err)
+	}
+	st := &structpb.Struct{}
+	if err := protojson.Unmarshal(b, st); err != nil {
+		return nil, errEncodeData.WithCause(err)
+	}
+	return st, nil
+}
+
+// mergeFPort returns the FPort of the association, falling back to the FPort of the default association.
+func mergeFPort(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) uint32 {
+	if fPort := assoc.GetIds().GetFPort(); fPort != 0 {
+		return fPort
+	}
+	return def.GetIds().GetFPort()
+}
@@ -0,0 +1,57 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errNoAssociation      = errors.DefineInternal("no_association", "no association available")
+	errUnknownCommand     = errors.DefineNotFound("unknown_command", "unknown command `{command_id}`")
+	errInsufficientLength = errors.DefineInvalidArgument(
+		"insufficient_length", "command payload has insufficient length", "command_id", "expected_length", "actual_length",
+	)
+
+	errDecodeData = errors.DefineCorruption("decode_data", "decode package data")
+	errEncodeData = errors.DefineCorruption("encode_data", "encode package data")
+
+	errNoBucket  = errors.DefineFailedPrecondition("no_bucket", "no blob bucket configured")
+	errReadBlob  = errors.Define("read_blob", "read blob `{path}`")
+	errEmptyBlob = errors.DefineInvalidArgument("empty_blob", "blob `{path}` is empty")
+	errFragIndex = errors.DefineInvalidArgument("frag_index", "invalid fragmentation session index `{frag_index}`")
+	errFragSize  = errors.DefineInvalidArgument("frag_size", "invalid fragment size `{frag_size}`")
+	errFragments = errors.DefineInvalidArgument(
+		"fragments", "data block requires `{fragments}` fragments, which exceeds the maximum of `{max}`",
+	)
+	errFPortInUse = errors.DefineFailedPrecondition(
+		"f_port_in_use", "FPort `{f_port}` is associated with package `{package_name}`",
+	)
+	errRequest       = errors.DefineInvalidArgument("request", "invalid request")
+	errSessionExists = errors.DefineAlreadyExists(
+		"session_exists", "fragmentation session `{frag_index}` already exists",
+	)
+	errSessionNotFound = errors.DefineNotFound(
+		"session_not_found", "fragmentation session `{frag_index}` not found",
+	)
+	errSessionRejected = errors.DefineAborted(
+		"session_rejected", "fragmentation session `{frag_index}` rejected by end device",
+		"encoding_unsupported", "not_enough_memory", "frag_session_index_not_supported", "wrong_descriptor",
+	)
+	errNoBand           = errors.DefineFailedPrecondition("no_band", "no band specified for end device")
+	errDataRate         = errors.DefineInvalidArgument("data_rate", "invalid data rate index `{data_rate_index}`")
+	errFragSizeDataRate = errors.DefineInvalidArgument(
+		"frag_size_data_rate",
+		"fragment size `{frag_size}` exceeds the maximum of `{max}` at data rate index `{data_rate_index}`",
+	)
+)
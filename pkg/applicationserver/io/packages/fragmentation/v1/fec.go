@@ -0,0 +1,78 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+// prbs23 is the 23-bit pseudo-random binary sequence generator of the parity matrix.
+func prbs23(x uint32) uint32 {
+	b0 := x & 1
+	b1 := (x & 32) >> 5
+	return x>>1 + (b0^b1)<<22
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// parityMatrixLine returns the line of the parity matrix for the n-th parity fragment (starting at 1),
+// for a data block of m fragments. The parity fragment is the XOR of the data fragments for which the line is set.
+func parityMatrixLine(n, m int) []bool {
+	line := make([]bool, m)
+	mod := m
+	if isPowerOfTwo(m) {
+		mod++
+	}
+	x := uint32(1 + 1001*n)
+	for i := 0; i < m/2; i++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = int(x % uint32(mod))
+		}
+		line[r] = true
+	}
+	return line
+}
+
+// fragmentsCount returns the number of data fragments and the padding for a data block of the given size.
+func fragmentsCount(size int, fragSize uint8) (nbFrag int, padding uint8) {
+	nbFrag = (size + int(fragSize) - 1) / int(fragSize)
+	return nbFrag, uint8(nbFrag*int(fragSize) - size)
+}
+
+// fragment splits the data block in fragments of fragSize bytes, followed by the given number of parity fragments.
+// The last data fragment is padded with zeros.
+func fragment(data []byte, fragSize uint8, redundancy int) [][]byte {
+	m, padding := fragmentsCount(len(data), fragSize)
+	padded := make([]byte, len(data)+int(padding))
+	copy(padded, data)
+
+	fragments := make([][]byte, 0, m+redundancy)
+	for i := 0; i < m; i++ {
+		fragments = append(fragments, padded[i*int(fragSize):(i+1)*int(fragSize)])
+	}
+	for n := 1; n <= redundancy; n++ {
+		parity := make([]byte, fragSize)
+		for i, set := range parityMatrixLine(n, m) {
+			if !set {
+				continue
+			}
+			for j, b := range fragments[i] {
+				parity[j] ^= b
+			}
+		}
+		fragments = append(fragments, parity)
+	}
+	return fragments
+}
@@ -0,0 +1,148 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// reconstruct reconstructs the data fragments from the received fragments using Gaussian elimination over GF(2).
+// The received map is keyed by the fragment counter, starting at 1.
+func reconstruct(received map[int][]byte, m int, fragSize uint8) [][]byte {
+	type row struct {
+		coefs []bool
+		data  []byte
+	}
+	pivots := make([]*row, m)
+	for n, payload := range received {
+		r := &row{coefs: make([]bool, m), data: append([]byte(nil), payload...)}
+		if n <= m {
+			r.coefs[n-1] = true
+		} else {
+			r.coefs = parityMatrixLine(n-m, m)
+		}
+		for i := 0; i < m; i++ {
+			if !r.coefs[i] {
+				continue
+			}
+			if pivots[i] == nil {
+				pivots[i] = r
+				break
+			}
+			for j := range r.coefs {
+				r.coefs[j] = r.coefs[j] != pivots[i].coefs[j]
+			}
+			for j := range r.data {
+				r.data[j] ^= pivots[i].data[j]
+			}
+		}
+	}
+	data := make([][]byte, m)
+	for i := m - 1; i >= 0; i-- {
+		if pivots[i] == nil {
+			return nil
+		}
+		d := append([]byte(nil), pivots[i].data...)
+		for j := i + 1; j < m; j++ {
+			if !pivots[i].coefs[j] {
+				continue
+			}
+			for k := range d {
+				d[k] ^= data[j][k]
+			}
+		}
+		data[i] = d
+	}
+	return data
+}
+
+func TestFragmentsCount(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Size     int
+		FragSize uint8
+		NbFrag   int
+		Padding  uint8
+	}{
+		{Size: 100, FragSize: 10, NbFrag: 10, Padding: 0},
+		{Size: 101, FragSize: 10, NbFrag: 11, Padding: 9},
+		{Size: 1, FragSize: 50, NbFrag: 1, Padding: 49},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%d/%d", tc.Size, tc.FragSize), func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			nbFrag, padding := fragmentsCount(tc.Size, tc.FragSize)
+			a.So(nbFrag, should.Equal, tc.NbFrag)
+			a.So(padding, should.Equal, tc.Padding)
+		})
+	}
+}
+
+func TestParityMatrixLine(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+	for _, m := range []int{2, 7, 8, 100} {
+		for n := 1; n <= 10; n++ {
+			line := parityMatrixLine(n, m)
+			if !a.So(line, should.HaveLength, m) {
+				continue
+			}
+			count := 0
+			for _, set := range line {
+				if set {
+					count++
+				}
+			}
+			a.So(count, should.BeGreaterThan, 0)
+			a.So(count, should.BeLessThanOrEqualTo, m/2)
+		}
+	}
+}
+
+func TestFragment(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	const fragSize = 16
+	data := make([]byte, 1000)
+	rand.New(rand.NewSource(42)).Read(data)
+
+	m, padding := fragmentsCount(len(data), fragSize)
+	fragments := fragment(data, fragSize, m/2)
+	if !a.So(fragments, should.HaveLength, m+m/2) {
+		t.FailNow()
+	}
+	padded := append(append([]byte(nil), data...), make([]byte, padding)...)
+	a.So(bytes.Join(fragments[:m], nil), should.Resemble, padded)
+
+	// Drop every third fragment, including data fragments, and reconstruct the data block from the rest.
+	received := make(map[int][]byte)
+	for i, f := range fragments {
+		if i%3 != 2 {
+			received[i+1] = f
+		}
+	}
+	recovered := reconstruct(received, m, fragSize)
+	if a.So(recovered, should.NotBeNil) {
+		a.So(bytes.Join(recovered, nil)[:len(data)], should.Resemble, data)
+	}
+}
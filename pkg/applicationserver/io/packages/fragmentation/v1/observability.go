@@ -0,0 +1,69 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func publishEvents(ctx context.Context, builders ...events.Builder) {
+	n := len(builders)
+	if n == 0 {
+		return
+	}
+
+	evts := events.Builders(builders).New(ctx)
+	log.FromContext(ctx).WithField("event_count", n).Debug("Publish events")
+	events.Publish(evts...)
+}
+
+func eventOptions(extraOpts ...events.Option) []events.Option {
+	return append([]events.Option{events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)}, extraOpts...)
+}
+
+func defineSessionEvent(name, desc string) events.Builder {
+	return events.Define(
+		fmt.Sprintf("as.packages.fragmentation.v1.session.%s", name),
+		desc,
+		eventOptions(events.WithDataType(&structpb.Struct{}))...,
+	)
+}
+
+var (
+	// EvtSessionSetup is the event that is published when a fragmentation session setup is enqueued.
+	EvtSessionSetup = defineSessionEvent("setup", "fragmentation session setup enqueued")
+	// EvtSessionTransfer is the event that is published when a fragmentation session is accepted
+	// by the end device and the fragments are enqueued.
+	EvtSessionTransfer = defineSessionEvent("transfer", "fragmentation session fragments enqueued")
+	// EvtSessionStatus is the event that is published when the status of a fragmentation session is received.
+	EvtSessionStatus = defineSessionEvent("status", "fragmentation session status received")
+	// EvtSessionComplete is the event that is published when the end device reconstructed the data block.
+	EvtSessionComplete = defineSessionEvent("complete", "fragmentation session complete")
+	// EvtSessionDelete is the event that is published when a fragmentation session is deleted.
+	EvtSessionDelete = defineSessionEvent("delete", "fragmentation session deleted")
+
+	// EvtPkgFail is the event that is published when an error occurs in the package.
+	EvtPkgFail = events.Define(
+		"as.packages.fragmentation.v1.fail", "package failed due to error", eventOptions(
+			events.WithErrorDataType(), events.WithPropagateToParent(),
+		)...,
+	)
+)
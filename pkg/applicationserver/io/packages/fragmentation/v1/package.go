@@ -0,0 +1,200 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"context"
+	"encoding"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// PackageName is the name of the package.
+	PackageName = "fragmentation-v1"
+	// DefaultFPort is the default FPort of the package.
+	DefaultFPort = 201
+
+	namespace = "applicationserver/io/packages/fragmentation/v1"
+)
+
+// Config is the configuration of the Fragmented Data Block Transport package.
+type Config struct {
+	Bucket     string  `name:"bucket" description:"Blob bucket that contains the data blocks to transport"`
+	Redundancy float64 `name:"redundancy" description:"Default ratio of parity fragments to data fragments"`
+}
+
+type fragmentationPackage struct {
+	server   io.Server
+	registry packages.Registry
+	config   Config
+}
+
+var (
+	_ packages.ApplicationPackageHandler = (*fragmentationPackage)(nil)
+	_ web.Registerer                     = (*fragmentationPackage)(nil)
+)
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *fragmentationPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		logger.Error("No association available")
+		return errNoAssociation.New()
+	}
+
+	fPort := mergeFPort(def, assoc)
+	ids := &ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIds: up.GetEndDeviceIds(),
+		FPort:        fPort,
+	}
+	if sent := up.GetDownlinkSent(); sent != nil {
+		if sent.GetFPort() != fPort {
+			return nil
+		}
+		return p.handleDownlinkSent(ctx, ids)
+	}
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		logger.Debug("Uplink is not an uplink message")
+		return nil
+	}
+
+	eventBuilders := make(events.Builders, 0)
+	defer func(ids *ttnpb.EndDeviceIdentifiers) {
+		if err != nil {
+			eventBuilders = append(eventBuilders, EvtPkgFail.With(
+				events.WithIdentifiers(ids),
+				events.WithData(err),
+			))
+		}
+		publishEvents(ctx, eventBuilders...)
+	}(up.GetEndDeviceIds())
+
+	if msg.GetFPort() != fPort {
+		logger.WithFields(log.Fields(
+			"expected_fport", fPort,
+			"received_fport", msg.GetFPort(),
+		)).Debug("Uplink received on unhandled FPort")
+		return nil
+	}
+	if len(msg.GetFrmPayload()) == 0 {
+		logger.Debug("Uplink message has no payload")
+		return nil
+	}
+
+	answers, err := parseAnswers(msg.GetFrmPayload())
+	if err != nil {
+		logger.WithError(err).Debug("Failed to parse frame payload into answers")
+	}
+	for _, ans := range answers {
+		var (
+			session *Session
+			evt     events.Builder
+			ansErr  error
+		)
+		switch ans := ans.(type) {
+		case *PackageVersionAns:
+			logger.WithFields(log.Fields(
+				"package_identifier", ans.PackageIdentifier,
+				"package_version", ans.PackageVersion,
+			)).Debug("Package version received")
+			continue
+		case *FragSessionSetupAns:
+			session, evt, ansErr = p.handleSetupAns(ctx, ids, ans)
+		case *FragSessionStatusAns:
+			session, evt, ansErr = p.handleStatusAns(ctx, ids, ans)
+		case *FragSessionDeleteAns:
+			session, evt, ansErr = p.handleDeleteAns(ctx, ids, ans)
+		}
+		if session != nil {
+			p.publishSession(ctx, ids.EndDeviceIds, evt, session)
+		}
+		if ansErr != nil {
+			logger.WithError(ansErr).Debug("Failed to handle answer")
+			eventBuilders = append(eventBuilders, EvtPkgFail.With(
+				events.WithIdentifiers(ids.EndDeviceIds),
+				events.WithData(ansErr),
+			))
+		}
+	}
+	return err
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*fragmentationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: DefaultFPort,
+	}
+}
+
+// pushDownlinks pushes the commands to the downlink queue of the end device, one command per downlink.
+func (p *fragmentationPackage) pushDownlinks(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fPort uint32, cmds ...encoding.BinaryMarshaler,
+) error {
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(cmds))
+	for _, cmd := range cmds {
+		b, err := cmd.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+			FPort:      fPort,
+			FrmPayload: b,
+		})
+	}
+	return p.server.DownlinkQueuePush(ctx, ids, downlinks)
+}
+
+func (p *fragmentationPackage) sendServiceData(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, data *structpb.Struct,
+) error {
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     timestamppb.Now(),
+		Up: &ttnpb.ApplicationUp_ServiceData{
+			ServiceData: &ttnpb.ApplicationServiceData{
+				Data:    data,
+				Service: PackageName,
+			},
+		},
+	})
+}
+
+// New returns a new Fragmented Data Block Transport package.
+func New(server io.Server, registry packages.Registry, conf Config) packages.ApplicationPackageHandler {
+	return &fragmentationPackage{
+		server:   server,
+		registry: registry,
+		config:   conf,
+	}
+}
@@ -0,0 +1,543 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"context"
+	"encoding"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// fragmentsWindow is the number of fragments that are pushed to the downlink queue at once.
+	// The next window is pushed when the downlink queue of the end device drains below half of the window.
+	fragmentsWindow = 32
+	// dataFragmentOverhead is the size of the DataFragment command without payload.
+	dataFragmentOverhead = 3
+	// fhdrAndFPortSize is the size of the frame header without options and the FPort.
+	fhdrAndFPortSize = 8
+)
+
+// StartSessionRequest is a request to start a fragmentation session.
+type StartSessionRequest struct {
+	// FPort is the FPort of the package. The default FPort of the package is used if zero.
+	FPort     uint32 `json:"f_port"`
+	FragIndex uint8  `json:"frag_index"`
+	// BlobPath is the path of the data block in the configured bucket.
+	BlobPath string `json:"blob_path"`
+	FragSize uint8  `json:"frag_size"`
+	// Redundancy is the number of parity fragments. The configured redundancy ratio is used if nil.
+	Redundancy     *uint16 `json:"redundancy,omitempty"`
+	BlockAckDelay  uint8   `json:"block_ack_delay"`
+	McGroupBitMask uint8   `json:"mc_group_bit_mask"`
+	Descriptor     uint32  `json:"descriptor"`
+	// BandID is the band of the end device. The band of the end device version identifiers is used if empty.
+	BandID string `json:"band_id,omitempty"`
+	// DataRateIndex is the lowest data rate index at which the fragments are sent.
+	DataRateIndex uint8 `json:"data_rate_index"`
+	// DwellTime indicates whether the downlink dwell time limitation applies to the end device.
+	DwellTime bool `json:"dwell_time,omitempty"`
+}
+
+// updateData updates the association data of the end device. The association is created if it does not exist.
+func (p *fragmentationPackage) updateData(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, f func(*associationData) error,
+) error {
+	_, err := p.registry.SetAssociation(
+		ctx, ids, []string{"data", "package_name"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			paths := []string{"data"}
+			if assoc == nil {
+				assoc = &ttnpb.ApplicationPackageAssociation{
+					Ids:         ids,
+					PackageName: PackageName,
+				}
+				paths = append(paths, "ids", "package_name")
+			} else if assoc.PackageName != PackageName {
+				return nil, nil, errFPortInUse.WithAttributes(
+					"f_port", ids.FPort,
+					"package_name", assoc.PackageName,
+				)
+			}
+			data := &associationData{}
+			if err := data.fromStruct(assoc.Data); err != nil {
+				return nil, nil, err
+			}
+			if err := f(data); err != nil {
+				return nil, nil, err
+			}
+			st, err := data.toStruct()
+			if err != nil {
+				return nil, nil, err
+			}
+			assoc.Data = st
+			return assoc, paths, nil
+		},
+	)
+	return err
+}
+
+// updateSession updates the session with the given index, and returns a copy of the updated session.
+func (p *fragmentationPackage) updateSession(
+	ctx context.Context,
+	ids *ttnpb.ApplicationPackageAssociationIdentifiers,
+	fragIndex uint8,
+	f func(*associationData, *Session) error,
+) (*Session, error) {
+	var updated Session
+	err := p.updateData(ctx, ids, func(data *associationData) error {
+		session := data.session(fragIndex)
+		if session == nil {
+			return errSessionNotFound.WithAttributes("frag_index", fragIndex)
+		}
+		if err := f(data, session); err != nil {
+			return err
+		}
+		session.UpdatedAt = time.Now().UTC()
+		updated = *session
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// findSession finds the association that contains the session with the given index.
+func (p *fragmentationPackage) findSession(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fragIndex uint8,
+) (*ttnpb.ApplicationPackageAssociationIdentifiers, *Session, error) {
+	assocs, err := p.registry.ListAssociations(ctx, ids, []string{"data", "ids", "package_name"})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, assoc := range assocs {
+		if assoc.PackageName != PackageName {
+			continue
+		}
+		data := &associationData{}
+		if err := data.fromStruct(assoc.Data); err != nil {
+			return nil, nil, err
+		}
+		if session := data.session(fragIndex); session != nil {
+			return assoc.Ids, session, nil
+		}
+	}
+	return nil, nil, errSessionNotFound.WithAttributes("frag_index", fragIndex)
+}
+
+// readBlob reads the data block from the configured bucket.
+func (p *fragmentationPackage) readBlob(ctx context.Context, path string) ([]byte, error) {
+	if p.config.Bucket == "" {
+		return nil, errNoBucket.New()
+	}
+	bucket, err := p.server.GetBaseConfig(ctx).Blob.Bucket(ctx, p.config.Bucket, p.server)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+	data, err := bucket.ReadAll(ctx, path)
+	if err != nil {
+		return nil, errReadBlob.WithCause(err).WithAttributes("path", path)
+	}
+	if len(data) == 0 {
+		return nil, errEmptyBlob.WithAttributes("path", path)
+	}
+	return data, nil
+}
+
+// ListSessions lists the fragmentation sessions of the end device.
+func (p *fragmentationPackage) ListSessions(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*Session, error) {
+	assocs, err := p.registry.ListAssociations(ctx, ids, []string{"data", "package_name"})
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*Session, 0)
+	for _, assoc := range assocs {
+		if assoc.PackageName != PackageName {
+			continue
+		}
+		data := &associationData{}
+		if err := data.fromStruct(assoc.Data); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, data.Sessions...)
+	}
+	return sessions, nil
+}
+
+// StartSession starts a fragmentation session to transport the data block in the given blob to the end device.
+// The session setup is enqueued; the fragments are enqueued when the end device accepts the session.
+func (p *fragmentationPackage) StartSession(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, req *StartSessionRequest,
+) (*Session, error) {
+	if req.FragIndex > maxFragIndex {
+		return nil, errFragIndex.WithAttributes("frag_index", req.FragIndex)
+	}
+	if req.FragSize == 0 {
+		return nil, errFragSize.WithAttributes("frag_size", req.FragSize)
+	}
+	if req.BlockAckDelay > 7 || req.McGroupBitMask > 15 {
+		return nil, errRequest.New()
+	}
+	if err := p.validateFragSize(ctx, ids, req); err != nil {
+		return nil, err
+	}
+	data, err := p.readBlob(ctx, req.BlobPath)
+	if err != nil {
+		return nil, err
+	}
+	nbFrag, padding := fragmentsCount(len(data), req.FragSize)
+	redundancy := int(math.Ceil(float64(nbFrag) * p.config.Redundancy))
+	if req.Redundancy != nil {
+		redundancy = int(*req.Redundancy)
+	}
+	if n := nbFrag + redundancy; n > maxFragments {
+		return nil, errFragments.WithAttributes(
+			"fragments", n,
+			"max", maxFragments,
+		)
+	}
+
+	fPort := req.FPort
+	if fPort == 0 {
+		fPort = DefaultFPort
+	}
+	now := time.Now().UTC()
+	session := &Session{
+		FragIndex:      req.FragIndex,
+		State:          SessionStateSetup,
+		BlobPath:       req.BlobPath,
+		Size:           len(data),
+		NbFrag:         uint16(nbFrag),
+		Redundancy:     uint16(redundancy),
+		FragSize:       req.FragSize,
+		Padding:        padding,
+		BlockAckDelay:  req.BlockAckDelay,
+		McGroupBitMask: req.McGroupBitMask,
+		Descriptor:     req.Descriptor,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	assocIDs := &ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIds: ids,
+		FPort:        fPort,
+	}
+	if err := p.updateData(ctx, assocIDs, func(data *associationData) error {
+		if existing := data.session(req.FragIndex); existing != nil && existing.active() {
+			return errSessionExists.WithAttributes("frag_index", req.FragIndex)
+		}
+		data.removeSession(req.FragIndex)
+		data.Sessions = append(data.Sessions, session)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := p.pushDownlinks(ctx, ids, fPort, session.setupReq()); err != nil {
+		return nil, err
+	}
+	p.publishSession(ctx, ids, EvtSessionSetup, session)
+	return session, nil
+}
+
+// validateFragSize validates that the DataFragment commands of the session fit in the downlinks at the requested
+// data rate of the band of the end device.
+func (p *fragmentationPackage) validateFragSize(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, req *StartSessionRequest,
+) error {
+	bandID := req.BandID
+	if bandID == "" {
+		dev, err := p.server.GetEndDevice(ctx, ids, []string{"version_ids"})
+		if err != nil {
+			return err
+		}
+		bandID = dev.GetVersionIds().GetBandId()
+	}
+	if bandID == "" {
+		return errNoBand.New()
+	}
+	phy, err := band.GetLatest(bandID)
+	if err != nil {
+		return err
+	}
+	dr, ok := phy.DataRates[ttnpb.DataRateIndex(req.DataRateIndex)]
+	if !ok {
+		return errDataRate.WithAttributes("data_rate_index", req.DataRateIndex)
+	}
+	maxFragSize := int(dr.MaxMACPayloadSize(req.DwellTime)) - fhdrAndFPortSize - dataFragmentOverhead
+	if int(req.FragSize) > maxFragSize {
+		return errFragSizeDataRate.WithAttributes(
+			"frag_size", req.FragSize,
+			"max", maxFragSize,
+			"data_rate_index", req.DataRateIndex,
+		)
+	}
+	return nil
+}
+
+// RequestStatus enqueues a request for the status of the fragmentation session.
+func (p *fragmentationPackage) RequestStatus(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fragIndex uint8,
+) (*Session, error) {
+	assocIDs, session, err := p.findSession(ctx, ids, fragIndex)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.pushDownlinks(ctx, ids, assocIDs.FPort, FragSessionStatusReq{
+		FragIndex: fragIndex,
+	}); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// DeleteSession enqueues the deletion of the fragmentation session.
+// The session is removed when the end device answers the deletion.
+func (p *fragmentationPackage) DeleteSession(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fragIndex uint8,
+) (*Session, error) {
+	assocIDs, _, err := p.findSession(ctx, ids, fragIndex)
+	if err != nil {
+		return nil, err
+	}
+	session, err := p.updateSession(ctx, assocIDs, fragIndex, func(_ *associationData, session *Session) error {
+		session.State = SessionStateDeleting
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := p.pushDownlinks(ctx, ids, assocIDs.FPort, FragSessionDeleteReq{
+		FragIndex: fragIndex,
+	}); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// enqueueFragments enqueues the next window of fragments of the session. The last window is followed by a request
+// for the session status.
+func (p *fragmentationPackage) enqueueFragments(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, fragIndex uint8,
+) error {
+	var from, to uint16
+	session, err := p.updateSession(ctx, ids, fragIndex, func(_ *associationData, session *Session) error {
+		if session.State != SessionStateTransfer {
+			return errSessionNotFound.WithAttributes("frag_index", fragIndex)
+		}
+		from, to = session.NextFrag, session.NextFrag+fragmentsWindow
+		if total := session.NbFrag + session.Redundancy; to > total {
+			to = total
+		}
+		session.NextFrag = to
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	if err := p.pushFragments(ctx, ids, session, from, to); err != nil {
+		// Release the window, so that it is enqueued again when the next downlink is sent.
+		if _, releaseErr := p.updateSession(ctx, ids, fragIndex, func(_ *associationData, session *Session) error {
+			if session.NextFrag == to {
+				session.NextFrag = from
+			}
+			return nil
+		}); releaseErr != nil {
+			log.FromContext(ctx).WithError(releaseErr).Warn("Failed to release fragments window")
+		}
+		return err
+	}
+	return nil
+}
+
+// pushFragments pushes the fragments of the session in the range [from, to) to the downlink queue.
+func (p *fragmentationPackage) pushFragments(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, session *Session, from, to uint16,
+) error {
+	data, err := p.readBlob(ctx, session.BlobPath)
+	if err != nil {
+		return err
+	}
+	if len(data) != session.Size {
+		return errReadBlob.WithAttributes("path", session.BlobPath)
+	}
+	fragments := fragment(data, session.FragSize, int(session.Redundancy))
+	cmds := make([]encoding.BinaryMarshaler, 0, int(to-from)+1)
+	for i := from; i < to; i++ {
+		cmds = append(cmds, DataFragment{
+			FragIndex: session.FragIndex,
+			N:         i + 1,
+			Payload:   fragments[i],
+		})
+	}
+	if int(to) == len(fragments) {
+		cmds = append(cmds, FragSessionStatusReq{
+			FragIndex: session.FragIndex,
+		})
+	}
+	return p.pushDownlinks(ctx, ids.EndDeviceIds, ids.FPort, cmds...)
+}
+
+func (p *fragmentationPackage) handleSetupAns(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, ans *FragSessionSetupAns,
+) (*Session, events.Builder, error) {
+	rejectErr := errSessionRejected.WithAttributes(
+		"frag_index", ans.FragIndex,
+		"encoding_unsupported", ans.EncodingUnsupported,
+		"not_enough_memory", ans.NotEnoughMemory,
+		"frag_session_index_not_supported", ans.FragSessionIndexNotSupported,
+		"wrong_descriptor", ans.WrongDescriptor,
+	)
+	session, err := p.updateSession(ctx, ids, ans.FragIndex, func(_ *associationData, session *Session) error {
+		if session.State != SessionStateSetup {
+			return errSessionNotFound.WithAttributes("frag_index", ans.FragIndex)
+		}
+		if !ans.Accepted() {
+			session.State = SessionStateFailed
+			session.Error = rejectErr.Error()
+			return nil
+		}
+		session.State = SessionStateTransfer
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.State == SessionStateFailed {
+		return session, nil, rejectErr
+	}
+	if err := p.enqueueFragments(ctx, ids, session.FragIndex); err != nil {
+		return session, nil, err
+	}
+	return session, EvtSessionTransfer, nil
+}
+
+// handleDownlinkSent enqueues the next window of fragments of the sessions in transfer when the downlink queue of
+// the end device drains.
+func (p *fragmentationPackage) handleDownlinkSent(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers,
+) error {
+	assoc, err := p.registry.GetAssociation(ctx, ids, []string{"data"})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	data := &associationData{}
+	if err := data.fromStruct(assoc.Data); err != nil {
+		return err
+	}
+	var pending []uint8
+	for _, session := range data.Sessions {
+		if session.State == SessionStateTransfer && session.NextFrag < session.NbFrag+session.Redundancy {
+			pending = append(pending, session.FragIndex)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	queue, err := p.server.DownlinkQueueList(ctx, ids.EndDeviceIds)
+	if err != nil {
+		return err
+	}
+	queued := 0
+	for _, down := range queue {
+		if down.FPort == ids.FPort {
+			queued++
+		}
+	}
+	if queued >= fragmentsWindow/2 {
+		return nil
+	}
+	for _, fragIndex := range pending {
+		if err := p.enqueueFragments(ctx, ids, fragIndex); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *fragmentationPackage) handleStatusAns(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, ans *FragSessionStatusAns,
+) (*Session, events.Builder, error) {
+	session, err := p.updateSession(ctx, ids, ans.FragIndex, func(_ *associationData, session *Session) error {
+		session.NbFragReceived = ans.NbFragReceived
+		session.MissingFrag = ans.MissingFrag
+		session.NotEnoughMatrixMemory = ans.NotEnoughMatrixMemory
+		if session.State == SessionStateTransfer && ans.MissingFrag == 0 && ans.NbFragReceived >= session.NbFrag {
+			session.State = SessionStateComplete
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.State == SessionStateComplete {
+		return session, EvtSessionComplete, nil
+	}
+	return session, EvtSessionStatus, nil
+}
+
+func (p *fragmentationPackage) handleDeleteAns(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, ans *FragSessionDeleteAns,
+) (*Session, events.Builder, error) {
+	var deleted *Session
+	err := p.updateData(ctx, ids, func(data *associationData) error {
+		session := data.session(ans.FragIndex)
+		if session == nil {
+			return errSessionNotFound.WithAttributes("frag_index", ans.FragIndex)
+		}
+		deleted = session
+		deleted.UpdatedAt = time.Now().UTC()
+		data.removeSession(ans.FragIndex)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return deleted, EvtSessionDelete, nil
+}
+
+// publishSession publishes the event (optional) and service data of the session.
+func (p *fragmentationPackage) publishSession(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, evt events.Builder, session *Session,
+) {
+	logger := log.FromContext(ctx)
+	st, err := toStruct(session)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to encode session")
+		return
+	}
+	if evt != nil {
+		publishEvents(ctx, evt.With(
+			events.WithIdentifiers(ids),
+			events.WithData(st),
+		))
+	}
+	if err := p.sendServiceData(ctx, ids, st); err != nil {
+		logger.WithError(err).Warn("Failed to send service data")
+	}
+}
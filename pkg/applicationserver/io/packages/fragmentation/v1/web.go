@@ -0,0 +1,155 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+type deviceHandlerFunc func(http.ResponseWriter, *http.Request, *ttnpb.EndDeviceIdentifiers)
+
+// withDevice validates the end device identifiers in the path and requires the given application rights.
+func withDevice(h deviceHandlerFunc, required ...ttnpb.Right) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		vars := mux.Vars(req)
+		ids := &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{
+				ApplicationId: vars["application_id"],
+			},
+			DeviceId: vars["device_id"],
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			webhandlers.Error(res, req, err)
+			return
+		}
+		if err := rights.RequireApplication(ctx, ids.ApplicationIds, required...); err != nil {
+			webhandlers.Error(res, req, err)
+			return
+		}
+		h(res, req, ids)
+	})
+}
+
+func fragIndexFromRequest(req *http.Request) (uint8, error) {
+	s := mux.Vars(req)["frag_index"]
+	fragIndex, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || fragIndex > maxFragIndex {
+		return 0, errFragIndex.WithAttributes("frag_index", s)
+	}
+	return uint8(fragIndex), nil
+}
+
+func writeJSON(res http.ResponseWriter, status int, v any) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(v) //nolint:errcheck
+}
+
+// RegisterRoutes implements web.Registerer.
+func (p *fragmentationPackage) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(
+		ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}/devices/{device_id}/packages/" + PackageName + "/sessions",
+	).Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace(namespace)),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+	)
+
+	router.Handle("", withDevice(
+		p.handleListSessions, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+	)).Methods(http.MethodGet)
+	router.Handle("", withDevice(
+		p.handleStartSession, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	)).Methods(http.MethodPost)
+	router.Handle("/{frag_index}/status", withDevice(
+		p.handleRequestStatus, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	)).Methods(http.MethodPost)
+	router.Handle("/{frag_index}", withDevice(
+		p.handleDeleteSession, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	)).Methods(http.MethodDelete)
+}
+
+func (p *fragmentationPackage) handleListSessions(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	sessions, err := p.ListSessions(req.Context(), ids)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, struct {
+		Sessions []*Session `json:"sessions"`
+	}{
+		Sessions: sessions,
+	})
+}
+
+func (p *fragmentationPackage) handleStartSession(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	body := &StartSessionRequest{}
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		webhandlers.Error(res, req, errRequest.WithCause(err))
+		return
+	}
+	session, err := p.StartSession(req.Context(), ids, body)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	writeJSON(res, http.StatusCreated, session)
+}
+
+func (p *fragmentationPackage) handleRequestStatus(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	fragIndex, err := fragIndexFromRequest(req)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	session, err := p.RequestStatus(req.Context(), ids, fragIndex)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	writeJSON(res, http.StatusAccepted, session)
+}
+
+func (p *fragmentationPackage) handleDeleteSession(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	fragIndex, err := fragIndexFromRequest(req)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	session, err := p.DeleteSession(req.Context(), ids, fragIndex)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	writeJSON(res, http.StatusAccepted, session)
+}
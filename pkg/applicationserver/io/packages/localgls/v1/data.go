@@ -0,0 +1,101 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Algorithm is the algorithm used to solve the location of an end device.
+type Algorithm string
+
+const (
+	// AlgorithmAuto uses TDOA multilateration when enough fine timestamps are available,
+	// and falls back to the RSSI/SNR-weighted centroid otherwise.
+	AlgorithmAuto Algorithm = "auto"
+	// AlgorithmRSSI uses the RSSI/SNR-weighted centroid of the gateway locations.
+	AlgorithmRSSI Algorithm = "rssi"
+	// AlgorithmTDOA uses TDOA multilateration of the gateway fine timestamps.
+	AlgorithmTDOA Algorithm = "tdoa"
+)
+
+const (
+	// defaultMinGateways is the default minimum number of gateways for the RSSI/SNR-weighted centroid.
+	defaultMinGateways = 1
+	// minTDOAGateways is the minimum number of gateways for TDOA multilateration.
+	minTDOAGateways = 3
+)
+
+// Data contains the package configuration.
+type Data struct {
+	// Algorithm is the algorithm used by the package. Defaults to AlgorithmAuto.
+	Algorithm Algorithm `json:"algorithm,omitempty"`
+	// MinGateways is the minimum number of gateways with location for the RSSI/SNR-weighted centroid.
+	MinGateways int `json:"min_gateways,omitempty"`
+}
+
+func (d *Data) fromStruct(st *structpb.Struct) error {
+	if len(st.GetFields()) == 0 {
+		return nil
+	}
+	b, err := protojson.Marshal(st)
+	if err != nil {
+		return errDecodeData.WithCause(err)
+	}
+	if err := json.Unmarshal(b, d); err != nil {
+		return errDecodeData.WithCause(err)
+	}
+	return nil
+}
+
+func (d *Data) validate() error {
+	switch d.Algorithm {
+	case AlgorithmAuto, AlgorithmRSSI, AlgorithmTDOA:
+		return nil
+	default:
+		return errAlgorithm.WithAttributes("algorithm", d.Algorithm)
+	}
+}
+
+// mergeData merges the data of the default association with the data of the association.
+// The fields of the association take precedence over the fields of the default association.
+func mergeData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*Data, error) {
+	data := &Data{
+		Algorithm:   AlgorithmAuto,
+		MinGateways: defaultMinGateways,
+	}
+	for _, st := range []*structpb.Struct{def.GetData(), assoc.GetData()} {
+		if err := data.fromStruct(st); err != nil {
+			return nil, err
+		}
+	}
+	if data.Algorithm == "" {
+		data.Algorithm = AlgorithmAuto
+	}
+	if data.MinGateways < 1 {
+		data.MinGateways = defaultMinGateways
+	}
+	if err := data.validate(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,32 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errNoAssociation = errors.DefineInternal("no_association", "no association available")
+	errDecodeData    = errors.DefineCorruption("decode_data", "decode package data")
+	errAlgorithm     = errors.DefineInvalidArgument("algorithm", "unknown algorithm `{algorithm}`")
+
+	errNotEnoughGateways = errors.DefineFailedPrecondition(
+		"not_enough_gateways", "not enough gateways with location", "gateways", "min_gateways",
+	)
+	errNotEnoughTimestamps = errors.DefineFailedPrecondition(
+		"not_enough_timestamps", "not enough gateways with location and fine timestamp", "gateways", "min_gateways",
+	)
+	errSingularGeometry = errors.DefineFailedPrecondition("singular_geometry", "singular gateway geometry")
+	errNoConvergence    = errors.DefineAborted("no_convergence", "multilateration did not converge")
+)
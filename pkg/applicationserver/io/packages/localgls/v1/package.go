@@ -0,0 +1,127 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localgeolocationv1 solves the location of end devices from the gateway metadata,
+// without depending on an external geolocation service.
+package localgeolocationv1
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// PackageName is the name of the package.
+	PackageName = "local-geolocation-v1"
+	// DefaultFPort is the default FPort of the package.
+	DefaultFPort = 198
+
+	namespace = "applicationserver/io/packages/localgls/v1"
+)
+
+// GeolocationPackage is the local geolocation application package.
+// The solved locations are published as location solved messages, which the Application Server
+// stores in the end device location registry.
+type GeolocationPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+var _ packages.ApplicationPackageHandler = (*GeolocationPackage)(nil)
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	ctx = events.ContextWithCorrelationID(
+		ctx, append(
+			up.CorrelationIds,
+			fmt.Sprintf("as:packages:localglsv1:%s", events.NewCorrelationID()),
+		)...,
+	)
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIds, err)
+		}
+	}()
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	data, err := mergeData(def, assoc)
+	if err != nil {
+		return err
+	}
+	s, err := solve(observations(msg.RxMetadata), data)
+	if err != nil {
+		return err
+	}
+	loc := s.location()
+	logger.WithFields(log.Fields(
+		"source", loc.Source,
+		"accuracy", loc.Accuracy,
+	)).Debug("Location solved")
+	return p.sendLocationSolved(ctx, up.EndDeviceIds, loc)
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*GeolocationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: DefaultFPort,
+	}
+}
+
+func (p *GeolocationPackage) sendLocationSolved(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, loc *ttnpb.Location,
+) error {
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     timestamppb.Now(),
+		Up: &ttnpb.ApplicationUp_LocationSolved{
+			LocationSolved: &ttnpb.ApplicationLocation{
+				Service:  PackageName,
+				Location: loc,
+			},
+		},
+	})
+}
+
+// New instantiates the local geolocation package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &GeolocationPackage{
+		server:   server,
+		registry: registry,
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// speedOfLight is the speed of light in vacuum in meters per second.
+	speedOfLight = 299792458.0
+	// earthRadius is the mean radius of the Earth in meters.
+	earthRadius = 6371008.8
+
+	// fineTimestampPeriod is the period of the fine timestamps in nanoseconds.
+	fineTimestampPeriod = 1e9
+	// fineTimestampSigma is the assumed standard deviation of the fine timestamps in nanoseconds.
+	fineTimestampSigma = 25.0
+
+	// maxIterations is the maximum number of Gauss-Newton iterations of the multilateration.
+	maxIterations = 50
+	// convergenceThreshold is the step size in meters below which the multilateration converged.
+	convergenceThreshold = 1e-3
+	// maxSolutionDistance is the maximum distance in meters of a multilateration solution to the gateways.
+	maxSolutionDistance = 100e3
+)
+
+// observation is the reception of an uplink by a single gateway.
+type observation struct {
+	gatewayID     string
+	latitude      float64
+	longitude     float64
+	signalRSSI    float64
+	fineTimestamp uint64
+}
+
+// hasFineTimestamp returns whether the observation has a fine timestamp.
+func (o observation) hasFineTimestamp() bool {
+	return o.fineTimestamp != 0
+}
+
+// signalRSSI returns the signal strength in dBm of the metadata.
+// If the gateway does not report the signal RSSI, it is estimated from the RSSI and the SNR.
+func signalRSSI(md *ttnpb.RxMetadata) float64 {
+	if md.SignalRssi != nil {
+		return float64(md.SignalRssi.Value)
+	}
+	rssi, snr := float64(md.Rssi), float64(md.Snr)
+	return rssi + snr - 10*math.Log10(1+math.Pow(10, snr/10))
+}
+
+// observations returns the observations of the gateways with a location in the given metadata.
+// Multiple antennas of the same gateway are merged into a single observation with the strongest signal.
+func observations(mds []*ttnpb.RxMetadata) []observation {
+	obs := make([]observation, 0, len(mds))
+	indices := make(map[string]int, len(mds))
+	for _, md := range mds {
+		loc := md.GetLocation()
+		if loc == nil || (loc.Latitude == 0 && loc.Longitude == 0) {
+			continue
+		}
+		o := observation{
+			gatewayID:     md.GetGatewayIds().GetGatewayId(),
+			latitude:      loc.Latitude,
+			longitude:     loc.Longitude,
+			signalRSSI:    signalRSSI(md),
+			fineTimestamp: md.FineTimestamp,
+		}
+		i, ok := indices[o.gatewayID]
+		if !ok || o.gatewayID == "" {
+			indices[o.gatewayID] = len(obs)
+			obs = append(obs, o)
+			continue
+		}
+		existing := &obs[i]
+		if !existing.hasFineTimestamp() {
+			existing.fineTimestamp = o.fineTimestamp
+		}
+		if o.signalRSSI > existing.signalRSSI {
+			existing.signalRSSI = o.signalRSSI
+			if o.hasFineTimestamp() {
+				existing.fineTimestamp = o.fineTimestamp
+			}
+		}
+	}
+	return obs
+}
+
+// point is a position in meters in a local east-north plane.
+type point struct {
+	x, y float64
+}
+
+func (p point) distance(q point) float64 {
+	return math.Hypot(p.x-q.x, p.y-q.y)
+}
+
+// frame is a local east-north plane tangent to the Earth at the reference coordinates.
+// The equirectangular projection is accurate for the distances covered by LoRaWAN gateways.
+type frame struct {
+	latitude, longitude float64
+	cosLatitude         float64
+}
+
+// newFrame returns the local frame centered at the mean coordinates of the observations.
+func newFrame(obs []observation) frame {
+	var lat, lon float64
+	for _, o := range obs {
+		lat += o.latitude
+		lon += o.longitude
+	}
+	lat, lon = lat/float64(len(obs)), lon/float64(len(obs))
+	return frame{
+		latitude:    lat,
+		longitude:   lon,
+		cosLatitude: math.Cos(lat * math.Pi / 180),
+	}
+}
+
+func (f frame) project(latitude, longitude float64) point {
+	return point{
+		x: (longitude - f.longitude) * math.Pi / 180 * earthRadius * f.cosLatitude,
+		y: (latitude - f.latitude) * math.Pi / 180 * earthRadius,
+	}
+}
+
+func (f frame) unproject(p point) (latitude, longitude float64) {
+	return f.latitude + p.y/earthRadius*180/math.Pi,
+		f.longitude + p.x/(earthRadius*f.cosLatitude)*180/math.Pi
+}
+
+// solution is a solved location.
+type solution struct {
+	latitude  float64
+	longitude float64
+	// accuracy is the estimated accuracy in meters.
+	accuracy float64
+	source   ttnpb.LocationSource
+}
+
+// location returns the solution as location.
+func (s *solution) location() *ttnpb.Location {
+	return &ttnpb.Location{
+		Latitude:  s.latitude,
+		Longitude: s.longitude,
+		Accuracy:  int32(math.Ceil(s.accuracy)),
+		Source:    s.source,
+	}
+}
+
+// weightedCentroid returns the centroid of the given positions, weighted by the linear signal amplitude.
+func weightedCentroid(obs []observation, positions []point) point {
+	var (
+		c   point
+		sum float64
+	)
+	for i, o := range obs {
+		w := math.Pow(10, o.signalRSSI/20)
+		c.x += w * positions[i].x
+		c.y += w * positions[i].y
+		sum += w
+	}
+	c.x, c.y = c.x/sum, c.y/sum
+	return c
+}
+
+// solveRSSI returns the RSSI/SNR-weighted centroid of the gateway locations.
+// The accuracy is the weighted root mean square distance of the gateways to the centroid.
+func solveRSSI(obs []observation, minGateways int) (*solution, error) {
+	if len(obs) < minGateways || len(obs) == 0 {
+		return nil, errNotEnoughGateways.WithAttributes(
+			"gateways", len(obs),
+			"min_gateways", minGateways,
+		)
+	}
+	f := newFrame(obs)
+	positions := make([]point, len(obs))
+	for i, o := range obs {
+		positions[i] = f.project(o.latitude, o.longitude)
+	}
+	c := weightedCentroid(obs, positions)
+	var variance, sum float64
+	for i, o := range obs {
+		w := math.Pow(10, o.signalRSSI/20)
+		d := c.distance(positions[i])
+		variance += w * d * d
+		sum += w
+	}
+	lat, lon := f.unproject(c)
+	return &solution{
+		latitude:  lat,
+		longitude: lon,
+		accuracy:  math.Sqrt(variance / sum),
+		source:    ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+	}, nil
+}
+
+// timeDifference returns the difference in nanoseconds between the fine timestamps a and b,
+// taking the wrap around at the GPS second into account.
+func timeDifference(a, b uint64) float64 {
+	d := float64(a) - float64(b)
+	switch {
+	case d > fineTimestampPeriod/2:
+		d -= fineTimestampPeriod
+	case d < -fineTimestampPeriod/2:
+		d += fineTimestampPeriod
+	}
+	return d
+}
+
+// solveTDOA returns the location that best fits the time differences of arrival at the gateways.
+//
+// The unknowns are the position (x, y) and the range offset b, which is the distance that the signal
+// travels in the time between transmission and the fine timestamp of the reference gateway.
+// The measured range of gateway i is then |p - g_i| + b. The unknowns are solved by Gauss-Newton
+// least squares, starting at the RSSI/SNR-weighted centroid. The accuracy is derived from the
+// covariance of the solution, assuming the fine timestamps have a standard deviation of
+// fineTimestampSigma or the root mean square residual, whichever is larger.
+func solveTDOA(obs []observation) (*solution, error) {
+	tdoaObs := make([]observation, 0, len(obs))
+	for _, o := range obs {
+		if o.hasFineTimestamp() {
+			tdoaObs = append(tdoaObs, o)
+		}
+	}
+	if len(tdoaObs) < minTDOAGateways {
+		return nil, errNotEnoughTimestamps.WithAttributes(
+			"gateways", len(tdoaObs),
+			"min_gateways", minTDOAGateways,
+		)
+	}
+	obs = tdoaObs
+
+	f := newFrame(obs)
+	positions := make([]point, len(obs))
+	ranges := make([]float64, len(obs))
+	for i, o := range obs {
+		positions[i] = f.project(o.latitude, o.longitude)
+		ranges[i] = timeDifference(o.fineTimestamp, obs[0].fineTimestamp) * 1e-9 * speedOfLight
+	}
+
+	p := weightedCentroid(obs, positions)
+	var b float64
+	for i := range obs {
+		b += ranges[i] - p.distance(positions[i])
+	}
+	b /= float64(len(obs))
+
+	var (
+		inv       [3][3]float64
+		residuals = make([]float64, len(obs))
+		converged bool
+	)
+	for iteration := 0; iteration < maxIterations && !converged; iteration++ {
+		var ata [3][3]float64
+		var atr [3]float64
+		for i := range obs {
+			d := math.Max(p.distance(positions[i]), convergenceThreshold)
+			row := [3]float64{(p.x - positions[i].x) / d, (p.y - positions[i].y) / d, 1}
+			residuals[i] = ranges[i] - d - b
+			for j := 0; j < 3; j++ {
+				atr[j] += row[j] * residuals[i]
+				for k := 0; k < 3; k++ {
+					ata[j][k] += row[j] * row[k]
+				}
+			}
+		}
+		var ok bool
+		if inv, ok = invert3(ata); !ok {
+			return nil, errSingularGeometry.New()
+		}
+		var delta [3]float64
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				delta[j] += inv[j][k] * atr[k]
+			}
+		}
+		p.x += delta[0]
+		p.y += delta[1]
+		b += delta[2]
+		converged = math.Hypot(delta[0], delta[1]) < convergenceThreshold
+	}
+	if !converged || math.IsNaN(p.x) || math.IsNaN(p.y) || p.distance(point{}) > maxSolutionDistance {
+		return nil, errNoConvergence.New()
+	}
+
+	var sumSquares float64
+	for i := range obs {
+		r := ranges[i] - p.distance(positions[i]) - b
+		sumSquares += r * r
+	}
+	sigma := math.Max(math.Sqrt(sumSquares/float64(len(obs))), fineTimestampSigma*1e-9*speedOfLight)
+	lat, lon := f.unproject(p)
+	return &solution{
+		latitude:  lat,
+		longitude: lon,
+		accuracy:  sigma * math.Sqrt(inv[0][0]+inv[1][1]),
+		source:    ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+	}, nil
+}
+
+// invert3 returns the inverse of the 3x3 matrix m.
+// The second return value is false if the matrix is singular.
+func invert3(m [3][3]float64) ([3][3]float64, bool) {
+	var inv [3][3]float64
+	inv[0][0] = m[1][1]*m[2][2] - m[1][2]*m[2][1]
+	inv[0][1] = m[0][2]*m[2][1] - m[0][1]*m[2][2]
+	inv[0][2] = m[0][1]*m[1][2] - m[0][2]*m[1][1]
+	inv[1][0] = m[1][2]*m[2][0] - m[1][0]*m[2][2]
+	inv[1][1] = m[0][0]*m[2][2] - m[0][2]*m[2][0]
+	inv[1][2] = m[0][2]*m[1][0] - m[0][0]*m[1][2]
+	inv[2][0] = m[1][0]*m[2][1] - m[1][1]*m[2][0]
+	inv[2][1] = m[0][1]*m[2][0] - m[0][0]*m[2][1]
+	inv[2][2] = m[0][0]*m[1][1] - m[0][1]*m[1][0]
+	det := m[0][0]*inv[0][0] + m[0][1]*inv[1][0] + m[0][2]*inv[2][0]
+	if math.Abs(det) < 1e-12 {
+		return inv, false
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			inv[i][j] /= det
+		}
+	}
+	return inv, true
+}
+
+// solve solves the location using the given algorithm.
+func solve(obs []observation, data *Data) (*solution, error) {
+	switch data.Algorithm {
+	case AlgorithmRSSI:
+		return solveRSSI(obs, data.MinGateways)
+	case AlgorithmTDOA:
+		return solveTDOA(obs)
+	default:
+		if s, err := solveTDOA(obs); err == nil {
+			return s, nil
+		}
+		return solveRSSI(obs, data.MinGateways)
+	}
+}
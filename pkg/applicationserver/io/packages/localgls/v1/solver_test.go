@@ -0,0 +1,231 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"math"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// syntheticMetadata returns the metadata of an uplink transmitted at the device coordinates at the given fine
+// timestamp, received by gateways at the given coordinates. Gateways at an odd index have no fine timestamp if
+// withFineTimestamps is false.
+func syntheticMetadata(
+	deviceLat, deviceLon float64, txTimestamp uint64, gateways [][2]float64, withFineTimestamps bool,
+) []*ttnpb.RxMetadata {
+	f := frame{latitude: deviceLat, longitude: deviceLon, cosLatitude: math.Cos(deviceLat * math.Pi / 180)}
+	mds := make([]*ttnpb.RxMetadata, 0, len(gateways))
+	for i, gtw := range gateways {
+		d := f.project(gtw[0], gtw[1]).distance(point{})
+		md := &ttnpb.RxMetadata{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: string(rune('a'+i)) + "-gateway"},
+			Location: &ttnpb.Location{
+				Latitude:  gtw[0],
+				Longitude: gtw[1],
+			},
+			Rssi: float32(-40 - 20*math.Log10(d)),
+			Snr:  10,
+		}
+		if withFineTimestamps || i%2 == 0 {
+			md.FineTimestamp = (txTimestamp + uint64(math.Round(d/speedOfLight*1e9))) % fineTimestampPeriod
+		}
+		mds = append(mds, md)
+	}
+	return mds
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+
+	const deviceLat, deviceLon = 52.3702, 4.8952
+	gateways := [][2]float64{
+		{52.3900, 4.8700},
+		{52.3500, 4.8600},
+		{52.3600, 4.9400},
+		{52.3950, 4.9300},
+	}
+
+	for _, tc := range []struct {
+		Name               string
+		Metadata           []*ttnpb.RxMetadata
+		Data               *Data
+		ExpectedSource     ttnpb.LocationSource
+		MaxError           float64
+		ErrorAssertion     func(error) bool
+		AccuracyAssertions func(*testing.T, int32)
+	}{
+		{
+			Name:           "TDOA",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, 123456789, gateways, true),
+			Data:           &Data{Algorithm: AlgorithmTDOA, MinGateways: 1},
+			ExpectedSource: ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxError:       1,
+		},
+		{
+			Name:           "TDOA/ThreeGateways",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, 123456789, gateways[:3], true),
+			Data:           &Data{Algorithm: AlgorithmTDOA, MinGateways: 1},
+			ExpectedSource: ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxError:       1,
+		},
+		{
+			Name:           "TDOA/SecondWrapAround",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, fineTimestampPeriod-20000, gateways, true),
+			Data:           &Data{Algorithm: AlgorithmTDOA, MinGateways: 1},
+			ExpectedSource: ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxError:       1,
+		},
+		{
+			Name:           "TDOA/NotEnoughTimestamps",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, 123456789, gateways, false),
+			Data:           &Data{Algorithm: AlgorithmTDOA, MinGateways: 1},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name:           "RSSI",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, 123456789, gateways, true),
+			Data:           &Data{Algorithm: AlgorithmRSSI, MinGateways: 1},
+			ExpectedSource: ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+			MaxError:       2000,
+		},
+		{
+			Name:           "RSSI/NotEnoughGateways",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, 123456789, gateways, true),
+			Data:           &Data{Algorithm: AlgorithmRSSI, MinGateways: 5},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name:           "Auto/TDOA",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, 123456789, gateways, true),
+			Data:           &Data{Algorithm: AlgorithmAuto, MinGateways: 1},
+			ExpectedSource: ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+			MaxError:       1,
+		},
+		{
+			Name:           "Auto/RSSIFallback",
+			Metadata:       syntheticMetadata(deviceLat, deviceLon, 123456789, gateways, false),
+			Data:           &Data{Algorithm: AlgorithmAuto, MinGateways: 1},
+			ExpectedSource: ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+			MaxError:       2000,
+		},
+		{
+			Name:           "NoLocation",
+			Metadata:       []*ttnpb.RxMetadata{{Rssi: -50, FineTimestamp: 1000}},
+			Data:           &Data{Algorithm: AlgorithmAuto, MinGateways: 1},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			s, err := solve(observations(tc.Metadata), tc.Data)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			loc := s.location()
+			a.So(loc.Source, should.Equal, tc.ExpectedSource)
+			a.So(loc.Accuracy, should.BeGreaterThan, 0)
+
+			f := frame{latitude: deviceLat, longitude: deviceLon, cosLatitude: math.Cos(deviceLat * math.Pi / 180)}
+			a.So(f.project(loc.Latitude, loc.Longitude).distance(point{}), should.BeLessThan, tc.MaxError)
+		})
+	}
+}
+
+func TestObservations(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	obs := observations([]*ttnpb.RxMetadata{
+		{
+			GatewayIds:   &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"},
+			AntennaIndex: 0,
+			Location:     &ttnpb.Location{Latitude: 52.1, Longitude: 4.1},
+			Rssi:         -100,
+			Snr:          5,
+		},
+		{
+			GatewayIds:    &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"},
+			AntennaIndex:  1,
+			Location:      &ttnpb.Location{Latitude: 52.1, Longitude: 4.1},
+			Rssi:          -90,
+			Snr:           5,
+			FineTimestamp: 1000,
+		},
+		{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"},
+			Rssi:       -80,
+		},
+	})
+	if !a.So(obs, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(obs[0].gatewayID, should.Equal, "gtw-1")
+	a.So(obs[0].fineTimestamp, should.Equal, 1000)
+	a.So(obs[0].signalRSSI, should.BeGreaterThan, -92)
+	a.So(obs[0].signalRSSI, should.BeLessThan, -91)
+}
+
+func TestMergeData(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	data, err := mergeData(nil, &ttnpb.ApplicationPackageAssociation{})
+	if a.So(err, should.BeNil) {
+		a.So(data, should.Resemble, &Data{Algorithm: AlgorithmAuto, MinGateways: defaultMinGateways})
+	}
+
+	data, err = mergeData(
+		&ttnpb.ApplicationPackageDefaultAssociation{
+			Data: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"algorithm":    structpb.NewStringValue("rssi"),
+					"min_gateways": structpb.NewNumberValue(3),
+				},
+			},
+		},
+		&ttnpb.ApplicationPackageAssociation{
+			Data: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"algorithm": structpb.NewStringValue("tdoa"),
+				},
+			},
+		},
+	)
+	if a.So(err, should.BeNil) {
+		a.So(data, should.Resemble, &Data{Algorithm: AlgorithmTDOA, MinGateways: 3})
+	}
+
+	_, err = mergeData(nil, &ttnpb.ApplicationPackageAssociation{
+		Data: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"algorithm": structpb.NewStringValue("magic"),
+			},
+		},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,315 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multicastsetupv1 provides the LoRaWAN Remote Multicast Setup Package (TS005 v1.0.0).
+package multicastsetupv1
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// commandID is the identifier of a multicast setup command.
+type commandID uint8
+
+const (
+	cidPackageVersion  commandID = 0x00
+	cidMcGroupStatus   commandID = 0x01
+	cidMcGroupSetup    commandID = 0x02
+	cidMcGroupDelete   commandID = 0x03
+	cidMcClassCSession commandID = 0x04
+	cidMcClassBSession commandID = 0x05
+)
+
+const (
+	packageIdentifier = 2
+	packageVersion    = 1
+
+	// maxMcGroupID is the maximum multicast group ID.
+	maxMcGroupID = 3
+	// allMcGroupsMask is the multicast group mask that selects all multicast groups.
+	allMcGroupsMask = 0x0F
+	// maxTimeOut is the maximum session timeout exponent.
+	maxTimeOut = 15
+	// maxPeriodicity is the maximum class B ping slot periodicity exponent.
+	maxPeriodicity = 7
+	// frequencyStep is the step of the downlink frequency in Hz, as the frequency is encoded in 24 bits.
+	frequencyStep = 100
+	// maxFrequency is the maximum downlink frequency in Hz.
+	maxFrequency = (1<<24 - 1) * frequencyStep
+
+	timeToStartLength    = 3
+	mcGroupStatusItemLen = 5
+)
+
+// reverse returns a copy of b in reverse byte order.
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+func putFrequency(b []byte, frequency uint64) {
+	f := uint32(frequency / frequencyStep)
+	b[0], b[1], b[2] = byte(f), byte(f>>8), byte(f>>16)
+}
+
+// McGroupStatusReq is the McGroupStatusReq command.
+type McGroupStatusReq struct {
+	ReqGroupMask uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r McGroupStatusReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// CmdMask - byte 1 (bits: RFU [7:4]; ReqGroupMask [3:0]).
+	return []byte{byte(cidMcGroupStatus), r.ReqGroupMask & allMcGroupsMask}, nil
+}
+
+// McGroupSetupReq is the McGroupSetupReq command.
+type McGroupSetupReq struct {
+	McGroupID      uint8
+	McAddr         types.DevAddr
+	McKeyEncrypted types.AES128Key
+	MinMcFCount    uint32
+	MaxMcFCount    uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r McGroupSetupReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// McGroupIDHeader - byte 1 (bits: RFU [7:2]; McGroupID [1:0]).
+	// McAddr - bytes [2, 5].
+	// McKey_encrypted - bytes [6, 21].
+	// minMcFCount - bytes [22, 25].
+	// maxMcFCount - bytes [26, 29].
+	b := make([]byte, 30)
+	b[0] = byte(cidMcGroupSetup)
+	b[1] = r.McGroupID & maxMcGroupID
+	copy(b[2:6], reverse(r.McAddr[:]))
+	copy(b[6:22], r.McKeyEncrypted[:])
+	binary.LittleEndian.PutUint32(b[22:26], r.MinMcFCount)
+	binary.LittleEndian.PutUint32(b[26:30], r.MaxMcFCount)
+	return b, nil
+}
+
+// McGroupDeleteReq is the McGroupDeleteReq command.
+type McGroupDeleteReq struct {
+	McGroupID uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r McGroupDeleteReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// McGroupIDHeader - byte 1 (bits: RFU [7:2]; McGroupID [1:0]).
+	return []byte{byte(cidMcGroupDelete), r.McGroupID & maxMcGroupID}, nil
+}
+
+// McClassCSessionReq is the McClassCSessionReq command.
+type McClassCSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start of the session in seconds since the GPS epoch, modulo 2^32.
+	SessionTime uint32
+	// TimeOut is the maximum duration of the session, as 2^TimeOut seconds.
+	TimeOut uint8
+	// Frequency is the downlink frequency in Hz.
+	Frequency     uint64
+	DataRateIndex uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r McClassCSessionReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// McGroupIDHeader - byte 1 (bits: RFU [7:2]; McGroupID [1:0]).
+	// SessionTime - bytes [2, 5].
+	// SessionTimeOut - byte 6 (bits: RFU [7:4]; TimeOut [3:0]).
+	// DLFrequency - bytes [7, 9].
+	// DR - byte 10.
+	b := make([]byte, 11)
+	b[0] = byte(cidMcClassCSession)
+	b[1] = r.McGroupID & maxMcGroupID
+	binary.LittleEndian.PutUint32(b[2:6], r.SessionTime)
+	b[6] = r.TimeOut & maxTimeOut
+	putFrequency(b[7:10], r.Frequency)
+	b[10] = r.DataRateIndex
+	return b, nil
+}
+
+// McClassBSessionReq is the McClassBSessionReq command.
+type McClassBSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start of the session in seconds since the GPS epoch, modulo 2^32.
+	SessionTime uint32
+	// TimeOut is the maximum duration of the session, as 2^TimeOut beacon periods.
+	TimeOut uint8
+	// Periodicity is the ping slot periodicity, as 2^Periodicity seconds.
+	Periodicity uint8
+	// Frequency is the downlink frequency in Hz.
+	Frequency     uint64
+	DataRateIndex uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r McClassBSessionReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// McGroupIDHeader - byte 1 (bits: RFU [7:2]; McGroupID [1:0]).
+	// SessionTime - bytes [2, 5].
+	// TimeOutPeriodicity - byte 6 (bits: RFU 7; Periodicity [6:4]; TimeOut [3:0]).
+	// DLFrequency - bytes [7, 9].
+	// DR - byte 10.
+	b := make([]byte, 11)
+	b[0] = byte(cidMcClassBSession)
+	b[1] = r.McGroupID & maxMcGroupID
+	binary.LittleEndian.PutUint32(b[2:6], r.SessionTime)
+	b[6] = (r.Periodicity&maxPeriodicity)<<4 | r.TimeOut&maxTimeOut
+	putFrequency(b[7:10], r.Frequency)
+	b[10] = r.DataRateIndex
+	return b, nil
+}
+
+// PackageVersionAns is the PackageVersionAns command.
+type PackageVersionAns struct {
+	PackageIdentifier uint8 `json:"package_identifier"`
+	PackageVersion    uint8 `json:"package_version"`
+}
+
+// McGroupStatusAns is the McGroupStatusAns command.
+type McGroupStatusAns struct {
+	NbTotalGroups uint8
+	// Groups contains the multicast address of the active groups that were requested, by multicast group ID.
+	Groups map[uint8]types.DevAddr
+}
+
+// McGroupSetupAns is the McGroupSetupAns command.
+type McGroupSetupAns struct {
+	McGroupID uint8
+	IDError   bool
+}
+
+// McGroupDeleteAns is the McGroupDeleteAns command.
+type McGroupDeleteAns struct {
+	McGroupID        uint8
+	McGroupUndefined bool
+}
+
+// McSessionAns is the McClassCSessionAns or McClassBSessionAns command.
+type McSessionAns struct {
+	McGroupID        uint8
+	McGroupUndefined bool
+	FreqError        bool
+	DRError          bool
+	// TimeToStart is the number of seconds until the session starts. It is only present if there is no error.
+	TimeToStart uint32
+}
+
+// Accepted returns whether the end device accepted the session.
+func (a McSessionAns) Accepted() bool {
+	return !a.McGroupUndefined && !a.FreqError && !a.DRError
+}
+
+// answerLength returns the length of the payload of the answer with the given command identifier.
+// The payload p starts after the command identifier, and may be used to determine variable lengths.
+func answerLength(cid commandID, p []byte) (int, bool) {
+	switch cid {
+	case cidPackageVersion:
+		return 2, true
+	case cidMcGroupStatus:
+		if len(p) == 0 {
+			return 1, true
+		}
+		return 1 + mcGroupStatusItemLen*bits.OnesCount8(p[0]&allMcGroupsMask), true
+	case cidMcGroupSetup, cidMcGroupDelete:
+		return 1, true
+	case cidMcClassCSession, cidMcClassBSession:
+		if len(p) == 0 || p[0]&0x1C != 0 {
+			return 1, true
+		}
+		return 1 + timeToStartLength, true
+	default:
+		return 0, false
+	}
+}
+
+// parseAnswers parses the answers in the uplink frame payload.
+// The answers are one of PackageVersionAns, McGroupStatusAns, McGroupSetupAns, McGroupDeleteAns or McSessionAns.
+func parseAnswers(b []byte) ([]any, error) {
+	var answers []any
+	for len(b) > 0 {
+		cid := commandID(b[0])
+		n, ok := answerLength(cid, b[1:])
+		if !ok {
+			return answers, errUnknownCommand.WithAttributes("command_id", cid)
+		}
+		if len(b) < 1+n {
+			return answers, errInsufficientLength.WithAttributes(
+				"command_id", cid,
+				"expected_length", n,
+				"actual_length", len(b)-1,
+			)
+		}
+		p := b[1 : 1+n]
+		b = b[1+n:]
+		switch cid {
+		case cidPackageVersion:
+			answers = append(answers, &PackageVersionAns{
+				PackageIdentifier: p[0],
+				PackageVersion:    p[1],
+			})
+		case cidMcGroupStatus:
+			// Status - byte 0 (bits: RFU 7; NbTotalGroups [6:4]; AnsGroupMask [3:0]).
+			// For each group in AnsGroupMask: McGroupID - byte 0 (bits: RFU [7:2]; McGroupID [1:0]); McAddr - bytes [1, 4].
+			ans := &McGroupStatusAns{
+				NbTotalGroups: p[0] >> 4 & 0x07,
+				Groups:        make(map[uint8]types.DevAddr),
+			}
+			for item := p[1:]; len(item) >= mcGroupStatusItemLen; item = item[mcGroupStatusItemLen:] {
+				var addr types.DevAddr
+				copy(addr[:], reverse(item[1:5]))
+				ans.Groups[item[0]&maxMcGroupID] = addr
+			}
+			answers = append(answers, ans)
+		case cidMcGroupSetup:
+			// IDError - byte 0 (bits: RFU [7:3]; IDerror 2; McGroupID [1:0]).
+			answers = append(answers, &McGroupSetupAns{
+				McGroupID: p[0] & maxMcGroupID,
+				IDError:   p[0]&0x04 != 0,
+			})
+		case cidMcGroupDelete:
+			// Status - byte 0 (bits: RFU [7:3]; McGroupUndefined 2; McGroupID [1:0]).
+			answers = append(answers, &McGroupDeleteAns{
+				McGroupID:        p[0] & maxMcGroupID,
+				McGroupUndefined: p[0]&0x04 != 0,
+			})
+		case cidMcClassCSession, cidMcClassBSession:
+			// StatusAndMcGroupID - byte 0 (bits: RFU [7:5]; McGroupUndefined 4; FreqError 3; DRError 2;
+			// McGroupID [1:0]).
+			// TimeToStart - bytes [1, 3], only present if there is no error.
+			ans := &McSessionAns{
+				McGroupID:        p[0] & maxMcGroupID,
+				McGroupUndefined: p[0]&0x10 != 0,
+				FreqError:        p[0]&0x08 != 0,
+				DRError:          p[0]&0x04 != 0,
+			}
+			if len(p) > 1 {
+				ans.TimeToStart = uint32(p[1]) | uint32(p[2])<<8 | uint32(p[3])<<16
+			}
+			answers = append(answers, ans)
+		}
+	}
+	return answers, nil
+}
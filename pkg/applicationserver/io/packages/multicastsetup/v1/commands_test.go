@@ -0,0 +1,218 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"crypto/aes"
+	"encoding"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarshalCommands(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name     string
+		Command  encoding.BinaryMarshaler
+		Expected []byte
+	}{
+		{
+			Name:     "McGroupStatusReq",
+			Command:  McGroupStatusReq{ReqGroupMask: 0xFF},
+			Expected: []byte{0x01, 0x0F},
+		},
+		{
+			Name: "McGroupSetupReq",
+			Command: McGroupSetupReq{
+				McGroupID:      1,
+				McAddr:         types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				McKeyEncrypted: types.AES128Key{0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f},
+				MinMcFCount:    0x10,
+				MaxMcFCount:    0x01020304,
+			},
+			Expected: []byte{
+				0x02, 0x01,
+				0x04, 0x03, 0x02, 0x01,
+				0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+				0x10, 0x00, 0x00, 0x00,
+				0x04, 0x03, 0x02, 0x01,
+			},
+		},
+		{
+			Name:     "McGroupDeleteReq",
+			Command:  McGroupDeleteReq{McGroupID: 3},
+			Expected: []byte{0x03, 0x03},
+		},
+		{
+			Name: "McClassCSessionReq",
+			Command: McClassCSessionReq{
+				McGroupID:     2,
+				SessionTime:   0x01020304,
+				TimeOut:       8,
+				Frequency:     869525000,
+				DataRateIndex: 3,
+			},
+			Expected: []byte{0x04, 0x02, 0x04, 0x03, 0x02, 0x01, 0x08, 0xd2, 0xad, 0x84, 0x03},
+		},
+		{
+			Name: "McClassBSessionReq",
+			Command: McClassBSessionReq{
+				McGroupID:     0,
+				SessionTime:   0x01020304,
+				TimeOut:       2,
+				Periodicity:   5,
+				Frequency:     869525000,
+				DataRateIndex: 3,
+			},
+			Expected: []byte{0x05, 0x00, 0x04, 0x03, 0x02, 0x01, 0x52, 0xd2, 0xad, 0x84, 0x03},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			b, err := tc.Command.MarshalBinary()
+			if a.So(err, should.BeNil) {
+				a.So(b, should.Resemble, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestParseAnswers(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name           string
+		Payload        []byte
+		Expected       []any
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:    "PackageVersionAns",
+			Payload: []byte{0x00, 0x02, 0x01},
+			Expected: []any{
+				&PackageVersionAns{PackageIdentifier: 2, PackageVersion: 1},
+			},
+		},
+		{
+			Name:    "McGroupStatusAns",
+			Payload: []byte{0x01, 0x25, 0x00, 0x04, 0x03, 0x02, 0x01, 0x02, 0x08, 0x07, 0x06, 0x05},
+			Expected: []any{
+				&McGroupStatusAns{
+					NbTotalGroups: 2,
+					Groups: map[uint8]types.DevAddr{
+						0: {0x01, 0x02, 0x03, 0x04},
+						2: {0x05, 0x06, 0x07, 0x08},
+					},
+				},
+			},
+		},
+		{
+			Name:    "MultipleAnswers",
+			Payload: []byte{0x02, 0x05, 0x04, 0x01, 0x10, 0x00, 0x00, 0x05, 0x0a, 0x03, 0x06},
+			Expected: []any{
+				&McGroupSetupAns{McGroupID: 1, IDError: true},
+				&McSessionAns{McGroupID: 1, TimeToStart: 16},
+				&McSessionAns{McGroupID: 2, FreqError: true},
+				&McGroupDeleteAns{McGroupID: 2, McGroupUndefined: true},
+			},
+		},
+		{
+			Name:           "UnknownCommand",
+			Payload:        []byte{0x03, 0x00, 0x7f},
+			Expected:       []any{&McGroupDeleteAns{}},
+			ErrorAssertion: errors.IsNotFound,
+		},
+		{
+			Name:           "InsufficientLength",
+			Payload:        []byte{0x01, 0x01, 0x00, 0x04},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			answers, err := parseAnswers(tc.Payload)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+			a.So(answers, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestEncryptMcKey(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	mcKEKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	mcKey := types.AES128Key{0xf0, 0xe0, 0xd0, 0xc0, 0xb0, 0xa0, 0x90, 0x80, 0x70, 0x60, 0x50, 0x40, 0x30, 0x20, 0x10, 0x00}
+	encrypted := encryptMcKey(mcKEKey, mcKey)
+	a.So(encrypted, should.NotResemble, mcKey)
+
+	// The end device recovers the multicast key by encrypting the received key with its McKEKey.
+	var recovered types.AES128Key
+	block, err := aes.NewCipher(mcKEKey[:])
+	if a.So(err, should.BeNil) {
+		block.Encrypt(recovered[:], encrypted[:])
+		a.So(recovered, should.Resemble, mcKey)
+	}
+
+	mcAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	a.So(deriveMcAppSKey(mcKey, mcAddr), should.Resemble, deriveMcAppSKey(mcKey, mcAddr))
+	a.So(deriveMcAppSKey(mcKey, mcAddr), should.NotResemble, deriveMcAppSKey(mcKey, types.DevAddr{0x01, 0x02, 0x03, 0x05}))
+}
+
+func TestSessionParameters(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	sessionTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := &SessionParameters{
+		Class:         ClassC,
+		SessionTime:   sessionTime,
+		TimeOut:       8,
+		Frequency:     869525000,
+		DataRateIndex: 3,
+	}
+	a.So(params.validate(), should.BeNil)
+	a.So(params.sessionReq(1), should.Resemble, McClassCSessionReq{
+		McGroupID:     1,
+		SessionTime:   uint32(gpstime.ToGPS(sessionTime) / time.Second),
+		TimeOut:       8,
+		Frequency:     869525000,
+		DataRateIndex: 3,
+	})
+
+	for _, invalid := range []func(*SessionParameters){
+		func(p *SessionParameters) { p.Class = "A" },
+		func(p *SessionParameters) { p.SessionTime = time.Time{} },
+		func(p *SessionParameters) { p.TimeOut = 16 },
+		func(p *SessionParameters) { p.Frequency = 869525050 },
+	} {
+		p := *params
+		invalid(&p)
+		a.So(errors.IsInvalidArgument(p.validate()), should.BeTrue)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"encoding"
+	"encoding/json"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// GroupState is the state of a multicast group on an end device.
+type GroupState string
+
+const (
+	// GroupStateSetup means that the group setup is sent to the end device.
+	GroupStateSetup GroupState = "setup"
+	// GroupStateSession means that the end device accepted the group and the session setup is sent to the end device.
+	GroupStateSession GroupState = "session"
+	// GroupStateActive means that the end device accepted the group and its session, if any.
+	GroupStateActive GroupState = "active"
+	// GroupStateFailed means that the end device rejected the group or its session, or lost the group.
+	GroupStateFailed GroupState = "failed"
+	// GroupStateDeleting means that the group deletion is sent to the end device.
+	GroupStateDeleting GroupState = "deleting"
+)
+
+// Class is the class of a multicast session.
+type Class string
+
+const (
+	// ClassB is a class B multicast session.
+	ClassB Class = "B"
+	// ClassC is a class C multicast session.
+	ClassC Class = "C"
+)
+
+// SessionParameters are the parameters of a multicast session.
+type SessionParameters struct {
+	Class Class `json:"class"`
+	// SessionTime is the start of the session.
+	SessionTime time.Time `json:"session_time"`
+	// TimeOut is the maximum duration of the session, as 2^TimeOut seconds for class C,
+	// or 2^TimeOut beacon periods for class B.
+	TimeOut uint8 `json:"time_out"`
+	// Periodicity is the class B ping slot periodicity, as 2^Periodicity seconds.
+	Periodicity uint8 `json:"periodicity,omitempty"`
+	// Frequency is the downlink frequency in Hz.
+	Frequency     uint64 `json:"frequency"`
+	DataRateIndex uint8  `json:"data_rate_index"`
+}
+
+// validate validates the session parameters.
+func (p *SessionParameters) validate() error {
+	switch {
+	case p.Class != ClassB && p.Class != ClassC:
+		return errSessionParameter.WithAttributes("parameter", "class")
+	case p.SessionTime.IsZero():
+		return errSessionParameter.WithAttributes("parameter", "session_time")
+	case p.TimeOut > maxTimeOut:
+		return errSessionParameter.WithAttributes("parameter", "time_out")
+	case p.Periodicity > maxPeriodicity:
+		return errSessionParameter.WithAttributes("parameter", "periodicity")
+	case p.Frequency == 0 || p.Frequency > maxFrequency || p.Frequency%frequencyStep != 0:
+		return errSessionParameter.WithAttributes("parameter", "frequency")
+	}
+	return nil
+}
+
+// sessionReq returns the McClassCSessionReq or McClassBSessionReq of the session.
+func (p *SessionParameters) sessionReq(mcGroupID uint8) encoding.BinaryMarshaler {
+	sessionTime := uint32(gpstime.ToGPS(p.SessionTime) / time.Second)
+	if p.Class == ClassB {
+		return McClassBSessionReq{
+			McGroupID:     mcGroupID,
+			SessionTime:   sessionTime,
+			TimeOut:       p.TimeOut,
+			Periodicity:   p.Periodicity,
+			Frequency:     p.Frequency,
+			DataRateIndex: p.DataRateIndex,
+		}
+	}
+	return McClassCSessionReq{
+		McGroupID:     mcGroupID,
+		SessionTime:   sessionTime,
+		TimeOut:       p.TimeOut,
+		Frequency:     p.Frequency,
+		DataRateIndex: p.DataRateIndex,
+	}
+}
+
+// Group is a multicast group of an end device.
+type Group struct {
+	McGroupID uint8      `json:"mc_group_id"`
+	State     GroupState `json:"state"`
+	// MulticastDeviceID is the ID of the multicast end device of the group, in the application of the end device.
+	MulticastDeviceID string             `json:"multicast_device_id"`
+	McAddr            types.DevAddr      `json:"mc_addr"`
+	MinMcFCount       uint32             `json:"min_mc_f_count"`
+	MaxMcFCount       uint32             `json:"max_mc_f_count"`
+	Session           *SessionParameters `json:"session,omitempty"`
+	// SessionStartsAt is the start of the session, as reported by the end device.
+	SessionStartsAt *time.Time `json:"session_starts_at,omitempty"`
+
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// setupReq returns the McGroupSetupReq of the group, with the multicast key encrypted using the given key.
+func (g *Group) setupReq(mcKEKey, mcKey types.AES128Key) McGroupSetupReq {
+	return McGroupSetupReq{
+		McGroupID:      g.McGroupID,
+		McAddr:         g.McAddr,
+		McKeyEncrypted: encryptMcKey(mcKEKey, mcKey),
+		MinMcFCount:    g.MinMcFCount,
+		MaxMcFCount:    g.MaxMcFCount,
+	}
+}
+
+// keyEnvelope is the representation of a ttnpb.KeyEnvelope in the association data.
+type keyEnvelope struct {
+	KEKLabel     string `json:"kek_label,omitempty"`
+	EncryptedKey []byte `json:"encrypted_key"`
+}
+
+func newKeyEnvelope(ke *ttnpb.KeyEnvelope) *keyEnvelope {
+	return &keyEnvelope{
+		KEKLabel:     ke.KekLabel,
+		EncryptedKey: ke.EncryptedKey,
+	}
+}
+
+func (ke *keyEnvelope) toPB() *ttnpb.KeyEnvelope {
+	return &ttnpb.KeyEnvelope{
+		KekLabel:     ke.KEKLabel,
+		EncryptedKey: ke.EncryptedKey,
+	}
+}
+
+// associationData is the data of the package association of an end device.
+type associationData struct {
+	// McKEKey is the wrapped multicast key encryption key of the end device, derived from its McRootKey.
+	McKEKey *keyEnvelope `json:"mc_ke_key,omitempty"`
+	Groups  []*Group     `json:"groups,omitempty"`
+}
+
+func (d *associationData) fromStruct(st *structpb.Struct) error {
+	if len(st.GetFields()) == 0 {
+		return nil
+	}
+	b, err := protojson.Marshal(st)
+	if err != nil {
+		return errDecodeData.WithCause(err)
+	}
+	if err := json.Unmarshal(b, d); err != nil {
+		return errDecodeData.WithCause(err)
+	}
+	return nil
+}
+
+func (d *associationData) toStruct() (*structpb.Struct, error) {
+	return toStruct(d)
+}
+
+// group returns the group with the given ID.
+func (d *associationData) group(mcGroupID uint8) *Group {
+	for _, g := range d.Groups {
+		if g.McGroupID == mcGroupID {
+			return g
+		}
+	}
+	return nil
+}
+
+// removeGroup removes the group with the given ID.
+func (d *associationData) removeGroup(mcGroupID uint8) {
+	groups := d.Groups[:0]
+	for _, g := range d.Groups {
+		if g.McGroupID != mcGroupID {
+			groups = append(groups, g)
+		}
+	}
+	d.Groups = groups
+}
+
+// toStruct converts the JSON representation of v to a Struct.
+func toStruct(v any) (*structpb.Struct, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, errEncodeData.WithCause(err)
+	}
+	st := &structpb.Struct{}
+	if err := protojson.Unmarshal(b, st); err != nil {
+		return nil, errEncodeData.WithCause(err)
+	}
+	return st, nil
+}
+
+// mergeFPort returns the FPort of the association, falling back to the FPort of the default association.
+func mergeFPort(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) uint32 {
+	if fPort := assoc.GetIds().GetFPort(); fPort != 0 {
+		return fPort
+	}
+	return def.GetIds().GetFPort()
+}
@@ -0,0 +1,54 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAssociationDataMcKEKey(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	keyService := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+	}))
+	mcKEKey := types.AES128Key{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}
+	wrapped, err := cryptoutil.WrapAES128Key(ctx, mcKEKey, "test", keyService)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	st, err := (&associationData{McKEKey: newKeyEnvelope(wrapped)}).toStruct()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	data := &associationData{}
+	if !a.So(data.fromStruct(st), should.BeNil) || !a.So(data.McKEKey, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(data.McKEKey.KEKLabel, should.Equal, "test")
+	a.So(data.McKEKey.EncryptedKey, should.NotResemble, mcKEKey[:])
+
+	key, err := cryptoutil.UnwrapAES128Key(ctx, data.McKEKey.toPB(), keyService)
+	a.So(err, should.BeNil)
+	a.So(key, should.Equal, mcKEKey)
+}
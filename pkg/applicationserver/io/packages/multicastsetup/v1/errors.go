@@ -0,0 +1,67 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errNoAssociation      = errors.DefineInternal("no_association", "no association available")
+	errUnknownCommand     = errors.DefineNotFound("unknown_command", "unknown command `{command_id}`")
+	errInsufficientLength = errors.DefineInvalidArgument(
+		"insufficient_length", "command payload has insufficient length", "command_id", "expected_length", "actual_length",
+	)
+
+	errDecodeData = errors.DefineCorruption("decode_data", "decode package data")
+	errEncodeData = errors.DefineCorruption("encode_data", "encode package data")
+
+	errRequest          = errors.DefineInvalidArgument("request", "invalid request")
+	errMcGroupID        = errors.DefineInvalidArgument("mc_group_id", "invalid multicast group ID `{mc_group_id}`")
+	errSessionParameter = errors.DefineInvalidArgument("session_parameter", "invalid session parameter `{parameter}`")
+	errNoDevices        = errors.DefineInvalidArgument("no_devices", "no end devices")
+	errNoSession        = errors.DefineFailedPrecondition(
+		"no_session", "multicast end device `{device_uid}` has no session",
+	)
+	errMcKey = errors.DefineFailedPrecondition(
+		"mc_key", "multicast key does not match the session of multicast end device `{device_uid}`",
+	)
+	errNoMcKEKey = errors.DefineFailedPrecondition(
+		"no_mc_ke_key", "no multicast key encryption key in association of end device `{device_uid}`",
+	)
+	errUnwrapMcKEKey = errors.DefineCorruption(
+		"unwrap_mc_ke_key", "unwrap multicast key encryption key of end device `{device_uid}`",
+	)
+	errNotAssociated = errors.DefineNotFound(
+		"not_associated", "end device `{device_uid}` is not associated with the package",
+	)
+	errFPortInUse = errors.DefineFailedPrecondition(
+		"f_port_in_use", "FPort `{f_port}` is associated with package `{package_name}`",
+	)
+	errGroupExists = errors.DefineAlreadyExists(
+		"group_exists", "multicast group `{mc_group_id}` already exists",
+	)
+	errGroupNotFound = errors.DefineNotFound(
+		"group_not_found", "multicast group `{mc_group_id}` not found",
+	)
+	errGroupRejected = errors.DefineAborted(
+		"group_rejected", "multicast group `{mc_group_id}` rejected by end device",
+	)
+	errSessionRejected = errors.DefineAborted(
+		"session_rejected", "multicast session of group `{mc_group_id}` rejected by end device",
+		"mc_group_undefined", "freq_error", "dr_error",
+	)
+	errGroupLost = errors.DefineAborted(
+		"group_lost", "multicast group `{mc_group_id}` not active on end device",
+	)
+)
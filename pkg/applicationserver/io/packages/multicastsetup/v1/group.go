@@ -0,0 +1,515 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// SetupGroupRequest is a request to set up a multicast group on a set of end devices.
+type SetupGroupRequest struct {
+	// FPort is the FPort of the package. The default FPort of the package is used if zero.
+	FPort     uint32 `json:"f_port"`
+	McGroupID uint8  `json:"mc_group_id"`
+	// MulticastDeviceID is the ID of the multicast end device, in the same application as the end devices.
+	// The multicast address is the device address of its session, and the multicast key must match its session keys.
+	MulticastDeviceID string          `json:"multicast_device_id"`
+	McKey             types.AES128Key `json:"mc_key"`
+	MinMcFCount       uint32          `json:"min_mc_f_count"`
+	// MaxMcFCount is the maximum multicast frame counter. The maximum value is used if zero.
+	MaxMcFCount uint32 `json:"max_mc_f_count"`
+	// Session contains the parameters of the class B or class C session, if any.
+	Session   *SessionParameters `json:"session,omitempty"`
+	DeviceIDs []string           `json:"device_ids"`
+}
+
+// SetupGroupResult is the result of setting up a multicast group on an end device.
+type SetupGroupResult struct {
+	DeviceID string `json:"device_id"`
+	Group    *Group `json:"group,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// updateData updates the association data of the end device. The association is created if it does not exist.
+func (p *multicastSetupPackage) updateData(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, f func(*associationData) error,
+) error {
+	_, err := p.registry.SetAssociation(
+		ctx, ids, []string{"data", "package_name"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			paths := []string{"data"}
+			if assoc == nil {
+				assoc = &ttnpb.ApplicationPackageAssociation{
+					Ids:         ids,
+					PackageName: PackageName,
+				}
+				paths = append(paths, "ids", "package_name")
+			} else if assoc.PackageName != PackageName {
+				return nil, nil, errFPortInUse.WithAttributes(
+					"f_port", ids.FPort,
+					"package_name", assoc.PackageName,
+				)
+			}
+			data := &associationData{}
+			if err := data.fromStruct(assoc.Data); err != nil {
+				return nil, nil, err
+			}
+			if err := f(data); err != nil {
+				return nil, nil, err
+			}
+			st, err := data.toStruct()
+			if err != nil {
+				return nil, nil, err
+			}
+			assoc.Data = st
+			return assoc, paths, nil
+		},
+	)
+	return err
+}
+
+// updateGroup updates the group with the given ID, and returns a copy of the updated group.
+func (p *multicastSetupPackage) updateGroup(
+	ctx context.Context,
+	ids *ttnpb.ApplicationPackageAssociationIdentifiers,
+	mcGroupID uint8,
+	f func(*Group) error,
+) (*Group, error) {
+	var updated Group
+	err := p.updateData(ctx, ids, func(data *associationData) error {
+		group := data.group(mcGroupID)
+		if group == nil {
+			return errGroupNotFound.WithAttributes("mc_group_id", mcGroupID)
+		}
+		if err := f(group); err != nil {
+			return err
+		}
+		group.UpdatedAt = time.Now().UTC()
+		updated = *group
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// listAssociations lists the associations of the end device with the package.
+func (p *multicastSetupPackage) listAssociations(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) ([]*ttnpb.ApplicationPackageAssociation, error) {
+	assocs, err := p.registry.ListAssociations(ctx, ids, []string{"data", "ids", "package_name"})
+	if err != nil {
+		return nil, err
+	}
+	res := assocs[:0]
+	for _, assoc := range assocs {
+		if assoc.PackageName == PackageName {
+			res = append(res, assoc)
+		}
+	}
+	return res, nil
+}
+
+// findGroup finds the association that contains the group with the given ID.
+func (p *multicastSetupPackage) findGroup(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, mcGroupID uint8,
+) (*ttnpb.ApplicationPackageAssociationIdentifiers, *Group, error) {
+	assocs, err := p.listAssociations(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, assoc := range assocs {
+		data := &associationData{}
+		if err := data.fromStruct(assoc.Data); err != nil {
+			return nil, nil, err
+		}
+		if group := data.group(mcGroupID); group != nil {
+			return assoc.Ids, group, nil
+		}
+	}
+	return nil, nil, errGroupNotFound.WithAttributes("mc_group_id", mcGroupID)
+}
+
+// multicastAddr returns the multicast address of the multicast end device.
+// The multicast key is verified against the application session key of the multicast end device, if available.
+func (p *multicastSetupPackage) multicastAddr(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, mcKey types.AES128Key,
+) (types.DevAddr, error) {
+	dev, err := p.server.GetEndDevice(ctx, ids, []string{"session"})
+	if err != nil {
+		return types.DevAddr{}, err
+	}
+	session := dev.GetSession()
+	if session == nil {
+		return types.DevAddr{}, errNoSession.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	mcAddr := types.MustDevAddr(session.DevAddr).OrZero()
+	if appSKey := session.GetKeys().GetAppSKey(); appSKey != nil {
+		key, err := cryptoutil.UnwrapAES128Key(ctx, appSKey, p.keyService)
+		if err != nil {
+			return types.DevAddr{}, err
+		}
+		if key != deriveMcAppSKey(mcKey, mcAddr) {
+			return types.DevAddr{}, errMcKey.WithAttributes("device_uid", unique.ID(ctx, ids))
+		}
+	}
+	return mcAddr, nil
+}
+
+// ListGroups lists the multicast groups of the end device.
+func (p *multicastSetupPackage) ListGroups(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*Group, error) {
+	assocs, err := p.listAssociations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*Group, 0)
+	for _, assoc := range assocs {
+		data := &associationData{}
+		if err := data.fromStruct(assoc.Data); err != nil {
+			return nil, err
+		}
+		groups = append(groups, data.Groups...)
+	}
+	return groups, nil
+}
+
+// SetupGroup sets up the multicast group on the end devices in the application.
+// The group setup is enqueued for each end device; the session setup is enqueued when the end device accepts the group.
+// Errors of individual end devices are reported in the results.
+func (p *multicastSetupPackage) SetupGroup(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, req *SetupGroupRequest,
+) ([]*SetupGroupResult, error) {
+	if req.McGroupID > maxMcGroupID {
+		return nil, errMcGroupID.WithAttributes("mc_group_id", req.McGroupID)
+	}
+	if len(req.DeviceIDs) == 0 {
+		return nil, errNoDevices.New()
+	}
+	if req.Session != nil {
+		if err := req.Session.validate(); err != nil {
+			return nil, err
+		}
+	}
+	maxMcFCount := req.MaxMcFCount
+	if maxMcFCount == 0 {
+		maxMcFCount = math.MaxUint32
+	}
+	if req.MinMcFCount > maxMcFCount {
+		return nil, errRequest.New()
+	}
+	mcIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appIDs,
+		DeviceId:       req.MulticastDeviceID,
+	}
+	if err := mcIDs.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	mcAddr, err := p.multicastAddr(ctx, mcIDs, req.McKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fPort := req.FPort
+	if fPort == 0 {
+		fPort = DefaultFPort
+	}
+	results := make([]*SetupGroupResult, 0, len(req.DeviceIDs))
+	for _, deviceID := range req.DeviceIDs {
+		ids := &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       deviceID,
+		}
+		now := time.Now().UTC()
+		group := &Group{
+			McGroupID:         req.McGroupID,
+			State:             GroupStateSetup,
+			MulticastDeviceID: req.MulticastDeviceID,
+			McAddr:            mcAddr,
+			MinMcFCount:       req.MinMcFCount,
+			MaxMcFCount:       maxMcFCount,
+			Session:           req.Session,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+		result := &SetupGroupResult{
+			DeviceID: deviceID,
+		}
+		if err := p.setupGroup(ctx, ids, fPort, group, req.McKey); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("device_id", deviceID).Debug("Failed to set up multicast group")
+			result.Error = err.Error()
+		} else {
+			result.Group = group
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (p *multicastSetupPackage) setupGroup(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fPort uint32, group *Group, mcKey types.AES128Key,
+) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	var mcKEKey types.AES128Key
+	assocIDs := &ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIds: ids,
+		FPort:        fPort,
+	}
+	if err := p.updateData(ctx, assocIDs, func(data *associationData) error {
+		if data.McKEKey == nil {
+			return errNoMcKEKey.WithAttributes("device_uid", unique.ID(ctx, ids))
+		}
+		key, err := cryptoutil.UnwrapAES128Key(ctx, data.McKEKey.toPB(), p.keyService)
+		if err != nil {
+			return errUnwrapMcKEKey.WithAttributes("device_uid", unique.ID(ctx, ids)).WithCause(err)
+		}
+		mcKEKey = key
+		if existing := data.group(group.McGroupID); existing != nil && existing.State != GroupStateFailed {
+			return errGroupExists.WithAttributes("mc_group_id", group.McGroupID)
+		}
+		data.removeGroup(group.McGroupID)
+		data.Groups = append(data.Groups, group)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := p.pushDownlinks(ctx, ids, fPort, group.setupReq(mcKEKey, mcKey)); err != nil {
+		return err
+	}
+	p.publishGroup(ctx, ids, EvtGroupSetup, group)
+	return nil
+}
+
+// SetMcKEKey stores the multicast key encryption key in the package associations of the end device.
+// The key is wrapped with the KEK of the package before it is stored.
+func (p *multicastSetupPackage) SetMcKEKey(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, mcKEKey types.AES128Key,
+) error {
+	assocs, err := p.listAssociations(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(assocs) == 0 {
+		return errNotAssociated.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	wrapped, err := cryptoutil.WrapAES128Key(ctx, mcKEKey, p.kekLabel, p.keyService)
+	if err != nil {
+		return err
+	}
+	for _, assoc := range assocs {
+		if err := p.updateData(ctx, assoc.Ids, func(data *associationData) error {
+			data.McKEKey = newKeyEnvelope(wrapped)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestStatus enqueues a request for the status of all multicast groups of the end device.
+func (p *multicastSetupPackage) RequestStatus(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	assocs, err := p.listAssociations(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(assocs) == 0 {
+		return errNotAssociated.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	for _, assoc := range assocs {
+		if err := p.pushDownlinks(ctx, ids, assoc.Ids.FPort, McGroupStatusReq{
+			ReqGroupMask: allMcGroupsMask,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteGroup enqueues the deletion of the multicast group.
+// The group is removed when the end device answers the deletion.
+func (p *multicastSetupPackage) DeleteGroup(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, mcGroupID uint8,
+) (*Group, error) {
+	assocIDs, _, err := p.findGroup(ctx, ids, mcGroupID)
+	if err != nil {
+		return nil, err
+	}
+	group, err := p.updateGroup(ctx, assocIDs, mcGroupID, func(group *Group) error {
+		group.State = GroupStateDeleting
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := p.pushDownlinks(ctx, ids, assocIDs.FPort, McGroupDeleteReq{
+		McGroupID: mcGroupID,
+	}); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (p *multicastSetupPackage) handleSetupAns(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, ans *McGroupSetupAns,
+) ([]*Group, events.Builder, error) {
+	rejectErr := errGroupRejected.WithAttributes("mc_group_id", ans.McGroupID)
+	group, err := p.updateGroup(ctx, ids, ans.McGroupID, func(group *Group) error {
+		if group.State != GroupStateSetup {
+			return errGroupNotFound.WithAttributes("mc_group_id", ans.McGroupID)
+		}
+		switch {
+		case ans.IDError:
+			group.State = GroupStateFailed
+			group.Error = rejectErr.Error()
+		case group.Session != nil:
+			group.State = GroupStateSession
+		default:
+			group.State = GroupStateActive
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch group.State {
+	case GroupStateFailed:
+		return []*Group{group}, nil, rejectErr
+	case GroupStateSession:
+		if err := p.pushDownlinks(
+			ctx, ids.EndDeviceIds, ids.FPort, group.Session.sessionReq(group.McGroupID),
+		); err != nil {
+			return []*Group{group}, nil, err
+		}
+		return []*Group{group}, EvtGroupSession, nil
+	default:
+		return []*Group{group}, EvtGroupActive, nil
+	}
+}
+
+func (p *multicastSetupPackage) handleSessionAns(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, ans *McSessionAns,
+) ([]*Group, events.Builder, error) {
+	rejectErr := errSessionRejected.WithAttributes(
+		"mc_group_id", ans.McGroupID,
+		"mc_group_undefined", ans.McGroupUndefined,
+		"freq_error", ans.FreqError,
+		"dr_error", ans.DRError,
+	)
+	group, err := p.updateGroup(ctx, ids, ans.McGroupID, func(group *Group) error {
+		if group.State != GroupStateSession && group.State != GroupStateActive {
+			return errGroupNotFound.WithAttributes("mc_group_id", ans.McGroupID)
+		}
+		if !ans.Accepted() {
+			group.State = GroupStateFailed
+			group.Error = rejectErr.Error()
+			return nil
+		}
+		startsAt := time.Now().UTC().Add(time.Duration(ans.TimeToStart) * time.Second).Truncate(time.Second)
+		group.State = GroupStateActive
+		group.SessionStartsAt = &startsAt
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if group.State == GroupStateFailed {
+		return []*Group{group}, nil, rejectErr
+	}
+	return []*Group{group}, EvtGroupActive, nil
+}
+
+func (p *multicastSetupPackage) handleStatusAns(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, ans *McGroupStatusAns,
+) ([]*Group, events.Builder, error) {
+	var groups []*Group
+	err := p.updateData(ctx, ids, func(data *associationData) error {
+		now := time.Now().UTC()
+		groups = make([]*Group, 0, len(data.Groups))
+		for _, group := range data.Groups {
+			// The status is always requested for all groups, so groups that are not reported are not active.
+			mcAddr, ok := ans.Groups[group.McGroupID]
+			switch group.State {
+			case GroupStateSession, GroupStateActive:
+				if !ok || mcAddr != group.McAddr {
+					group.State = GroupStateFailed
+					group.Error = errGroupLost.WithAttributes("mc_group_id", group.McGroupID).Error()
+				}
+			default:
+				continue
+			}
+			group.UpdatedAt = now
+			updated := *group
+			groups = append(groups, &updated)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return groups, EvtGroupStatus, nil
+}
+
+func (p *multicastSetupPackage) handleDeleteAns(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, ans *McGroupDeleteAns,
+) ([]*Group, events.Builder, error) {
+	var deleted *Group
+	err := p.updateData(ctx, ids, func(data *associationData) error {
+		group := data.group(ans.McGroupID)
+		if group == nil {
+			return errGroupNotFound.WithAttributes("mc_group_id", ans.McGroupID)
+		}
+		deleted = group
+		deleted.UpdatedAt = time.Now().UTC()
+		data.removeGroup(ans.McGroupID)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return []*Group{deleted}, EvtGroupDelete, nil
+}
+
+// publishGroup publishes the event (optional) and service data of the group.
+func (p *multicastSetupPackage) publishGroup(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, evt events.Builder, group *Group,
+) {
+	logger := log.FromContext(ctx)
+	st, err := toStruct(group)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to encode group")
+		return
+	}
+	if evt != nil {
+		publishEvents(ctx, evt.With(
+			events.WithIdentifiers(ids),
+			events.WithData(st),
+		))
+	}
+	if err := p.sendServiceData(ctx, ids, st); err != nil {
+		logger.WithError(err).Warn("Failed to send service data")
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"crypto/aes"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// encryptMcKey encrypts the multicast key with the multicast key encryption key of the end device.
+// As specified, the end device recovers the multicast key by encrypting it, so the key is decrypted here.
+func encryptMcKey(mcKEKey, mcKey types.AES128Key) (encrypted types.AES128Key) {
+	block, _ := aes.NewCipher(mcKEKey[:])
+	block.Decrypt(encrypted[:], mcKey[:])
+	return encrypted
+}
+
+// deriveMcAppSKey derives the McAppSKey from the multicast key and address.
+func deriveMcAppSKey(mcKey types.AES128Key, mcAddr types.DevAddr) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	buf[0] = 0x01
+	copy(buf[1:5], reverse(mcAddr[:]))
+	block, _ := aes.NewCipher(mcKey[:])
+	block.Encrypt(derived[:], buf)
+	return derived
+}
@@ -0,0 +1,69 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func publishEvents(ctx context.Context, builders ...events.Builder) {
+	n := len(builders)
+	if n == 0 {
+		return
+	}
+
+	evts := events.Builders(builders).New(ctx)
+	log.FromContext(ctx).WithField("event_count", n).Debug("Publish events")
+	events.Publish(evts...)
+}
+
+func eventOptions(extraOpts ...events.Option) []events.Option {
+	return append([]events.Option{events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)}, extraOpts...)
+}
+
+func defineGroupEvent(name, desc string) events.Builder {
+	return events.Define(
+		fmt.Sprintf("as.packages.multicastsetup.v1.group.%s", name),
+		desc,
+		eventOptions(events.WithDataType(&structpb.Struct{}))...,
+	)
+}
+
+var (
+	// EvtGroupSetup is the event that is published when a multicast group setup is enqueued.
+	EvtGroupSetup = defineGroupEvent("setup", "multicast group setup enqueued")
+	// EvtGroupSession is the event that is published when a multicast group is accepted by the end device
+	// and the session setup is enqueued.
+	EvtGroupSession = defineGroupEvent("session", "multicast group session setup enqueued")
+	// EvtGroupActive is the event that is published when the end device accepted the multicast group and its session.
+	EvtGroupActive = defineGroupEvent("active", "multicast group active")
+	// EvtGroupStatus is the event that is published when the status of a multicast group is received.
+	EvtGroupStatus = defineGroupEvent("status", "multicast group status received")
+	// EvtGroupDelete is the event that is published when a multicast group is deleted.
+	EvtGroupDelete = defineGroupEvent("delete", "multicast group deleted")
+
+	// EvtPkgFail is the event that is published when an error occurs in the package.
+	EvtPkgFail = events.Define(
+		"as.packages.multicastsetup.v1.fail", "package failed due to error", eventOptions(
+			events.WithErrorDataType(), events.WithPropagateToParent(),
+		)...,
+	)
+)
@@ -0,0 +1,196 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"context"
+	"encoding"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// PackageName is the name of the package.
+	PackageName = "multicast-setup-v1"
+	// DefaultFPort is the default FPort of the package.
+	DefaultFPort = 200
+
+	namespace = "applicationserver/io/packages/multicastsetup/v1"
+)
+
+type multicastSetupPackage struct {
+	server     io.Server
+	registry   packages.Registry
+	keyService crypto.KeyService
+	kekLabel   string
+}
+
+var (
+	_ packages.ApplicationPackageHandler = (*multicastSetupPackage)(nil)
+	_ web.Registerer                     = (*multicastSetupPackage)(nil)
+)
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *multicastSetupPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		logger.Error("No association available")
+		return errNoAssociation.New()
+	}
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		logger.Debug("Uplink is not an uplink message")
+		return nil
+	}
+
+	eventBuilders := make(events.Builders, 0)
+	defer func(ids *ttnpb.EndDeviceIdentifiers) {
+		if err != nil {
+			eventBuilders = append(eventBuilders, EvtPkgFail.With(
+				events.WithIdentifiers(ids),
+				events.WithData(err),
+			))
+		}
+		publishEvents(ctx, eventBuilders...)
+	}(up.GetEndDeviceIds())
+
+	fPort := mergeFPort(def, assoc)
+	if msg.GetFPort() != fPort {
+		logger.WithFields(log.Fields(
+			"expected_fport", fPort,
+			"received_fport", msg.GetFPort(),
+		)).Debug("Uplink received on unhandled FPort")
+		return nil
+	}
+	if len(msg.GetFrmPayload()) == 0 {
+		logger.Debug("Uplink message has no payload")
+		return nil
+	}
+
+	answers, err := parseAnswers(msg.GetFrmPayload())
+	if err != nil {
+		logger.WithError(err).Debug("Failed to parse frame payload into answers")
+	}
+	ids := &ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIds: up.GetEndDeviceIds(),
+		FPort:        fPort,
+	}
+	for _, ans := range answers {
+		var (
+			groups []*Group
+			evt    events.Builder
+			ansErr error
+		)
+		switch ans := ans.(type) {
+		case *PackageVersionAns:
+			logger.WithFields(log.Fields(
+				"package_identifier", ans.PackageIdentifier,
+				"package_version", ans.PackageVersion,
+			)).Debug("Package version received")
+			continue
+		case *McGroupStatusAns:
+			groups, evt, ansErr = p.handleStatusAns(ctx, ids, ans)
+		case *McGroupSetupAns:
+			groups, evt, ansErr = p.handleSetupAns(ctx, ids, ans)
+		case *McGroupDeleteAns:
+			groups, evt, ansErr = p.handleDeleteAns(ctx, ids, ans)
+		case *McSessionAns:
+			groups, evt, ansErr = p.handleSessionAns(ctx, ids, ans)
+		}
+		for _, group := range groups {
+			p.publishGroup(ctx, ids.EndDeviceIds, evt, group)
+		}
+		if ansErr != nil {
+			logger.WithError(ansErr).Debug("Failed to handle answer")
+			eventBuilders = append(eventBuilders, EvtPkgFail.With(
+				events.WithIdentifiers(ids.EndDeviceIds),
+				events.WithData(ansErr),
+			))
+		}
+	}
+	return err
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*multicastSetupPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: DefaultFPort,
+	}
+}
+
+// pushDownlinks pushes the commands to the downlink queue of the end device, one command per downlink.
+func (p *multicastSetupPackage) pushDownlinks(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fPort uint32, cmds ...encoding.BinaryMarshaler,
+) error {
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(cmds))
+	for _, cmd := range cmds {
+		b, err := cmd.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		downlinks = append(downlinks, &ttnpb.ApplicationDownlink{
+			FPort:      fPort,
+			FrmPayload: b,
+		})
+	}
+	return p.server.DownlinkQueuePush(ctx, ids, downlinks)
+}
+
+func (p *multicastSetupPackage) sendServiceData(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, data *structpb.Struct,
+) error {
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     timestamppb.Now(),
+		Up: &ttnpb.ApplicationUp_ServiceData{
+			ServiceData: &ttnpb.ApplicationServiceData{
+				Data:    data,
+				Service: PackageName,
+			},
+		},
+	})
+}
+
+// New returns a new Remote Multicast Setup package.
+// The key service is used to unwrap the session keys of multicast end devices, and to wrap the multicast key
+// encryption keys of end devices with the KEK with the given label.
+func New(
+	server io.Server, registry packages.Registry, keyService crypto.KeyService, kekLabel string,
+) packages.ApplicationPackageHandler {
+	return &multicastSetupPackage{
+		server:     server,
+		registry:   registry,
+		keyService: keyService,
+		kekLabel:   kekLabel,
+	}
+}
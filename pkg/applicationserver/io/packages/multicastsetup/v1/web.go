@@ -0,0 +1,206 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+type applicationHandlerFunc func(http.ResponseWriter, *http.Request, *ttnpb.ApplicationIdentifiers)
+
+type deviceHandlerFunc func(http.ResponseWriter, *http.Request, *ttnpb.EndDeviceIdentifiers)
+
+// withApplication validates the application identifiers in the path and requires the given application rights.
+func withApplication(h applicationHandlerFunc, required ...ttnpb.Right) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		ids := &ttnpb.ApplicationIdentifiers{
+			ApplicationId: mux.Vars(req)["application_id"],
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			webhandlers.Error(res, req, err)
+			return
+		}
+		if err := rights.RequireApplication(ctx, ids, required...); err != nil {
+			webhandlers.Error(res, req, err)
+			return
+		}
+		h(res, req, ids)
+	})
+}
+
+// withDevice validates the end device identifiers in the path and requires the given application rights.
+func withDevice(h deviceHandlerFunc, required ...ttnpb.Right) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		vars := mux.Vars(req)
+		ids := &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{
+				ApplicationId: vars["application_id"],
+			},
+			DeviceId: vars["device_id"],
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			webhandlers.Error(res, req, err)
+			return
+		}
+		if err := rights.RequireApplication(ctx, ids.ApplicationIds, required...); err != nil {
+			webhandlers.Error(res, req, err)
+			return
+		}
+		h(res, req, ids)
+	})
+}
+
+func mcGroupIDFromRequest(req *http.Request) (uint8, error) {
+	s := mux.Vars(req)["mc_group_id"]
+	mcGroupID, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || mcGroupID > maxMcGroupID {
+		return 0, errMcGroupID.WithAttributes("mc_group_id", s)
+	}
+	return uint8(mcGroupID), nil
+}
+
+func writeJSON(res http.ResponseWriter, status int, v any) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(v) //nolint:errcheck
+}
+
+// RegisterRoutes implements web.Registerer.
+func (p *multicastSetupPackage) RegisterRoutes(server *web.Server) {
+	middleware := []mux.MiddlewareFunc{
+		mux.MiddlewareFunc(webmiddleware.Namespace(namespace)),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+	}
+
+	appRouter := server.Prefix(
+		ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}/packages/" + PackageName + "/groups",
+	).Subrouter()
+	appRouter.Use(middleware...)
+	appRouter.Handle("", withApplication(
+		p.handleSetupGroup, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	)).Methods(http.MethodPost)
+
+	devRouter := server.Prefix(
+		ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}/devices/{device_id}/packages/" + PackageName + "/groups",
+	).Subrouter()
+	devRouter.Use(middleware...)
+	devRouter.Handle("", withDevice(
+		p.handleListGroups, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+	)).Methods(http.MethodGet)
+	devRouter.Handle("/status", withDevice(
+		p.handleRequestStatus, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	)).Methods(http.MethodPost)
+	devRouter.Handle("/{mc_group_id}", withDevice(
+		p.handleDeleteGroup, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	)).Methods(http.MethodDelete)
+
+	keyRouter := server.Prefix(
+		ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}/devices/{device_id}/packages/" + PackageName + "/mc-ke-key",
+	).Subrouter()
+	keyRouter.Use(middleware...)
+	keyRouter.Handle("", withDevice(
+		p.handleSetMcKEKey, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	)).Methods(http.MethodPut)
+}
+
+func (p *multicastSetupPackage) handleSetMcKEKey(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	body := &struct {
+		McKEKey types.AES128Key `json:"mc_ke_key"`
+	}{}
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		webhandlers.Error(res, req, errRequest.WithCause(err))
+		return
+	}
+	if err := p.SetMcKEKey(req.Context(), ids, body.McKEKey); err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (p *multicastSetupPackage) handleSetupGroup(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.ApplicationIdentifiers,
+) {
+	body := &SetupGroupRequest{}
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		webhandlers.Error(res, req, errRequest.WithCause(err))
+		return
+	}
+	results, err := p.SetupGroup(req.Context(), ids, body)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, struct {
+		Results []*SetupGroupResult `json:"results"`
+	}{
+		Results: results,
+	})
+}
+
+func (p *multicastSetupPackage) handleListGroups(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	groups, err := p.ListGroups(req.Context(), ids)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	writeJSON(res, http.StatusOK, struct {
+		Groups []*Group `json:"groups"`
+	}{
+		Groups: groups,
+	})
+}
+
+func (p *multicastSetupPackage) handleRequestStatus(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	if err := p.RequestStatus(req.Context(), ids); err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	res.WriteHeader(http.StatusAccepted)
+}
+
+func (p *multicastSetupPackage) handleDeleteGroup(
+	res http.ResponseWriter, req *http.Request, ids *ttnpb.EndDeviceIdentifiers,
+) {
+	mcGroupID, err := mcGroupIDFromRequest(req)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	group, err := p.DeleteGroup(req.Context(), ids, mcGroupID)
+	if err != nil {
+		webhandlers.Error(res, req, err)
+		return
+	}
+	writeJSON(res, http.StatusAccepted, group)
+}
@@ -0,0 +1,32 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "time"
+
+// PostgresConfig is the configuration of the PostgreSQL storage provider.
+type PostgresConfig struct {
+	DatabaseURI string `name:"database-uri" description:"Database connection URI"`
+}
+
+// Config is the configuration of the Storage Integration.
+type Config struct {
+	Store Store `name:"-"`
+
+	Provider        string         `name:"provider" description:"Storage provider (postgres). Leave empty to disable the Storage Integration"` // nolint:lll
+	Postgres        PostgresConfig `name:"postgres" description:"PostgreSQL storage provider configuration"`
+	Retention       time.Duration  `name:"retention" description:"Time after which stored messages are deleted (0 to keep messages indefinitely)"` // nolint:lll
+	CleanupInterval time.Duration  `name:"cleanup-interval" description:"Interval at which expired messages are deleted"`
+}
@@ -0,0 +1,26 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errInvalidInterval   = errors.DefineInvalidArgument("invalid_interval", "invalid cleanup interval `{interval}`")
+	errIdentifiers       = errors.DefineInvalidArgument("identifiers", "exactly one of application or end device identifiers must be set") // nolint:lll
+	errLastAndTimeRange  = errors.DefineInvalidArgument("last_and_time_range", "`last` cannot be used with `after` or `before`")           // nolint:lll
+	errContinuationToken = errors.DefineInvalidArgument("continuation_token", "invalid continuation token")
+	errUnknownType       = errors.DefineInvalidArgument("unknown_type", "unknown message type `{type}`")
+	errUnknownOrder      = errors.DefineInvalidArgument("unknown_order", "unknown order `{order}`")
+)
@@ -0,0 +1,134 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// appendImplicitStoredUpGetPaths appends implicit ttnpb.ApplicationUp get paths to paths.
+func appendImplicitStoredUpGetPaths(paths ...string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+	return append(append(make([]string, 0, 2+len(paths)),
+		"end_device_ids",
+		"received_at",
+	), paths...)
+}
+
+func filterApplicationUp(up *ttnpb.ApplicationUp, paths []string) (*ttnpb.ApplicationUp, error) {
+	if len(paths) == 0 {
+		return up, nil
+	}
+	res := &ttnpb.ApplicationUp{}
+	if err := res.SetFields(up, paths...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func setContinuationTokenHeader(ctx context.Context, token string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs("x-continuation-token", token))
+}
+
+// GetStoredApplicationUp implements ttnpb.ApplicationUpStorageServer.
+func (p *storagePackage) GetStoredApplicationUp(
+	req *ttnpb.GetStoredApplicationUpRequest, stream ttnpb.ApplicationUpStorage_GetStoredApplicationUpServer,
+) error {
+	ctx := stream.Context()
+	filter, paths, err := filterFromRequest(req, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := rights.RequireApplication(
+		ctx, filter.ApplicationIdentifiers(), ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return err
+	}
+	getPaths := appendImplicitStoredUpGetPaths(paths...)
+
+	if filter.Limit == 0 {
+		return p.store.Range(ctx, filter, func(_ int64, up *ttnpb.ApplicationUp) error {
+			up, err := filterApplicationUp(up, getPaths)
+			if err != nil {
+				return err
+			}
+			return stream.Send(up)
+		})
+	}
+
+	// Retrieve one message more than the limit in order to determine if a continuation token
+	// should be returned. The header must be set before the first message is sent.
+	limit := filter.Limit
+	filter.Limit++
+	ids := make([]int64, 0, limit)
+	ups := make([]*ttnpb.ApplicationUp, 0, limit)
+	if err := p.store.Range(ctx, filter, func(id int64, up *ttnpb.ApplicationUp) error {
+		ids, ups = append(ids, id), append(ups, up)
+		return nil
+	}); err != nil {
+		return err
+	}
+	filter.Limit = limit
+	if uint32(len(ups)) > limit {
+		ids, ups = ids[:limit], ups[:limit]
+		token, err := continuationTokenFromFilter(filter, paths, ids[len(ids)-1])
+		if err != nil {
+			return err
+		}
+		if err := setContinuationTokenHeader(ctx, token); err != nil {
+			return err
+		}
+	}
+	for _, up := range ups {
+		up, err := filterApplicationUp(up, getPaths)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(up); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetStoredApplicationUpCount implements ttnpb.ApplicationUpStorageServer.
+func (p *storagePackage) GetStoredApplicationUpCount(
+	ctx context.Context, req *ttnpb.GetStoredApplicationUpCountRequest,
+) (*ttnpb.GetStoredApplicationUpCountResponse, error) {
+	filter, err := countFilterFromRequest(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := rights.RequireApplication(
+		ctx, filter.ApplicationIdentifiers(), ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	count, err := p.store.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GetStoredApplicationUpCountResponse{
+		Count: count,
+	}, nil
+}
@@ -0,0 +1,298 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// memoryStore is an in-memory Store. The ID of a message is its index in the store plus one.
+type memoryStore struct {
+	ups []*ttnpb.ApplicationUp
+}
+
+func (s *memoryStore) matches(filter *Filter, up *ttnpb.ApplicationUp) bool {
+	if ids := filter.EndDeviceIDs; ids != nil && up.EndDeviceIds.GetDeviceId() != ids.GetDeviceId() {
+		return false
+	}
+	if filter.Type != "" && MessageType(up) != filter.Type {
+		return false
+	}
+	return true
+}
+
+func (s *memoryStore) Store(_ context.Context, up *ttnpb.ApplicationUp) error {
+	s.ups = append(s.ups, up)
+	return nil
+}
+
+func (s *memoryStore) Range(
+	_ context.Context, filter *Filter, f func(int64, *ttnpb.ApplicationUp) error,
+) error {
+	var n uint32
+	for i, up := range s.ups {
+		id := int64(i + 1)
+		if id <= filter.AfterID || !s.matches(filter, up) {
+			continue
+		}
+		if filter.Limit > 0 && n == filter.Limit {
+			return nil
+		}
+		n++
+		if err := f(id, up); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) Count(_ context.Context, filter *Filter) (map[string]uint32, error) {
+	count := make(map[string]uint32)
+	for _, up := range s.ups {
+		if s.matches(filter, up) {
+			count[up.EndDeviceIds.GetDeviceId()]++
+		}
+	}
+	return count, nil
+}
+
+func (*memoryStore) DeleteBefore(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+type mockServerTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *mockServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+type mockGetStoredApplicationUpStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ups []*ttnpb.ApplicationUp
+}
+
+func (s *mockGetStoredApplicationUpStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockGetStoredApplicationUpStream) Send(up *ttnpb.ApplicationUp) error {
+	s.ups = append(s.ups, up)
+	return nil
+}
+
+func TestGetStoredApplicationUp(t *testing.T) {
+	t.Parallel()
+	_, ctx := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	devIDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "test-dev"}
+	now := time.Now().UTC()
+	store := &memoryStore{}
+	for i := 0; i < 3; i++ {
+		store.ups = append(store.ups, &ttnpb.ApplicationUp{
+			EndDeviceIds: devIDs,
+			ReceivedAt:   timestamppb.New(now.Add(time.Duration(i) * time.Second)),
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      uint32(i + 1),
+					FrmPayload: []byte{byte(i)},
+				},
+			},
+		})
+	}
+	store.ups = append(store.ups, &ttnpb.ApplicationUp{
+		EndDeviceIds: devIDs,
+		ReceivedAt:   timestamppb.New(now.Add(3 * time.Second)),
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{},
+		},
+	})
+	p := &storagePackage{store: store}
+
+	rightsCtx := func(ctx context.Context, rs ...ttnpb.Right) context.Context {
+		return rights.NewContext(ctx, &rights.Rights{
+			ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+				unique.ID(ctx, appIDs): ttnpb.RightsFrom(rs...),
+			}),
+		})
+	}
+	newStream := func(ctx context.Context) (*mockGetStoredApplicationUpStream, *mockServerTransportStream) {
+		sts := &mockServerTransportStream{}
+		return &mockGetStoredApplicationUpStream{
+			ctx: grpc.NewContextWithServerTransportStream(ctx, sts),
+		}, sts
+	}
+
+	t.Run("NoRights", func(t *testing.T) {
+		a, _ := test.New(t)
+		stream, _ := newStream(rightsCtx(ctx, ttnpb.Right_RIGHT_APPLICATION_INFO))
+		err := p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			EndDeviceIds: devIDs,
+		}, stream)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		a.So(stream.ups, should.BeEmpty)
+	})
+
+	ctx = rightsCtx(ctx, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)
+
+	t.Run("All", func(t *testing.T) {
+		a, _ := test.New(t)
+		stream, sts := newStream(ctx)
+		err := p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			EndDeviceIds: devIDs,
+		}, stream)
+		a.So(err, should.BeNil)
+		a.So(stream.ups, should.Resemble, store.ups)
+		a.So(sts.header.Get("x-continuation-token"), should.BeEmpty)
+	})
+
+	t.Run("FieldMask", func(t *testing.T) {
+		a, _ := test.New(t)
+		stream, _ := newStream(ctx)
+		err := p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			EndDeviceIds: devIDs,
+			Type:         "uplink_message",
+			FieldMask:    ttnpb.FieldMask("up.uplink_message.f_port"),
+		}, stream)
+		a.So(err, should.BeNil)
+		if !a.So(stream.ups, should.HaveLength, 3) {
+			t.FailNow()
+		}
+		for i, up := range stream.ups {
+			a.So(up, should.Resemble, &ttnpb.ApplicationUp{
+				EndDeviceIds: devIDs,
+				ReceivedAt:   store.ups[i].ReceivedAt,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort: uint32(i + 1),
+					},
+				},
+			})
+		}
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		a, _ := test.New(t)
+
+		stream, sts := newStream(ctx)
+		err := p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			EndDeviceIds: devIDs,
+			Type:         "uplink_message",
+			Limit:        wrapperspb.UInt32(2),
+		}, stream)
+		a.So(err, should.BeNil)
+		a.So(stream.ups, should.Resemble, store.ups[:2])
+		tokens := sts.header.Get("x-continuation-token")
+		if !a.So(tokens, should.HaveLength, 1) {
+			t.FailNow()
+		}
+
+		stream, sts = newStream(ctx)
+		err = p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			EndDeviceIds:      devIDs,
+			Type:              "uplink_message",
+			ContinuationToken: tokens[0],
+		}, stream)
+		a.So(err, should.BeNil)
+		a.So(stream.ups, should.Resemble, store.ups[2:3])
+		a.So(sts.header.Get("x-continuation-token"), should.BeEmpty)
+	})
+
+	t.Run("ExactLimit", func(t *testing.T) {
+		a, _ := test.New(t)
+		stream, sts := newStream(ctx)
+		err := p.GetStoredApplicationUp(&ttnpb.GetStoredApplicationUpRequest{
+			EndDeviceIds: devIDs,
+			Limit:        wrapperspb.UInt32(4),
+		}, stream)
+		a.So(err, should.BeNil)
+		a.So(stream.ups, should.Resemble, store.ups)
+		a.So(sts.header.Get("x-continuation-token"), should.BeEmpty)
+	})
+}
+
+func TestGetStoredApplicationUpCount(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	store := &memoryStore{}
+	for _, devID := range []string{"dev-1", "dev-1", "dev-2"} {
+		store.ups = append(store.ups, &ttnpb.ApplicationUp{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: devID},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{},
+			},
+		})
+	}
+	p := &storagePackage{store: store}
+
+	rightsCtx := func(ctx context.Context, rs ...ttnpb.Right) context.Context {
+		return rights.NewContext(ctx, &rights.Rights{
+			ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+				unique.ID(ctx, appIDs): ttnpb.RightsFrom(rs...),
+			}),
+		})
+	}
+
+	_, err := p.GetStoredApplicationUpCount(
+		rightsCtx(ctx, ttnpb.Right_RIGHT_APPLICATION_INFO),
+		&ttnpb.GetStoredApplicationUpCountRequest{
+			ApplicationIds: appIDs,
+		},
+	)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	ctx = rightsCtx(ctx, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)
+
+	_, err = p.GetStoredApplicationUpCount(ctx, &ttnpb.GetStoredApplicationUpCountRequest{})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	res, err := p.GetStoredApplicationUpCount(ctx, &ttnpb.GetStoredApplicationUpCountRequest{
+		ApplicationIds: appIDs,
+	})
+	if a.So(err, should.BeNil) {
+		a.So(res.Count, should.Resemble, map[string]uint32{
+			"dev-1": 2,
+			"dev-2": 1,
+		})
+	}
+
+	res, err = p.GetStoredApplicationUpCount(ctx, &ttnpb.GetStoredApplicationUpCountRequest{
+		ApplicationIds: appIDs,
+		Type:           "join_accept",
+	})
+	if a.So(err, should.BeNil) {
+		a.So(res.Count, should.BeEmpty)
+	}
+}
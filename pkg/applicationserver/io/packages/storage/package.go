@@ -0,0 +1,118 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName is the name of the package.
+const PackageName = "storage-integration"
+
+const namespace = "applicationserver/io/packages/storage"
+
+type storagePackage struct {
+	ttnpb.UnimplementedApplicationUpStorageServer
+
+	ctx    context.Context
+	server io.Server
+	store  Store
+}
+
+// New returns a new Storage Integration package.
+// If the retention is positive, expired messages are deleted from the store every cleanup interval.
+func New(ctx context.Context, server io.Server, conf Config) (packages.ApplicationPackageHandler, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	p := &storagePackage{
+		ctx:    ctx,
+		server: server,
+		store:  conf.Store,
+	}
+	if conf.Retention > 0 {
+		if conf.CleanupInterval <= 0 {
+			return nil, errInvalidInterval.WithAttributes("interval", conf.CleanupInterval)
+		}
+		server.StartTask(&task.Config{
+			Context: ctx,
+			ID:      "storage_integration_cleanup",
+			Func: func(ctx context.Context) error {
+				return p.runCleanup(ctx, conf.Retention, conf.CleanupInterval)
+			},
+			Restart: task.RestartOnFailure,
+			Backoff: task.DefaultBackoffConfig,
+		})
+	}
+	return p, nil
+}
+
+func (p *storagePackage) runCleanup(ctx context.Context, retention, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		n, err := p.store.DeleteBefore(ctx, time.Now().Add(-retention))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.FromContext(ctx).WithField("count", n).Debug("Deleted expired messages")
+		}
+	}
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *storagePackage) HandleUp(
+	ctx context.Context,
+	_ *ttnpb.ApplicationPackageDefaultAssociation,
+	_ *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) error {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	if MessageType(up) == "" {
+		log.FromContext(ctx).Debug("Skip unsupported message type")
+		return nil
+	}
+	return p.store.Store(ctx, up)
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*storagePackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name: PackageName,
+	}
+}
+
+// RegisterServices implements rpcserver.ServiceRegisterer.
+func (p *storagePackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationUpStorageServer(s, p)
+}
+
+// RegisterHandlers implements rpcserver.ServiceRegisterer.
+func (p *storagePackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationUpStorageHandler(p.ctx, s, conn) // nolint:errcheck
+}
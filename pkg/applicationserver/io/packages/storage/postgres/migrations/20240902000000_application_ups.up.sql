@@ -0,0 +1,18 @@
+CREATE TABLE application_ups (
+  id bigserial PRIMARY KEY,
+  received_at timestamp with time zone NOT NULL,
+
+  application_id character varying(36) NOT NULL,
+  device_id character varying(36) NOT NULL,
+  type character varying(32) NOT NULL,
+  f_port integer,
+
+  message bytea NOT NULL
+);
+
+CREATE INDEX application_ups_application_id_received_at_idx
+  ON application_ups (application_id, received_at, id);
+CREATE INDEX application_ups_application_id_device_id_received_at_idx
+  ON application_ups (application_id, device_id, received_at, id);
+CREATE INDEX application_ups_received_at_idx
+  ON application_ups (received_at);
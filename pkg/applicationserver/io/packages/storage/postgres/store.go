@@ -0,0 +1,225 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres implements a PostgreSQL storage provider for the Storage Integration.
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/postgres/migrations"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// applicationUp is the application_ups model in the database.
+type applicationUp struct {
+	bun.BaseModel `bun:"table:application_ups,alias:up"`
+
+	ID         int64     `bun:"id,pk,autoincrement"`
+	ReceivedAt time.Time `bun:"received_at,notnull"`
+
+	ApplicationID string `bun:"application_id,notnull"`
+	DeviceID      string `bun:"device_id,notnull"`
+	Type          string `bun:"type,notnull"`
+	FPort         *int   `bun:"f_port"`
+
+	Message []byte `bun:"message,notnull"`
+}
+
+func applicationUpFromPB(pb *ttnpb.ApplicationUp) (*applicationUp, error) {
+	b, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, err
+	}
+	m := &applicationUp{
+		ReceivedAt:    ttnpb.StdTimeOrZero(pb.ReceivedAt),
+		ApplicationID: pb.EndDeviceIds.GetApplicationIds().GetApplicationId(),
+		DeviceID:      pb.EndDeviceIds.GetDeviceId(),
+		Type:          storage.MessageType(pb),
+		Message:       b,
+	}
+	if m.ReceivedAt.IsZero() {
+		m.ReceivedAt = time.Now()
+	}
+	if up := pb.GetUplinkMessage(); up != nil {
+		fPort := int(up.FPort)
+		m.FPort = &fPort
+	}
+	return m, nil
+}
+
+func (m *applicationUp) toPB() (*ttnpb.ApplicationUp, error) {
+	pb := &ttnpb.ApplicationUp{}
+	if err := proto.Unmarshal(m.Message, pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// NewMigrator returns a new migrator for the Storage Integration database.
+// The migrations are tracked in dedicated tables, so that the database can be shared with other components.
+func NewMigrator(db *bun.DB) *migrate.Migrator {
+	return migrate.NewMigrator(
+		db,
+		migrations.Migrations,
+		migrate.WithTableName("storage_bun_migrations"),
+		migrate.WithLocksTableName("storage_bun_migration_locks"),
+	)
+}
+
+// Store is a PostgreSQL-backed storage.Store.
+type Store struct {
+	DB *bun.DB
+}
+
+var _ storage.Store = (*Store)(nil)
+
+// NewStore returns a new Store using the given database.
+func NewStore(db *bun.DB) *Store {
+	return &Store{
+		DB: db,
+	}
+}
+
+// Store implements storage.Store.
+func (s *Store) Store(ctx context.Context, pb *ttnpb.ApplicationUp) error {
+	model, err := applicationUpFromPB(pb)
+	if err != nil {
+		return err
+	}
+	if _, err := s.DB.NewInsert().Model(model).Exec(ctx); err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func selectWithFilter(filter *storage.Filter) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		if ids := filter.EndDeviceIDs; ids != nil {
+			q = q.
+				Where("?TableAlias.application_id = ?", ids.GetApplicationIds().GetApplicationId()).
+				Where("?TableAlias.device_id = ?", ids.GetDeviceId())
+		} else {
+			q = q.Where("?TableAlias.application_id = ?", filter.ApplicationIDs.GetApplicationId())
+		}
+		if filter.Type != "" {
+			q = q.Where("?TableAlias.type = ?", filter.Type)
+		}
+		if filter.After != nil {
+			q = q.Where("?TableAlias.received_at > ?", *filter.After)
+		}
+		if filter.Before != nil {
+			q = q.Where("?TableAlias.received_at < ?", *filter.Before)
+		}
+		if filter.FPort != nil {
+			q = q.Where("?TableAlias.f_port = ?", int(*filter.FPort))
+		}
+		return q
+	}
+}
+
+func selectWithOrderAndLimit(filter *storage.Filter) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		direction, comparison := "ASC", ">"
+		if filter.Order == storage.OrderReceivedAtDesc {
+			direction, comparison = "DESC", "<"
+		}
+		if filter.AfterID > 0 {
+			q = q.Where(
+				"(?TableAlias.received_at, ?TableAlias.id) "+comparison+
+					" (SELECT prev.received_at, prev.id FROM ?TableName AS prev WHERE prev.id = ?)",
+				filter.AfterID,
+			)
+		}
+		q = q.
+			OrderExpr("?TableAlias.received_at " + direction).
+			OrderExpr("?TableAlias.id " + direction)
+		if filter.Limit > 0 {
+			q = q.Limit(int(filter.Limit))
+		}
+		return q
+	}
+}
+
+// Range implements storage.Store.
+func (s *Store) Range(
+	ctx context.Context, filter *storage.Filter, f func(int64, *ttnpb.ApplicationUp) error,
+) error {
+	rows, err := s.DB.NewSelect().
+		Model((*applicationUp)(nil)).
+		Apply(selectWithFilter(filter)).
+		Apply(selectWithOrderAndLimit(filter)).
+		Rows(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		model := &applicationUp{}
+		if err := s.DB.ScanRow(ctx, rows, model); err != nil {
+			return storeutil.WrapDriverError(err)
+		}
+		pb, err := model.toPB()
+		if err != nil {
+			return err
+		}
+		if err := f(model.ID, pb); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+// Count implements storage.Store.
+func (s *Store) Count(ctx context.Context, filter *storage.Filter) (map[string]uint32, error) {
+	var results []struct {
+		DeviceID string `bun:"device_id"`
+		Count    uint32 `bun:"count"`
+	}
+	if err := s.DB.NewSelect().
+		Model((*applicationUp)(nil)).
+		Column("device_id").
+		ColumnExpr("COUNT(*) AS count").
+		Apply(selectWithFilter(filter)).
+		Group("device_id").
+		Scan(ctx, &results); err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	count := make(map[string]uint32, len(results))
+	for _, res := range results {
+		count[res.DeviceID] = res.Count
+	}
+	return count, nil
+}
+
+// DeleteBefore implements storage.Store.
+func (s *Store) DeleteBefore(ctx context.Context, t time.Time) (int64, error) {
+	res, err := s.DB.NewDelete().
+		Model((*applicationUp)(nil)).
+		Where("?TableAlias.received_at < ?", t).
+		Exec(ctx)
+	if err != nil {
+		return 0, storeutil.WrapDriverError(err)
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,258 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres_test
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/postgres"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const schemaName = "storage_integration_postgres_test"
+
+// testDSN returns the DSN of the test database. The address and credentials are taken from the SQL_DB_ADDRESS
+// and SQL_DB_AUTH environment variables, and the database from STORAGE_TEST_DATABASE_NAME.
+func testDSN() *url.URL {
+	dsn := &url.URL{
+		Scheme: "postgresql",
+		Host:   "localhost:5432",
+		User:   url.UserPassword("root", "root"),
+		Path:   "ttn_lorawan_as_storage_test",
+	}
+	if address := os.Getenv("SQL_DB_ADDRESS"); address != "" {
+		dsn.Host = address
+	}
+	if auth := os.Getenv("SQL_DB_AUTH"); auth != "" {
+		if username, password, ok := strings.Cut(auth, ":"); ok {
+			dsn.User = url.UserPassword(username, password)
+		} else {
+			dsn.User = url.User(username)
+		}
+	}
+	if name := os.Getenv("STORAGE_TEST_DATABASE_NAME"); name != "" {
+		dsn.Path = name
+	}
+	query := make(url.Values)
+	query.Add("sslmode", "disable")
+	dsn.RawQuery = query.Encode()
+	return dsn
+}
+
+func newStore(ctx context.Context, t *testing.T) *postgres.Store {
+	t.Helper()
+	a, _ := test.New(t)
+
+	dsn := testDSN()
+	baseDB, err := storeutil.OpenDB(ctx, dsn.String())
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { baseDB.Close() })
+	if err := baseDB.PingContext(ctx); err != nil {
+		t.Skipf("PostgreSQL not available: %v", err)
+	}
+	for _, stmt := range []string{
+		"DROP SCHEMA IF EXISTS " + schemaName + " CASCADE",
+		"CREATE SCHEMA " + schemaName,
+	} {
+		if _, err := baseDB.ExecContext(ctx, stmt); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	t.Cleanup(func() { baseDB.Exec("DROP SCHEMA " + schemaName + " CASCADE") }) // nolint:errcheck
+
+	query := dsn.Query()
+	query.Add("search_path", schemaName)
+	dsn.RawQuery = query.Encode()
+	sqlDB, err := storeutil.OpenDB(ctx, dsn.String())
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	db := bun.NewDB(sqlDB, pgdialect.New())
+	t.Cleanup(func() { db.Close() })
+
+	migrator := postgres.NewMigrator(db)
+	if err := migrator.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if _, err := migrator.Migrate(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	return postgres.NewStore(db)
+}
+
+func TestStore(t *testing.T) { //nolint:paralleltest
+	a, ctx := test.New(t)
+	s := newStore(ctx, t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	dev1IDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "test-dev-1"}
+	dev2IDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "test-dev-2"}
+	otherDevIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "other-app"},
+		DeviceId:       "test-dev-1",
+	}
+	start := time.Now().Truncate(time.Millisecond).UTC()
+	at := func(i int) time.Time { return start.Add(time.Duration(i) * time.Second) }
+	uplink := func(ids *ttnpb.EndDeviceIdentifiers, i int, fPort uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: ids,
+			ReceivedAt:   timestamppb.New(at(i)),
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      fPort,
+					FrmPayload: []byte{byte(i)},
+				},
+			},
+		}
+	}
+	ups := []*ttnpb.ApplicationUp{
+		uplink(dev1IDs, 0, 1),
+		uplink(dev2IDs, 1, 1),
+		uplink(dev1IDs, 2, 2),
+		{
+			EndDeviceIds: dev1IDs,
+			ReceivedAt:   timestamppb.New(at(3)),
+			Up: &ttnpb.ApplicationUp_JoinAccept{
+				JoinAccept: &ttnpb.ApplicationJoinAccept{SessionKeyId: []byte{0x01}},
+			},
+		},
+		uplink(dev1IDs, 4, 1),
+		uplink(otherDevIDs, 5, 1),
+	}
+	for _, up := range ups {
+		if err := s.Store(ctx, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	rangeUps := func(filter *storage.Filter) (ids []int64, res []*ttnpb.ApplicationUp) {
+		err := s.Range(ctx, filter, func(id int64, up *ttnpb.ApplicationUp) error {
+			ids, res = append(ids, id), append(res, up)
+			return nil
+		})
+		a.So(err, should.BeNil)
+		return ids, res
+	}
+	after, before := at(0), at(4)
+	fPort := uint32(1)
+
+	for _, tc := range []struct {
+		Name     string
+		Filter   *storage.Filter
+		Expected []*ttnpb.ApplicationUp
+	}{
+		{
+			Name:     "Application",
+			Filter:   &storage.Filter{ApplicationIDs: appIDs},
+			Expected: ups[:5],
+		},
+		{
+			Name:     "EndDevice",
+			Filter:   &storage.Filter{EndDeviceIDs: dev1IDs},
+			Expected: []*ttnpb.ApplicationUp{ups[0], ups[2], ups[3], ups[4]},
+		},
+		{
+			Name:     "Type",
+			Filter:   &storage.Filter{ApplicationIDs: appIDs, Type: "join_accept"},
+			Expected: []*ttnpb.ApplicationUp{ups[3]},
+		},
+		{
+			Name:     "TimeRange",
+			Filter:   &storage.Filter{ApplicationIDs: appIDs, After: &after, Before: &before},
+			Expected: ups[1:4],
+		},
+		{
+			Name:     "FPort",
+			Filter:   &storage.Filter{EndDeviceIDs: dev1IDs, FPort: &fPort},
+			Expected: []*ttnpb.ApplicationUp{ups[0], ups[4]},
+		},
+		{
+			Name:     "Descending",
+			Filter:   &storage.Filter{EndDeviceIDs: dev1IDs, Order: storage.OrderReceivedAtDesc},
+			Expected: []*ttnpb.ApplicationUp{ups[4], ups[3], ups[2], ups[0]},
+		},
+		{
+			Name:     "Limit",
+			Filter:   &storage.Filter{ApplicationIDs: appIDs, Limit: 2},
+			Expected: ups[:2],
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a, _ := test.New(t)
+			_, res := rangeUps(tc.Filter)
+			a.So(res, should.Resemble, tc.Expected)
+		})
+	}
+
+	t.Run("Pagination", func(t *testing.T) {
+		a, _ := test.New(t)
+		for _, order := range []string{storage.OrderReceivedAt, storage.OrderReceivedAtDesc} {
+			filter := &storage.Filter{ApplicationIDs: appIDs, Order: order}
+			_, expected := rangeUps(filter)
+
+			filter.Limit = 2
+			var res []*ttnpb.ApplicationUp
+			for {
+				pageIDs, page := rangeUps(filter)
+				res = append(res, page...)
+				if len(page) < int(filter.Limit) {
+					break
+				}
+				filter.AfterID = pageIDs[len(pageIDs)-1]
+			}
+			a.So(res, should.Resemble, expected)
+		}
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		a, _ := test.New(t)
+		count, err := s.Count(ctx, &storage.Filter{ApplicationIDs: appIDs, Limit: 1, AfterID: 1})
+		a.So(err, should.BeNil)
+		a.So(count, should.Resemble, map[string]uint32{
+			"test-dev-1": 4,
+			"test-dev-2": 1,
+		})
+
+		count, err = s.Count(ctx, &storage.Filter{EndDeviceIDs: dev1IDs, Type: "uplink_message"})
+		a.So(err, should.BeNil)
+		a.So(count, should.Resemble, map[string]uint32{
+			"test-dev-1": 3,
+		})
+	})
+
+	t.Run("DeleteBefore", func(t *testing.T) {
+		a, _ := test.New(t)
+		n, err := s.DeleteBefore(ctx, at(2))
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, int64(2))
+
+		_, res := rangeUps(&storage.Filter{ApplicationIDs: appIDs})
+		a.So(res, should.Resemble, ups[2:5])
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/base64"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func validateIdentifiers(appIDs *ttnpb.ApplicationIdentifiers, devIDs *ttnpb.EndDeviceIdentifiers) error {
+	if (appIDs == nil) == (devIDs == nil) {
+		return errIdentifiers.New()
+	}
+	return nil
+}
+
+func validateType(typ string) error {
+	if typ == "uplink_normalized" {
+		return nil
+	}
+	if _, ok := ttnpb.StoredApplicationUpTypes[typ]; !ok {
+		return errUnknownType.WithAttributes("type", typ)
+	}
+	return nil
+}
+
+func validateOrder(order string) error {
+	switch order {
+	case "", OrderReceivedAt, OrderReceivedAtDesc:
+		return nil
+	default:
+		return errUnknownOrder.WithAttributes("order", order)
+	}
+}
+
+// timeRange returns the time range defined by after, before and last.
+func timeRange(
+	after, before *timestamppb.Timestamp, last *durationpb.Duration, now time.Time,
+) (*time.Time, *time.Time, error) {
+	if last != nil {
+		if after != nil || before != nil {
+			return nil, nil, errLastAndTimeRange.New()
+		}
+		t := now.Add(-last.AsDuration())
+		return &t, nil, nil
+	}
+	return ttnpb.StdTime(after), ttnpb.StdTime(before), nil
+}
+
+// encodeContinuationToken encodes the given payload to a continuation token.
+func encodeContinuationToken(payload *ttnpb.ContinuationTokenPayload) (string, error) {
+	b, err := proto.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeContinuationToken decodes the given continuation token.
+func decodeContinuationToken(token string) (*ttnpb.ContinuationTokenPayload, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errContinuationToken.WithCause(err)
+	}
+	payload := &ttnpb.ContinuationTokenPayload{}
+	if err := proto.Unmarshal(b, payload); err != nil {
+		return nil, errContinuationToken.WithCause(err)
+	}
+	if payload.LastReceivedId <= 0 {
+		return nil, errContinuationToken.New()
+	}
+	return payload, nil
+}
+
+// filterFromRequest returns the filter and the field mask paths defined by the given request.
+// If the request contains a continuation token, the payload of the token is used instead of the
+// filtering fields of the request.
+func filterFromRequest(req *ttnpb.GetStoredApplicationUpRequest, now time.Time) (*Filter, []string, error) {
+	if err := validateIdentifiers(req.ApplicationIds, req.EndDeviceIds); err != nil {
+		return nil, nil, err
+	}
+	if err := validateType(req.Type); err != nil {
+		return nil, nil, err
+	}
+	payload := &ttnpb.ContinuationTokenPayload{
+		Limit:     req.Limit,
+		After:     req.After,
+		Before:    req.Before,
+		FPort:     req.FPort,
+		Order:     req.Order,
+		FieldMask: req.FieldMask,
+		Last:      req.Last, // nolint:staticcheck
+	}
+	if req.ContinuationToken != "" {
+		var err error
+		if payload, err = decodeContinuationToken(req.ContinuationToken); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := validateOrder(payload.Order); err != nil {
+		return nil, nil, err
+	}
+	after, before, err := timeRange(payload.After, payload.Before, payload.Last, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := &Filter{
+		ApplicationIDs: req.ApplicationIds,
+		EndDeviceIDs:   req.EndDeviceIds,
+		Type:           req.Type,
+		After:          after,
+		Before:         before,
+		Order:          payload.Order,
+		Limit:          payload.GetLimit().GetValue(),
+		AfterID:        payload.LastReceivedId,
+	}
+	if fPort := payload.FPort; fPort != nil {
+		filter.FPort = &fPort.Value
+	}
+	return filter, payload.GetFieldMask().GetPaths(), nil
+}
+
+// continuationTokenFromFilter returns the continuation token which continues the given filter after the
+// message with the given ID.
+func continuationTokenFromFilter(filter *Filter, paths []string, lastID int64) (string, error) {
+	payload := &ttnpb.ContinuationTokenPayload{
+		After:          ttnpb.ProtoTime(filter.After),
+		Before:         ttnpb.ProtoTime(filter.Before),
+		Order:          filter.Order,
+		LastReceivedId: lastID,
+	}
+	if filter.Limit > 0 {
+		payload.Limit = wrapperspb.UInt32(filter.Limit)
+	}
+	if filter.FPort != nil {
+		payload.FPort = wrapperspb.UInt32(*filter.FPort)
+	}
+	if len(paths) > 0 {
+		payload.FieldMask = ttnpb.FieldMask(paths...)
+	}
+	return encodeContinuationToken(payload)
+}
+
+// countFilterFromRequest returns the filter defined by the given count request.
+func countFilterFromRequest(req *ttnpb.GetStoredApplicationUpCountRequest, now time.Time) (*Filter, error) {
+	if err := validateIdentifiers(req.ApplicationIds, req.EndDeviceIds); err != nil {
+		return nil, err
+	}
+	if err := validateType(req.Type); err != nil {
+		return nil, err
+	}
+	after, before, err := timeRange(req.After, req.Before, req.Last, now)
+	if err != nil {
+		return nil, err
+	}
+	filter := &Filter{
+		ApplicationIDs: req.ApplicationIds,
+		EndDeviceIDs:   req.EndDeviceIds,
+		Type:           req.Type,
+		After:          after,
+		Before:         before,
+	}
+	if fPort := req.FPort; fPort != nil {
+		filter.FPort = &fPort.Value
+	}
+	return filter, nil
+}
@@ -0,0 +1,216 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestFilterFromRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0).UTC()
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	devIDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "test-dev"}
+	after, before := now.Add(-time.Hour), now.Add(-time.Minute)
+	last := now.Add(-10 * time.Minute)
+	fPort := uint32(42)
+
+	for _, tc := range []struct {
+		Name           string
+		Request        *ttnpb.GetStoredApplicationUpRequest
+		ExpectedFilter *Filter
+		ExpectedPaths  []string
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "NoIdentifiers",
+			Request:        &ttnpb.GetStoredApplicationUpRequest{},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "BothIdentifiers",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: appIDs,
+				EndDeviceIds:   devIDs,
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "UnknownType",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: appIDs,
+				Type:           "unknown",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "UnknownOrder",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: appIDs,
+				Order:          "f_port",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "LastAndAfter",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: appIDs,
+				After:          timestamppb.New(after),
+				Last:           durationpb.New(10 * time.Minute),
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "InvalidContinuationToken",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds:    appIDs,
+				ContinuationToken: "invalid!",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Application",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIds: appIDs,
+				Type:           "uplink_message",
+				Limit:          wrapperspb.UInt32(10),
+				After:          timestamppb.New(after),
+				Before:         timestamppb.New(before),
+				FPort:          wrapperspb.UInt32(fPort),
+				Order:          OrderReceivedAtDesc,
+				FieldMask:      ttnpb.FieldMask("up.uplink_message.decoded_payload"),
+			},
+			ExpectedFilter: &Filter{
+				ApplicationIDs: appIDs,
+				Type:           "uplink_message",
+				After:          &after,
+				Before:         &before,
+				FPort:          &fPort,
+				Order:          OrderReceivedAtDesc,
+				Limit:          10,
+			},
+			ExpectedPaths: []string{"up.uplink_message.decoded_payload"},
+		},
+		{
+			Name: "EndDeviceLast",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIds: devIDs,
+				Last:         durationpb.New(10 * time.Minute),
+			},
+			ExpectedFilter: &Filter{
+				EndDeviceIDs: devIDs,
+				After:        &last,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			filter, paths, err := filterFromRequest(tc.Request, now)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(filter, should.Resemble, tc.ExpectedFilter)
+			a.So(paths, should.Resemble, tc.ExpectedPaths)
+		})
+	}
+}
+
+func TestContinuationToken(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	now := time.Unix(1700000000, 0).UTC()
+	devIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	req := &ttnpb.GetStoredApplicationUpRequest{
+		EndDeviceIds: devIDs,
+		Type:         "uplink_message",
+		Limit:        wrapperspb.UInt32(5),
+		Last:         durationpb.New(time.Hour),
+		FPort:        wrapperspb.UInt32(1),
+		Order:        OrderReceivedAtDesc,
+		FieldMask:    ttnpb.FieldMask("received_at"),
+	}
+	filter, paths, err := filterFromRequest(req, now)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	token, err := continuationTokenFromFilter(filter, paths, 1234)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The continuation token takes precedence over the request fields, and the time range
+	// that was computed from `last` does not move.
+	next, nextPaths, err := filterFromRequest(&ttnpb.GetStoredApplicationUpRequest{
+		EndDeviceIds:      devIDs,
+		Type:              "uplink_message",
+		Limit:             wrapperspb.UInt32(100),
+		ContinuationToken: token,
+	}, now.Add(time.Minute))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	filter.AfterID = 1234
+	a.So(next, should.Resemble, filter)
+	a.So(nextPaths, should.Resemble, paths)
+}
+
+func TestCountFilterFromRequest(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	now := time.Unix(1700000000, 0).UTC()
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+
+	_, err := countFilterFromRequest(&ttnpb.GetStoredApplicationUpCountRequest{}, now)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	filter, err := countFilterFromRequest(&ttnpb.GetStoredApplicationUpCountRequest{
+		ApplicationIds: appIDs,
+		Type:           "join_accept",
+		Last:           durationpb.New(time.Hour),
+	}, now)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	after := now.Add(-time.Hour)
+	a.So(filter, should.Resemble, &Filter{
+		ApplicationIDs: appIDs,
+		Type:           "join_accept",
+		After:          &after,
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage implements the Storage Integration application package, which persists
+// application upstream messages and serves them through the ApplicationUpStorage service.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// OrderReceivedAt orders the stored messages by ascending reception time.
+	OrderReceivedAt = "received_at"
+	// OrderReceivedAtDesc orders the stored messages by descending reception time.
+	OrderReceivedAtDesc = "-received_at"
+)
+
+// Filter selects stored upstream messages.
+type Filter struct {
+	// ApplicationIDs selects the messages of all end devices of an application.
+	ApplicationIDs *ttnpb.ApplicationIdentifiers
+	// EndDeviceIDs selects the messages of a single end device. Takes precedence over ApplicationIDs.
+	EndDeviceIDs *ttnpb.EndDeviceIdentifiers
+	// Type selects the messages of a single type. If empty, all types are selected.
+	Type string
+	// After selects the messages received after the given time.
+	After *time.Time
+	// Before selects the messages received before the given time.
+	Before *time.Time
+	// FPort selects the uplink messages received on the given FPort.
+	FPort *uint32
+	// Order is the order in which the messages are returned.
+	Order string
+	// Limit is the maximum number of messages to return. Zero means no limit.
+	Limit uint32
+	// AfterID selects the messages that follow the message with the given ID in the given order.
+	// This is used for pagination. Zero means no pagination.
+	AfterID int64
+}
+
+// ApplicationIdentifiers returns the application identifiers selected by the filter.
+func (f *Filter) ApplicationIdentifiers() *ttnpb.ApplicationIdentifiers {
+	if ids := f.EndDeviceIDs; ids != nil {
+		return ids.GetApplicationIds()
+	}
+	return f.ApplicationIDs
+}
+
+// Store is a store of application upstream messages.
+type Store interface {
+	// Store persists the given upstream message.
+	Store(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Range calls f sequentially for each stored upstream message that matches the filter.
+	// The id passed to f can be used as the AfterID of a subsequent filter.
+	// If f returns an error, the iteration stops and the error is returned.
+	Range(ctx context.Context, filter *Filter, f func(id int64, up *ttnpb.ApplicationUp) error) error
+	// Count returns the number of stored upstream messages that match the filter, by end device ID.
+	// The Order, Limit and AfterID fields of the filter are ignored.
+	Count(ctx context.Context, filter *Filter) (map[string]uint32, error)
+	// DeleteBefore deletes the upstream messages that were received before the given time,
+	// and returns the number of deleted messages.
+	DeleteBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+// MessageType returns the type of the given upstream message, as used by the Type field of Filter.
+// The empty string is returned for unknown message types.
+func MessageType(up *ttnpb.ApplicationUp) string {
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message"
+	case *ttnpb.ApplicationUp_UplinkNormalized:
+		return "uplink_normalized"
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept"
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack"
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack"
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent"
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed"
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued"
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+		return "downlink_queue_invalidated"
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved"
+	case *ttnpb.ApplicationUp_ServiceData:
+		return "service_data"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a pub/sub registry backed by an embedded bbolt database.
+package bolt
+
+import (
+	"context"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errApplicationUID     = errors.DefineCorruption("application_uid", "invalid application UID `{application_uid}`")
+)
+
+// appendImplicitPubSubGetPaths appends implicit ttnpb.ApplicationPubSub get paths to paths.
+func appendImplicitPubSubGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 4+len(paths)),
+		"created_at",
+		"ids",
+		"provider",
+		"updated_at",
+	), paths...)
+}
+
+func applyPubSubFieldMask(dst, src *ttnpb.ApplicationPubSub, paths ...string) (*ttnpb.ApplicationPubSub, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationPubSub{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// PubSubRegistry is a bbolt pub/sub registry.
+type PubSubRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the PubSubRegistry.
+func (r *PubSubRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+// appPrefix returns the prefix of the keys of the pub/subs of the application.
+func (r *PubSubRegistry) appPrefix(appUID string) string {
+	return ttnbolt.Key("uid", appUID, "")
+}
+
+func (r *PubSubRegistry) idKey(appUID, id string) string {
+	return ttnbolt.Key("uid", appUID, id)
+}
+
+// Get implements pubsub.Registry.
+func (r PubSubRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers, paths []string,
+) (*ttnpb.ApplicationPubSub, error) {
+	pb := &ttnpb.ApplicationPubSub{}
+	if err := r.Bolt.GetProto(r.idKey(unique.ID(ctx, ids.ApplicationIds), ids.PubSubId), pb); err != nil {
+		return nil, err
+	}
+	return applyPubSubFieldMask(nil, pb, appendImplicitPubSubGetPaths(paths...)...)
+}
+
+// List implements pubsub.Registry.
+func (r PubSubRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string,
+) ([]*ttnpb.ApplicationPubSub, error) {
+	var pbs []*ttnpb.ApplicationPubSub
+	err := r.Bolt.Range(r.appPrefix(unique.ID(ctx, ids)), func(_ string, v []byte) (bool, error) {
+		pb := &ttnpb.ApplicationPubSub{}
+		if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+			return false, err
+		}
+		pb, err := applyPubSubFieldMask(nil, pb, appendImplicitPubSubGetPaths(paths...)...)
+		if err != nil {
+			return false, err
+		}
+		pbs = append(pbs, pb)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements pubsub.Registry.
+func (r PubSubRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationPubSubIdentifiers,
+	gets []string,
+	f func(*ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error),
+) (*ttnpb.ApplicationPubSub, error) {
+	ik := r.idKey(unique.ID(ctx, ids.ApplicationIds), ids.PubSubId)
+	gets = appendImplicitPubSubGetPaths(gets...)
+
+	var pb *ttnpb.ApplicationPubSub
+	err := r.Bolt.LockedWatch(ctx, ik, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.ApplicationPubSub{}
+		if err := tx.GetProto(ik, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb = nil
+		if stored != nil {
+			pb, err = applyPubSubFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return errInvalidFieldmask.WithCause(err)
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyPubSubFieldMask(nil, stored, gets...)
+			return err
+		}
+		if pb == nil && len(sets) == 0 {
+			tx.Del(ik)
+			return nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationPubSub{}
+		}
+
+		pb.UpdatedAt = timestamppb.Now()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.ApplicationPubSub{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.pub_sub_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = applyPubSubFieldMask(updated, pb, sets...)
+			if err != nil {
+				return err
+			}
+			if updated.Ids.ApplicationIds.ApplicationId != ids.ApplicationIds.ApplicationId ||
+				updated.Ids.PubSubId != ids.PubSubId {
+				return errInvalidIdentifiers.New()
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+				pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+				return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.pub_sub_id") && pb.Ids.PubSubId != stored.Ids.PubSubId {
+				return errReadOnlyField.WithAttributes("field", "ids.pub_sub_id")
+			}
+			updated, err = applyPubSubFieldMask(stored, pb, sets...)
+			if err != nil {
+				return err
+			}
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+		if err := tx.SetProto(ik, updated, 0); err != nil {
+			return err
+		}
+		pb, err = applyPubSubFieldMask(nil, updated, gets...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Range implements pubsub.Registry.
+func (r PubSubRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationPubSub) bool,
+) error {
+	return r.Bolt.Range(ttnbolt.Key("uid", ""), func(_ string, v []byte) (bool, error) {
+		pb := &ttnpb.ApplicationPubSub{}
+		if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+			return false, err
+		}
+		appUID := unique.ID(ctx, pb.GetIds().GetApplicationIds())
+		ctx, err := unique.WithContext(ctx, appUID)
+		if err != nil {
+			return false, errApplicationUID.WithCause(err).WithAttributes(
+				"application_uid", appUID,
+				"pub_sub_id", pb.GetIds().GetPubSubId(),
+			)
+		}
+		appIDs := pb.GetIds().GetApplicationIds()
+		pb, err = applyPubSubFieldMask(nil, pb, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, appIDs, pb), nil
+	})
+}
@@ -0,0 +1,169 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package awsiot implements the AWS IoT provider using the MQTT driver.
+//
+// The connection to AWS IoT Core uses MQTT over secure WebSockets. The WebSocket upgrade request is signed
+// using AWS Signature Version 4, using either the configured access key, the assumed role or the default
+// credentials chain of the environment.
+package awsiot
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// signingName is the service name used to sign the requests to the AWS IoT Core data plane.
+	signingName = "iotdevicegateway"
+	// endpointType is the endpoint type that is discovered if no endpoint address is configured.
+	endpointType = "iot:Data-ATS"
+)
+
+type impl struct {
+	// awsConfig returns the base AWS configuration for the given region.
+	awsConfig func(region string) *aws.Config
+	// tlsConfig is the TLS configuration used to connect to the endpoint.
+	tlsConfig *tls.Config
+	// scheme is the scheme of the endpoint URL.
+	scheme string
+}
+
+func defaultAWSConfig(region string) *aws.Config {
+	return aws.NewConfig().WithRegion(region)
+}
+
+var (
+	errAWSSession       = errors.DefineUnavailable("aws_session", "create AWS session")
+	errEndpointDiscover = errors.DefineUnavailable("endpoint_discover", "discover AWS IoT endpoint")
+	errSign             = errors.DefineUnauthenticated("sign", "sign AWS IoT request")
+)
+
+// OpenConnection implements provider.Provider using the MQTT driver.
+func (i impl) OpenConnection(
+	ctx context.Context, target provider.Target, enabler provider.Enabler,
+) (*provider.Connection, error) {
+	pb, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AwsIot)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+	settings := pb.AwsIot
+
+	config := i.awsConfig(settings.GetRegion())
+	if accessKey := settings.GetAccessKey(); accessKey != nil {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			accessKey.GetAccessKeyId(),
+			accessKey.GetSecretAccessKey(),
+			accessKey.GetSessionToken(),
+		))
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, errAWSSession.WithCause(err)
+	}
+	creds := sess.Config.Credentials
+	if assumeRole := settings.GetAssumeRole(); assumeRole != nil {
+		creds = stscreds.NewCredentials(sess, assumeRole.GetArn(), func(p *stscreds.AssumeRoleProvider) {
+			if externalID := assumeRole.GetExternalId(); externalID != "" {
+				p.ExternalID = aws.String(externalID)
+			}
+			if d := assumeRole.GetSessionDuration(); d != nil {
+				p.Duration = d.AsDuration()
+			}
+		})
+	}
+
+	endpointAddress := settings.GetEndpointAddress()
+	if endpointAddress == "" {
+		res, err := iot.New(sess, aws.NewConfig().WithCredentials(creds)).DescribeEndpointWithContext(
+			ctx,
+			&iot.DescribeEndpointInput{
+				EndpointType: aws.String(endpointType),
+			},
+		)
+		if err != nil {
+			return nil, errEndpointDiscover.WithCause(err)
+		}
+		endpointAddress = aws.StringValue(res.EndpointAddress)
+	}
+	serverURL := (&url.URL{
+		Scheme: i.scheme,
+		Host:   endpointAddress,
+		Path:   "/mqtt",
+	}).String()
+
+	signer := v4.NewSigner(creds)
+	region := settings.GetRegion()
+	var topics provider.Topics = target
+	if defaultIntegration := settings.GetDefault(); defaultIntegration != nil {
+		topics = newDefaultIntegrationTopics(defaultIntegration.GetStackName(), target)
+	}
+	return mqtt.OpenConnection(ctx, mqtt.Settings{
+		URL:      serverURL,
+		ClientID: clientID(target),
+		TLS:      i.tlsConfig,
+		// The credentials may expire, so the WebSocket upgrade request is signed on every (re)connect.
+		HTTPHeadersProvider: func(ctx context.Context) (http.Header, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverURL, nil)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := signer.Sign(req, nil, signingName, region, time.Now()); err != nil {
+				return nil, errSign.WithCause(err)
+			}
+			return req.Header, nil
+		},
+		PublishQoS:   1,
+		SubscribeQoS: 1,
+	}, topics)
+}
+
+// identifiersGetter is implemented by targets that carry the pub/sub identifiers.
+type identifiersGetter interface {
+	GetIds() *ttnpb.ApplicationPubSubIdentifiers
+}
+
+// clientID returns the MQTT client ID for the given target.
+// AWS IoT Core disconnects clients that connect with a client ID that is already in use.
+func clientID(target provider.Target) string {
+	if t, ok := target.(identifiersGetter); ok {
+		ids := t.GetIds()
+		return ids.GetApplicationIds().GetApplicationId() + "_" + ids.GetPubSubId()
+	}
+	return ""
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AwsIot{}, impl{
+		awsConfig: defaultAWSConfig,
+		scheme:    "wss",
+	})
+}
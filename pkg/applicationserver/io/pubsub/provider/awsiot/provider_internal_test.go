@@ -0,0 +1,262 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiot
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/server"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	paho_mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+type allEnabled struct{}
+
+// Enabled implements provider.Enabler.
+func (*allEnabled) Enabled(context.Context, ttnpb.ApplicationPubSub_Provider) error {
+	return nil
+}
+
+// startMQTTServer starts a MQTT over WebSocket server that records the headers of the upgrade requests.
+func startMQTTServer(ctx context.Context) (*httptest.Server, <-chan http.Header) {
+	s := server.New(ctx)
+	ws := mqttnet.Websocket(s.Handle)
+	headerCh := make(chan http.Header, 4)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case headerCh <- r.Header.Clone():
+		default:
+		}
+		if r.Header.Get("Origin") == "" {
+			r.Header.Set("Origin", "https://"+r.Host)
+		}
+		ws.ServeHTTP(w, r)
+	}))
+	return srv, headerCh
+}
+
+// startAWSServer starts a server that serves the STS AssumeRole and IoT DescribeEndpoint operations.
+func startAWSServer(t *testing.T, endpointAddress string) (*httptest.Server, <-chan url.Values) {
+	t.Helper()
+	assumeRoleCh := make(chan url.Values, 4)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/":
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if r.PostForm.Get("Action") != "AssumeRole" ||
+				!strings.Contains(r.Header.Get("Authorization"), "Credential=AKIDBASE/") {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+			assumeRoleCh <- r.PostForm
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>ASIAASSUMED</AccessKeyId>
+      <SecretAccessKey>assumed-secret</SecretAccessKey>
+      <SessionToken>assumed-token</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/test/session</Arn>
+      <AssumedRoleId>AROATEST:session</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+  <ResponseMetadata><RequestId>test</RequestId></ResponseMetadata>
+</AssumeRoleResponse>`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+
+		case r.Method == http.MethodGet && r.URL.Path == "/endpoint":
+			if r.URL.Query().Get("endpointType") != endpointType ||
+				!strings.Contains(r.Header.Get("Authorization"), "Credential=ASIAASSUMED/") {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"endpointAddress":%q}`, endpointAddress)
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return srv, assumeRoleCh
+}
+
+func TestOpenConnection(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	mqttServer, headerCh := startMQTTServer(ctx)
+	defer mqttServer.Close()
+	mqttURL, err := url.Parse(mqttServer.URL)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	awsServer, assumeRoleCh := startAWSServer(t, mqttURL.Host)
+	defer awsServer.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(mqttServer.Certificate())
+	tlsConfig := &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+
+	impl := impl{
+		awsConfig: func(region string) *aws.Config {
+			return defaultAWSConfig(region).WithEndpointResolver(endpoints.ResolverFunc(
+				func(string, string, ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+					return endpoints.ResolvedEndpoint{
+						URL:           awsServer.URL,
+						SigningRegion: region,
+					}, nil
+				},
+			))
+		},
+		tlsConfig: tlsConfig,
+		scheme:    "wss",
+	}
+
+	pb := &ttnpb.ApplicationPubSub{
+		Ids: &ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{
+				ApplicationId: "app1",
+			},
+			PubSubId: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_AwsIot{
+			AwsIot: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Region: "eu-west-1",
+				AccessKey: &ttnpb.ApplicationPubSub_AWSIoTProvider_AccessKey{
+					AccessKeyId:     "AKIDBASE",
+					SecretAccessKey: "base-secret",
+				},
+				AssumeRole: &ttnpb.ApplicationPubSub_AWSIoTProvider_AssumeRole{
+					Arn:             "arn:aws:iam::123456789012:role/test",
+					ExternalId:      "external-id",
+					SessionDuration: durationpb.New(time.Hour),
+				},
+				Deployment: &ttnpb.ApplicationPubSub_AWSIoTProvider_Default{
+					Default: &ttnpb.ApplicationPubSub_AWSIoTProvider_DefaultIntegration{
+						StackName: "tts",
+					},
+				},
+			},
+		},
+	}
+
+	conn, err := impl.OpenConnection(ctx, pb, &allEnabled{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Shutdown(ctx) //nolint:errcheck
+
+	select {
+	case form := <-assumeRoleCh:
+		a.So(form.Get("RoleArn"), should.Equal, "arn:aws:iam::123456789012:role/test")
+		a.So(form.Get("ExternalId"), should.Equal, "external-id")
+		a.So(form.Get("DurationSeconds"), should.Equal, "3600")
+	case <-time.After(timeout):
+		t.Fatal("Expected AssumeRole request")
+	}
+
+	select {
+	case header := <-headerCh:
+		auth := header.Get("Authorization")
+		a.So(auth, should.StartWith, "AWS4-HMAC-SHA256 Credential=ASIAASSUMED/")
+		a.So(auth, should.ContainSubstring, "/eu-west-1/iotdevicegateway/aws4_request")
+		a.So(header.Get("X-Amz-Security-Token"), should.Equal, "assumed-token")
+		a.So(header.Get("X-Amz-Date"), should.NotBeEmpty)
+	case <-time.After(timeout):
+		t.Fatal("Expected WebSocket upgrade request")
+	}
+
+	clientOpts := paho_mqtt.NewClientOptions()
+	clientOpts.AddBroker("wss://" + mqttURL.Host + "/mqtt")
+	clientOpts.SetClientID("test")
+	clientOpts.SetTLSConfig(tlsConfig)
+	clientOpts.SetHTTPHeaders(http.Header{"Origin": []string{mqttServer.URL}})
+	client := paho_mqtt.NewClient(clientOpts)
+	if token := client.Connect(); !token.WaitTimeout(timeout) || !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+	defer client.Disconnect(uint(timeout / time.Millisecond))
+
+	// The default integration overrides the base topic and message topics.
+	t.Run("Uplink", func(t *testing.T) {
+		a, ctx := test.New(t)
+		var (
+			mu       sync.Mutex
+			received []byte
+		)
+		done := make(chan struct{})
+		token := client.Subscribe("tts/app1/ps1/uplink/message", 1, func(_ paho_mqtt.Client, msg paho_mqtt.Message) {
+			mu.Lock()
+			defer mu.Unlock()
+			received = msg.Payload()
+			close(done)
+		})
+		if !token.WaitTimeout(timeout) || !a.So(token.Error(), should.BeNil) {
+			t.FailNow()
+		}
+		err := conn.Topics.UplinkMessage.Send(ctx, &pubsub.Message{Body: []byte("uplink")})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case <-done:
+			mu.Lock()
+			a.So(received, should.Resemble, []byte("uplink"))
+			mu.Unlock()
+		case <-time.After(timeout):
+			t.Fatal("Expected uplink message")
+		}
+	})
+
+	t.Run("DownlinkPush", func(t *testing.T) {
+		a, ctx := test.New(t)
+		token := client.Publish("tts/app1/ps1/downlink/push", 1, false, []byte("downlink"))
+		if !token.WaitTimeout(timeout) || !a.So(token.Error(), should.BeNil) {
+			t.FailNow()
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		msg, err := conn.Subscriptions.Push.Receive(ctx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		msg.Ack()
+		a.So(msg.Body, should.Resemble, []byte("downlink"))
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiot
+
+import (
+	mqtt_topic "github.com/TheThingsIndustries/mystique/pkg/topic"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// defaultIntegrationTopics are the topics used by the default integration.
+// The base topic is `<stack-name>/<application-id>/<pub-sub-id>`, which overrides the configured base topic and
+// message topics of the pub/sub integration.
+type defaultIntegrationTopics struct {
+	baseTopic string
+}
+
+func newDefaultIntegrationTopics(stackName string, target provider.Target) *defaultIntegrationTopics {
+	parts := []string{stackName}
+	if t, ok := target.(identifiersGetter); ok {
+		ids := t.GetIds()
+		parts = append(parts, ids.GetApplicationIds().GetApplicationId(), ids.GetPubSubId())
+	}
+	return &defaultIntegrationTopics{
+		baseTopic: mqtt_topic.Join(parts),
+	}
+}
+
+var _ provider.Topics = (*defaultIntegrationTopics)(nil)
+
+func message(topic string) *ttnpb.ApplicationPubSub_Message {
+	return &ttnpb.ApplicationPubSub_Message{Topic: topic}
+}
+
+// GetBaseTopic implements provider.Topics.
+func (t *defaultIntegrationTopics) GetBaseTopic() string { return t.baseTopic }
+
+// GetUplinkMessage implements provider.Topics.
+func (*defaultIntegrationTopics) GetUplinkMessage() *ttnpb.ApplicationPubSub_Message {
+	return message("uplink/message")
+}
+
+// GetUplinkNormalized implements provider.Topics.
+func (*defaultIntegrationTopics) GetUplinkNormalized() *ttnpb.ApplicationPubSub_Message {
+	return message("uplink/normalized")
+}
+
+// GetJoinAccept implements provider.Topics.
+func (*defaultIntegrationTopics) GetJoinAccept() *ttnpb.ApplicationPubSub_Message {
+	return message("join/accept")
+}
+
+// GetDownlinkAck implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkAck() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/ack")
+}
+
+// GetDownlinkNack implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkNack() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/nack")
+}
+
+// GetDownlinkSent implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkSent() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/sent")
+}
+
+// GetDownlinkFailed implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkFailed() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/failed")
+}
+
+// GetDownlinkQueued implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkQueued() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/queued")
+}
+
+// GetDownlinkQueueInvalidated implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkQueueInvalidated() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/invalidated")
+}
+
+// GetLocationSolved implements provider.Topics.
+func (*defaultIntegrationTopics) GetLocationSolved() *ttnpb.ApplicationPubSub_Message {
+	return message("location/solved")
+}
+
+// GetServiceData implements provider.Topics.
+func (*defaultIntegrationTopics) GetServiceData() *ttnpb.ApplicationPubSub_Message {
+	return message("service/data")
+}
+
+// GetDownlinkPush implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkPush() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/push")
+}
+
+// GetDownlinkReplace implements provider.Topics.
+func (*defaultIntegrationTopics) GetDownlinkReplace() *ttnpb.ApplicationPubSub_Message {
+	return message("downlink/replace")
+}
@@ -81,6 +81,12 @@ type ProviderConnection interface { //nolint:revive
 	Shutdowner
 }
 
+// HealthChecker is an interface that contains a contextual health check method.
+// Provider connections may implement this interface if they are able to detect that the connection is unhealthy.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
 // Connection is a wrapper that wraps the topics and subscriptions with a ProviderConnection.
 type Connection struct {
 	Topics             UplinkTopics
@@ -97,6 +103,15 @@ func (c *Connection) Shutdown(ctx context.Context) error {
 	)
 }
 
+// CheckHealth checks the health of the provider connection.
+// Connections which do not implement HealthChecker are considered to be healthy.
+func (c *Connection) CheckHealth(ctx context.Context) error {
+	if hc, ok := c.ProviderConnection.(HealthChecker); ok {
+		return hc.CheckHealth(ctx)
+	}
+	return nil
+}
+
 var errShutdown = errors.DefineInternal("shutdown", "shutdown")
 
 func shutdown(ctx context.Context, shutdowners ...Shutdowner) error {
@@ -0,0 +1,139 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// OpenTopic returns a *pubsub.Topic that produces to the given topic name with the given Kafka producer.
+// The key of the produced messages can be set using provider.WithMessageKey.
+func OpenTopic(producer sarama.SyncProducer, topicName string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(producer, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(producer sarama.SyncProducer, topicName string) (driver.Topic, error) {
+	if producer == nil {
+		return nil, errNilClient.New()
+	}
+	return &topic{
+		producer: producer,
+		topic:    topicName,
+	}, nil
+}
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil || t.producer == nil {
+		return errNilClient.New()
+	}
+	pms := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pm := &sarama.ProducerMessage{
+			Topic: t.topic,
+			Value: sarama.ByteEncoder(msg.Body),
+		}
+		for k, v := range msg.Metadata {
+			pm.Headers = append(pm.Headers, sarama.RecordHeader{
+				Key:   []byte(k),
+				Value: []byte(v),
+			})
+		}
+		if msg.BeforeSend != nil {
+			var key provider.MessageKey
+			asFunc := func(i any) bool {
+				switch p := i.(type) {
+				case **provider.MessageKey:
+					*p = &key
+				case **sarama.ProducerMessage:
+					*p = pm
+				default:
+					return false
+				}
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+			if key != "" {
+				pm.Key = sarama.StringEncoder(key)
+			}
+		}
+		pms = append(pms, pm)
+	}
+	if err := t.producer.SendMessages(pms); err != nil {
+		return errPublishFailed.WithCause(err)
+	}
+	return nil
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i any) bool {
+	p, ok := i.(*sarama.SyncProducer)
+	if !ok {
+		return false
+	}
+	*p = t.producer
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(error, any) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (*topic) Close() error { return nil }
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	if errors.Resemble(err, errNilClient) {
+		return gcerrors.NotFound
+	}
+	switch {
+	case err == nil:
+		return gcerrors.OK
+	case errors.Is(err, context.Canceled), errors.Is(err, errors.ErrContextCanceled):
+		return gcerrors.Canceled
+	case errors.Is(err, sarama.ErrClosedClient), errors.Is(err, sarama.ErrNotConnected):
+		return gcerrors.NotFound
+	default:
+		return gcerrors.Unknown
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Kafka provider using the Sarama client.
+//
+// Upstream messages are produced to the Kafka topics derived from the base topic and the message topics, keyed by
+// the message key, which is the end device unique ID. Downlink queue operations are consumed from the downlink
+// push and replace topics using a consumer group.
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/kafkapubsub"
+)
+
+// SASL mechanisms.
+const (
+	SASLMechanismPlain       = sarama.SASLTypePlaintext
+	SASLMechanismSCRAMSHA256 = sarama.SASLTypeSCRAMSHA256
+	SASLMechanismSCRAMSHA512 = sarama.SASLTypeSCRAMSHA512
+)
+
+// SASLSettings configure the SASL authentication.
+type SASLSettings struct {
+	Mechanism,
+	Username,
+	Password string
+}
+
+// Settings configure the Kafka client.
+type Settings struct {
+	// Brokers are the addresses of the bootstrap brokers.
+	Brokers []string
+	// ClientID is the client ID used in the requests to the brokers.
+	ClientID string
+	// GroupID is the consumer group ID used to consume the downlink queue operations.
+	GroupID string
+	// TLS enables TLS if set.
+	TLS *tls.Config
+	// SASL enables SASL authentication if set.
+	SASL *SASLSettings
+}
+
+var (
+	errNoBrokers       = errors.DefineInvalidArgument("no_brokers", "no brokers")
+	errSASLMechanism   = errors.DefineInvalidArgument("sasl_mechanism", "unsupported SASL mechanism `{mechanism}`")
+	errConnectFailed   = errors.DefineUnavailable("connect_failed", "connection to Kafka brokers failed")
+	errNoGroupID       = errors.DefineInvalidArgument("no_group_id", "no consumer group ID")
+	errUnhealthy       = errors.DefineUnavailable("unhealthy", "Kafka connection unhealthy")
+	errInvalidTopic    = errors.DefineInvalidArgument("invalid_topic", "invalid Kafka topic `{topic}`")
+	errClientClosed    = errors.DefineUnavailable("client_closed", "Kafka client closed")
+	errNilClient       = errors.DefineInvalidArgument("nil_client", "client is nil")
+	errPublishFailed   = errors.Define("publish_failed", "publish to Kafka topic failed")
+	errConfigureSarama = errors.DefineInvalidArgument("configure", "configure Kafka client")
+	errCAPEMData       = errors.DefineInvalidArgument("ca_pem_data", "CA PEM data is invalid")
+	errClientKeyPair   = errors.DefineInvalidArgument("client_key_pair", "invalid client certificate or key")
+)
+
+func saramaConfig(settings Settings) (*sarama.Config, error) {
+	config := kafkapubsub.MinimalConfig()
+	if settings.ClientID != "" {
+		config.ClientID = settings.ClientID
+	}
+	// Partition the upstream messages by the message key, such that the messages of an end device are ordered.
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	if settings.TLS != nil {
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = settings.TLS
+	}
+	if sasl := settings.SASL; sasl != nil {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = sasl.Username
+		config.Net.SASL.Password = sasl.Password
+		switch sasl.Mechanism {
+		case "", SASLMechanismPlain:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case SASLMechanismSCRAMSHA256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMClientGenerator(scramSHA256)
+		case SASLMechanismSCRAMSHA512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMClientGenerator(scramSHA512)
+		default:
+			return nil, errSASLMechanism.WithAttributes("mechanism", sasl.Mechanism)
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, errConfigureSarama.WithCause(err)
+	}
+	return config, nil
+}
+
+type connection struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	if err := c.producer.Close(); err != nil {
+		c.client.Close() // nolint:errcheck
+		return err
+	}
+	return c.client.Close()
+}
+
+// CheckHealth implements provider.HealthChecker.
+// The connection is healthy if the cluster metadata can be retrieved from the brokers.
+func (c *connection) CheckHealth(_ context.Context) error {
+	if c.client.Closed() {
+		return errClientClosed.New()
+	}
+	if err := c.client.RefreshMetadata(); err != nil {
+		return errUnhealthy.WithCause(err)
+	}
+	return nil
+}
+
+// combineTopics combines the given topic parts to a Kafka topic name.
+// As Kafka topic names cannot contain slashes, the topic levels are separated by dots.
+func combineTopics(parts ...string) string {
+	levels := make([]string, 0, len(parts))
+	for _, part := range parts {
+		for _, level := range strings.FieldsFunc(part, func(r rune) bool { return r == '/' || r == '.' }) {
+			levels = append(levels, level)
+		}
+	}
+	return strings.Join(levels, ".")
+}
+
+// validateTopic validates the given Kafka topic name.
+func validateTopic(topic string) error {
+	if topic == "" || len(topic) > 249 {
+		return errInvalidTopic.WithAttributes("topic", topic)
+	}
+	for _, r := range topic {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+		default:
+			return errInvalidTopic.WithAttributes("topic", topic)
+		}
+	}
+	return nil
+}
+
+// OpenConnection opens a Kafka connection using the given settings.
+func OpenConnection(
+	ctx context.Context, settings Settings, topics provider.Topics,
+) (_ *provider.Connection, err error) {
+	if len(settings.Brokers) == 0 {
+		return nil, errNoBrokers.New()
+	}
+	config, err := saramaConfig(settings)
+	if err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"brokers", settings.Brokers,
+		"client_id", config.ClientID,
+	))
+
+	client, err := sarama.NewClient(settings.Brokers, config)
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		client.Close() // nolint:errcheck
+		return nil, errConnectFailed.WithCause(err)
+	}
+	conn := &provider.Connection{
+		ProviderConnection: &connection{
+			client:   client,
+			producer: producer,
+		},
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx) // nolint:errcheck
+		}
+	}()
+	logger.Info("Connected to Kafka brokers")
+
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &conn.Topics.UplinkMessage,
+			message: topics.GetUplinkMessage(),
+		},
+		{
+			topic:   &conn.Topics.UplinkNormalized,
+			message: topics.GetUplinkNormalized(),
+		},
+		{
+			topic:   &conn.Topics.JoinAccept,
+			message: topics.GetJoinAccept(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkAck,
+			message: topics.GetDownlinkAck(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkNack,
+			message: topics.GetDownlinkNack(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkSent,
+			message: topics.GetDownlinkSent(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkFailed,
+			message: topics.GetDownlinkFailed(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkQueued,
+			message: topics.GetDownlinkQueued(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkQueueInvalidated,
+			message: topics.GetDownlinkQueueInvalidated(),
+		},
+		{
+			topic:   &conn.Topics.LocationSolved,
+			message: topics.GetLocationSolved(),
+		},
+		{
+			topic:   &conn.Topics.ServiceData,
+			message: topics.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		topicName := combineTopics(topics.GetBaseTopic(), t.message.GetTopic())
+		if err := validateTopic(topicName); err != nil {
+			return nil, err
+		}
+		if *t.topic, err = OpenTopic(producer, topicName); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &conn.Subscriptions.Push,
+			message:      topics.GetDownlinkPush(),
+		},
+		{
+			subscription: &conn.Subscriptions.Replace,
+			message:      topics.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		if settings.GroupID == "" {
+			return nil, errNoGroupID.New()
+		}
+		topicName := combineTopics(topics.GetBaseTopic(), s.message.GetTopic())
+		if err := validateTopic(topicName); err != nil {
+			return nil, err
+		}
+		// The consumer groups of the operations are distinct, such that each operation receives all messages
+		// of its topic.
+		if *s.subscription, err = kafkapubsub.OpenSubscription(
+			settings.Brokers,
+			config,
+			settings.GroupID+"."+topicName,
+			[]string{topicName},
+			&kafkapubsub.SubscriptionOptions{},
+		); err != nil {
+			return nil, errConnectFailed.WithCause(err)
+		}
+	}
+	return conn, nil
+}
+
+type impl struct{}
+
+var saslMechanisms = map[ttnpb.ApplicationPubSub_KafkaProvider_SASL_Mechanism]string{
+	ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN:         SASLMechanismPlain,
+	ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256: SASLMechanismSCRAMSHA256,
+	ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512: SASLMechanismSCRAMSHA512,
+}
+
+func createTLSConfig(caPEM, certPEM, keyPEM []byte) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	// Change the CA certificate pool only if a CA has been provided.
+	// This allows the system-wide CA pool to be used.
+	if len(caPEM) != 0 {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errCAPEMData.New()
+		}
+	}
+	// The client certificate is optional, as the brokers may authenticate the client using SASL instead.
+	if len(certPEM) != 0 || len(keyPEM) != 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, errClientKeyPair.WithCause(err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// OpenConnection implements provider.Provider using the Sarama client.
+func (impl) OpenConnection(
+	ctx context.Context, target provider.Target, enabler provider.Enabler,
+) (*provider.Connection, error) {
+	pb, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	if err := enabler.Enabled(ctx, target.GetProvider()); err != nil {
+		return nil, err
+	}
+
+	settings := Settings{
+		Brokers:  pb.Kafka.GetBrokers(),
+		ClientID: pb.Kafka.GetClientId(),
+		GroupID:  pb.Kafka.GetGroupId(),
+	}
+	if pb.Kafka.GetUseTls() {
+		tlsConfig, err := createTLSConfig(pb.Kafka.TlsCa, pb.Kafka.TlsClientCert, pb.Kafka.TlsClientKey)
+		if err != nil {
+			return nil, err
+		}
+		settings.TLS = tlsConfig
+	}
+	if sasl := pb.Kafka.GetSasl(); sasl != nil {
+		mechanism, ok := saslMechanisms[sasl.Mechanism]
+		if !ok {
+			return nil, errSASLMechanism.WithAttributes("mechanism", sasl.Mechanism.String())
+		}
+		settings.SASL = &SASLSettings{
+			Mechanism: mechanism,
+			Username:  sasl.Username,
+			Password:  sasl.Password,
+		}
+	}
+	return OpenConnection(ctx, settings, target)
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
@@ -0,0 +1,283 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+)
+
+type allEnabled struct{}
+
+// Enabled implements provider.Enabler.
+func (*allEnabled) Enabled(context.Context, ttnpb.ApplicationPubSub_Provider) error {
+	return nil
+}
+
+func TestCombineTopics(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Parts    []string
+		Expected string
+	}{
+		{
+			Parts:    []string{"app1/ps1", "uplink/message"},
+			Expected: "app1.ps1.uplink.message",
+		},
+		{
+			Parts:    []string{"/app1.ps1/", "/uplink//message/"},
+			Expected: "app1.ps1.uplink.message",
+		},
+		{
+			Parts:    []string{"", "uplink"},
+			Expected: "uplink",
+		},
+		{
+			Parts:    []string{"app1", ""},
+			Expected: "app1",
+		},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%v", tc.Parts), func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			a.So(combineTopics(tc.Parts...), should.Equal, tc.Expected)
+		})
+	}
+}
+
+func TestValidateTopic(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	a.So(validateTopic("app1.ps1.uplink_message-v3"), should.BeNil)
+	a.So(errors.IsInvalidArgument(validateTopic("")), should.BeTrue)
+	a.So(errors.IsInvalidArgument(validateTopic("app1 ps1")), should.BeTrue)
+	a.So(errors.IsInvalidArgument(validateTopic("app1/ps1")), should.BeTrue)
+}
+
+func TestSASLConfig(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	for _, mechanism := range []string{"", SASLMechanismPlain, SASLMechanismSCRAMSHA256, SASLMechanismSCRAMSHA512} {
+		config, err := saramaConfig(Settings{
+			SASL: &SASLSettings{
+				Mechanism: mechanism,
+				Username:  "user",
+				Password:  "secret",
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(config.Net.SASL.Enable, should.BeTrue)
+		if mechanism == "" {
+			a.So(string(config.Net.SASL.Mechanism), should.Equal, SASLMechanismPlain)
+		} else {
+			a.So(string(config.Net.SASL.Mechanism), should.Equal, mechanism)
+		}
+	}
+
+	_, err := saramaConfig(Settings{
+		SASL: &SASLSettings{
+			Mechanism: "GSSAPI",
+		},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestTopicMessageKey(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	producer := mocks.NewSyncProducer(t, nil)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(pm *sarama.ProducerMessage) error {
+		if pm.Topic != "app1.ps1.uplink.message" {
+			return fmt.Errorf("unexpected topic %q", pm.Topic)
+		}
+		if pm.Key == nil {
+			return fmt.Errorf("no key")
+		}
+		if key, _ := pm.Key.Encode(); string(key) != "app1.dev1" {
+			return fmt.Errorf("unexpected key %q", key)
+		}
+		if value, _ := pm.Value.Encode(); string(value) != "uplink" {
+			return fmt.Errorf("unexpected value %q", value)
+		}
+		return nil
+	})
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(pm *sarama.ProducerMessage) error {
+		if pm.Key != nil {
+			return fmt.Errorf("unexpected key")
+		}
+		return nil
+	})
+	defer producer.Close()
+
+	topic, err := OpenTopic(producer, "app1.ps1.uplink.message")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer topic.Shutdown(ctx) //nolint:errcheck
+
+	a.So(topic.Send(ctx, &pubsub.Message{
+		Body:       []byte("uplink"),
+		BeforeSend: provider.WithMessageKey("app1.dev1"),
+	}), should.BeNil)
+	a.So(topic.Send(ctx, &pubsub.Message{
+		Body: []byte("uplink"),
+	}), should.BeNil)
+}
+
+func TestOpenConnection(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader("app1.ps1.uplink.message", 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t),
+	})
+
+	topics := &ttnpb.ApplicationPubSub{
+		BaseTopic: "app1/ps1",
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink/message",
+		},
+	}
+
+	// No brokers.
+	{
+		_, err := OpenConnection(ctx, Settings{}, topics)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	// Invalid topic.
+	{
+		_, err := OpenConnection(ctx, Settings{
+			Brokers: []string{broker.Addr()},
+		}, &ttnpb.ApplicationPubSub{
+			BaseTopic: "app1 ps1",
+			UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+				Topic: "uplink",
+			},
+		})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	// Downlink subscriptions require a consumer group.
+	{
+		_, err := OpenConnection(ctx, Settings{
+			Brokers: []string{broker.Addr()},
+		}, &ttnpb.ApplicationPubSub{
+			BaseTopic: "app1/ps1",
+			DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+				Topic: "downlink/push",
+			},
+		})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	conn, err := OpenConnection(ctx, Settings{
+		Brokers:  []string{broker.Addr()},
+		ClientID: "test",
+	}, topics)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(conn.Topics.UplinkMessage, should.NotBeNil)
+	a.So(conn.Topics.JoinAccept, should.BeNil)
+	a.So(conn.Subscriptions.Push, should.BeNil)
+	a.So(conn.CheckHealth(ctx), should.BeNil)
+
+	a.So(conn.Topics.UplinkMessage.Send(ctx, &pubsub.Message{
+		Body:       []byte("uplink"),
+		BeforeSend: provider.WithMessageKey("app1.dev1"),
+	}), should.BeNil)
+
+	a.So(conn.Shutdown(ctx), should.BeNil)
+	a.So(errors.IsUnavailable(conn.CheckHealth(ctx)), should.BeTrue)
+}
+
+func TestToErrorCode(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	a.So(toErrorCode(nil), should.Equal, gcerrors.OK)
+	a.So(toErrorCode(errNilClient.New()), should.Equal, gcerrors.NotFound)
+	a.So(toErrorCode(errPublishFailed.WithCause(context.Canceled)), should.Equal, gcerrors.Canceled)
+	a.So(toErrorCode(errPublishFailed.WithCause(sarama.ErrClosedClient)), should.Equal, gcerrors.NotFound)
+	a.So(toErrorCode(errPublishFailed.WithCause(&sarama.ProducerError{
+		Err: sarama.ErrNotConnected,
+	})), should.Equal, gcerrors.NotFound)
+	a.So(toErrorCode(errPublishFailed.WithCause(sarama.ErrOutOfBrokers)), should.Equal, gcerrors.Unknown)
+}
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()),
+	})
+
+	pb := &ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_Kafka{
+			Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers:  []string{broker.Addr()},
+				ClientId: "test",
+			},
+		},
+	}
+	impl, err := provider.GetProvider(pb)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Invalid CA certificate.
+	{
+		pb.GetKafka().UseTls = true
+		pb.GetKafka().TlsCa = []byte("invalid")
+		_, err := impl.OpenConnection(ctx, pb, &allEnabled{})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		pb.GetKafka().UseTls = false
+		pb.GetKafka().TlsCa = nil
+	}
+
+	conn, err := impl.OpenConnection(ctx, pb, &allEnabled{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(conn.Shutdown(ctx), should.BeNil)
+}
@@ -0,0 +1,32 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+// MessageKey is the key of a message.
+// Providers that partition messages use the key in order to determine the partition of the message.
+type MessageKey string
+
+// WithMessageKey returns a pubsub.Message BeforeSend function which sets the key of the message.
+// Drivers that support message keys expose a **MessageKey to the BeforeSend function. Other drivers
+// ignore the key.
+func WithMessageKey(key string) func(asFunc func(any) bool) error {
+	return func(asFunc func(any) bool) error {
+		var mk *MessageKey
+		if asFunc(&mk) {
+			*mk = MessageKey(key)
+		}
+		return nil
+	}
+}
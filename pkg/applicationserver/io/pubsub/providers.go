@@ -59,6 +59,10 @@ func providerTypeFromString(ctx context.Context, s string) (reflect.Type, error)
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Mqtt{}), nil
 	case "nats":
 		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Nats{}), nil
+	case "awsiot":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_AwsIot{}), nil
+	case "kafka":
+		return reflect.TypeOf(&ttnpb.ApplicationPubSub_Kafka{}), nil
 	default:
 		log.FromContext(ctx).WithField("provider", s).Warn("Unknown PubSub provider specified")
 		return nil, nil
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
@@ -165,7 +166,8 @@ func (i *integration) handleUp(ctx context.Context) {
 				continue
 			}
 			err = topic.Send(ctx, &pubsub.Message{
-				Body: buf,
+				Body:       buf,
+				BeforeSend: provider.WithMessageKey(unique.ID(ctx, up.ApplicationUp.EndDeviceIds)),
 			})
 			if err != nil {
 				logger.WithError(err).Warn("Failed to publish upstream message")
@@ -219,6 +221,28 @@ func (i *integration) handleDown(
 	}
 }
 
+// healthCheckInterval is the interval in which the health of the provider connections is checked.
+var healthCheckInterval = time.Minute
+
+// monitorHealth periodically checks the health of the provider connection, and closes the integration if the
+// connection is unhealthy.
+func (i *integration) monitorHealth(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := i.conn.CheckHealth(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Pub/sub connection unhealthy")
+			i.cancel(err)
+			return
+		}
+	}
+}
+
 func (i *integration) startHandleDown(ctx context.Context) {
 	for _, downlink := range []struct {
 		name         string
@@ -317,6 +341,9 @@ func (ps *PubSub) start(ctx context.Context, pb *ttnpb.ApplicationPubSub) (err e
 
 	go i.handleUp(ctx)
 	i.startHandleDown(ctx)
+	if _, ok := i.conn.ProviderConnection.(provider.HealthChecker); ok {
+		go i.monitorHealth(ctx)
+	}
 	logger.Info("Pub/sub started")
 	registerIntegrationStart(ctx, i)
 	defer func() {
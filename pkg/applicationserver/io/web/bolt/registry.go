@@ -0,0 +1,223 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a webhook registry backed by an embedded bbolt database.
+package bolt
+
+import (
+	"context"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// appendImplicitWebhookGetPaths appends implicit ttnpb.ApplicationWebhook get paths to paths.
+func appendImplicitWebhookGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 3+len(paths)),
+		"created_at",
+		"ids",
+		"updated_at",
+	), paths...)
+}
+
+func applyWebhookFieldMask(dst, src *ttnpb.ApplicationWebhook, paths ...string) (*ttnpb.ApplicationWebhook, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationWebhook{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// WebhookRegistry is a bbolt webhook registry.
+type WebhookRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the WebhookRegistry.
+func (r *WebhookRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+// appPrefix returns the prefix of the keys of the webhooks of the application.
+func (r *WebhookRegistry) appPrefix(appUID string) string {
+	return ttnbolt.Key("uid", appUID, "")
+}
+
+func (r *WebhookRegistry) idKey(appUID, id string) string {
+	return ttnbolt.Key("uid", appUID, id)
+}
+
+// Get implements WebhookRegistry.
+func (r WebhookRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, paths []string,
+) (*ttnpb.ApplicationWebhook, error) {
+	pb := &ttnpb.ApplicationWebhook{}
+	if err := r.Bolt.GetProto(r.idKey(unique.ID(ctx, ids.ApplicationIds), ids.WebhookId), pb); err != nil {
+		return nil, err
+	}
+	return applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
+}
+
+// List implements WebhookRegistry.
+func (r WebhookRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string,
+) ([]*ttnpb.ApplicationWebhook, error) {
+	var pbs []*ttnpb.ApplicationWebhook
+	err := r.Bolt.Range(r.appPrefix(unique.ID(ctx, ids)), func(_ string, v []byte) (bool, error) {
+		pb := &ttnpb.ApplicationWebhook{}
+		if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+			return false, err
+		}
+		pb, err := applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
+		if err != nil {
+			return false, err
+		}
+		pbs = append(pbs, pb)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements WebhookRegistry.
+func (r WebhookRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationWebhookIdentifiers,
+	gets []string,
+	f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error),
+) (*ttnpb.ApplicationWebhook, error) {
+	ik := r.idKey(unique.ID(ctx, ids.ApplicationIds), ids.WebhookId)
+	gets = appendImplicitWebhookGetPaths(gets...)
+
+	var pb *ttnpb.ApplicationWebhook
+	err := r.Bolt.LockedWatch(ctx, ik, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.ApplicationWebhook{}
+		if err := tx.GetProto(ik, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb = nil
+		if stored != nil {
+			pb, err = applyWebhookFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyWebhookFieldMask(nil, stored, gets...)
+			return err
+		}
+		if pb == nil && len(sets) == 0 {
+			tx.Del(ik)
+			return nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationWebhook{}
+		}
+
+		pb.UpdatedAt = timestamppb.Now()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.ApplicationWebhook{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.webhook_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = applyWebhookFieldMask(updated, pb, sets...)
+			if err != nil {
+				return err
+			}
+			if updated.Ids.ApplicationIds.ApplicationId != ids.ApplicationIds.ApplicationId ||
+				updated.Ids.WebhookId != ids.WebhookId {
+				return errInvalidIdentifiers.New()
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+				pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+				return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.webhook_id") && pb.Ids.WebhookId != stored.Ids.WebhookId {
+				return errReadOnlyField.WithAttributes("field", "ids.webhook_id")
+			}
+			updated, err = applyWebhookFieldMask(stored, pb, sets...)
+			if err != nil {
+				return err
+			}
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+		if err := tx.SetProto(ik, updated, 0); err != nil {
+			return err
+		}
+		pb, err = applyWebhookFieldMask(nil, updated, gets...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Range implements WebhookRegistry.
+func (r WebhookRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationWebhook) bool,
+) error {
+	return r.Bolt.Range(ttnbolt.Key("uid", ""), func(_ string, v []byte) (bool, error) {
+		wh := &ttnpb.ApplicationWebhook{}
+		if err := ttnbolt.UnmarshalProto(v, wh); err != nil {
+			return false, err
+		}
+		appIDs := wh.GetIds().GetApplicationIds()
+		wh, err := applyWebhookFieldMask(nil, wh, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, appIDs, wh), nil
+	})
+}
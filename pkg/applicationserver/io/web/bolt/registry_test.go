@@ -0,0 +1,136 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ web.WebhookRegistry = &WebhookRegistry{}
+
+func TestWebhookRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, closeFn := test.NewBolt(ctx, "as", "io", "webhooks")
+	defer closeFn()
+
+	reg := &WebhookRegistry{
+		Bolt: cl,
+	}
+	if err := reg.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	app1IDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"}
+	app2IDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "app-10"}
+	allIDs := []*ttnpb.ApplicationWebhookIdentifiers{
+		{ApplicationIds: app1IDs, WebhookId: "wh-1"},
+		{ApplicationIds: app1IDs, WebhookId: "wh-2"},
+		{ApplicationIds: app2IDs, WebhookId: "wh-1"},
+	}
+
+	_, err := reg.Get(ctx, allIDs[0], []string{"base_url"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	for _, ids := range allIDs {
+		pb, err := reg.Set(ctx, ids, []string{"base_url"},
+			func(pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+				a.So(pb, should.BeNil)
+				return &ttnpb.ApplicationWebhook{
+					Ids:     ids,
+					BaseUrl: "https://example.com/" + ids.WebhookId,
+					Format:  "json",
+				}, []string{"ids", "base_url", "format"}, nil
+			},
+		)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(pb.Ids, should.Resemble, ids)
+		a.So(pb.BaseUrl, should.Equal, "https://example.com/"+ids.WebhookId)
+		a.So(pb.Format, should.BeEmpty)
+		a.So(pb.CreatedAt, should.NotBeNil)
+		a.So(pb.UpdatedAt, should.NotBeNil)
+	}
+
+	// Identifiers are read-only.
+	_, err = reg.Set(ctx, allIDs[0], nil,
+		func(pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			pb.Ids = allIDs[1]
+			return pb, []string{"ids.webhook_id"}, nil
+		},
+	)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Only the fields in the field mask are updated.
+	pb, err := reg.Set(ctx, allIDs[0], []string{"base_url", "format"},
+		func(pb *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			a.So(pb.BaseUrl, should.Equal, "https://example.com/wh-1")
+			return &ttnpb.ApplicationWebhook{
+				BaseUrl: "https://example.org",
+				Format:  "protobuf",
+			}, []string{"format"}, nil
+		},
+	)
+	if a.So(err, should.BeNil) {
+		a.So(pb.BaseUrl, should.Equal, "https://example.com/wh-1")
+		a.So(pb.Format, should.Equal, "protobuf")
+	}
+
+	list, err := reg.List(ctx, app1IDs, []string{"format"})
+	if a.So(err, should.BeNil) && a.So(list, should.HaveLength, 2) {
+		a.So(list[0].Ids, should.Resemble, allIDs[0])
+		a.So(list[0].Format, should.Equal, "protobuf")
+		a.So(list[0].BaseUrl, should.BeEmpty)
+		a.So(list[1].Ids, should.Resemble, allIDs[1])
+	}
+	list, err = reg.List(ctx, app2IDs, nil)
+	if a.So(err, should.BeNil) && a.So(list, should.HaveLength, 1) {
+		a.So(list[0].Ids, should.Resemble, allIDs[2])
+	}
+
+	seen := make(map[string]int)
+	err = reg.Range(ctx, []string{"base_url"},
+		func(_ context.Context, ids *ttnpb.ApplicationIdentifiers, pb *ttnpb.ApplicationWebhook) bool {
+			seen[ids.ApplicationId]++
+			a.So(pb.BaseUrl, should.NotBeEmpty)
+			return true
+		},
+	)
+	a.So(err, should.BeNil)
+	a.So(seen, should.Resemble, map[string]int{"app-1": 2, "app-10": 1})
+
+	for _, ids := range allIDs {
+		_, err := reg.Set(ctx, ids, nil,
+			func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+				return nil, nil, nil
+			},
+		)
+		a.So(err, should.BeNil)
+		_, err = reg.Get(ctx, ids, nil)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+	list, err = reg.List(ctx, app1IDs, nil)
+	a.So(err, should.BeNil)
+	a.So(list, should.BeEmpty)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
@@ -77,6 +78,17 @@ func (c TemplatesConfig) NewTemplateStore(ctx context.Context, httpClientProvide
 	}, nil
 }
 
+// DeliveryQueueConfig defines the configuration of the durable webhook delivery queue.
+type DeliveryQueueConfig struct {
+	Queue          DeliveryQueue `name:"-"`
+	Enable         bool          `name:"enable" description:"Persist webhook requests in a queue and retry failed requests"`
+	MaxLen         int64         `name:"max-len" description:"Maximum length of the delivery queue stream"`
+	Group          string        `name:"group" description:"Consumer group of the delivery queue"`
+	NumConsumers   uint64        `name:"num-consumers" description:"Number of consumers of the delivery queue"`
+	MaxAge         time.Duration `name:"max-age" description:"Maximum age of a failing request before it is moved to the dead letters"`
+	MaxDeadLetters int64         `name:"max-dead-letters" description:"Maximum number of dead letters retained per webhook"`
+}
+
 // DownlinksConfig defines the configuration for the webhook downlink queue operations.
 // For public addresses, the TLS version is preferred when present.
 type DownlinksConfig struct {
@@ -0,0 +1,215 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Delivery is a pending delivery of an upstream message to a webhook.
+type Delivery struct {
+	// ID is the unique identifier of the delivery.
+	ID string
+	// WebhookIDs are the identifiers of the webhook to which the message is delivered.
+	WebhookIDs *ttnpb.ApplicationWebhookIdentifiers
+	// Up is the upstream message.
+	Up *ttnpb.ApplicationUp
+	// CreatedAt is the time at which the delivery was created.
+	CreatedAt time.Time
+	// QueuedAt is the time at which the delivery was added to the queue, or replayed from the dead letters.
+	QueuedAt time.Time
+	// Attempts is the number of failed attempts since the delivery was queued.
+	Attempts uint32
+	// LastAttemptAt is the time of the last failed attempt.
+	LastAttemptAt time.Time
+	// LastError is the error of the last failed attempt.
+	LastError string
+}
+
+// DeliveryQueue is a durable queue of webhook deliveries.
+type DeliveryQueue interface {
+	// Add adds the delivery to the queue. The delivery is attempted at startAt.
+	Add(ctx context.Context, delivery *Delivery, startAt time.Time) error
+	// Dispatch dispatches the deliveries in the queue. It runs until the context is done.
+	// consumerID is used to identify the consumer and should be unique for all concurrent calls to Dispatch.
+	Dispatch(ctx context.Context, consumerID string) error
+	// Pop calls f on the earliest delivery for which the start time is in range [0, time.Now()], if such is
+	// available, otherwise it blocks until it is.
+	// If f returns without error, the delivery is removed from the queue.
+	// If f returns an error and a non-zero time, the delivery is attempted again at that time.
+	// If f returns an error and a zero time, the delivery is moved to the dead letters of the webhook.
+	// consumerID is used to identify the consumer and should be unique for all concurrent calls to Pop.
+	Pop(ctx context.Context, consumerID string, f func(context.Context, *Delivery) (time.Time, error)) error
+	// ListDeadLetters returns the dead letters of the webhook, oldest first.
+	ListDeadLetters(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) ([]*Delivery, error)
+	// ReplayDeadLetters moves the dead letters of the webhook back to the queue.
+	// Dead letters that are added while replaying are retained. The number of replayed deliveries is returned.
+	ReplayDeadLetters(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) (int, error)
+	// Delete removes the pending deliveries and the dead letters of the webhook.
+	Delete(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) error
+	// WithPagination adds the pagination information to the context.
+	WithPagination(ctx context.Context, limit, page uint32, total *int64) context.Context
+}
+
+var errDeliveryMaxAge = errors.DefineInvalidArgument(
+	"delivery_max_age", "delivery queue maximum age must be positive",
+)
+
+const (
+	deliveryDispatchTaskName = "webhooks_delivery_dispatch"
+	deliveryProcessTaskName  = "webhooks_delivery_process"
+)
+
+var deliveryTaskBackoff = &task.BackoffConfig{
+	Jitter:       task.DefaultBackoffConfig.Jitter,
+	IntervalFunc: task.MakeBackoffIntervalFunc(true, task.DefaultBackoffResetDuration, task.DefaultBackoffIntervals[:]...),
+}
+
+// startDeliveryTasks starts the dispatcher and the consumers of the delivery queue.
+func (w *webhooks) startDeliveryTasks(ctx context.Context) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	consumerIDPrefix := fmt.Sprintf("%s:%d", hostname, os.Getpid())
+	w.server.StartTask(&task.Config{
+		Context: ctx,
+		ID:      deliveryDispatchTaskName,
+		Func: func(ctx context.Context) error {
+			return w.deliveries.Queue.Dispatch(ctx, consumerIDPrefix)
+		},
+		Restart: task.RestartAlways,
+		Backoff: deliveryTaskBackoff,
+	})
+	for i := uint64(0); i < w.deliveries.NumConsumers; i++ {
+		consumerID := fmt.Sprintf("%s:%d", consumerIDPrefix, i)
+		w.server.StartTask(&task.Config{
+			Context: ctx,
+			ID:      fmt.Sprintf("%s_%d", deliveryProcessTaskName, i),
+			Func: func(ctx context.Context) error {
+				return w.deliveries.Queue.Pop(ctx, consumerID, w.deliver)
+			},
+			Restart: task.RestartAlways,
+			Backoff: deliveryTaskBackoff,
+		})
+	}
+	return nil
+}
+
+// enqueue adds the deliveries of the upstream message to the webhooks to the delivery queue.
+// A failure to queue the delivery to one webhook does not prevent the delivery to the other webhooks.
+// The first error is returned.
+func (w *webhooks) enqueue(ctx context.Context, msg *ttnpb.ApplicationUp, hooks []*ttnpb.ApplicationWebhook) error {
+	now := time.Now()
+	var firstErr error
+	for _, hook := range hooks {
+		if webhookMessage(msg, hook) == nil {
+			continue
+		}
+		err := w.enqueueHook(ctx, msg, hook, now)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("hook", hook.Ids.WebhookId).Warn("Failed to queue request")
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func (w *webhooks) enqueueHook(
+	ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook, now time.Time,
+) error {
+	id, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return err
+	}
+	return w.deliveries.Queue.Add(ctx, &Delivery{
+		ID:         id.String(),
+		WebhookIDs: hook.Ids,
+		Up:         msg,
+		CreatedAt:  now,
+		QueuedAt:   now,
+	}, now)
+}
+
+// deliver attempts the delivery and returns the time at which the delivery should be attempted again on failure.
+// Deliveries that are older than the maximum age are not attempted again.
+func (w *webhooks) deliver(ctx context.Context, d *Delivery) (time.Time, error) {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", namespace,
+		"hook", d.WebhookIDs.GetWebhookId(),
+		"delivery_id", d.ID,
+	))
+	logger := log.FromContext(ctx)
+	hook, err := w.registry.Get(ctx, d.WebhookIDs, webhookFanOutFieldMask)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.Debug("Webhook not found, drop delivery")
+			return time.Time{}, nil
+		}
+		return w.retryAt(ctx, d, err)
+	}
+	ctx = internal.WithWebhookData(ctx, &internal.WebhookData{
+		EndDeviceIDs: d.Up.EndDeviceIds,
+		WebhookIDs:   hook.Ids,
+		Health:       hook.HealthStatus,
+	})
+	signingSecret, err := w.secrets.decrypt(ctx, hook.SigningSecret)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to decrypt signing secret")
+		return w.retryAt(ctx, d, err)
+	}
+	req, err := NewRequest(ctx, w.downlinks, d.Up, hook, signingSecret)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create request")
+		return w.retryAt(ctx, d, err)
+	}
+	if req == nil {
+		return time.Time{}, nil
+	}
+	logger.WithField("url", req.URL).Debug("Process request")
+	if err := w.target.Process(req); err != nil {
+		logger.WithError(err).Warn("Failed to process request")
+		return w.retryAt(ctx, d, err)
+	}
+	return time.Time{}, nil
+}
+
+func (w *webhooks) retryAt(ctx context.Context, d *Delivery, err error) (time.Time, error) {
+	now := time.Now()
+	d.Attempts++
+	d.LastAttemptAt = now
+	d.LastError = err.Error()
+	if now.Sub(d.QueuedAt) >= w.deliveries.MaxAge {
+		log.FromContext(ctx).WithField("attempts", d.Attempts).Warn("Delivery expired, move to dead letters")
+		return time.Time{}, err
+	}
+	backoff := io.DialTaskBackoffConfig
+	return now.Add(random.Jitter(backoff.IntervalFunc(ctx, 0, uint(d.Attempts), err), backoff.Jitter)), err
+}
@@ -0,0 +1,381 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errTestDelivery        = errors.DefineUnavailable("test_delivery", "test delivery")
+	errTestWebhookNotFound = errors.DefineNotFound("test_webhook_not_found", "test webhook not found")
+)
+
+type mockDeliveryRegistry struct {
+	WebhookRegistry
+	hook *ttnpb.ApplicationWebhook
+}
+
+func (r *mockDeliveryRegistry) Get(
+	_ context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, _ []string,
+) (*ttnpb.ApplicationWebhook, error) {
+	if r.hook == nil || r.hook.Ids.WebhookId != ids.WebhookId {
+		return nil, errTestWebhookNotFound.New()
+	}
+	return ttnpb.Clone(r.hook), nil
+}
+
+func (r *mockDeliveryRegistry) Set(
+	_ context.Context,
+	_ *ttnpb.ApplicationWebhookIdentifiers,
+	_ []string,
+	f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error),
+) (*ttnpb.ApplicationWebhook, error) {
+	hook, _, err := f(r.hook)
+	if err != nil {
+		return nil, err
+	}
+	r.hook = hook
+	return hook, nil
+}
+
+type mockDeliverySink struct {
+	reqs []*http.Request
+	err  error
+}
+
+func (s *mockDeliverySink) Process(req *http.Request) error {
+	s.reqs = append(s.reqs, req)
+	return s.err
+}
+
+func TestDeliver(t *testing.T) {
+	t.Parallel()
+
+	ids := &ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		WebhookId:      "foo-hook",
+	}
+	hook := &ttnpb.ApplicationWebhook{
+		Ids:     ids,
+		BaseUrl: "https://example.com",
+		Format:  "json",
+		UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+			Path: "/up",
+		},
+	}
+	newDelivery := func(queuedAt time.Time) *Delivery {
+		return &Delivery{
+			ID:         "01HQ0000000000000000000000",
+			WebhookIDs: ids,
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+					ApplicationIds: ids.ApplicationIds,
+					DeviceId:       "foo-device",
+				},
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{FrmPayload: []byte{0x01}},
+				},
+			},
+			CreatedAt: queuedAt,
+			QueuedAt:  queuedAt,
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		target := &mockDeliverySink{}
+		w := &webhooks{
+			registry: &mockDeliveryRegistry{hook: hook},
+			target:   target,
+		}
+		d := newDelivery(time.Now())
+		retryAt, err := w.deliver(ctx, d)
+		a.So(err, should.BeNil)
+		a.So(retryAt.IsZero(), should.BeTrue)
+		if a.So(target.reqs, should.HaveLength, 1) {
+			a.So(target.reqs[0].URL.String(), should.Equal, "https://example.com/up")
+		}
+		a.So(d.Attempts, should.Equal, 0)
+	})
+
+	t.Run("WebhookNotFound", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		target := &mockDeliverySink{}
+		w := &webhooks{
+			registry: &mockDeliveryRegistry{},
+			target:   target,
+		}
+		retryAt, err := w.deliver(ctx, newDelivery(time.Now()))
+		a.So(err, should.BeNil)
+		a.So(retryAt.IsZero(), should.BeTrue)
+		a.So(target.reqs, should.BeEmpty)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		target := &mockDeliverySink{err: errTestDelivery.New()}
+		w := &webhooks{
+			registry: &mockDeliveryRegistry{hook: hook},
+			target:   target,
+			deliveries: DeliveryQueueConfig{
+				MaxAge: time.Hour,
+			},
+		}
+		d := newDelivery(time.Now())
+		start := time.Now()
+		retryAt, err := w.deliver(ctx, d)
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+		a.So(retryAt, should.HappenAfter, start)
+		a.So(d.Attempts, should.Equal, 1)
+		a.So(d.LastAttemptAt, should.HappenOnOrAfter, start)
+		a.So(d.LastError, should.NotBeEmpty)
+
+		_, err = w.deliver(ctx, d)
+		a.So(err, should.NotBeNil)
+		a.So(d.Attempts, should.Equal, 2)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		target := &mockDeliverySink{err: errTestDelivery.New()}
+		w := &webhooks{
+			registry: &mockDeliveryRegistry{hook: hook},
+			target:   target,
+			deliveries: DeliveryQueueConfig{
+				MaxAge: time.Hour,
+			},
+		}
+		d := newDelivery(time.Now().Add(-2 * time.Hour))
+		retryAt, err := w.deliver(ctx, d)
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+		a.So(retryAt.IsZero(), should.BeTrue)
+		a.So(d.Attempts, should.Equal, 1)
+	})
+}
+
+type mockDeliveryQueue struct {
+	DeliveryQueue
+	added         []*Delivery
+	failWebhookID string
+	deadLetters   []*Delivery
+}
+
+func (q *mockDeliveryQueue) Add(_ context.Context, d *Delivery, _ time.Time) error {
+	if d.WebhookIDs.WebhookId == q.failWebhookID {
+		return errTestDelivery.New()
+	}
+	q.added = append(q.added, d)
+	return nil
+}
+
+func (q *mockDeliveryQueue) ListDeadLetters(
+	_ context.Context, ids *ttnpb.ApplicationWebhookIdentifiers,
+) ([]*Delivery, error) {
+	var res []*Delivery
+	for _, d := range q.deadLetters {
+		if d.WebhookIDs.WebhookId == ids.WebhookId {
+			res = append(res, d)
+		}
+	}
+	return res, nil
+}
+
+func (q *mockDeliveryQueue) ReplayDeadLetters(_ context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) (int, error) {
+	deadLetters, _ := q.ListDeadLetters(context.Background(), ids)
+	q.added = append(q.added, deadLetters...)
+	q.deadLetters = nil
+	return len(deadLetters), nil
+}
+
+func (q *mockDeliveryQueue) Delete(_ context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) error {
+	deadLetters := q.deadLetters[:0]
+	for _, d := range q.deadLetters {
+		if d.WebhookIDs.WebhookId != ids.WebhookId {
+			deadLetters = append(deadLetters, d)
+		}
+	}
+	q.deadLetters = deadLetters
+	return nil
+}
+
+func (*mockDeliveryQueue) WithPagination(ctx context.Context, _, _ uint32, _ *int64) context.Context {
+	return ctx
+}
+
+func TestEnqueue(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	queue := &mockDeliveryQueue{}
+	w := &webhooks{
+		deliveries: DeliveryQueueConfig{Queue: queue},
+	}
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       "foo-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{},
+		},
+	}
+	hooks := []*ttnpb.ApplicationWebhook{
+		{
+			Ids:           &ttnpb.ApplicationWebhookIdentifiers{ApplicationIds: appIDs, WebhookId: "uplink"},
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{},
+		},
+		{
+			Ids:         &ttnpb.ApplicationWebhookIdentifiers{ApplicationIds: appIDs, WebhookId: "join"},
+			JoinAccept:  &ttnpb.ApplicationWebhook_Message{},
+			DownlinkAck: &ttnpb.ApplicationWebhook_Message{},
+		},
+		{
+			Ids:           &ttnpb.ApplicationWebhookIdentifiers{ApplicationIds: appIDs, WebhookId: "other"},
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{},
+		},
+	}
+	err := w.enqueue(ctx, msg, hooks)
+	a.So(err, should.BeNil)
+	if !a.So(queue.added, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(queue.added[0].WebhookIDs.WebhookId, should.Equal, "uplink")
+	a.So(queue.added[1].WebhookIDs.WebhookId, should.Equal, "other")
+	a.So(queue.added[0].ID, should.NotEqual, queue.added[1].ID)
+	a.So(queue.added[0].Up, should.Resemble, msg)
+
+	// A failing webhook does not prevent the deliveries to the other webhooks.
+	queue = &mockDeliveryQueue{failWebhookID: "uplink"}
+	w.deliveries.Queue = queue
+	err = w.enqueue(ctx, msg, hooks)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	if a.So(queue.added, should.HaveLength, 1) {
+		a.So(queue.added[0].WebhookIDs.WebhookId, should.Equal, "other")
+	}
+}
+
+func TestDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	ids := &ttnpb.ApplicationWebhookIdentifiers{ApplicationIds: appIDs, WebhookId: "foo-hook"}
+	now := time.Now()
+	deadLetter := &Delivery{
+		ID:         "01HQ0000000000000000000000",
+		WebhookIDs: ids,
+		Up: &ttnpb.ApplicationUp{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "foo-device"},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{},
+			},
+		},
+		CreatedAt:     now.Add(-time.Hour),
+		QueuedAt:      now.Add(-time.Hour),
+		Attempts:      3,
+		LastAttemptAt: now,
+		LastError:     "test",
+	}
+	withRights := func(ctx context.Context, rs ...ttnpb.Right) context.Context {
+		return rights.NewContext(ctx, &rights.Rights{
+			ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+				unique.ID(ctx, appIDs): ttnpb.RightsFrom(rs...),
+			}),
+		})
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = withRights(ctx, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)
+		srv := NewWebhookRegistryRPC(nil, nil, SigningSecrets{}, nil)
+		_, err := srv.ListDeadLetters(ctx, &ttnpb.ListApplicationWebhookDeadLettersRequest{Ids: ids})
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		_, err = srv.ReplayDeadLetters(ctx, ids)
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	})
+
+	t.Run("Rights", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = withRights(ctx, ttnpb.Right_RIGHT_APPLICATION_INFO)
+		srv := NewWebhookRegistryRPC(nil, nil, SigningSecrets{}, &mockDeliveryQueue{})
+		_, err := srv.ListDeadLetters(ctx, &ttnpb.ListApplicationWebhookDeadLettersRequest{Ids: ids})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		_, err = srv.ReplayDeadLetters(ctx, ids)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("ListAndReplay", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = withRights(ctx, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)
+		queue := &mockDeliveryQueue{deadLetters: []*Delivery{deadLetter}}
+		srv := NewWebhookRegistryRPC(nil, nil, SigningSecrets{}, queue)
+
+		res, err := srv.ListDeadLetters(ctx, &ttnpb.ListApplicationWebhookDeadLettersRequest{Ids: ids})
+		if !a.So(err, should.BeNil) || !a.So(res.DeadLetters, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(res.DeadLetters[0], should.Resemble, &ttnpb.ApplicationWebhookDeadLetter{
+			Id:            deadLetter.ID,
+			EndDeviceIds:  deadLetter.Up.EndDeviceIds,
+			MessageType:   "uplink_message",
+			CreatedAt:     timestamppb.New(deadLetter.CreatedAt),
+			Attempts:      3,
+			LastAttemptAt: timestamppb.New(now),
+			LastError:     "test",
+		})
+
+		replayed, err := srv.ReplayDeadLetters(ctx, ids)
+		a.So(err, should.BeNil)
+		a.So(replayed.GetReplayed(), should.Equal, 1)
+		a.So(queue.added, should.HaveLength, 1)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = withRights(ctx,
+			ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+		)
+		registry := &mockDeliveryRegistry{hook: &ttnpb.ApplicationWebhook{Ids: ids}}
+		queue := &mockDeliveryQueue{deadLetters: []*Delivery{deadLetter}}
+		srv := NewWebhookRegistryRPC(registry, nil, SigningSecrets{}, queue)
+
+		_, err := srv.Delete(ctx, ids)
+		a.So(err, should.BeNil)
+		a.So(registry.hook, should.BeNil)
+		a.So(queue.deadLetters, should.BeEmpty)
+	})
+}
@@ -17,12 +17,16 @@ package web
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func setTotalHeader(ctx context.Context, total uint64) {
@@ -40,15 +44,22 @@ func appendImplicitWebhookGetPaths(paths ...string) []string {
 type webhookRegistryRPC struct {
 	ttnpb.UnimplementedApplicationWebhookRegistryServer
 
-	webhooks  WebhookRegistry
-	templates TemplateStore
+	webhooks   WebhookRegistry
+	templates  TemplateStore
+	secrets    SigningSecrets
+	deliveries DeliveryQueue
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
+// The deliveries may be nil if the delivery queue is disabled.
+func NewWebhookRegistryRPC(
+	webhooks WebhookRegistry, templates TemplateStore, secrets SigningSecrets, deliveries DeliveryQueue,
+) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
-		webhooks:  webhooks,
-		templates: templates,
+		webhooks:   webhooks,
+		templates:  templates,
+		secrets:    secrets,
+		deliveries: deliveries,
 	}
 }
 
@@ -74,7 +85,12 @@ func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWe
 	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Get(ctx, req.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...))
+	webhook, err := s.webhooks.Get(ctx, req.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...))
+	if err != nil {
+		return nil, err
+	}
+	redactSigningSecret(webhook)
+	return webhook, nil
 }
 
 func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplicationWebhooksRequest) (*ttnpb.ApplicationWebhooks, error) {
@@ -85,6 +101,9 @@ func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplication
 	if err != nil {
 		return nil, err
 	}
+	for _, webhook := range webhooks {
+		redactSigningSecret(webhook)
+	}
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, uint64(len(webhooks)))
@@ -103,17 +122,35 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...),
+	hook, paths := req.Webhook, req.FieldMask.GetPaths()
+	// The signing secret is always set as a whole, as the key ID is determined by the Application Server.
+	if ttnpb.HasAnyField(paths, "signing_secret.value") {
+		secret, err := s.secrets.encrypt(ctx, hook.GetSigningSecret().GetValue())
+		if err != nil {
+			return nil, err
+		}
+		hook = ttnpb.Clone(hook)
+		hook.SigningSecret = secret
+		paths = append(ttnpb.ExcludeFields(paths, "signing_secret"), "signing_secret")
+	} else {
+		paths = ttnpb.ExcludeFields(paths, "signing_secret")
+	}
+	webhook, err := s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(paths...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
 			if webhook != nil {
-				return req.Webhook, req.FieldMask.GetPaths(), nil
+				return hook, paths, nil
 			}
-			return req.Webhook, append(req.FieldMask.GetPaths(),
+			return hook, append(paths,
 				"ids.application_ids",
 				"ids.webhook_id",
 			), nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	redactSigningSecret(webhook)
+	return webhook, nil
 }
 
 func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers) (*emptypb.Empty, error) {
@@ -132,5 +169,84 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	if err != nil {
 		return nil, err
 	}
+	if s.deliveries != nil {
+		if err := s.deliveries.Delete(ctx, req); err != nil {
+			return nil, err
+		}
+	}
 	return ttnpb.Empty, nil
 }
+
+var errDeliveryQueueDisabled = errors.DefineFailedPrecondition(
+	"delivery_queue_disabled", "webhook delivery queue is disabled",
+)
+
+func toDeadLetterPB(d *Delivery) *ttnpb.ApplicationWebhookDeadLetter {
+	pb := &ttnpb.ApplicationWebhookDeadLetter{
+		Id:           d.ID,
+		EndDeviceIds: d.Up.GetEndDeviceIds(),
+		MessageType:  strings.TrimPrefix(webhookUplinkMessageMask(d.Up), "up."),
+		CreatedAt:    timestamppb.New(d.CreatedAt),
+		Attempts:     d.Attempts,
+		LastError:    d.LastError,
+	}
+	if !d.LastAttemptAt.IsZero() {
+		pb.LastAttemptAt = timestamppb.New(d.LastAttemptAt)
+	}
+	return pb
+}
+
+func (s webhookRegistryRPC) ListDeadLetters(
+	ctx context.Context, req *ttnpb.ListApplicationWebhookDeadLettersRequest,
+) (_ *ttnpb.ApplicationWebhookDeadLetters, err error) {
+	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errDeliveryQueueDisabled.New()
+	}
+	var total int64
+	ctx = s.deliveries.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, uint64(total))
+		}
+	}()
+	deliveries, err := s.deliveries.ListDeadLetters(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	deadLetters := make([]*ttnpb.ApplicationWebhookDeadLetter, 0, len(deliveries))
+	for _, d := range deliveries {
+		deadLetters = append(deadLetters, toDeadLetterPB(d))
+	}
+	return &ttnpb.ApplicationWebhookDeadLetters{
+		DeadLetters: deadLetters,
+	}, nil
+}
+
+func (s webhookRegistryRPC) ReplayDeadLetters(
+	ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers,
+) (*ttnpb.ReplayApplicationWebhookDeadLettersResponse, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errDeliveryQueueDisabled.New()
+	}
+	n, err := s.deliveries.ReplayDeadLetters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"application_id", req.ApplicationIds.ApplicationId,
+		"webhook_id", req.WebhookId,
+		"count", n,
+	)).Info("Replay dead letters")
+	return &ttnpb.ReplayApplicationWebhookDeadLettersResponse{
+		Replayed: uint32(n),
+	}, nil
+}
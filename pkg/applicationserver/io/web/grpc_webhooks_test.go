@@ -25,6 +25,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -63,7 +65,13 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	if err := webhookReg.Init(ctx); !a.So(err, should.BeNil) {
 		t.FailNow()
 	}
-	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
+	keyService := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+	}))
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, web.SigningSecrets{
+		KeyService:      keyService,
+		EncryptionKeyID: "test",
+	}, nil)
 	c.RegisterGRPC(&mockRegisterer{ctx, srv})
 	componenttest.StartComponent(t, c)
 	defer c.Close()
@@ -137,6 +145,61 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(res.BaseUrl, should.Equal, "http://localhost/test")
 	}
 
+	// Set signing secret; assert the value is encrypted at rest and never returned.
+	{
+		ids := &ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIds: registeredApplicationID,
+			WebhookId:      registeredWebhookID,
+		}
+		res, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			Webhook: &ttnpb.ApplicationWebhook{
+				Ids: ids,
+				SigningSecret: &ttnpb.Secret{
+					KeyId: "other",
+					Value: []byte("secret"),
+				},
+			},
+			FieldMask: ttnpb.FieldMask("signing_secret.value"),
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(res.SigningSecret, should.Resemble, &ttnpb.Secret{KeyId: "test"})
+		}
+
+		stored, err := webhookReg.Get(ctx, ids, []string{"signing_secret"})
+		if a.So(err, should.BeNil) && a.So(stored.SigningSecret, should.NotBeNil) {
+			a.So(stored.SigningSecret.KeyId, should.Equal, "test")
+			a.So(stored.SigningSecret.Value, should.NotResemble, []byte("secret"))
+			value, err := keyService.Decrypt(ctx, stored.SigningSecret.Value, "test")
+			a.So(err, should.BeNil)
+			a.So(value, should.Resemble, []byte("secret"))
+		}
+
+		got, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+			Ids:       ids,
+			FieldMask: ttnpb.FieldMask("signing_secret"),
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(got.SigningSecret, should.Resemble, &ttnpb.Secret{KeyId: "test"})
+		}
+
+		list, err := client.List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+			ApplicationIds: registeredApplicationID,
+			FieldMask:      ttnpb.FieldMask("signing_secret"),
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(list.Webhooks, should.HaveLength, 1) {
+			a.So(list.Webhooks[0].SigningSecret, should.Resemble, &ttnpb.Secret{KeyId: "test"})
+		}
+
+		// Remove the signing secret.
+		res, err = client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			Webhook:   &ttnpb.ApplicationWebhook{Ids: ids},
+			FieldMask: ttnpb.FieldMask("signing_secret"),
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(res.SigningSecret, should.BeNil)
+		}
+	}
+
 	// Delete.
 	{
 		_, err := client.Delete(ctx, &ttnpb.ApplicationWebhookIdentifiers{
@@ -234,7 +297,7 @@ description: Bar`),
 			store, err := config.NewTemplateStore(ctx, c)
 			a.So(err, should.BeNil)
 
-			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store)})
+			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store, web.SigningSecrets{}, nil)})
 			componenttest.StartComponent(t, c)
 			defer c.Close()
 
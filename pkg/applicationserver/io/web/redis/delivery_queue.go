@@ -0,0 +1,301 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errInvalidDeliveryKey = errors.DefineCorruption("invalid_delivery_key", "invalid delivery key `{key}`")
+
+const (
+	deliveryTasksKey = "tasks"
+	pendingKey       = "pending"
+	deadLettersKey   = "dead-letters"
+
+	keySeparator = ":"
+)
+
+// deliveryRecord is the stored representation of a web.Delivery.
+type deliveryRecord struct {
+	ID            string    `json:"id"`
+	WebhookIDs    string    `json:"webhook_ids"`
+	Up            string    `json:"up"`
+	CreatedAt     time.Time `json:"created_at"`
+	QueuedAt      time.Time `json:"queued_at"`
+	Attempts      uint32    `json:"attempts,omitempty"`
+	LastAttemptAt time.Time `json:"last_attempt_at,omitempty"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+func marshalDelivery(d *web.Delivery) (string, error) {
+	ids, err := ttnredis.MarshalProto(d.WebhookIDs)
+	if err != nil {
+		return "", err
+	}
+	up, err := ttnredis.MarshalProto(d.Up)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(deliveryRecord{
+		ID:            d.ID,
+		WebhookIDs:    ids,
+		Up:            up,
+		CreatedAt:     d.CreatedAt,
+		QueuedAt:      d.QueuedAt,
+		Attempts:      d.Attempts,
+		LastAttemptAt: d.LastAttemptAt,
+		LastError:     d.LastError,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unmarshalDelivery(s string) (*web.Delivery, error) {
+	var record deliveryRecord
+	if err := json.Unmarshal([]byte(s), &record); err != nil {
+		return nil, err
+	}
+	d := &web.Delivery{
+		ID:            record.ID,
+		WebhookIDs:    &ttnpb.ApplicationWebhookIdentifiers{},
+		Up:            &ttnpb.ApplicationUp{},
+		CreatedAt:     record.CreatedAt,
+		QueuedAt:      record.QueuedAt,
+		Attempts:      record.Attempts,
+		LastAttemptAt: record.LastAttemptAt,
+		LastError:     record.LastError,
+	}
+	if err := ttnredis.UnmarshalProto(record.WebhookIDs, d.WebhookIDs); err != nil {
+		return nil, err
+	}
+	if err := ttnredis.UnmarshalProto(record.Up, d.Up); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// DeliveryQueue is an implementation of web.DeliveryQueue.
+// The pending deliveries and the dead letters are stored per webhook.
+type DeliveryQueue struct {
+	redis          *ttnredis.Client
+	queue          *ttnredis.TaskQueue
+	maxDeadLetters int64
+}
+
+// NewDeliveryQueue returns a new delivery queue.
+// maxDeadLetters is the maximum number of dead letters retained per webhook. If zero, the number is unlimited.
+func NewDeliveryQueue(
+	cl *ttnredis.Client, maxLen int64, group string, streamBlockLimit time.Duration, maxDeadLetters int64,
+) *DeliveryQueue {
+	return &DeliveryQueue{
+		redis: cl,
+		queue: &ttnredis.TaskQueue{
+			Redis:            cl,
+			MaxLen:           maxLen,
+			Group:            group,
+			Key:              cl.Key(deliveryTasksKey),
+			StreamBlockLimit: streamBlockLimit,
+		},
+		maxDeadLetters: maxDeadLetters,
+	}
+}
+
+// Init initializes the DeliveryQueue.
+func (q *DeliveryQueue) Init(ctx context.Context) error {
+	return q.queue.Init(ctx)
+}
+
+// Close closes the DeliveryQueue.
+func (q *DeliveryQueue) Close(ctx context.Context) error {
+	return q.queue.Close(ctx)
+}
+
+func (q *DeliveryQueue) pendingKey(appUID, webhookID string) string {
+	return q.redis.Key(pendingKey, appUID, webhookID)
+}
+
+func (q *DeliveryQueue) deadLettersKey(appUID, webhookID string) string {
+	return q.redis.Key(deadLettersKey, appUID, webhookID)
+}
+
+func (q *DeliveryQueue) add(ctx context.Context, p redis.Pipeliner, d *web.Delivery, startAt time.Time) error {
+	s, err := marshalDelivery(d)
+	if err != nil {
+		return err
+	}
+	appUID := unique.ID(ctx, d.WebhookIDs.ApplicationIds)
+	p.HSet(ctx, q.pendingKey(appUID, d.WebhookIDs.WebhookId), d.ID, s)
+	return q.queue.Add(ctx, p, ttnredis.Key(appUID, d.WebhookIDs.WebhookId, d.ID), startAt, false)
+}
+
+// Add implements web.DeliveryQueue.
+func (q *DeliveryQueue) Add(ctx context.Context, d *web.Delivery, startAt time.Time) error {
+	_, err := q.redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		return q.add(ctx, p, d, startAt)
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// Dispatch implements web.DeliveryQueue.
+func (q *DeliveryQueue) Dispatch(ctx context.Context, consumerID string) error {
+	return q.queue.Dispatch(ctx, consumerID, nil)
+}
+
+// Pop implements web.DeliveryQueue.
+func (q *DeliveryQueue) Pop(
+	ctx context.Context, consumerID string, f func(context.Context, *web.Delivery) (time.Time, error),
+) error {
+	return q.queue.Pop(ctx, consumerID, nil, func(p redis.Pipeliner, key string, _ time.Time) error {
+		parts := strings.Split(key, keySeparator)
+		if len(parts) != 3 {
+			return errInvalidDeliveryKey.WithAttributes("key", key)
+		}
+		appUID, webhookID, id := parts[0], parts[1], parts[2]
+		ctx, err := unique.WithContext(ctx, appUID)
+		if err != nil {
+			return err
+		}
+		pendingKey := q.pendingKey(appUID, webhookID)
+		s, err := q.redis.HGet(ctx, pendingKey, id).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				// The delivery has been removed from the queue.
+				return nil
+			}
+			return ttnredis.ConvertError(err)
+		}
+		d, err := unmarshalDelivery(s)
+		if err != nil {
+			return err
+		}
+		t, err := f(ctx, d)
+		switch {
+		case err == nil:
+			p.HDel(ctx, pendingKey, id)
+			return nil
+		case !t.IsZero():
+			return q.add(ctx, p, d, t)
+		default:
+			s, err := marshalDelivery(d)
+			if err != nil {
+				return err
+			}
+			deadLettersKey := q.deadLettersKey(appUID, webhookID)
+			p.HDel(ctx, pendingKey, id)
+			p.RPush(ctx, deadLettersKey, s)
+			if q.maxDeadLetters > 0 {
+				p.LTrim(ctx, deadLettersKey, -q.maxDeadLetters, -1)
+			}
+			return nil
+		}
+	})
+}
+
+// ListDeadLetters implements web.DeliveryQueue.
+func (q *DeliveryQueue) ListDeadLetters(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers,
+) ([]*web.Delivery, error) {
+	key := q.deadLettersKey(unique.ID(ctx, ids.ApplicationIds), ids.WebhookId)
+	start, stop := int64(0), int64(-1)
+	if limit, offset := ttnredis.PaginationLimitAndOffsetFromContext(ctx); limit != 0 {
+		start, stop = offset, offset+limit-1
+	}
+	var (
+		lenCmd   *redis.IntCmd
+		rangeCmd *redis.StringSliceCmd
+	)
+	if _, err := q.redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		lenCmd = p.LLen(ctx, key)
+		rangeCmd = p.LRange(ctx, key, start, stop)
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	ttnredis.SetPaginationTotal(ctx, lenCmd.Val())
+	ss := rangeCmd.Val()
+	deliveries := make([]*web.Delivery, 0, len(ss))
+	for _, s := range ss {
+		d, err := unmarshalDelivery(s)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+// ReplayDeadLetters implements web.DeliveryQueue.
+func (q *DeliveryQueue) ReplayDeadLetters(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) (int, error) {
+	key := q.deadLettersKey(unique.ID(ctx, ids.ApplicationIds), ids.WebhookId)
+	var n int
+	err := q.redis.Watch(ctx, func(tx *redis.Tx) error {
+		ss, err := tx.LRange(ctx, key, 0, -1).Result()
+		if err != nil {
+			return err
+		}
+		n = len(ss)
+		if n == 0 {
+			return nil
+		}
+		now := time.Now()
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			for _, s := range ss {
+				d, err := unmarshalDelivery(s)
+				if err != nil {
+					return err
+				}
+				d.QueuedAt = now
+				d.Attempts = 0
+				if err := q.add(ctx, p, d, now); err != nil {
+					return err
+				}
+				// Remove exactly the replayed dead letter, as dead letters may be added or trimmed in the meantime.
+				p.LRem(ctx, key, 1, s)
+			}
+			return nil
+		})
+		return err
+	}, key)
+	if err != nil {
+		return 0, ttnredis.ConvertError(err)
+	}
+	return n, nil
+}
+
+// Delete implements web.DeliveryQueue.
+// The tasks of the pending deliveries remain in the task queue, but are skipped when popped.
+func (q *DeliveryQueue) Delete(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) error {
+	appUID := unique.ID(ctx, ids.ApplicationIds)
+	err := q.redis.Del(ctx, q.pendingKey(appUID, ids.WebhookId), q.deadLettersKey(appUID, ids.WebhookId)).Err()
+	return ttnredis.ConvertError(err)
+}
+
+// WithPagination implements web.DeliveryQueue.
+func (*DeliveryQueue) WithPagination(ctx context.Context, limit, page uint32, total *int64) context.Context {
+	return ttnredis.NewContextWithPagination(ctx, int64(limit), int64(page), total)
+}
@@ -0,0 +1,162 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ web.DeliveryQueue = &DeliveryQueue{}
+
+var errTestDelivery = errors.DefineUnavailable("test_delivery", "test delivery")
+
+func TestDeliveryQueue(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cl, flush := test.NewRedis(ctx, "web_test")
+	defer flush()
+	defer cl.Close()
+
+	q := NewDeliveryQueue(cl, 0, "test", test.Delay, 2)
+	if err := q.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer q.Close(ctx)
+	go q.Dispatch(ctx, "test") //nolint:errcheck
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	hook1IDs := &ttnpb.ApplicationWebhookIdentifiers{ApplicationIds: appIDs, WebhookId: "hook-1"}
+	hook2IDs := &ttnpb.ApplicationWebhookIdentifiers{ApplicationIds: appIDs, WebhookId: "hook-2"}
+	now := time.Now().UTC()
+	newDelivery := func(id string, ids *ttnpb.ApplicationWebhookIdentifiers) *web.Delivery {
+		return &web.Delivery{
+			ID:         id,
+			WebhookIDs: ids,
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIds: &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "foo-device"},
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{FPort: 1},
+				},
+			},
+			CreatedAt: now,
+			QueuedAt:  now,
+		}
+	}
+	pop := func(f func(context.Context, *web.Delivery) (time.Time, error)) {
+		ctx, cancel := context.WithTimeout(ctx, test.Delay<<6)
+		defer cancel()
+		if err := q.Pop(ctx, "test", f); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	fail := func(_ context.Context, d *web.Delivery) (time.Time, error) {
+		d.Attempts++
+		d.LastError = "test"
+		return time.Time{}, errTestDelivery.New()
+	}
+
+	// A failed delivery that is not retried is moved to the dead letters.
+	if err := q.Add(ctx, newDelivery("delivery-1", hook1IDs), now); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	pop(func(ctx context.Context, d *web.Delivery) (time.Time, error) {
+		a.So(d, should.Resemble, newDelivery("delivery-1", hook1IDs))
+		return fail(ctx, d)
+	})
+	deadLetters, err := q.ListDeadLetters(ctx, hook1IDs)
+	if a.So(err, should.BeNil) && a.So(deadLetters, should.HaveLength, 1) {
+		a.So(deadLetters[0].ID, should.Equal, "delivery-1")
+		a.So(deadLetters[0].Attempts, should.Equal, 1)
+		a.So(deadLetters[0].LastError, should.Equal, "test")
+	}
+	deadLetters, err = q.ListDeadLetters(ctx, hook2IDs)
+	a.So(err, should.BeNil)
+	a.So(deadLetters, should.BeEmpty)
+
+	// A retried delivery is attempted again.
+	if err := q.Add(ctx, newDelivery("delivery-2", hook1IDs), now); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	pop(func(_ context.Context, d *web.Delivery) (time.Time, error) {
+		a.So(d.ID, should.Equal, "delivery-2")
+		d.Attempts++
+		return time.Now(), errTestDelivery.New()
+	})
+	pop(func(_ context.Context, d *web.Delivery) (time.Time, error) {
+		a.So(d.ID, should.Equal, "delivery-2")
+		a.So(d.Attempts, should.Equal, 1)
+		return time.Time{}, nil
+	})
+
+	// Replayed dead letters are queued again with reset attempts.
+	n, err := q.ReplayDeadLetters(ctx, hook1IDs)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 1)
+	deadLetters, err = q.ListDeadLetters(ctx, hook1IDs)
+	a.So(err, should.BeNil)
+	a.So(deadLetters, should.BeEmpty)
+	pop(func(_ context.Context, d *web.Delivery) (time.Time, error) {
+		a.So(d.ID, should.Equal, "delivery-1")
+		a.So(d.Attempts, should.Equal, 0)
+		return time.Time{}, nil
+	})
+
+	// The number of dead letters per webhook is limited.
+	for _, id := range []string{"delivery-3", "delivery-4", "delivery-5"} {
+		if err := q.Add(ctx, newDelivery(id, hook1IDs), now); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		pop(fail)
+	}
+	deadLetters, err = q.ListDeadLetters(ctx, hook1IDs)
+	if a.So(err, should.BeNil) && a.So(deadLetters, should.HaveLength, 2) {
+		a.So(deadLetters[0].ID, should.Equal, "delivery-4")
+		a.So(deadLetters[1].ID, should.Equal, "delivery-5")
+	}
+
+	// Deleting the webhook removes its dead letters and pending deliveries.
+	if err := q.Add(ctx, newDelivery("delivery-6", hook1IDs), now); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if err := q.Delete(ctx, hook1IDs); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	deadLetters, err = q.ListDeadLetters(ctx, hook1IDs)
+	a.So(err, should.BeNil)
+	a.So(deadLetters, should.BeEmpty)
+	if err := q.Add(ctx, newDelivery("delivery-7", hook2IDs), now.Add(test.Delay)); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var popped []string
+	for len(popped) == 0 {
+		pop(func(_ context.Context, d *web.Delivery) (time.Time, error) {
+			popped = append(popped, d.ID)
+			return time.Time{}, nil
+		})
+	}
+	a.So(popped, should.Resemble, []string{"delivery-7"})
+}
@@ -20,8 +20,10 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/jtacoma/uritemplates"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/signature"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -124,9 +126,14 @@ func expandVariables(u string, up *ttnpb.ApplicationUp) (*url.URL, error) {
 }
 
 // NewRequest returns an HTTP request.
+// If signingSecret is not empty, the request is signed using the secret.
 // This method returns nil, nil if the hook is not configured for the message.
 func NewRequest(
-	ctx context.Context, downlinks DownlinksConfig, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook,
+	ctx context.Context,
+	downlinks DownlinksConfig,
+	msg *ttnpb.ApplicationUp,
+	hook *ttnpb.ApplicationWebhook,
+	signingSecret []byte,
 ) (*http.Request, error) {
 	cfg := webhookMessage(msg, hook)
 	if cfg == nil {
@@ -193,5 +200,8 @@ func NewRequest(
 		req.Header.Set(domainHeader, domain)
 	}
 	req.Header.Set("Content-Type", format.ContentType)
+	if len(signingSecret) > 0 {
+		req.Header.Set(signature.Header, signature.Sign(signingSecret, time.Now(), buf))
+	}
 	return req, nil
 }
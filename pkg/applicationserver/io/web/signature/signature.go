@@ -0,0 +1,198 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signature implements the signing and verification of webhook requests.
+//
+// The signature header has the format `t=<timestamp>,v1=<signature>`, where the timestamp is the Unix time in seconds
+// at which the request was signed, and the signature is the hex encoded HMAC-SHA256 of `<timestamp>.<body>` using the
+// signing secret of the webhook as key.
+//
+// Receivers should use a Verifier in order to verify the signature, reject stale requests and reject replays.
+package signature
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	// Header is the HTTP header that contains the signature of the request.
+	Header = "X-Tts-Signature"
+
+	// DefaultTolerance is the default maximum age of a signature.
+	DefaultTolerance = 5 * time.Minute
+
+	timestampKey = "t"
+	signatureKey = "v1"
+)
+
+var (
+	errMissingSignature  = errors.DefineInvalidArgument("missing_signature", "missing signature")
+	errSignatureFormat   = errors.DefineInvalidArgument("signature_format", "invalid signature format")
+	errSignatureMismatch = errors.DefinePermissionDenied("signature_mismatch", "signature mismatch")
+	errSignatureExpired  = errors.DefinePermissionDenied(
+		"signature_expired", "signature timestamp `{timestamp}` outside of tolerance",
+	)
+	errSignatureReplayed = errors.DefinePermissionDenied("signature_replayed", "signature replayed")
+	errReadBody          = errors.DefineInvalidArgument("read_body", "read body")
+)
+
+func compute(secret []byte, timestamp int64, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10))) //nolint:errcheck
+	mac.Write([]byte{'.'})                              //nolint:errcheck
+	mac.Write(body)                                     //nolint:errcheck
+	return mac.Sum(nil)
+}
+
+// Sign returns the signature header value of the given body, signed at the given time.
+func Sign(secret []byte, t time.Time, body []byte) string {
+	timestamp := t.Unix()
+	return timestampKey + "=" + strconv.FormatInt(timestamp, 10) + "," +
+		signatureKey + "=" + hex.EncodeToString(compute(secret, timestamp, body))
+}
+
+// Parse parses the given signature header value.
+// The signatures are all the signatures of the header, of which at least one must match.
+func Parse(header string) (timestamp int64, signatures [][]byte, err error) {
+	if header == "" {
+		return 0, nil, errMissingSignature.New()
+	}
+	var hasTimestamp bool
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return 0, nil, errSignatureFormat.New()
+		}
+		switch key {
+		case timestampKey:
+			if timestamp, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return 0, nil, errSignatureFormat.WithCause(err)
+			}
+			hasTimestamp = true
+		case signatureKey:
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				return 0, nil, errSignatureFormat.WithCause(err)
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+	if !hasTimestamp || len(signatures) == 0 {
+		return 0, nil, errSignatureFormat.New()
+	}
+	return timestamp, signatures, nil
+}
+
+// Option configures the Verifier.
+type Option func(*Verifier)
+
+// WithTolerance configures the maximum age of a signature.
+func WithTolerance(d time.Duration) Option {
+	return func(v *Verifier) {
+		v.tolerance = d
+	}
+}
+
+// WithClock configures the clock used to verify the timestamp of the signature.
+func WithClock(now func() time.Time) Option {
+	return func(v *Verifier) {
+		v.now = now
+	}
+}
+
+// Verifier verifies signed webhook requests.
+// Signatures are accepted only once within the tolerance, in order to reject replayed requests.
+type Verifier struct {
+	secret    []byte
+	tolerance time.Duration
+	now       func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]int64
+}
+
+// NewVerifier returns a new Verifier for the given signing secret.
+func NewVerifier(secret []byte, opts ...Option) *Verifier {
+	v := &Verifier{
+		secret:    secret,
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+		seen:      make(map[string]int64),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Verify verifies the given signature header value of the given body.
+func (v *Verifier) Verify(header string, body []byte) error {
+	timestamp, signatures, err := Parse(header)
+	if err != nil {
+		return err
+	}
+	now := v.now()
+	if t := time.Unix(timestamp, 0); t.Before(now.Add(-v.tolerance)) || t.After(now.Add(v.tolerance)) {
+		return errSignatureExpired.WithAttributes("timestamp", timestamp)
+	}
+	expected := compute(v.secret, timestamp, body)
+	var match []byte
+	for _, signature := range signatures {
+		if hmac.Equal(signature, expected) {
+			match = signature
+			break
+		}
+	}
+	if match == nil {
+		return errSignatureMismatch.New()
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	expired := now.Add(-v.tolerance).Unix()
+	for k, t := range v.seen {
+		if t < expired {
+			delete(v.seen, k)
+		}
+	}
+	key := string(match)
+	if _, ok := v.seen[key]; ok {
+		return errSignatureReplayed.New()
+	}
+	v.seen[key] = timestamp
+	return nil
+}
+
+// VerifyRequest verifies the signature of the given request.
+// The body of the request is read and replaced, such that it can be read again by the caller.
+func (v *Verifier) VerifyRequest(r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errReadBody.WithCause(err)
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return v.Verify(r.Header.Get(Header), body)
+}
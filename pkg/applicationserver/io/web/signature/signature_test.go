@@ -0,0 +1,146 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/signature"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	body := []byte(`{"foo":"bar"}`)
+	now := time.Unix(1700000000, 0)
+	clock := func() time.Time { return now }
+
+	for _, tc := range []struct {
+		Name      string
+		Header    string
+		Body      []byte
+		Assertion func(error) bool
+	}{
+		{
+			Name:      "Valid",
+			Header:    signature.Sign(secret, now, body),
+			Body:      body,
+			Assertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name:      "ValidWithinTolerance",
+			Header:    signature.Sign(secret, now.Add(-4*time.Minute), body),
+			Body:      body,
+			Assertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name:      "Missing",
+			Body:      body,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "InvalidFormat",
+			Header:    "foo",
+			Body:      body,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "NoSignature",
+			Header:    "t=1700000000",
+			Body:      body,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "Expired",
+			Header:    signature.Sign(secret, now.Add(-6*time.Minute), body),
+			Body:      body,
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "Future",
+			Header:    signature.Sign(secret, now.Add(6*time.Minute), body),
+			Body:      body,
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "OtherSecret",
+			Header:    signature.Sign([]byte("other"), now, body),
+			Body:      body,
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "OtherBody",
+			Header:    signature.Sign(secret, now, body),
+			Body:      []byte(`{"foo":"baz"}`),
+			Assertion: errors.IsPermissionDenied,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			v := signature.NewVerifier(secret, signature.WithClock(clock))
+			err := v.Verify(tc.Header, tc.Body)
+			if !a.So(tc.Assertion(err), should.BeTrue) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyReplay(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	secret := []byte("secret")
+	body := []byte(`{"foo":"bar"}`)
+	now := time.Unix(1700000000, 0)
+	v := signature.NewVerifier(secret,
+		signature.WithTolerance(time.Minute),
+		signature.WithClock(func() time.Time { return now }),
+	)
+
+	header := signature.Sign(secret, now, body)
+	a.So(v.Verify(header, body), should.BeNil)
+	a.So(errors.IsPermissionDenied(v.Verify(header, body)), should.BeTrue)
+
+	// Another request in the same second has a different signature.
+	other := []byte(`{"foo":"baz"}`)
+	a.So(v.Verify(signature.Sign(secret, now, other), other), should.BeNil)
+}
+
+func TestVerifyRequest(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	secret := []byte("secret")
+	body := []byte(`{"foo":"bar"}`)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(signature.Header, signature.Sign(secret, time.Now(), body))
+
+	a.So(signature.NewVerifier(secret).VerifyRequest(req), should.BeNil)
+	read, err := io.ReadAll(req.Body)
+	a.So(err, should.BeNil)
+	a.So(read, should.Resemble, body)
+}
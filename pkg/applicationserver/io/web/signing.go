@@ -0,0 +1,84 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errDecryptSigningSecret = errors.DefineCorruption("decrypt_signing_secret", "decrypt signing secret")
+
+// SigningSecrets encrypts and decrypts the signing secrets of webhooks.
+// If EncryptionKeyID is empty, the signing secrets are stored in plaintext.
+type SigningSecrets struct {
+	KeyService      crypto.KeyService
+	EncryptionKeyID string
+}
+
+// encrypt returns the signing secret to store for the given plaintext value.
+// If the value is empty, the signing secret is removed.
+func (s SigningSecrets) encrypt(ctx context.Context, value []byte) (*ttnpb.Secret, error) {
+	if len(value) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+	if s.EncryptionKeyID == "" || s.KeyService == nil {
+		log.FromContext(ctx).Debug("No encryption key defined, store webhook signing secret directly in plaintext")
+		return &ttnpb.Secret{Value: value}, nil
+	}
+	encrypted, err := s.KeyService.Encrypt(ctx, value, s.EncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.Secret{
+		KeyId: s.EncryptionKeyID,
+		Value: encrypted,
+	}, nil
+}
+
+// decrypt returns the plaintext value of the given stored signing secret.
+// The stored signing secret is not modified.
+func (s SigningSecrets) decrypt(ctx context.Context, secret *ttnpb.Secret) ([]byte, error) {
+	if len(secret.GetValue()) == 0 {
+		return nil, nil
+	}
+	if secret.KeyId == "" {
+		// The signing secret is stored in plaintext.
+		return secret.Value, nil
+	}
+	if s.KeyService == nil {
+		return nil, errDecryptSigningSecret.New()
+	}
+	value, err := s.KeyService.Decrypt(ctx, secret.Value, secret.KeyId)
+	if err != nil {
+		return nil, errDecryptSigningSecret.WithCause(err)
+	}
+	return value, nil
+}
+
+// redactSigningSecret removes the value of the signing secret of the webhook, if any.
+// Only the ID of the key used to encrypt the signing secret is retained.
+func redactSigningSecret(hook *ttnpb.ApplicationWebhook) {
+	if hook.GetSigningSecret() == nil {
+		return
+	}
+	hook.SigningSecret = &ttnpb.Secret{
+		KeyId: hook.SigningSecret.KeyId,
+	}
+}
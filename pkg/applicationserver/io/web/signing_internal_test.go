@@ -0,0 +1,140 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/signature"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSigningSecrets(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	keyService := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+	}))
+	secrets := SigningSecrets{
+		KeyService:      keyService,
+		EncryptionKeyID: "test",
+	}
+
+	encrypted, err := secrets.encrypt(ctx, []byte("secret"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(encrypted.KeyId, should.Equal, "test")
+	a.So(encrypted.Value, should.NotResemble, []byte("secret"))
+
+	stored := ttnpb.Clone(encrypted)
+	value, err := secrets.decrypt(ctx, stored)
+	a.So(err, should.BeNil)
+	a.So(value, should.Resemble, []byte("secret"))
+	a.So(stored, should.Resemble, encrypted)
+
+	// Plaintext secrets are returned as is.
+	plaintext, err := SigningSecrets{}.encrypt(ctx, []byte("secret"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(plaintext, should.Resemble, &ttnpb.Secret{Value: []byte("secret")})
+	value, err = SigningSecrets{}.decrypt(ctx, plaintext)
+	a.So(err, should.BeNil)
+	a.So(value, should.Resemble, []byte("secret"))
+
+	// Empty secrets remove the signing secret.
+	empty, err := secrets.encrypt(ctx, nil)
+	a.So(err, should.BeNil)
+	a.So(empty, should.BeNil)
+	value, err = secrets.decrypt(ctx, nil)
+	a.So(err, should.BeNil)
+	a.So(value, should.BeNil)
+
+	// Secrets encrypted with an unknown key cannot be decrypted.
+	_, err = secrets.decrypt(ctx, &ttnpb.Secret{
+		KeyId: "unknown",
+		Value: []byte{0x00, 0x11, 0x22, 0x33},
+	})
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+
+	// Only the key ID is retained when redacting.
+	hook := &ttnpb.ApplicationWebhook{SigningSecret: encrypted}
+	redactSigningSecret(hook)
+	a.So(hook.SigningSecret, should.Resemble, &ttnpb.Secret{KeyId: "test"})
+	a.So(encrypted.Value, should.NotBeEmpty)
+}
+
+func TestNewRequestSignature(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+			DeviceId:       "foo-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{FrmPayload: []byte{0x01}},
+		},
+	}
+	hook := &ttnpb.ApplicationWebhook{
+		Ids: &ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+			WebhookId:      "foo-hook",
+		},
+		BaseUrl: "https://example.com",
+		Format:  "json",
+		Headers: map[string]string{
+			"Authorization": "Bearer token",
+		},
+		UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+			Path: "/up",
+		},
+	}
+
+	req, err := NewRequest(ctx, DownlinksConfig{}, msg, hook, []byte("secret"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(req.Header.Get("Authorization"), should.Equal, "Bearer token")
+	a.So(req.Header.Get(signature.Header), should.NotBeEmpty)
+	for _, values := range req.Header {
+		for _, value := range values {
+			a.So(strings.Contains(value, "secret"), should.BeFalse)
+		}
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(signature.NewVerifier([]byte("secret")).Verify(req.Header.Get(signature.Header), body), should.BeNil)
+
+	// Requests of webhooks without a signing secret are not signed.
+	req, err = NewRequest(ctx, DownlinksConfig{}, msg, hook, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(req.Header.Get(signature.Header), should.BeEmpty)
+}
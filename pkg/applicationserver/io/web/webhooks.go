@@ -52,6 +52,7 @@ var webhookFanOutFieldMask = []string{
 	"join_accept",
 	"location_solved",
 	"service_data",
+	"signing_secret",
 	"uplink_message",
 	"uplink_normalized",
 }
@@ -64,11 +65,13 @@ type Webhooks interface {
 }
 
 type webhooks struct {
-	ctx       context.Context
-	server    io.Server
-	registry  WebhookRegistry
-	target    sink.Sink
-	downlinks DownlinksConfig
+	ctx        context.Context
+	server     io.Server
+	registry   WebhookRegistry
+	target     sink.Sink
+	downlinks  DownlinksConfig
+	secrets    SigningSecrets
+	deliveries DeliveryQueueConfig
 }
 
 // NewWebhooks returns a new Webhooks.
@@ -78,14 +81,26 @@ func NewWebhooks(
 	registry WebhookRegistry,
 	target sink.Sink,
 	downlinks DownlinksConfig,
+	secrets SigningSecrets,
+	deliveries DeliveryQueueConfig,
 ) (Webhooks, error) {
 	ctx = log.NewContextWithField(ctx, "namespace", namespace)
 	w := &webhooks{
-		ctx:       ctx,
-		server:    server,
-		registry:  registry,
-		target:    target,
-		downlinks: downlinks,
+		ctx:        ctx,
+		server:     server,
+		registry:   registry,
+		target:     target,
+		downlinks:  downlinks,
+		secrets:    secrets,
+		deliveries: deliveries,
+	}
+	if deliveries.Queue != nil {
+		if deliveries.MaxAge <= 0 {
+			return nil, errDeliveryMaxAge.New()
+		}
+		if err := w.startDeliveryTasks(ctx); err != nil {
+			return nil, err
+		}
 	}
 	sub, err := server.Subscribe(ctx, "webhooks", nil, false)
 	if err != nil {
@@ -126,6 +141,9 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	if err != nil {
 		return err
 	}
+	if w.deliveries.Queue != nil {
+		return w.enqueue(ctx, msg, hooks)
+	}
 	wg := sync.WaitGroup{}
 	for i := range hooks {
 		hook := hooks[i]
@@ -136,7 +154,12 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 		})
 		ctx = log.NewContextWithField(ctx, "hook", hook.Ids.WebhookId)
 		f := func(ctx context.Context) error {
-			req, err := NewRequest(ctx, w.downlinks, msg, hook)
+			signingSecret, err := w.secrets.decrypt(ctx, hook.SigningSecret)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decrypt signing secret")
+				return err
+			}
+			req, err := NewRequest(ctx, w.downlinks, msg, hook, signingSecret)
 			if err != nil {
 				log.FromContext(ctx).WithError(err).Warn("Failed to create request")
 				return err
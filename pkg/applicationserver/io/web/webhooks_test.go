@@ -211,7 +211,7 @@ func TestWebhooks(t *testing.T) {
 						componenttest.StartComponent(t, c)
 						defer c.Close()
 						as := mock.NewServer(c)
-						_, err := web.NewWebhooks(ctx, as, registry, sink, downlinks)
+						_, err := web.NewWebhooks(ctx, as, registry, sink, downlinks, web.SigningSecrets{}, web.DeliveryQueueConfig{})
 						if err != nil {
 							t.Fatalf("Unexpected error %v", err)
 						}
@@ -516,7 +516,7 @@ func TestWebhooks(t *testing.T) {
 		c := componenttest.NewComponent(t, conf)
 		io := mock.NewServer(c)
 		testSink := mocksink.New(nil)
-		w, err := web.NewWebhooks(ctx, io, registry, testSink, downlinks)
+		w, err := web.NewWebhooks(ctx, io, registry, testSink, downlinks, web.SigningSecrets{}, web.DeliveryQueueConfig{})
 		if err != nil {
 			t.Fatalf("Unexpected error %v", err)
 		}
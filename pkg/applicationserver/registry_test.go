@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/bolt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -267,6 +268,23 @@ func TestDeviceRegistry(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "Bolt",
+			New: func(ctx context.Context) (DeviceRegistry, func() error, error) {
+				cl, closeFn := test.NewBolt(ctx, namespace[:]...)
+				registry := &bolt.DeviceRegistry{
+					Bolt: cl,
+				}
+				if err := registry.Init(ctx); err != nil {
+					return nil, nil, err
+				}
+				return registry, func() error {
+					closeFn()
+					return nil
+				}, nil
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			test.RunSubtest(t, test.SubtestConfig{
@@ -399,6 +417,23 @@ func TestLinkRegistry(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "Bolt",
+			New: func(ctx context.Context) (LinkRegistry, func() error, error) {
+				cl, closeFn := test.NewBolt(ctx, namespace[:]...)
+				registry := &bolt.LinkRegistry{
+					Bolt: cl,
+				}
+				if err := registry.Init(ctx); err != nil {
+					return nil, nil, err
+				}
+				return registry, func() error {
+					closeFn()
+					return nil
+				}, nil
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			test.RunSubtest(t, test.SubtestConfig{
@@ -0,0 +1,120 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements time-based one-time passwords as specified in RFC 6238.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // TOTP uses HMAC-SHA1 for compatibility with authenticator apps.
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// Digits is the number of digits of a code.
+	Digits = 6
+	// Period is the period in which a code is valid.
+	Period = 30 * time.Second
+	// SecretLength is the length of generated secrets in bytes.
+	SecretLength = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret generates a random secret.
+func GenerateSecret() ([]byte, error) {
+	secret := make([]byte, SecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncodeSecret encodes the secret in the base32 format that is used by authenticator apps.
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// URI returns the otpauth URI that is used to add the secret to authenticator apps, typically encoded in a QR code.
+func URI(issuer, account string, secret []byte) string {
+	label := url.PathEscape(account)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+	query := url.Values{
+		"secret":    []string{EncodeSecret(secret)},
+		"algorithm": []string{"SHA1"},
+		"digits":    []string{fmt.Sprint(Digits)},
+		"period":    []string{fmt.Sprint(int(Period.Seconds()))},
+	}
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Opaque:   "//totp/" + label,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// Step returns the time step of the given time.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+func code(secret []byte, step int64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// Code returns the code of the secret at the given time step.
+func Code(secret []byte, step int64) string {
+	return code(secret, step, Digits)
+}
+
+// Validate validates the code at the given time, allowing the given number of time steps of clock skew.
+// Codes of time steps up to and including the last used step are rejected, so that a code can only be used once.
+// Validate returns the time step of the code if it is valid.
+func Validate(secret []byte, passcode string, t time.Time, skew int, lastUsedStep int64) (int64, bool) {
+	if len(passcode) != Digits {
+		return 0, false
+	}
+	current := Step(t)
+	for i := -skew; i <= skew; i++ {
+		step := current + int64(i)
+		if step <= lastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(Code(secret, step)), []byte(passcode)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,48 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+	// Test vectors of RFC 6238, Appendix B, for HMAC-SHA1.
+	secret := []byte("12345678901234567890")
+	for _, tc := range []struct {
+		Time time.Time
+		Code string
+	}{
+		{Time: time.Unix(59, 0), Code: "94287082"},
+		{Time: time.Unix(1111111109, 0), Code: "07081804"},
+		{Time: time.Unix(1111111111, 0), Code: "14050471"},
+		{Time: time.Unix(1234567890, 0), Code: "89005924"},
+		{Time: time.Unix(2000000000, 0), Code: "69279037"},
+		{Time: time.Unix(20000000000, 0), Code: "65353130"},
+	} {
+		tc := tc
+		t.Run(tc.Time.UTC().Format(time.RFC3339), func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			a.So(code(secret, Step(tc.Time), 8), should.Equal, tc.Code)
+			a.So(Code(secret, Step(tc.Time)), should.Equal, tc.Code[2:])
+		})
+	}
+}
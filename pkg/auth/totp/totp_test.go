@@ -0,0 +1,82 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	secret, err := totp.GenerateSecret()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	now := time.Unix(1700000000, 0)
+	step := totp.Step(now)
+
+	for _, tc := range []struct {
+		Name         string
+		Code         string
+		LastUsedStep int64
+		Step         int64
+		OK           bool
+	}{
+		{Name: "Current", Code: totp.Code(secret, step), Step: step, OK: true},
+		{Name: "Previous", Code: totp.Code(secret, step-1), Step: step - 1, OK: true},
+		{Name: "Next", Code: totp.Code(secret, step+1), Step: step + 1, OK: true},
+		{Name: "TooOld", Code: totp.Code(secret, step-2)},
+		{Name: "TooNew", Code: totp.Code(secret, step+2)},
+		{Name: "Replay", Code: totp.Code(secret, step), LastUsedStep: step},
+		{Name: "Invalid", Code: "abcdef"},
+		{Name: "Short", Code: totp.Code(secret, step)[:5]},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			validStep, ok := totp.Validate(secret, tc.Code, now, 1, tc.LastUsedStep)
+			a.So(ok, should.Equal, tc.OK)
+			a.So(validStep, should.Equal, tc.Step)
+		})
+	}
+}
+
+func TestURI(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	secret := []byte("12345678901234567890")
+	uri, err := url.Parse(totp.URI("The Things Stack", "user@example.com", secret))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(uri.Scheme, should.Equal, "otpauth")
+	a.So(uri.Host, should.Equal, "totp")
+	a.So(uri.Path, should.Equal, "/The Things Stack:user@example.com")
+	query := uri.Query()
+	a.So(query.Get("secret"), should.Equal, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	a.So(query.Get("issuer"), should.Equal, "The Things Stack")
+	a.So(query.Get("digits"), should.Equal, "6")
+	a.So(query.Get("period"), should.Equal, "30")
+}
@@ -0,0 +1,75 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides CUPS registries backed by an embedded bbolt database.
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups"
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// FirmwareUpdateRegistry implements the cups.FirmwareUpdateRegistry interface.
+type FirmwareUpdateRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the FirmwareUpdateRegistry.
+func (r *FirmwareUpdateRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (r *FirmwareUpdateRegistry) key(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+// Get returns the status of the last firmware update that was sent to the gateway,
+// or nil if no update was sent to the gateway.
+func (r *FirmwareUpdateRegistry) Get(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*cups.FirmwareUpdateStatus, error) {
+	b, err := r.Bolt.Get(r.key(unique.ID(ctx, ids)))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil //nolint:nilnil
+		}
+		return nil, err
+	}
+	status := &cups.FirmwareUpdateStatus{}
+	if err := json.Unmarshal(b, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Set sets the status of the last firmware update that was sent to the gateway.
+func (r *FirmwareUpdateRegistry) Set(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, status *cups.FirmwareUpdateStatus,
+) error {
+	b, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	uk := r.key(unique.ID(ctx, ids))
+	return r.Bolt.Watch(ctx, func(tx *ttnbolt.Tx) error {
+		tx.Set(uk, b, 0)
+		return nil
+	})
+}
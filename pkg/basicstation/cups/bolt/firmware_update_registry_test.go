@@ -0,0 +1,69 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups"
+	. "go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ cups.FirmwareUpdateRegistry = &FirmwareUpdateRegistry{}
+
+func TestFirmwareUpdateRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, closeFn := test.NewBolt(ctx, "gcs", "cups", "firmware")
+	defer closeFn()
+
+	registry := &FirmwareUpdateRegistry{
+		Bolt: cl,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids1 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	ids2 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}
+
+	status, err := registry.Get(ctx, ids1)
+	a.So(err, should.BeNil)
+	a.So(status, should.BeNil)
+
+	pending := &cups.FirmwareUpdateStatus{
+		Package: "2.0.1",
+		Status:  cups.FirmwareUpdateStatusPending,
+	}
+	a.So(registry.Set(ctx, ids1, pending), should.BeNil)
+	status, err = registry.Get(ctx, ids1)
+	a.So(err, should.BeNil)
+	a.So(status, should.Resemble, pending)
+
+	installed := &cups.FirmwareUpdateStatus{
+		Package: "2.0.1",
+		Status:  cups.FirmwareUpdateStatusInstalled,
+	}
+	a.So(registry.Set(ctx, ids1, installed), should.BeNil)
+	status, err = registry.Get(ctx, ids1)
+	a.So(err, should.BeNil)
+	a.So(status, should.Resemble, installed)
+
+	status, err = registry.Get(ctx, ids2)
+	a.So(err, should.BeNil)
+	a.So(status, should.BeNil)
+}
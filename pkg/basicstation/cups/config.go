@@ -17,8 +17,13 @@ package cups
 
 import (
 	"context"
+	"crypto"
+	"fmt"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -35,11 +40,54 @@ type ServerConfig struct {
 	Default struct {
 		LNSURI string `name:"lns-uri" description:"The default LNS URI that the gateways should use"`
 	} `name:"default" description:"Default gateway settings"`
-	AllowCUPSURIUpdate bool `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	AllowCUPSURIUpdate bool           `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	Firmware           FirmwareConfig `name:"firmware" description:"Firmware updates for gateways with automatic updates enabled"` //nolint:lll
+}
+
+// FirmwareConfig is the configuration of the firmware updates.
+// The firmware images and the FirmwareManifest are stored in the blob bucket.
+type FirmwareConfig struct {
+	Blob        config.BlobPathConfig `name:"blob"`
+	Manifest    string                `name:"manifest" description:"Path of the firmware manifest, relative to the blob path"`                     //nolint:lll
+	ManifestTTL time.Duration         `name:"manifest-ttl" description:"Time to cache the firmware manifest"`                                      //nolint:lll
+	SigningKeys []string              `name:"signing-keys" description:"Labels of the certificates in the key vault to sign firmware images with"` //nolint:lll
+
+	Registry FirmwareUpdateRegistry `name:"-"`
+}
+
+// options returns the firmware options of the CUPS server.
+func (conf FirmwareConfig) options(ctx context.Context, c *component.Component) ([]Option, error) {
+	if conf.Registry == nil {
+		return nil, errNoFirmwareUpdateRegistry.New()
+	}
+	bucket, err := c.GetBaseConfig(ctx).Blob.Bucket(ctx, conf.Blob.Bucket, c)
+	if err != nil {
+		return nil, err
+	}
+	opts := []Option{
+		WithFirmware(fetch.FromBucket(ctx, bucket, conf.Blob.Path), conf.Manifest, conf.ManifestTTL),
+		WithFirmwareUpdateRegistry(conf.Registry),
+	}
+	for _, label := range conf.SigningKeys {
+		cert, err := c.KeyService().ServerCertificate(ctx, label)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := cert.PrivateKey.(crypto.Signer)
+		if !ok {
+			return nil, errUnsupportedPrivateKey.WithAttributes("type", fmt.Sprintf("%T", cert.PrivateKey))
+		}
+		keyCRC, err := SignatureKeyCRC(signer.Public())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithSigner(keyCRC, signer))
+	}
+	return opts, nil
 }
 
 // NewServer returns a new CUPS server from this config on top of the component.
-func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) *Server {
+func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) (*Server, error) {
 	opts := []Option{
 		WithAllowCUPSURIUpdate(conf.AllowCUPSURIUpdate),
 		WithDefaultLNSURI(conf.Default.LNSURI),
@@ -71,7 +119,14 @@ func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option)
 	if tlsConfig, err := c.GetTLSClientConfig(c.Context()); err == nil {
 		opts = append(opts, WithTLSConfig(tlsConfig))
 	}
+	if conf.Firmware.Blob.Bucket != "" {
+		firmwareOpts, err := conf.Firmware.options(c.Context(), c)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, firmwareOpts...)
+	}
 	s := NewServer(c, append(opts, customOpts...)...)
 	c.RegisterWeb(s)
-	return s
+	return s, nil
 }
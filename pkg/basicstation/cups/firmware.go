@@ -0,0 +1,211 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"slices"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Statuses of a FirmwareUpdateStatus.
+const (
+	FirmwareUpdateStatusPending   = "pending"
+	FirmwareUpdateStatusInstalled = "installed"
+	FirmwareUpdateStatusFailed    = "failed"
+)
+
+// FirmwareUpdateStatus is the status of the last firmware update that was sent to a gateway.
+type FirmwareUpdateStatus struct {
+	// Package is the package version of the update.
+	Package string `json:"package"`
+	// Status is the status of the update.
+	Status string `json:"status"`
+}
+
+// FirmwareUpdateRegistry stores the status of the firmware updates that were sent to gateways.
+// The status is kept out of the gateway registry, as gateway owners can change the gateway.
+type FirmwareUpdateRegistry interface {
+	// Get returns the status of the last firmware update that was sent to the gateway,
+	// or nil if no update was sent to the gateway.
+	Get(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*FirmwareUpdateStatus, error)
+	// Set sets the status of the last firmware update that was sent to the gateway.
+	Set(ctx context.Context, ids *ttnpb.GatewayIdentifiers, status *FirmwareUpdateStatus) error
+}
+
+var (
+	errFirmwareManifest = errors.DefineCorruption(
+		"firmware_manifest", "invalid firmware manifest",
+	)
+	errUnsupportedSignatureKey = errors.DefineInvalidArgument(
+		"unsupported_signature_key", "the signature key type `{type}` is not supported",
+	)
+	errNoFirmwareUpdateRegistry = errors.DefineFailedPrecondition(
+		"no_firmware_update_registry", "no firmware update registry configured",
+	)
+	errFirmwareUpdateFile = errors.DefineUnavailable(
+		"firmware_update_file", "read file of firmware update `{package}`",
+	)
+)
+
+// FirmwareManifest is the manifest of the firmware updates.
+type FirmwareManifest struct {
+	// Updates are the firmware updates. The first update that applies to a gateway is sent to the gateway.
+	Updates []*FirmwareUpdate `json:"updates"`
+}
+
+// FirmwareUpdate is a firmware update in the FirmwareManifest.
+type FirmwareUpdate struct {
+	// Package is the package version that the station reports after installing the update.
+	Package string `json:"package"`
+	// File is the path of the update data, relative to the blob path.
+	File string `json:"file"`
+	// Models are the station models that the update applies to. If empty, the update applies to all models.
+	Models []string `json:"models,omitempty"`
+	// Packages are the package versions that the update applies to. If empty, the update applies to all packages.
+	Packages []string `json:"packages,omitempty"`
+	// UpdateChannel is the update channel of the gateways that the update applies to.
+	// If empty, the update applies to all update channels.
+	UpdateChannel string `json:"update_channel,omitempty"`
+	// Attributes are the attributes that the gateways must have for the update to apply.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// RolloutPercentage is the percentage of gateways that the update applies to which receive the update.
+	// The gateways are selected deterministically, so increasing the percentage extends the rollout.
+	RolloutPercentage uint32 `json:"rollout_percentage"`
+}
+
+// appliesTo returns whether the update applies to the gateway with the given update info request.
+func (u *FirmwareUpdate) appliesTo(gtw *ttnpb.Gateway, req UpdateInfoRequest) bool {
+	if req.Package == u.Package {
+		return false
+	}
+	if len(u.Models) > 0 && !slices.Contains(u.Models, req.Model) {
+		return false
+	}
+	if len(u.Packages) > 0 && !slices.Contains(u.Packages, req.Package) {
+		return false
+	}
+	if u.UpdateChannel != "" && u.UpdateChannel != gtw.UpdateChannel {
+		return false
+	}
+	for k, v := range u.Attributes {
+		if gtw.Attributes[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// inRollout returns whether the gateway with the given EUI is in the staged rollout of the update.
+func (u *FirmwareUpdate) inRollout(eui types.EUI64) bool {
+	h := crc32.NewIEEE()
+	h.Write(eui[:])
+	h.Write([]byte(u.Package))
+	return h.Sum32()%100 < u.RolloutPercentage
+}
+
+// firmwareManifest returns the firmware manifest. The manifest is cached for the configured TTL.
+func (s *Server) firmwareManifest() (*FirmwareManifest, error) {
+	s.firmwareManifestMu.Lock()
+	defer s.firmwareManifestMu.Unlock()
+	if s.firmwareManifestCache != nil && time.Now().Before(s.firmwareManifestExpiry) {
+		return s.firmwareManifestCache, nil
+	}
+	b, err := s.firmware.File(s.firmwareManifestPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &FirmwareManifest{}
+	if err := json.Unmarshal(b, manifest); err != nil {
+		return nil, errFirmwareManifest.WithCause(err)
+	}
+	s.firmwareManifestCache, s.firmwareManifestExpiry = manifest, time.Now().Add(s.firmwareManifestTTL)
+	return manifest, nil
+}
+
+// firmwareUpdate returns the firmware update for the gateway, or nil if there is no update.
+// Updates that failed to install on the gateway are not sent again.
+func (s *Server) firmwareUpdate(
+	gtw *ttnpb.Gateway, status *FirmwareUpdateStatus, req UpdateInfoRequest,
+) (*FirmwareUpdate, error) {
+	manifest, err := s.firmwareManifest()
+	if err != nil {
+		return nil, err
+	}
+	for _, update := range manifest.Updates {
+		if !update.appliesTo(gtw, req) || !update.inRollout(req.Router.EUI64) {
+			continue
+		}
+		if status != nil && status.Package == update.Package && status.Status == FirmwareUpdateStatusFailed {
+			continue
+		}
+		return update, nil
+	}
+	return nil, nil //nolint:nilnil
+}
+
+func firmwareEventData(pkg string, req UpdateInfoRequest) *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"package":         structpb.NewStringValue(pkg),
+			"station":         structpb.NewStringValue(req.Station),
+			"model":           structpb.NewStringValue(req.Model),
+			"station_package": structpb.NewStringValue(req.Package),
+		},
+	}
+}
+
+// updateFirmwareStatus updates the status of the firmware update that was last sent to the gateway, based on the
+// package that the station reports. It returns the event of the status change, or nil if the status is unchanged.
+func updateFirmwareStatus(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, status *FirmwareUpdateStatus, req UpdateInfoRequest,
+) events.Event {
+	if status == nil || status.Status != FirmwareUpdateStatusPending {
+		return nil
+	}
+	if req.Package == status.Package {
+		status.Status = FirmwareUpdateStatusInstalled
+		return evtFirmwareUpdateInstalled.NewWithIdentifiersAndData(ctx, ids, firmwareEventData(status.Package, req))
+	}
+	status.Status = FirmwareUpdateStatusFailed
+	return evtFirmwareUpdateFailed.NewWithIdentifiersAndData(ctx, ids, firmwareEventData(status.Package, req))
+}
+
+// SignatureKeyCRC returns the CRC of the public key that stations use to find the key to verify the signature of
+// the update data with. Only ECDSA keys on the P-256 curve are supported.
+func SignatureKeyCRC(pub crypto.PublicKey) (uint32, error) {
+	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok || ecdsaPub.Curve != elliptic.P256() {
+		return 0, errUnsupportedSignatureKey.WithAttributes("type", fmt.Sprintf("%T", pub))
+	}
+	ecdhPub, err := ecdsaPub.ECDH()
+	if err != nil {
+		return 0, err
+	}
+	// Stations store the key as the X and Y coordinates, without the uncompressed point prefix.
+	return crc32.ChecksumIEEE(ecdhPub.Bytes()[1:]), nil
+}
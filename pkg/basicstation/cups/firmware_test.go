@@ -0,0 +1,241 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/semtechws/id6"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFirmwareUpdateAppliesTo(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	update := &FirmwareUpdate{
+		Package:       "2.0.1",
+		Models:        []string{"minihub", "corecell"},
+		Packages:      []string{"2.0.0"},
+		UpdateChannel: "stable",
+		Attributes: map[string]string{
+			"region": "eu",
+		},
+	}
+	gtw := &ttnpb.Gateway{
+		UpdateChannel: "stable",
+		Attributes: map[string]string{
+			"region": "eu",
+			"site":   "amsterdam",
+		},
+	}
+	req := UpdateInfoRequest{
+		Model:   "minihub",
+		Package: "2.0.0",
+	}
+	a.So(update.appliesTo(gtw, req), should.BeTrue)
+
+	for _, tc := range []struct {
+		Name  string
+		Setup func(*ttnpb.Gateway, *UpdateInfoRequest)
+	}{
+		{
+			Name: "Installed",
+			Setup: func(_ *ttnpb.Gateway, req *UpdateInfoRequest) {
+				req.Package = "2.0.1"
+			},
+		},
+		{
+			Name: "Model",
+			Setup: func(_ *ttnpb.Gateway, req *UpdateInfoRequest) {
+				req.Model = "rpi"
+			},
+		},
+		{
+			Name: "Package",
+			Setup: func(_ *ttnpb.Gateway, req *UpdateInfoRequest) {
+				req.Package = "1.9.0"
+			},
+		},
+		{
+			Name: "UpdateChannel",
+			Setup: func(gtw *ttnpb.Gateway, _ *UpdateInfoRequest) {
+				gtw.UpdateChannel = "beta"
+			},
+		},
+		{
+			Name: "Attributes",
+			Setup: func(gtw *ttnpb.Gateway, _ *UpdateInfoRequest) {
+				delete(gtw.Attributes, "region")
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			gtw := &ttnpb.Gateway{
+				UpdateChannel: gtw.UpdateChannel,
+				Attributes: map[string]string{
+					"region": gtw.Attributes["region"],
+				},
+			}
+			req := req
+			tc.Setup(gtw, &req)
+			a.So(update.appliesTo(gtw, req), should.BeFalse)
+		})
+	}
+}
+
+func TestFirmwareUpdateInRollout(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	euis := make([]types.EUI64, 1000)
+	for i := range euis {
+		binary.BigEndian.PutUint64(euis[i][:], uint64(i))
+	}
+	count := func(update *FirmwareUpdate) (n int) {
+		for _, eui := range euis {
+			if update.inRollout(eui) {
+				n++
+			}
+		}
+		return n
+	}
+
+	a.So(count(&FirmwareUpdate{Package: "2.0.1"}), should.Equal, 0)
+	a.So(count(&FirmwareUpdate{Package: "2.0.1", RolloutPercentage: 100}), should.Equal, len(euis))
+	a.So(count(&FirmwareUpdate{Package: "2.0.1", RolloutPercentage: 20}), should.BeBetween, 100, 300)
+
+	// Increasing the percentage keeps the gateways that are already in the rollout.
+	small := &FirmwareUpdate{Package: "2.0.1", RolloutPercentage: 10}
+	large := &FirmwareUpdate{Package: "2.0.1", RolloutPercentage: 50}
+	for _, eui := range euis {
+		if small.inRollout(eui) {
+			a.So(large.inRollout(eui), should.BeTrue)
+		}
+	}
+}
+
+func TestFirmwareUpdateStatus(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	req := UpdateInfoRequest{
+		Router:  id6.EUI{EUI64: types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x19}},
+		Package: "2.0.1",
+	}
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"}
+	a.So(updateFirmwareStatus(ctx, ids, nil, req), should.BeNil)
+
+	status := &FirmwareUpdateStatus{
+		Package: "2.0.1",
+		Status:  FirmwareUpdateStatusPending,
+	}
+	evt := updateFirmwareStatus(ctx, ids, status, req)
+	if a.So(evt, should.NotBeNil) {
+		a.So(evt.Name(), should.Equal, "gcs.cups.firmware.installed")
+	}
+	a.So(status.Status, should.Equal, FirmwareUpdateStatusInstalled)
+
+	// The status only changes once.
+	a.So(updateFirmwareStatus(ctx, ids, status, req), should.BeNil)
+
+	status.Status = FirmwareUpdateStatusPending
+	req.Package = "2.0.0"
+	evt = updateFirmwareStatus(ctx, ids, status, req)
+	if a.So(evt, should.NotBeNil) {
+		a.So(evt.Name(), should.Equal, "gcs.cups.firmware.fail")
+	}
+	a.So(status.Status, should.Equal, FirmwareUpdateStatusFailed)
+}
+
+func TestFirmwareManifestCache(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	files := map[string][]byte{
+		"manifest.json": []byte(`{"updates": [{"package": "2.0.1", "file": "station-2.0.1.bin"}]}`),
+	}
+	s := &Server{}
+	WithFirmware(fetch.NewMemFetcher(files), "manifest.json", time.Hour)(s)
+
+	manifest, err := s.firmwareManifest()
+	if a.So(err, should.BeNil) && a.So(manifest.Updates, should.HaveLength, 1) {
+		a.So(manifest.Updates[0].Package, should.Equal, "2.0.1")
+	}
+
+	// The manifest is cached, so changes are not picked up until the cache expires.
+	files["manifest.json"] = []byte(`{"updates": [{"package": "2.0.2", "file": "station-2.0.2.bin"}]}`)
+	manifest, err = s.firmwareManifest()
+	if a.So(err, should.BeNil) && a.So(manifest.Updates, should.HaveLength, 1) {
+		a.So(manifest.Updates[0].Package, should.Equal, "2.0.1")
+	}
+
+	s.firmwareManifestExpiry = time.Now()
+	manifest, err = s.firmwareManifest()
+	if a.So(err, should.BeNil) && a.So(manifest.Updates, should.HaveLength, 1) {
+		a.So(manifest.Updates[0].Package, should.Equal, "2.0.2")
+	}
+
+	// Invalid manifests are not cached.
+	files["manifest.json"] = []byte(`{`)
+	s.firmwareManifestExpiry = time.Now()
+	_, err = s.firmwareManifest()
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+}
+
+func TestSignatureKeyCRC(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	keyCRC, err := SignatureKeyCRC(p256Key.Public())
+	a.So(err, should.BeNil)
+	raw := make([]byte, 64)
+	p256Key.X.FillBytes(raw[:32])
+	p256Key.Y.FillBytes(raw[32:])
+	a.So(keyCRC, should.Equal, crc32.ChecksumIEEE(raw))
+
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = SignatureKeyCRC(p384Key.Public())
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = SignatureKeyCRC(rsaKey.Public())
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -19,7 +19,33 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	evtSendFirmwareUpdate = events.Define(
+		"gcs.cups.firmware.send", "send firmware update",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithDataType(&structpb.Struct{}),
+	)
+	evtFirmwareUpdateInstalled = events.Define(
+		"gcs.cups.firmware.installed", "firmware update installed",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithDataType(&structpb.Struct{}),
+	)
+	evtFirmwareUpdateFailed = events.Define(
+		"gcs.cups.firmware.fail", "firmware update failed",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithDataType(&structpb.Struct{}),
+	)
+	evtFirmwareUpdateUnavailable = events.Define(
+		"gcs.cups.firmware.unavailable", "firmware update unavailable",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithErrorDataType(),
+	)
 )
 
 type messageMetrics struct {
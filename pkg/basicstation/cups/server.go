@@ -28,6 +28,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/web"
@@ -61,6 +62,15 @@ type Server struct {
 	trustCache   map[string]*x509.Certificate
 
 	signers map[uint32]crypto.Signer
+
+	firmware             fetch.Interface
+	firmwareManifestPath string
+	firmwareManifestTTL  time.Duration
+	firmwareUpdates      FirmwareUpdateRegistry
+
+	firmwareManifestMu     sync.Mutex
+	firmwareManifestCache  *FirmwareManifest
+	firmwareManifestExpiry time.Time
 }
 
 func (s *Server) getServerAuth(ctx context.Context) grpc.CallOption {
@@ -148,6 +158,23 @@ func WithSigner(keyCRC uint32, signer crypto.Signer) Option {
 	}
 }
 
+// WithFirmware configures the CUPS server to serve firmware updates to gateways with automatic updates enabled.
+// The manifest is the path of the FirmwareManifest, and the files in the manifest are relative to the fetcher.
+// The manifest is cached for the given TTL.
+// Firmware updates are only served when the CUPS server is also configured with a FirmwareUpdateRegistry.
+func WithFirmware(fetcher fetch.Interface, manifest string, manifestTTL time.Duration) Option {
+	return func(s *Server) {
+		s.firmware, s.firmwareManifestPath, s.firmwareManifestTTL = fetcher, manifest, manifestTTL
+	}
+}
+
+// WithFirmwareUpdateRegistry configures the CUPS server with the registry of the status of firmware updates.
+func WithFirmwareUpdateRegistry(registry FirmwareUpdateRegistry) Option {
+	return func(s *Server) {
+		s.firmwareUpdates = registry
+	}
+}
+
 // WithRegistries overrides the CUPS server's gateway registries.
 func WithRegistries(registry ttnpb.GatewayRegistryClient, access ttnpb.GatewayAccessClient) Option {
 	return func(s *Server) {
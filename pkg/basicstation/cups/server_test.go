@@ -16,13 +16,19 @@ package cups
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
 	"crypto/tls"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/smarty/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
@@ -30,6 +36,7 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -56,6 +63,30 @@ func TestGetTrust(t *testing.T) {
 	}
 }
 
+type mockFirmwareUpdateRegistry struct {
+	statuses map[string]*FirmwareUpdateStatus
+}
+
+func (r *mockFirmwareUpdateRegistry) Get(
+	_ context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*FirmwareUpdateStatus, error) {
+	status, ok := r.statuses[ids.GetGatewayId()]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+	return &FirmwareUpdateStatus{Package: status.Package, Status: status.Status}, nil
+}
+
+func (r *mockFirmwareUpdateRegistry) Set(
+	_ context.Context, ids *ttnpb.GatewayIdentifiers, status *FirmwareUpdateStatus,
+) error {
+	if r.statuses == nil {
+		r.statuses = make(map[string]*FirmwareUpdateStatus)
+	}
+	r.statuses[ids.GetGatewayId()] = status
+	return nil
+}
+
 type mockGatewayClientData struct {
 	ctx struct {
 		GetIdentifiersForEUI context.Context
@@ -187,6 +218,38 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 
 	var kv config.KeyVault //nolint:gosimple
 
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	firmwareData := []byte("FIRMWARE")
+	firmwareOptions := func(rolloutPercentage int, files ...string) []Option {
+		manifest := fmt.Sprintf(`{
+			"updates": [
+				{
+					"package": "2.0.1",
+					"file": "station-2.0.1.bin",
+					"models": ["minihub"],
+					"packages": ["2.0.0"],
+					"rollout_percentage": %d
+				}
+			]
+		}`, rolloutPercentage)
+		store := map[string][]byte{
+			"manifest.json": []byte(manifest),
+		}
+		if len(files) == 0 {
+			files = []string{"station-2.0.1.bin"}
+		}
+		for _, file := range files {
+			store[file] = firmwareData
+		}
+		return []Option{
+			WithFirmware(fetch.NewMemFetcher(store), "manifest.json", time.Minute),
+			WithSigner(392840017, signingKey),
+		}
+	}
+
 	mockGateway := func(hasLNSSecret, redirectCUPS, updateCUPSCreds bool) *ttnpb.Gateway {
 		secret := &ttnpb.Secret{
 			KeyId: "test-key",
@@ -216,6 +279,19 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 		}
 		return &gtw
 	}
+	mockAutoUpdateGateway := func() *ttnpb.Gateway {
+		gtw := mockGateway(true, false, false)
+		gtw.AutoUpdate = true
+		return gtw
+	}
+	assertNoFirmwareUpdate := func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+		var res UpdateInfoResponse
+		err := res.UnmarshalBinary(rec.Body.Bytes())
+		a.So(err, should.BeNil)
+		a.So(res.SignatureKeyCRC, should.BeZeroValue)
+		a.So(res.Signature, should.BeEmpty)
+		a.So(res.UpdateData, should.BeEmpty)
+	}
 
 	for _, tt := range []struct {
 		Name           string
@@ -226,6 +302,8 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 		AssertError    func(error) bool
 		AssertStore    func(*assertions.Assertion, *mockGatewayClient)
 		AssertResponse func(*assertions.Assertion, *httptest.ResponseRecorder)
+		FirmwareSetup  func(*mockFirmwareUpdateRegistry)
+		AssertFirmware func(*assertions.Assertion, *mockFirmwareUpdateRegistry)
 	}{
 		{
 			Name: "No Auth",
@@ -558,6 +636,137 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				}
 			},
 		},
+		{
+			Name: "Firmware Update",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockAutoUpdateGateway()
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: firmwareOptions(100),
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+				var res UpdateInfoResponse
+				err := res.UnmarshalBinary(rec.Body.Bytes())
+				a.So(err, should.BeNil)
+				a.So(res.SignatureKeyCRC, should.Equal, 392840017)
+				a.So(res.UpdateData, should.Resemble, firmwareData)
+				hash := sha512.Sum512(firmwareData)
+				a.So(ecdsa.VerifyASN1(&signingKey.PublicKey, hash[:], res.Signature), should.BeTrue)
+			},
+			AssertFirmware: func(a *assertions.Assertion, r *mockFirmwareUpdateRegistry) {
+				a.So(r.statuses["test-gateway"], should.Resemble, &FirmwareUpdateStatus{
+					Package: "2.0.1",
+					Status:  FirmwareUpdateStatusPending,
+				})
+			},
+		},
+		{
+			Name: "Firmware Update Not In Rollout",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockAutoUpdateGateway()
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: firmwareOptions(0),
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertFirmware: func(a *assertions.Assertion, r *mockFirmwareUpdateRegistry) {
+				a.So(r.statuses, should.BeEmpty)
+			},
+		},
+		{
+			Name: "Firmware Update File Unavailable",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockAutoUpdateGateway()
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: firmwareOptions(100, "station-2.0.2.bin"),
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertFirmware: func(a *assertions.Assertion, r *mockFirmwareUpdateRegistry) {
+				a.So(r.statuses, should.BeEmpty)
+			},
+		},
+		{
+			Name: "Firmware Update Without Auto Update",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: firmwareOptions(100),
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+		},
+		{
+			Name: "Firmware Update Installed",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockAutoUpdateGateway()
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			FirmwareSetup: func(r *mockFirmwareUpdateRegistry) {
+				r.statuses = map[string]*FirmwareUpdateStatus{
+					"test-gateway": {Package: "2.0.0", Status: FirmwareUpdateStatusPending},
+				}
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertFirmware: func(a *assertions.Assertion, r *mockFirmwareUpdateRegistry) {
+				a.So(r.statuses["test-gateway"], should.Resemble, &FirmwareUpdateStatus{
+					Package: "2.0.0",
+					Status:  FirmwareUpdateStatusInstalled,
+				})
+			},
+		},
+		{
+			Name: "Firmware Update Failed",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockAutoUpdateGateway()
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			FirmwareSetup: func(r *mockFirmwareUpdateRegistry) {
+				r.statuses = map[string]*FirmwareUpdateStatus{
+					"test-gateway": {Package: "2.0.1", Status: FirmwareUpdateStatusPending},
+				}
+			},
+			Options: firmwareOptions(100),
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertFirmware: func(a *assertions.Assertion, r *mockFirmwareUpdateRegistry) {
+				// The failed update is not sent again.
+				a.So(r.statuses["test-gateway"], should.Resemble, &FirmwareUpdateStatus{
+					Package: "2.0.1",
+					Status:  FirmwareUpdateStatusFailed,
+				})
+			},
+		},
 	} {
 		tt := tt
 		t.Run(tt.Name, func(t *testing.T) {
@@ -567,6 +776,10 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 			if tt.StoreSetup != nil {
 				tt.StoreSetup(store)
 			}
+			firmwareUpdates := &mockFirmwareUpdateRegistry{}
+			if tt.FirmwareSetup != nil {
+				tt.FirmwareSetup(firmwareUpdates)
+			}
 
 			s := NewServer(componenttest.NewComponent(t, &component.Config{
 				ServiceBase: config.ServiceBase{
@@ -578,6 +791,7 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				}),
 				WithAuth(mockAuthFunc),
 				WithRegistries(store, store),
+				WithFirmwareUpdateRegistry(firmwareUpdates),
 			}, tt.Options...)...)
 			req := httptest.NewRequest(http.MethodPost, "/update-info", strings.NewReader(updateInfoRequest))
 			ctx := test.Context()
@@ -603,6 +817,9 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 			if tt.AssertStore != nil {
 				tt.AssertStore(a, store)
 			}
+			if tt.AssertFirmware != nil {
+				tt.AssertFirmware(a, firmwareUpdates)
+			}
 		})
 	}
 }
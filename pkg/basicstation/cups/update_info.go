@@ -29,6 +29,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -328,10 +329,38 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 		}
 	}
 
-	if gtw.AutoUpdate {
-		// TODO: Compare the Station, Model, Package, version_ids and update_channel in order to check if any updates are required
-		// (https://github.com/TheThingsNetwork/lorawan-stack/issues/365)
+	var (
+		evs                   []events.Event
+		firmwareUpdates       bool
+		firmwareStatus        *FirmwareUpdateStatus
+		firmwareStatusChanged bool
+	)
+	if s.firmwareUpdates != nil {
+		firmwareStatus, err = s.firmwareUpdates.Get(ctx, gtw.GetIds())
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get firmware update status")
+		} else {
+			firmwareUpdates = true
+			if evt := updateFirmwareStatus(ctx, gtw.GetIds(), firmwareStatus, req); evt != nil {
+				evs = append(evs, evt)
+				firmwareStatusChanged = true
+			}
+		}
+	}
+	if firmwareUpdates && gtw.AutoUpdate && s.firmware != nil {
 		var updateData []byte
+		update, err := s.firmwareUpdate(gtw, firmwareStatus, req)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to find firmware update")
+		} else if update != nil {
+			updateData, err = s.firmware.File(update.File)
+			if err != nil {
+				logger.WithError(err).WithField("file", update.File).Warn("Failed to read firmware update")
+				evs = append(evs, evtFirmwareUpdateUnavailable.NewWithIdentifiersAndData(
+					ctx, gtw.GetIds(), errFirmwareUpdateFile.WithAttributes("package", update.Package),
+				))
+			}
+		}
 		if updateData != nil {
 			var (
 				keyCRC uint32
@@ -343,6 +372,7 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 					break
 				}
 			}
+			logger := logger.WithField("package", update.Package)
 			if signer != nil {
 				hash := sha512.Sum512(updateData)
 				sig, err := signer.Sign(rand.Reader, hash[:], nil)
@@ -352,6 +382,17 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 				res.SignatureKeyCRC = keyCRC
 				res.Signature = sig
 				res.UpdateData = updateData
+				logger.Info("Send firmware update")
+				firmwareStatus = &FirmwareUpdateStatus{
+					Package: update.Package,
+					Status:  FirmwareUpdateStatusPending,
+				}
+				firmwareStatusChanged = true
+				evs = append(evs, evtSendFirmwareUpdate.NewWithIdentifiersAndData(
+					ctx, gtw.GetIds(), firmwareEventData(update.Package, req),
+				))
+			} else {
+				logger.Warn("No signing key trusted by the station for firmware update")
 			}
 		}
 	}
@@ -378,6 +419,12 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 	if err != nil {
 		return err
 	}
+	if firmwareStatusChanged {
+		if err := s.firmwareUpdates.Set(ctx, gtw.GetIds(), firmwareStatus); err != nil {
+			return err
+		}
+	}
+	events.Publish(evs...)
 
 	b, err := res.MarshalBinary()
 	if err != nil {
@@ -0,0 +1,499 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a general embedded key-value store client based on bbolt and utilities.
+// It is meant for single node deployments, where running Redis is not desired.
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// separator is character used to separate the keys.
+	separator = ':'
+
+	// DefaultRangeCount is the number of entries that are read from the database at once when ranging.
+	DefaultRangeCount = 1024
+
+	// expiryLength is the length of the expiration time prefix of the stored values.
+	expiryLength = 8
+
+	// ExpiryInterval is the interval at which the expired values are deleted.
+	ExpiryInterval = 10 * time.Minute
+)
+
+// Config represents the configuration of the embedded database.
+type Config struct {
+	Path    string        `name:"path" description:"Path of the database file"`
+	Timeout time.Duration `name:"timeout" description:"Time to wait for the lock on the database file"`
+}
+
+// Open opens the database file configured in conf, creating it if it does not exist.
+// The database file is locked while it is open, so it cannot be shared between processes.
+func Open(conf Config) (*bbolt.DB, error) {
+	if conf.Path == "" {
+		return nil, errNoPath.New()
+	}
+	if err := os.MkdirAll(filepath.Dir(conf.Path), 0o700); err != nil {
+		return nil, errOpen.WithAttributes("path", conf.Path).WithCause(err)
+	}
+	db, err := bbolt.Open(conf.Path, 0o600, &bbolt.Options{
+		Timeout: conf.Timeout,
+	})
+	if err != nil {
+		return nil, errOpen.WithAttributes("path", conf.Path).WithCause(err)
+	}
+	return db, nil
+}
+
+// Key constructs the full key for entity identified by ks by joining ks using the default separator.
+func Key(ks ...string) string {
+	return strings.Join(ks, string(separator))
+}
+
+// MarshalProto marshals pb.
+func MarshalProto(pb proto.Message) ([]byte, error) {
+	b, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, errEncode.WithCause(err)
+	}
+	return b, nil
+}
+
+// UnmarshalProto unmarshals b returned from MarshalProto into pb.
+func UnmarshalProto(b []byte, pb proto.Message) error {
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return errDecode.WithCause(err)
+	}
+	return nil
+}
+
+// encodeValue prefixes v with the expiration time in Unix nanoseconds, or 0 if the value does not expire.
+func encodeValue(v []byte, ttl time.Duration) []byte {
+	b := make([]byte, expiryLength+len(v))
+	if ttl > 0 {
+		binary.BigEndian.PutUint64(b, uint64(time.Now().Add(ttl).UnixNano()))
+	}
+	copy(b[expiryLength:], v)
+	return b
+}
+
+// decodeValue returns the value in raw and whether it is present and not expired.
+func decodeValue(raw []byte, now time.Time) ([]byte, bool) {
+	if len(raw) < expiryLength {
+		return nil, false
+	}
+	if expiry := binary.BigEndian.Uint64(raw); expiry != 0 && expiry <= uint64(now.UnixNano()) {
+		return nil, false
+	}
+	return raw[expiryLength:], true
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(b)), b...)
+}
+
+// Client represents a namespaced client of the embedded store.
+// The values of the client are stored in a single bucket named after the namespace.
+type Client struct {
+	db     *bbolt.DB
+	bucket []byte
+	locks  keyLocks
+}
+
+// New returns a new client for the namespace in db.
+func New(db *bbolt.DB, namespace ...string) *Client {
+	return &Client{
+		db:     db,
+		bucket: []byte(Key(namespace...)),
+	}
+}
+
+// Init creates the bucket of the client.
+// The expired values of the client are deleted every ExpiryInterval until the context is done.
+func (c *Client) Init(ctx context.Context) error {
+	if err := ConvertError(c.db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(c.bucket)
+		return err
+	})); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(ExpiryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.DeleteExpired(); err != nil {
+					log.FromContext(ctx).WithError(err).WithField("bucket", string(c.bucket)).Warn(
+						"Failed to delete expired values",
+					)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *Client) view(f func(*bbolt.Bucket) error) error {
+	return ConvertError(c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(c.bucket)
+		if b == nil {
+			return bbolt.ErrBucketNotFound
+		}
+		return f(b)
+	}))
+}
+
+func (c *Client) update(f func(*bbolt.Bucket) error) error {
+	return ConvertError(c.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(c.bucket)
+		if b == nil {
+			return bbolt.ErrBucketNotFound
+		}
+		return f(b)
+	}))
+}
+
+// getRaw returns copies of the raw values stored at keys ks, or nil if the key does not exist.
+func (c *Client) getRaw(ks ...string) ([][]byte, error) {
+	vs := make([][]byte, len(ks))
+	if err := c.view(func(b *bbolt.Bucket) error {
+		for i, k := range ks {
+			vs[i] = copyBytes(b.Get([]byte(k)))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return vs, nil
+}
+
+// Get returns the value stored at key k.
+func (c *Client) Get(k string) ([]byte, error) {
+	vs, err := c.MGet(k)
+	if err != nil {
+		return nil, err
+	}
+	if vs[0] == nil {
+		return nil, errNotFound.New()
+	}
+	return vs[0], nil
+}
+
+// GetProto unmarshals the value stored at key k into pb.
+func (c *Client) GetProto(k string, pb proto.Message) error {
+	b, err := c.Get(k)
+	if err != nil {
+		return err
+	}
+	return UnmarshalProto(b, pb)
+}
+
+// MGet returns the values stored at keys ks. The value is nil if the key does not exist or is expired.
+func (c *Client) MGet(ks ...string) ([][]byte, error) {
+	raw, err := c.getRaw(ks...)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	vs := make([][]byte, len(ks))
+	for i, r := range raw {
+		if v, ok := decodeValue(r, now); ok {
+			vs[i] = v
+		}
+	}
+	return vs, nil
+}
+
+// Range calls f for the values with keys that start with prefix, in key order, until f returns false or an error.
+// The values are read in batches of DefaultRangeCount outside of f, so f may modify the store.
+func (c *Client) Range(prefix string, f func(k string, v []byte) (bool, error)) error {
+	type entry struct {
+		key   string
+		value []byte
+	}
+	seek := []byte(prefix)
+	for {
+		batch := make([]entry, 0, DefaultRangeCount)
+		if err := c.view(func(b *bbolt.Bucket) error {
+			now := time.Now()
+			cur := b.Cursor()
+			for k, raw := cur.Seek(seek); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, raw = cur.Next() {
+				if len(batch) == DefaultRangeCount {
+					seek = copyBytes(k)
+					return nil
+				}
+				if v, ok := decodeValue(raw, now); ok {
+					batch = append(batch, entry{key: string(k), value: copyBytes(v)})
+				}
+			}
+			seek = nil
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, e := range batch {
+			ok, err := f(e.key, e.value)
+			if err != nil || !ok {
+				return err
+			}
+		}
+		if seek == nil {
+			return nil
+		}
+	}
+}
+
+// DeleteExpired deletes the expired values.
+// The values are scanned in a read-only transaction, so that writers are only blocked if values expired.
+func (c *Client) DeleteExpired() error {
+	var expired [][]byte
+	if err := c.view(func(b *bbolt.Bucket) error {
+		now := time.Now()
+		return b.ForEach(func(k, raw []byte) error {
+			if _, ok := decodeValue(raw, now); !ok {
+				expired = append(expired, copyBytes(k))
+			}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	return c.update(func(b *bbolt.Bucket) error {
+		now := time.Now()
+		for _, k := range expired {
+			// The value may have been set again since it was scanned.
+			if _, ok := decodeValue(b.Get(k), now); ok {
+				continue
+			}
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Tx is an optimistic transaction, similar to a Redis transaction with WATCH.
+// The values read through the transaction are watched, and the writes are buffered until the transaction commits.
+// The transaction fails if any of the watched values changed before the commit.
+//
+// The reads are not performed in a database transaction, so that the caller does not hold any database locks
+// while it processes the values. This allows callers to use other clients of the same database while the
+// transaction is in progress.
+type Tx struct {
+	c       *Client
+	watched map[string][]byte
+	writes  []txWrite
+}
+
+type txWrite struct {
+	key   []byte
+	value []byte
+}
+
+// MGet returns the values stored at keys ks and watches the keys.
+// The value is nil if the key does not exist or is expired.
+func (tx *Tx) MGet(ks ...string) ([][]byte, error) {
+	missing := make([]string, 0, len(ks))
+	for _, k := range ks {
+		if _, ok := tx.watched[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		raw, err := tx.c.getRaw(missing...)
+		if err != nil {
+			return nil, err
+		}
+		for i, k := range missing {
+			tx.watched[k] = raw[i]
+		}
+	}
+	now := time.Now()
+	vs := make([][]byte, len(ks))
+	for i, k := range ks {
+		if v, ok := decodeValue(tx.watched[k], now); ok {
+			vs[i] = v
+		}
+	}
+	return vs, nil
+}
+
+// Get returns the value stored at key k and watches the key.
+func (tx *Tx) Get(k string) ([]byte, error) {
+	vs, err := tx.MGet(k)
+	if err != nil {
+		return nil, err
+	}
+	if vs[0] == nil {
+		return nil, errNotFound.New()
+	}
+	return vs[0], nil
+}
+
+// GetProto unmarshals the value stored at key k into pb and watches the key.
+func (tx *Tx) GetProto(k string, pb proto.Message) error {
+	b, err := tx.Get(k)
+	if err != nil {
+		return err
+	}
+	return UnmarshalProto(b, pb)
+}
+
+// Set sets the value at key k when the transaction commits. If ttl is 0, the value does not expire.
+func (tx *Tx) Set(k string, v []byte, ttl time.Duration) {
+	tx.writes = append(tx.writes, txWrite{
+		key:   []byte(k),
+		value: encodeValue(v, ttl),
+	})
+}
+
+// SetProto sets the marshaled pb at key k when the transaction commits. If ttl is 0, the value does not expire.
+func (tx *Tx) SetProto(k string, pb proto.Message, ttl time.Duration) error {
+	b, err := MarshalProto(pb)
+	if err != nil {
+		return err
+	}
+	tx.Set(k, b, ttl)
+	return nil
+}
+
+// Del deletes the keys ks when the transaction commits.
+func (tx *Tx) Del(ks ...string) {
+	for _, k := range ks {
+		tx.writes = append(tx.writes, txWrite{
+			key: []byte(k),
+		})
+	}
+}
+
+func (tx *Tx) commit() error {
+	if len(tx.writes) == 0 {
+		return nil
+	}
+	return tx.c.update(func(b *bbolt.Bucket) error {
+		for k, v := range tx.watched {
+			if stored := b.Get([]byte(k)); !bytes.Equal(stored, v) || (stored == nil) != (v == nil) {
+				return errTransactionFailed.New()
+			}
+		}
+		for _, w := range tx.writes {
+			if w.value == nil {
+				if err := b.Delete(w.key); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := b.Put(w.key, w.value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Watch calls f with a new transaction, and commits the transaction if f returns without error.
+// Watch returns an Aborted error if any of the values read in the transaction changed before the commit.
+func (c *Client) Watch(ctx context.Context, f func(*Tx) error) error {
+	tx := &Tx{
+		c:       c,
+		watched: make(map[string][]byte),
+	}
+	if err := f(tx); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return tx.commit()
+}
+
+// LockedWatch is like Watch, but holds the lock of key k while the transaction is in progress.
+// This serializes the transactions on k within the client.
+func (c *Client) LockedWatch(ctx context.Context, k string, f func(*Tx) error) error {
+	unlock, err := c.locks.lock(ctx, k)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return c.Watch(ctx, f)
+}
+
+type keyLock struct {
+	ch   chan struct{}
+	refs int
+}
+
+// keyLocks is a set of mutexes by key.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+func (l *keyLocks) lock(ctx context.Context, k string) (func(), error) {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*keyLock)
+	}
+	kl, ok := l.locks[k]
+	if !ok {
+		kl = &keyLock{
+			ch: make(chan struct{}, 1),
+		}
+		l.locks[k] = kl
+	}
+	kl.refs++
+	l.mu.Unlock()
+
+	release := func() {
+		l.mu.Lock()
+		kl.refs--
+		if kl.refs == 0 {
+			delete(l.locks, k)
+		}
+		l.mu.Unlock()
+	}
+	select {
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	case kl.ch <- struct{}{}:
+	}
+	return func() {
+		<-kl.ch
+		release()
+	}, nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, closeFn := test.NewBolt(ctx, "bolt", "test")
+	defer closeFn()
+
+	_, err := cl.Get("foo")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	err = cl.Watch(ctx, func(tx *Tx) error {
+		tx.Set("foo", []byte("bar"), 0)
+		tx.Set("expires", []byte("later"), time.Hour)
+		tx.Set("expired", []byte("now"), time.Nanosecond)
+		return tx.SetProto("ids", &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}, 0)
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	v, err := cl.Get("foo")
+	a.So(err, should.BeNil)
+	a.So(v, should.Resemble, []byte("bar"))
+	ids := &ttnpb.ApplicationIdentifiers{}
+	a.So(cl.GetProto("ids", ids), should.BeNil)
+	a.So(ids.ApplicationId, should.Equal, "test-app")
+	vs, err := cl.MGet("foo", "missing", "expires", "expired")
+	a.So(err, should.BeNil)
+	a.So(vs, should.Resemble, [][]byte{[]byte("bar"), nil, []byte("later"), nil})
+	_, err = cl.Get("expired")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(cl.DeleteExpired(), should.BeNil)
+	v, err = cl.Get("expires")
+	a.So(err, should.BeNil)
+	a.So(v, should.Resemble, []byte("later"))
+
+	// A transaction fails if a watched value changes before the commit.
+	err = cl.Watch(ctx, func(tx *Tx) error {
+		if _, err := tx.Get("foo"); err != nil {
+			return err
+		}
+		if err := cl.Watch(ctx, func(tx *Tx) error {
+			tx.Set("foo", []byte("baz"), 0)
+			return nil
+		}); err != nil {
+			return err
+		}
+		tx.Set("foo", []byte("qux"), 0)
+		return nil
+	})
+	a.So(errors.IsAborted(err), should.BeTrue)
+	v, err = cl.Get("foo")
+	a.So(err, should.BeNil)
+	a.So(v, should.Resemble, []byte("baz"))
+
+	// A transaction fails if a watched missing value is created before the commit.
+	err = cl.Watch(ctx, func(tx *Tx) error {
+		if _, err := tx.Get("new"); !errors.IsNotFound(err) {
+			return err
+		}
+		if err := cl.Watch(ctx, func(tx *Tx) error {
+			tx.Set("new", []byte("value"), 0)
+			return nil
+		}); err != nil {
+			return err
+		}
+		tx.Set("new", []byte("other"), 0)
+		return nil
+	})
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	// Transactions locked on the same key are serialized.
+	const n = 16
+	errCh := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			errCh <- cl.LockedWatch(ctx, "counter", func(tx *Tx) error {
+				v, err := tx.Get("counter")
+				if err != nil && !errors.IsNotFound(err) {
+					return err
+				}
+				tx.Set("counter", append(v, 'x'), 0)
+				return nil
+			})
+		}()
+	}
+	for i := 0; i < n; i++ {
+		a.So(<-errCh, should.BeNil)
+	}
+	v, err = cl.Get("counter")
+	a.So(err, should.BeNil)
+	a.So(v, should.HaveLength, n)
+
+	// Range iterates over the keys with the prefix, in batches.
+	err = cl.Watch(ctx, func(tx *Tx) error {
+		for i := 0; i < DefaultRangeCount+10; i++ {
+			tx.Set(Key("range", fmt.Sprintf("%05d", i)), []byte{byte(i)}, 0)
+		}
+		tx.Set(Key("rangeother"), []byte("other"), 0)
+		return nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var keys []string
+	err = cl.Range(Key("range", ""), func(k string, _ []byte) (bool, error) {
+		keys = append(keys, k)
+		// Modifying the store while ranging is allowed.
+		return true, cl.Watch(ctx, func(tx *Tx) error {
+			tx.Del(k)
+			return nil
+		})
+	})
+	a.So(err, should.BeNil)
+	if a.So(keys, should.HaveLength, DefaultRangeCount+10) {
+		a.So(keys[0], should.Equal, Key("range", "00000"))
+		a.So(keys[len(keys)-1], should.Equal, Key("range", fmt.Sprintf("%05d", DefaultRangeCount+9)))
+	}
+	_, err = cl.Get(Key("range", "00000"))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	var count int
+	err = cl.Range(Key("range"), func(string, []byte) (bool, error) {
+		count++
+		return false, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(count, should.Equal, 1)
+}
@@ -0,0 +1,40 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errDecode            = errors.Define("decode", "decode value")
+	errEncode            = errors.Define("encode", "encode value")
+	errNoPath            = errors.DefineInvalidArgument("no_path", "no database path configured")
+	errOpen              = errors.DefineUnavailable("open", "open database `{path}`")
+	errNotFound          = errors.DefineNotFound("not_found", "entity not found")
+	errStore             = errors.Define("store", "store error")
+	errTransactionFailed = errors.DefineAborted("transaction_failed", "transaction failed")
+)
+
+// ConvertError converts bbolt error into errors.Error.
+func ConvertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnErr
+	}
+	return errStore.WithCause(err)
+}
@@ -22,10 +22,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	ttnblob "go.thethings.network/lorawan-stack/v3/pkg/blob"
+	"go.thethings.network/lorawan-stack/v3/pkg/bolt"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/pkcs11"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/experimental"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
@@ -130,6 +132,12 @@ type Cache struct {
 	Redis   redis.Config `name:"redis"`
 }
 
+// Registry represents configuration for the storage of the device and related registries.
+type Registry struct {
+	Backend string      `name:"backend" description:"Backend of the registries (redis, bolt)"`
+	Bolt    bolt.Config `name:"bolt"`
+}
+
 // RedisEvents represents configuration for the Redis events backend.
 type RedisEvents struct {
 	redis.Config `name:",squash"`
@@ -148,6 +156,17 @@ type RedisEvents struct {
 	} `name:"publish"`
 }
 
+// PostgresEvents represents configuration for the PostgreSQL events backend.
+type PostgresEvents struct {
+	DatabaseURI string `name:"database-uri" description:"Database connection URI"`
+	Store       struct {
+		Retention          time.Duration `name:"retention" description:"How long events are retained"`
+		PartitionsAhead    int           `name:"partitions-ahead" description:"How many daily partitions are created ahead of time"`            //nolint:lll
+		HistoryCount       int           `name:"history-count" description:"How many events are returned for an entity ID if no tail is given"` //nolint:lll
+		CorrelationIDCount int           `name:"correlation-id-count" description:"How many events are returned for a correlation ID"`          //nolint:lll
+	} `name:"store"`
+}
+
 // BatchEvents represents the configuration for batch event publication.
 type BatchEvents struct {
 	Enable     bool          `name:"enable" description:"Enable events batching (EXPERIMENTAL)"`
@@ -157,10 +176,11 @@ type BatchEvents struct {
 
 // Events represents configuration for the events system.
 type Events struct {
-	Backend string      `name:"backend" description:"Backend to use for events (internal, redis, cloud)"`
-	Redis   RedisEvents `name:"redis"`
-	Cloud   CloudEvents `name:"cloud"`
-	Batch   BatchEvents `name:"batch"`
+	Backend  string         `name:"backend" description:"Backend to use for events (internal, redis, postgres, cloud)"`
+	Redis    RedisEvents    `name:"redis"`
+	Postgres PostgresEvents `name:"postgres"`
+	Cloud    CloudEvents    `name:"cloud"`
+	Batch    BatchEvents    `name:"batch"`
 }
 
 // Rights represents the configuration to apply when fetching entity rights.
@@ -177,16 +197,38 @@ type KeyVaultCache struct {
 	ErrorTTL time.Duration `name:"error-ttl" description:"Cache elements time to live for errors. If 0, the TTL is used"`
 }
 
+// KeyVaultPKCS11 represents the configuration for key vaults backed by a PKCS#11 token.
+type KeyVaultPKCS11 struct {
+	ModulePath string `name:"module-path" description:"Path to the PKCS#11 module (shared library)"`
+	TokenLabel string `name:"token-label" description:"Label of the token. If empty, the first token is used"`
+	PIN        string `name:"pin" description:"User PIN of the token"`
+	Sessions   int    `name:"sessions" description:"Number of sessions opened with the token"`
+}
+
+// KeyVaultDirectory represents the configuration for key vaults backed by a directory.
+type KeyVaultDirectory struct {
+	Path           string        `name:"path" description:"Path to the directory with the keys and certificates"`
+	ResyncInterval time.Duration `name:"resync-interval" description:"Interval to reload the directory regardless of file system notifications. If 0, only notifications are used"` //nolint:lll
+}
+
 // KeyVault represents configuration for key vaults.
 type KeyVault struct {
-	Provider string            `name:"provider" description:"Provider (static)"`
-	Cache    KeyVaultCache     `name:"cache"`
-	Static   map[string][]byte `name:"static"`
+	Provider  string            `name:"provider" description:"Provider (static, directory, pkcs11)"`
+	Cache     KeyVaultCache     `name:"cache"`
+	Static    map[string][]byte `name:"static"`
+	Directory KeyVaultDirectory `name:"directory"`
+	PKCS11    KeyVaultPKCS11    `name:"pkcs11"`
 }
 
 // ComponentKEKLabeler returns an initialized crypto.ComponentKEKLabeler based on the configuration.
 func (v KeyVault) ComponentKEKLabeler() (crypto.ComponentKEKLabeler, error) {
-	switch v.Provider { //nolint:revive
+	switch v.Provider {
+	case "directory":
+		// Labels are file names, which cannot contain colons in Kubernetes secrets.
+		return &cryptoutil.ComponentPrefixKEKLabeler{
+			Separator:     ".",
+			ReplaceOldNew: []string{":", "_"},
+		}, nil
 	default:
 		return &cryptoutil.ComponentPrefixKEKLabeler{
 			Separator:     ":",
@@ -199,8 +241,33 @@ func (v KeyVault) ComponentKEKLabeler() (crypto.ComponentKEKLabeler, error) {
 func (v KeyVault) KeyService(ctx context.Context, httpClientProvider httpclient.Provider) (crypto.KeyService, error) {
 	var kv crypto.KeyVault
 	switch v.Provider {
+	case "pkcs11":
+		// The keys never leave the token, so only the key service operations can be cached.
+		// Certificates are not stored in the token, but can be configured statically.
+		ks, err := pkcs11.New(ctx, pkcs11.Config{
+			ModulePath: v.PKCS11.ModulePath,
+			TokenLabel: v.PKCS11.TokenLabel,
+			PIN:        v.PKCS11.PIN,
+			Sessions:   v.PKCS11.Sessions,
+		}, cryptoutil.NewMemKeyVault(v.Static))
+		if err != nil {
+			return nil, err
+		}
+		if v.Cache.Size > 0 {
+			ks = cryptoutil.NewCacheKeyService(ks, v.Cache.TTL, v.Cache.Size)
+		}
+		return ks, nil
 	case "static":
 		kv = cryptoutil.NewMemKeyVault(v.Static)
+	case "directory":
+		// Changes to the directory are effective after the cache TTL, if caching is enabled.
+		var err error
+		kv, err = cryptoutil.NewDirKeyVault(ctx, v.Directory.Path,
+			cryptoutil.WithDirKeyVaultResyncInterval(v.Directory.ResyncInterval),
+		)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		kv = cryptoutil.EmptyKeyVault
 	}
@@ -505,6 +572,7 @@ type ServiceBase struct {
 	Cluster          cluster.Config       `name:"cluster"`
 	Cache            Cache                `name:"cache"`
 	Redis            redis.Config         `name:"redis"`
+	Registry         Registry             `name:"registry"`
 	Events           Events               `name:"events"`
 	GRPC             GRPC                 `name:"grpc"`
 	HTTP             HTTP                 `name:"http"`
@@ -0,0 +1,247 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+var errInvalidCertificate = errors.DefineCorruption(
+	"invalid_certificate", "invalid certificate with label `{label}`",
+)
+
+// dirKeyVaultReloadDelay is the delay between a change in the directory and the reload.
+// Changes are typically made to multiple files, so the reload is delayed until the changes settle.
+const dirKeyVaultReloadDelay = 100 * time.Millisecond
+
+// dirKeyVaultCertificateExtensions are the file extensions of PEM encoded certificates and private keys.
+var dirKeyVaultCertificateExtensions = map[string]struct{}{
+	".pem": {},
+	".crt": {},
+	".key": {},
+}
+
+type dirKeyVaultSnapshot struct {
+	keys         map[string][]byte
+	certificates map[string]tls.Certificate
+}
+
+type dirKeyVault struct {
+	dir      string
+	snapshot atomic.Pointer[dirKeyVaultSnapshot]
+}
+
+type dirKeyVaultOptions struct {
+	resyncInterval time.Duration
+}
+
+// DirKeyVaultOption configures DirKeyVault.
+type DirKeyVaultOption interface {
+	apply(*dirKeyVaultOptions)
+}
+
+type dirKeyVaultOptionFunc func(*dirKeyVaultOptions)
+
+func (f dirKeyVaultOptionFunc) apply(opts *dirKeyVaultOptions) {
+	f(opts)
+}
+
+// WithDirKeyVaultResyncInterval configures the interval at which the directory is reloaded, regardless of file system
+// notifications. This is useful for file systems that do not support notifications. If 0, the directory is only
+// reloaded on notifications.
+func WithDirKeyVaultResyncInterval(interval time.Duration) DirKeyVaultOption {
+	return dirKeyVaultOptionFunc(func(opts *dirKeyVaultOptions) {
+		opts.resyncInterval = interval
+	})
+}
+
+// NewDirKeyVault returns a crypto.KeyVault that reads keys and certificates from the files in the given directory.
+//
+// Files with a .pem, .crt or .key extension contain PEM encoded certificates and private keys. The label of the
+// certificate is the file name without extension; the certificate and the private key may be in the same file or in
+// separate files with the same name. Other files contain keys, either hex encoded or as raw bytes, where the label is
+// the file name. Hidden files are ignored.
+//
+// The directory is watched for changes, and the keys and certificates are swapped atomically when all files are loaded
+// successfully. This supports Kubernetes secret volumes, which are updated by swapping a symbolic link.
+// The directory is no longer watched when the context is done.
+func NewDirKeyVault(ctx context.Context, dir string, opts ...DirKeyVaultOption) (crypto.KeyVault, error) {
+	options := &dirKeyVaultOptions{}
+	for _, opt := range opts {
+		opt.apply(options)
+	}
+	kv := &dirKeyVault{
+		dir: dir,
+	}
+	snapshot, err := loadDirKeyVault(dir)
+	if err != nil {
+		return nil, err
+	}
+	kv.snapshot.Store(snapshot)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	go kv.watch(ctx, watcher, options.resyncInterval)
+	return kv, nil
+}
+
+func loadDirKeyVault(dir string) (*dirKeyVaultSnapshot, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &dirKeyVaultSnapshot{
+		keys:         make(map[string][]byte),
+		certificates: make(map[string]tls.Certificate),
+	}
+	pemBlocks := make(map[string][]byte)
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		// Follow symbolic links, as used by Kubernetes secret volumes.
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		ext := filepath.Ext(name)
+		if _, ok := dirKeyVaultCertificateExtensions[ext]; ok {
+			label := strings.TrimSuffix(name, ext)
+			pemBlocks[label] = append(append(pemBlocks[label], raw...), '\n')
+			continue
+		}
+		snapshot.keys[name] = parseDirKeyVaultKey(raw)
+	}
+	for label, raw := range pemBlocks {
+		cert, err := parsePEMCertificate(raw)
+		if err != nil {
+			return nil, errInvalidCertificate.WithAttributes("label", label).WithCause(err)
+		}
+		snapshot.certificates[label] = cert
+	}
+	return snapshot, nil
+}
+
+// parseDirKeyVaultKey returns the hex decoded key, or the raw bytes if the key is not hex encoded.
+func parseDirKeyVaultKey(raw []byte) []byte {
+	trimmed := bytes.TrimSpace(raw)
+	key := make([]byte, hex.DecodedLen(len(trimmed)))
+	if _, err := hex.Decode(key, trimmed); err != nil {
+		return raw
+	}
+	return key
+}
+
+func (kv *dirKeyVault) watch(ctx context.Context, watcher *fsnotify.Watcher, resyncInterval time.Duration) {
+	defer watcher.Close()
+	logger := log.FromContext(ctx).WithField("directory", kv.dir)
+	var resync <-chan time.Time
+	if resyncInterval > 0 {
+		ticker := time.NewTicker(resyncInterval)
+		defer ticker.Stop()
+		resync = ticker.C
+	}
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			reload = time.After(dirKeyVaultReloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.WithError(err).Warn("Failed to watch key vault directory")
+		case <-reload:
+			reload = nil
+			kv.reload(logger)
+		case <-resync:
+			kv.reload(logger)
+		}
+	}
+}
+
+func (kv *dirKeyVault) reload(logger log.Interface) {
+	snapshot, err := loadDirKeyVault(kv.dir)
+	if err != nil {
+		// The directory may be partially updated. Keep the current keys until the next change.
+		logger.WithError(err).Warn("Failed to reload key vault directory")
+		return
+	}
+	kv.snapshot.Store(snapshot)
+	logger.WithFields(log.Fields(
+		"keys", len(snapshot.keys),
+		"certificates", len(snapshot.certificates),
+	)).Debug("Reloaded key vault directory")
+}
+
+// Key implements crypto.KeyVault.
+func (kv *dirKeyVault) Key(_ context.Context, label string) ([]byte, error) {
+	key, ok := kv.snapshot.Load().keys[label]
+	if !ok {
+		return nil, errKeyNotFound.WithAttributes("label", label)
+	}
+	return key, nil
+}
+
+func (kv *dirKeyVault) certificate(label string) (tls.Certificate, error) {
+	cert, ok := kv.snapshot.Load().certificates[label]
+	if !ok {
+		return tls.Certificate{}, errCertificateNotFound.WithAttributes("label", label)
+	}
+	return cert, nil
+}
+
+// ServerCertificate implements crypto.KeyVault.
+func (kv *dirKeyVault) ServerCertificate(_ context.Context, label string) (tls.Certificate, error) {
+	return kv.certificate(label)
+}
+
+// ClientCertificate implements crypto.KeyVault.
+func (kv *dirKeyVault) ClientCertificate(_ context.Context, label string) (tls.Certificate, error) {
+	return kv.certificate(label)
+}
@@ -0,0 +1,199 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func generateTestCertificate(t *testing.T, commonName string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeTestFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitForKey waits until the key vault returns the expected key.
+func waitForKey(ctx context.Context, kv crypto.KeyVault, label string, expected []byte) bool {
+	deadline := time.Now().Add(test.Delay << 10)
+	for time.Now().Before(deadline) {
+		if key, err := kv.Key(ctx, label); err == nil && bytes.Equal(key, expected) {
+			return true
+		}
+		time.Sleep(test.Delay)
+	}
+	return false
+}
+
+func TestDirKeyVault(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	dir := t.TempDir()
+	kek1, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	kek2, _ := hex.DecodeString("0F0E0D0C0B0A09080706050403020100")
+	writeTestFile(t, filepath.Join(dir, "kek1"), []byte("000102030405060708090A0B0C0D0E0F\n"))
+	writeTestFile(t, filepath.Join(dir, "kek2"), kek2)
+	writeTestFile(t, filepath.Join(dir, ".hidden"), kek2)
+	certPEM, keyPEM := generateTestCertificate(t, "server")
+	writeTestFile(t, filepath.Join(dir, "server.crt"), certPEM)
+	writeTestFile(t, filepath.Join(dir, "server.key"), keyPEM)
+	certPEM, keyPEM = generateTestCertificate(t, "client")
+	writeTestFile(t, filepath.Join(dir, "client.pem"), append(certPEM, keyPEM...))
+
+	kv, err := cryptoutil.NewDirKeyVault(ctx, dir)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	key, err := kv.Key(ctx, "kek1")
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, kek1)
+	key, err = kv.Key(ctx, "kek2")
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, kek2)
+	_, err = kv.Key(ctx, ".hidden")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = kv.Key(ctx, "server.crt")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	cert, err := kv.ServerCertificate(ctx, "server")
+	if a.So(err, should.BeNil) && a.So(cert.Certificate, should.HaveLength, 1) {
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		a.So(err, should.BeNil)
+		a.So(parsed.Subject.CommonName, should.Equal, "server")
+	}
+	cert, err = kv.ClientCertificate(ctx, "client")
+	if a.So(err, should.BeNil) && a.So(cert.Certificate, should.HaveLength, 1) {
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		a.So(err, should.BeNil)
+		a.So(parsed.Subject.CommonName, should.Equal, "client")
+	}
+	_, err = kv.ServerCertificate(ctx, "kek1")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Keys are used through the key service.
+	ks := crypto.NewKeyService(kv)
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	wrapped, err := ks.Wrap(ctx, plaintext, "kek1")
+	a.So(err, should.BeNil)
+	a.So(hex.EncodeToString(wrapped), should.Equal, "1fa68b0a8112b447aef34bd8fb5a7b829d3e862371d2cfe5")
+
+	// Rotate the key and add a new key.
+	writeTestFile(t, filepath.Join(dir, "kek1"), kek2)
+	writeTestFile(t, filepath.Join(dir, "kek3"), kek1)
+	a.So(waitForKey(ctx, kv, "kek1", kek2), should.BeTrue)
+	a.So(waitForKey(ctx, kv, "kek3", kek1), should.BeTrue)
+
+	// Invalid certificates do not replace the current keys and certificates.
+	writeTestFile(t, filepath.Join(dir, "invalid.pem"), []byte("invalid"))
+	writeTestFile(t, filepath.Join(dir, "kek4"), kek1)
+	time.Sleep(test.Delay << 3)
+	_, err = kv.Key(ctx, "kek4")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Removed keys are no longer available.
+	if err := os.Remove(filepath.Join(dir, "invalid.pem")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "kek3")); err != nil {
+		t.Fatal(err)
+	}
+	a.So(waitForKey(ctx, kv, "kek4", kek1), should.BeTrue)
+	_, err = kv.Key(ctx, "kek3")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestDirKeyVaultSymlinkSwap(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	// Kubernetes secret volumes contain symbolic links to files in a data directory.
+	// Updates are made by atomically replacing the symbolic link to the data directory.
+	dir := t.TempDir()
+	kek1, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	kek2, _ := hex.DecodeString("0F0E0D0C0B0A09080706050403020100")
+	for name, key := range map[string][]byte{"..v1": kek1, "..v2": kek2} {
+		if err := os.Mkdir(filepath.Join(dir, name), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		writeTestFile(t, filepath.Join(dir, name, "kek"), key)
+	}
+	if err := os.Symlink("..v1", filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join("..data", "kek"), filepath.Join(dir, "kek")); err != nil {
+		t.Fatal(err)
+	}
+
+	kv, err := cryptoutil.NewDirKeyVault(ctx, dir)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	key, err := kv.Key(ctx, "kek")
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, kek1)
+
+	if err := os.Symlink("..v2", filepath.Join(dir, "..data_tmp")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	a.So(waitForKey(ctx, kv, "kek", kek2), should.BeTrue)
+}
@@ -42,6 +42,11 @@ func (kv *memKeyVault) certificate(label string) (tls.Certificate, error) {
 	if !ok {
 		return tls.Certificate{}, errCertificateNotFound.WithAttributes("label", label)
 	}
+	return parsePEMCertificate(raw)
+}
+
+// parsePEMCertificate parses the PEM encoded certificate chain and private key.
+func parsePEMCertificate(raw []byte) (tls.Certificate, error) {
 	certPEMBlock, keyPEMBlock := &bytes.Buffer{}, &bytes.Buffer{}
 	for {
 		block, rest := pem.Decode(raw)
@@ -0,0 +1,369 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package pkcs11
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"io"
+	"sync"
+
+	p11 "github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+type keyService struct {
+	module       *p11.Ctx
+	sessions     chan p11.SessionHandle
+	opened       int
+	closed       chan struct{}
+	certificates crypto.KeyVault
+
+	handlesMu sync.RWMutex
+	handles   map[string]p11.ObjectHandle
+}
+
+// New returns a crypto.KeyService that performs the key operations in the PKCS#11 token.
+// Certificates are not stored in the token; they are retrieved from the given key vault, if any.
+// The sessions with the token are closed when the context is done.
+func New(ctx context.Context, conf Config, certificates crypto.KeyVault) (crypto.KeyService, error) {
+	if conf.ModulePath == "" {
+		return nil, errNoModulePath.New()
+	}
+	if conf.Sessions <= 0 {
+		conf.Sessions = DefaultSessions
+	}
+	if certificates == nil {
+		certificates = cryptoutil.EmptyKeyVault
+	}
+	module := p11.New(conf.ModulePath)
+	if module == nil {
+		return nil, errLoadModule.WithAttributes("path", conf.ModulePath)
+	}
+	if err := module.Initialize(); err != nil && !isError(err, p11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		module.Destroy()
+		return nil, operationError("C_Initialize", err)
+	}
+	ks := &keyService{
+		module:       module,
+		sessions:     make(chan p11.SessionHandle, conf.Sessions),
+		closed:       make(chan struct{}),
+		certificates: certificates,
+		handles:      make(map[string]p11.ObjectHandle),
+	}
+	if err := ks.open(conf); err != nil {
+		ks.close()
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		ks.close()
+	}()
+	log.FromContext(ctx).WithFields(log.Fields(
+		"module_path", conf.ModulePath,
+		"token_label", conf.TokenLabel,
+		"sessions", conf.Sessions,
+	)).Info("Opened PKCS#11 token")
+	return ks, nil
+}
+
+func (ks *keyService) slot(label string) (uint, error) {
+	slots, err := ks.module.GetSlotList(true)
+	if err != nil {
+		return 0, operationError("C_GetSlotList", err)
+	}
+	for _, slot := range slots {
+		if label == "" {
+			return slot, nil
+		}
+		info, err := ks.module.GetTokenInfo(slot)
+		if err != nil {
+			return 0, operationError("C_GetTokenInfo", err)
+		}
+		if info.Label == label {
+			return slot, nil
+		}
+	}
+	return 0, errTokenNotFound.WithAttributes("label", label)
+}
+
+func (ks *keyService) open(conf Config) error {
+	slot, err := ks.slot(conf.TokenLabel)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < conf.Sessions; i++ {
+		session, err := ks.module.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+		if err != nil {
+			return operationError("C_OpenSession", err)
+		}
+		ks.sessions <- session
+		ks.opened++
+		// The login state is shared by all sessions of the application with the token.
+		if i > 0 {
+			continue
+		}
+		if err := ks.module.Login(session, p11.CKU_USER, conf.PIN); err != nil &&
+			!isError(err, p11.CKR_USER_ALREADY_LOGGED_IN) {
+			return operationError("C_Login", err)
+		}
+	}
+	return nil
+}
+
+// close closes the sessions with the token and finalizes the module.
+// Operations that are started after close return errClosed. close waits for the sessions that are in use.
+func (ks *keyService) close() {
+	close(ks.closed)
+	for i := 0; i < ks.opened; i++ {
+		ks.module.CloseSession(<-ks.sessions) //nolint:errcheck
+	}
+	ks.module.Finalize() //nolint:errcheck
+	ks.module.Destroy()
+}
+
+func isError(err error, code uint) bool {
+	var p11Err p11.Error
+	return errors.As(err, &p11Err) && uint(p11Err) == code
+}
+
+func operationError(operation string, err error) error {
+	return errOperation.WithAttributes("operation", operation).WithCause(err)
+}
+
+func (ks *keyService) findKey(session p11.SessionHandle, label string) (p11.ObjectHandle, error) {
+	ks.handlesMu.RLock()
+	handle, ok := ks.handles[label]
+	ks.handlesMu.RUnlock()
+	if ok {
+		return handle, nil
+	}
+	if err := ks.module.FindObjectsInit(session, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, operationError("C_FindObjectsInit", err)
+	}
+	handles, _, err := ks.module.FindObjects(session, 1)
+	if finalErr := ks.module.FindObjectsFinal(session); err == nil && finalErr != nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, operationError("C_FindObjects", err)
+	}
+	if len(handles) == 0 {
+		return 0, errKeyNotFound.WithAttributes("label", label)
+	}
+	ks.handlesMu.Lock()
+	ks.handles[label] = handles[0]
+	ks.handlesMu.Unlock()
+	return handles[0], nil
+}
+
+// withKey calls f with a session and the handle of the key with the given label.
+// If the key handle is no longer valid, for example because the key has been replaced in the token, the key is looked
+// up again and f is retried once.
+func (ks *keyService) withKey(
+	ctx context.Context, label string, f func(p11.SessionHandle, p11.ObjectHandle) error,
+) error {
+	var session p11.SessionHandle
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ks.closed:
+		return errClosed.New()
+	case session = <-ks.sessions:
+	}
+	defer func() { ks.sessions <- session }()
+	// The session may be acquired while the key service is closing.
+	select {
+	case <-ks.closed:
+		return errClosed.New()
+	default:
+	}
+
+	for retry := true; ; retry = false {
+		key, err := ks.findKey(session, label)
+		if err != nil {
+			return err
+		}
+		err = f(session, key)
+		if !retry || !(isError(err, p11.CKR_KEY_HANDLE_INVALID) || isError(err, p11.CKR_OBJECT_HANDLE_INVALID)) {
+			return err
+		}
+		ks.handlesMu.Lock()
+		delete(ks.handles, label)
+		ks.handlesMu.Unlock()
+	}
+}
+
+// sessionKeyTemplate is the template of the temporary session objects that hold the keys to (un)wrap.
+func sessionKeyTemplate(attrs ...*p11.Attribute) []*p11.Attribute {
+	return append([]*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_AES),
+		p11.NewAttribute(p11.CKA_TOKEN, false),
+		p11.NewAttribute(p11.CKA_SENSITIVE, false),
+		p11.NewAttribute(p11.CKA_EXTRACTABLE, true),
+	}, attrs...)
+}
+
+func checkKeySize(size int) error {
+	switch size {
+	case 16, 24, 32:
+		return nil
+	default:
+		return errInvalidKeySize.WithAttributes("size", size)
+	}
+}
+
+// Wrap implements crypto.KeyService.
+func (ks *keyService) Wrap(ctx context.Context, plaintext []byte, kekLabel string) ([]byte, error) {
+	if err := checkKeySize(len(plaintext)); err != nil {
+		return nil, err
+	}
+	var res []byte
+	err := ks.withKey(ctx, kekLabel, func(session p11.SessionHandle, kek p11.ObjectHandle) error {
+		key, err := ks.module.CreateObject(session, sessionKeyTemplate(p11.NewAttribute(p11.CKA_VALUE, plaintext)))
+		if err != nil {
+			return operationError("C_CreateObject", err)
+		}
+		defer ks.module.DestroyObject(session, key) //nolint:errcheck
+		res, err = ks.module.WrapKey(session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_AES_KEY_WRAP, nil)}, kek, key)
+		if err != nil {
+			return operationError("C_WrapKey", err)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// Unwrap implements crypto.KeyService.
+func (ks *keyService) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
+	if err := checkKeySize(len(ciphertext) - 8); err != nil {
+		return nil, err
+	}
+	var res []byte
+	err := ks.withKey(ctx, kekLabel, func(session p11.SessionHandle, kek p11.ObjectHandle) error {
+		key, err := ks.module.UnwrapKey(
+			session,
+			[]*p11.Mechanism{p11.NewMechanism(p11.CKM_AES_KEY_WRAP, nil)},
+			kek,
+			ciphertext,
+			sessionKeyTemplate(),
+		)
+		if err != nil {
+			return operationError("C_UnwrapKey", err)
+		}
+		defer ks.module.DestroyObject(session, key) //nolint:errcheck
+		attrs, err := ks.module.GetAttributeValue(session, key, []*p11.Attribute{
+			p11.NewAttribute(p11.CKA_VALUE, nil),
+		})
+		if err != nil {
+			return operationError("C_GetAttributeValue", err)
+		}
+		res = attrs[0].Value
+		return nil
+	})
+	return res, err
+}
+
+// Encrypt implements crypto.KeyService.
+// The ciphertext has the same format as crypto.Encrypt: the nonce followed by the sealed plaintext.
+func (ks *keyService) Encrypt(ctx context.Context, plaintext []byte, label string) ([]byte, error) {
+	nonce := make([]byte, gcmNonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	var res []byte
+	err := ks.withKey(ctx, label, func(session p11.SessionHandle, key p11.ObjectHandle) error {
+		params := p11.NewGCMParams(nonce, nil, 128)
+		defer params.Free()
+		if err := ks.module.EncryptInit(
+			session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_AES_GCM, params)}, key,
+		); err != nil {
+			return operationError("C_EncryptInit", err)
+		}
+		sealed, err := ks.module.Encrypt(session, plaintext)
+		if err != nil {
+			return operationError("C_Encrypt", err)
+		}
+		res = append(nonce, sealed...)
+		return nil
+	})
+	return res, err
+}
+
+// Decrypt implements crypto.KeyService.
+func (ks *keyService) Decrypt(ctx context.Context, ciphertext []byte, label string) ([]byte, error) {
+	if len(ciphertext) < gcmNonceSize {
+		return nil, errMalformedCipherText.New()
+	}
+	var res []byte
+	err := ks.withKey(ctx, label, func(session p11.SessionHandle, key p11.ObjectHandle) error {
+		params := p11.NewGCMParams(ciphertext[:gcmNonceSize], nil, 128)
+		defer params.Free()
+		if err := ks.module.DecryptInit(
+			session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_AES_GCM, params)}, key,
+		); err != nil {
+			return operationError("C_DecryptInit", err)
+		}
+		plaintext, err := ks.module.Decrypt(session, ciphertext[gcmNonceSize:])
+		if err != nil {
+			return operationError("C_Decrypt", err)
+		}
+		res = plaintext
+		return nil
+	})
+	return res, err
+}
+
+// HMACHash implements crypto.KeyService.
+// The key must be a generic secret or HMAC key that permits signing with the CKM_SHA256_HMAC mechanism.
+// Some tokens require HMAC keys to be at least as long as the hash.
+func (ks *keyService) HMACHash(ctx context.Context, payload []byte, label string) ([]byte, error) {
+	var res []byte
+	err := ks.withKey(ctx, label, func(session p11.SessionHandle, key p11.ObjectHandle) error {
+		if err := ks.module.SignInit(
+			session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_SHA256_HMAC, nil)}, key,
+		); err != nil {
+			return operationError("C_SignInit", err)
+		}
+		hash, err := ks.module.Sign(session, payload)
+		if err != nil {
+			return operationError("C_Sign", err)
+		}
+		res = hash
+		return nil
+	})
+	return res, err
+}
+
+// ServerCertificate implements crypto.KeyService.
+func (ks *keyService) ServerCertificate(ctx context.Context, label string) (tls.Certificate, error) {
+	return ks.certificates.ServerCertificate(ctx, label)
+}
+
+// ClientCertificate implements crypto.KeyService.
+func (ks *keyService) ClientCertificate(ctx context.Context, label string) (tls.Certificate, error) {
+	return ks.certificates.ClientCertificate(ctx, label)
+}
@@ -0,0 +1,29 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cgo
+// +build !cgo
+
+package pkcs11
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+)
+
+// New returns an error, as PKCS#11 support requires cgo.
+func New(context.Context, Config, crypto.KeyVault) (crypto.KeyService, error) {
+	return nil, errUnavailable.New()
+}
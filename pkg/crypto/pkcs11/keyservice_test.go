@@ -0,0 +1,193 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package pkcs11_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	p11 "github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/pkcs11"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// newTestConfig returns the configuration of the token used for testing.
+// The tests are run against a token, such as SoftHSM, configured with the TEST_PKCS11_MODULE,
+// TEST_PKCS11_TOKEN_LABEL and TEST_PKCS11_PIN environment variables.
+func newTestConfig(t *testing.T) pkcs11.Config {
+	t.Helper()
+	conf := pkcs11.Config{
+		ModulePath: os.Getenv("TEST_PKCS11_MODULE"),
+		TokenLabel: os.Getenv("TEST_PKCS11_TOKEN_LABEL"),
+		PIN:        os.Getenv("TEST_PKCS11_PIN"),
+		Sessions:   2,
+	}
+	if conf.ModulePath == "" {
+		t.Skip("TEST_PKCS11_MODULE is not set, skipping PKCS#11 tests")
+	}
+	return conf
+}
+
+// createTestKeys creates session objects in the token with the given labels, values and key types.
+// The session objects are visible to all sessions of the process, and are destroyed when the test session closes.
+func createTestKeys(
+	t *testing.T, conf pkcs11.Config, keys map[string][]byte, keyTypes map[string]uint,
+) (cleanup func()) {
+	t.Helper()
+	module := p11.New(conf.ModulePath)
+	if module == nil {
+		t.Fatalf("Failed to load PKCS#11 module %s", conf.ModulePath)
+	}
+	if err := module.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize PKCS#11 module: %v", err)
+	}
+	slots, err := module.GetSlotList(true)
+	if err != nil || len(slots) == 0 {
+		t.Fatalf("Failed to get slots: %v", err)
+	}
+	slot := slots[0]
+	for _, s := range slots {
+		info, err := module.GetTokenInfo(s)
+		if err == nil && info.Label == conf.TokenLabel {
+			slot = s
+		}
+	}
+	session, err := module.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+	if err != nil {
+		t.Fatalf("Failed to open session: %v", err)
+	}
+	if err := module.Login(session, p11.CKU_USER, conf.PIN); err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+	for label, value := range keys {
+		if _, err := module.CreateObject(session, []*p11.Attribute{
+			p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+			p11.NewAttribute(p11.CKA_KEY_TYPE, keyTypes[label]),
+			p11.NewAttribute(p11.CKA_TOKEN, false),
+			p11.NewAttribute(p11.CKA_LABEL, label),
+			p11.NewAttribute(p11.CKA_VALUE, value),
+			p11.NewAttribute(p11.CKA_SENSITIVE, true),
+			p11.NewAttribute(p11.CKA_EXTRACTABLE, false),
+			p11.NewAttribute(p11.CKA_ENCRYPT, true),
+			p11.NewAttribute(p11.CKA_DECRYPT, true),
+			p11.NewAttribute(p11.CKA_WRAP, true),
+			p11.NewAttribute(p11.CKA_UNWRAP, true),
+			p11.NewAttribute(p11.CKA_SIGN, true),
+		}); err != nil {
+			t.Fatalf("Failed to create key %s: %v", label, err)
+		}
+	}
+	return func() {
+		module.CloseSession(session) //nolint:errcheck
+	}
+}
+
+func TestKeyService(t *testing.T) {
+	t.Parallel()
+	conf := newTestConfig(t)
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	kekLabel, keyLabel, hmacLabel := "kek-"+suffix, "key-"+suffix, "hmac-"+suffix
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	key, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	hmacKey, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F")
+	cleanup := createTestKeys(t, conf, map[string][]byte{
+		kekLabel:  kek,
+		keyLabel:  key,
+		hmacLabel: hmacKey,
+	}, map[string]uint{
+		kekLabel:  p11.CKK_AES,
+		keyLabel:  p11.CKK_AES,
+		hmacLabel: p11.CKK_GENERIC_SECRET,
+	})
+	defer cleanup()
+
+	ks, err := pkcs11.New(ctx, conf, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// RFC 3394 test vector 4.1.
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	ciphertext, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+
+	wrapped, err := ks.Wrap(ctx, plaintext, kekLabel)
+	a.So(err, should.BeNil)
+	a.So(wrapped, should.Resemble, ciphertext)
+
+	unwrapped, err := ks.Unwrap(ctx, ciphertext, kekLabel)
+	a.So(err, should.BeNil)
+	a.So(unwrapped, should.Resemble, plaintext)
+
+	_, err = ks.Wrap(ctx, plaintext[:15], kekLabel)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = ks.Wrap(ctx, plaintext, "unknown-"+suffix)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Messages encrypted by the token can be decrypted in software, and vice versa.
+	var aesKey types.AES128Key
+	copy(aesKey[:], key)
+	message := []byte("thisisabigsecret")
+
+	encrypted, err := ks.Encrypt(ctx, message, keyLabel)
+	a.So(err, should.BeNil)
+	decrypted, err := crypto.Decrypt(aesKey, encrypted)
+	a.So(err, should.BeNil)
+	a.So(decrypted, should.Resemble, message)
+
+	encrypted, err = crypto.Encrypt(aesKey, message)
+	a.So(err, should.BeNil)
+	decrypted, err = ks.Decrypt(ctx, encrypted, keyLabel)
+	a.So(err, should.BeNil)
+	a.So(decrypted, should.Resemble, message)
+
+	_, err = ks.Decrypt(ctx, encrypted[:8], keyLabel)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	hash, err := ks.HMACHash(ctx, message, hmacLabel)
+	a.So(err, should.BeNil)
+	h := hmac.New(sha256.New, hmacKey)
+	h.Write(message)
+	a.So(hash, should.Resemble, h.Sum(nil))
+
+	_, err = ks.ServerCertificate(ctx, "cert")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Operations fail instead of blocking once the key service is closed.
+	cancel()
+	for deadline := time.Now().Add(test.Delay << 6); time.Now().Before(deadline); time.Sleep(test.Delay) {
+		if _, err = ks.Wrap(test.Context(), plaintext, kekLabel); err != nil {
+			break
+		}
+	}
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+}
@@ -0,0 +1,54 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pkcs11 implements a crypto.KeyService backed by a PKCS#11 token, such as a Hardware Security Module.
+//
+// The keys are referenced by the label (CKA_LABEL) of the secret key objects in the token. The key material never
+// leaves the token: key wrapping, encryption and hashing are performed by the token itself.
+//
+// The implementation requires cgo. When built without cgo, New returns an error.
+package pkcs11
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errNoModulePath   = errors.DefineInvalidArgument("no_module_path", "no PKCS#11 module path configured")
+	errLoadModule     = errors.DefineFailedPrecondition("load_module", "load PKCS#11 module `{path}`")
+	errTokenNotFound  = errors.DefineNotFound("token_not_found", "token with label `{label}` not found")
+	errKeyNotFound    = errors.DefineNotFound("key_not_found", "key with label `{label}` not found")
+	errInvalidKeySize = errors.DefineInvalidArgument(
+		"invalid_key_size", "invalid key size `{size}`, must be 16, 24 or 32",
+	)
+	errMalformedCipherText = errors.DefineInvalidArgument("malformed_cipher_text", "malformed cipher text")
+	errOperation           = errors.DefineUnavailable("operation", "PKCS#11 operation `{operation}` failed")
+	errClosed              = errors.DefineUnavailable("closed", "PKCS#11 key service is closed")
+	errUnavailable         = errors.DefineUnimplemented("unavailable", "PKCS#11 support is not available in this build")
+)
+
+// DefaultSessions is the default number of sessions opened with the token.
+const DefaultSessions = 8
+
+// Config is the configuration of the PKCS#11 key service.
+type Config struct {
+	// ModulePath is the path to the PKCS#11 module (shared library) of the token vendor.
+	ModulePath string
+	// TokenLabel is the label of the token. If empty, the first token is used.
+	TokenLabel string
+	// PIN is the user PIN of the token.
+	PIN string
+	// Sessions is the number of sessions opened with the token. If zero, DefaultSessions is used.
+	Sessions int
+}
+
+const gcmNonceSize = 12
@@ -0,0 +1,24 @@
+CREATE TABLE events (
+  id bigserial NOT NULL,
+  time timestamp with time zone NOT NULL,
+
+  unique_id character varying(64) NOT NULL,
+  name character varying(128) NOT NULL,
+  entity_type character varying(32) NOT NULL,
+  entity_id character varying(128) NOT NULL,
+  correlation_ids text[] NOT NULL,
+
+  data bytea NOT NULL,
+
+  PRIMARY KEY (time, id)
+) PARTITION BY RANGE (time);
+
+-- Events with a time outside of the range of the daily partitions are stored in the default partition.
+CREATE TABLE events_default PARTITION OF events DEFAULT;
+
+CREATE INDEX events_entity_type_entity_id_time_idx
+  ON events (entity_type, entity_id, time, id);
+CREATE INDEX events_unique_id_idx
+  ON events (unique_id);
+CREATE INDEX events_correlation_ids_idx
+  ON events USING GIN (correlation_ids);
@@ -0,0 +1,155 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+const (
+	defaultPartition    = "events_default"
+	partitionPrefix     = "events_p"
+	partitionDateFormat = "20060102"
+	partitionInterval   = 24 * time.Hour
+
+	// partitionsCheckInterval is the interval at which partitions are created and dropped.
+	partitionsCheckInterval = time.Hour
+)
+
+// partitionStart returns the start of the partition that contains t.
+func partitionStart(t time.Time) time.Time {
+	return t.UTC().Truncate(partitionInterval)
+}
+
+// partitionName returns the name of the partition that starts at start.
+func partitionName(start time.Time) string {
+	return partitionPrefix + start.UTC().Format(partitionDateFormat)
+}
+
+// parsePartitionName returns the start of the partition with the given name.
+func parsePartitionName(name string) (time.Time, bool) {
+	date, ok := strings.CutPrefix(name, partitionPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+	start, err := time.ParseInLocation(partitionDateFormat, date, time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// createPartitions creates the partitions from the previous day up to the configured number of days ahead.
+// The partition of the previous day accounts for clock skew around midnight.
+func (ps *PubSubStore) createPartitions(ctx context.Context, now time.Time) error {
+	today := partitionStart(now)
+	for i := -1; i <= ps.partitionsAhead; i++ {
+		if err := ps.createPartition(ctx, today.Add(time.Duration(i)*partitionInterval)); err != nil {
+			return storeutil.WrapDriverError(err)
+		}
+	}
+	return nil
+}
+
+// createPartition creates the partition that starts at start, if it does not exist.
+// The events in the range of the partition that are stored in the default partition are moved to the partition, as
+// the partition can not be attached while the default partition contains events in its range.
+func (ps *PubSubStore) createPartition(ctx context.Context, start time.Time) error {
+	name, end := partitionName(start), start.Add(partitionInterval)
+	return ps.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Attaching a partition takes this lock, so concurrent instances create the partition one at a time.
+		if _, err := tx.ExecContext(ctx, "LOCK TABLE events IN SHARE UPDATE EXCLUSIVE MODE"); err != nil {
+			return err
+		}
+		var exists bool
+		if err := tx.NewRaw("SELECT to_regclass(?) IS NOT NULL", name).Scan(ctx, &exists); err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx,
+			"CREATE TABLE ? (LIKE events INCLUDING DEFAULTS INCLUDING CONSTRAINTS)", bun.Ident(name),
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx,
+			"WITH moved AS (DELETE FROM ? WHERE time >= ? AND time < ? RETURNING *) INSERT INTO ? SELECT * FROM moved",
+			bun.Ident(defaultPartition), start, end, bun.Ident(name),
+		); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			"ALTER TABLE events ATTACH PARTITION ? FOR VALUES FROM (?) TO (?)", bun.Ident(name), start, end,
+		)
+		return err
+	})
+}
+
+// dropPartitions drops the partitions that only contain events older than the retention.
+// The events older than the retention are deleted from the default partition.
+func (ps *PubSubStore) dropPartitions(ctx context.Context, now time.Time) error {
+	var names []string
+	if err := ps.db.NewRaw(
+		"SELECT child.relname FROM pg_inherits "+
+			"JOIN pg_class AS parent ON pg_inherits.inhparent = parent.oid "+
+			"JOIN pg_class AS child ON pg_inherits.inhrelid = child.oid "+
+			"WHERE parent.relname = ?",
+		"events",
+	).Scan(ctx, &names); err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	threshold := now.Add(-ps.retention)
+	if _, err := ps.db.ExecContext(ctx,
+		"DELETE FROM ? WHERE time < ?", bun.Ident(defaultPartition), threshold,
+	); err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	for _, name := range names {
+		start, ok := parsePartitionName(name)
+		if !ok || start.Add(partitionInterval).After(threshold) {
+			continue
+		}
+		log.FromContext(ctx).WithField("partition", name).Debug("Drop expired events partition")
+		if _, err := ps.db.ExecContext(ctx, "DROP TABLE IF EXISTS ?", bun.Ident(name)); err != nil {
+			return storeutil.WrapDriverError(err)
+		}
+	}
+	return nil
+}
+
+func (ps *PubSubStore) partitionsTask(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(random.Jitter(partitionsCheckInterval, 0.1)):
+		}
+		now := time.Now()
+		if err := ps.createPartitions(ctx, now); err != nil {
+			return err
+		}
+		if err := ps.dropPartitions(ctx, now); err != nil {
+			return err
+		}
+	}
+}
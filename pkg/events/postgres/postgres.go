@@ -0,0 +1,378 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres implements an events.Store that stores events in PostgreSQL
+// and distributes them to other instances using LISTEN/NOTIFY.
+package postgres
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/basic"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/batch"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/postgres/migrations"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/workerpool"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// notifyChannel is the PostgreSQL channel on which the unique IDs of published events are announced.
+	notifyChannel = "ttn_lw_events"
+	// maxNotifyPayload is the maximum size of a notification payload.
+	// PostgreSQL limits the payload to 8000 bytes.
+	maxNotifyPayload = 7900
+)
+
+// event is the events model in the database.
+// An event is stored once for every entity it relates to.
+type event struct {
+	bun.BaseModel `bun:"table:events,alias:evt"`
+
+	ID   int64     `bun:"id,pk,autoincrement"`
+	Time time.Time `bun:"time,pk,notnull"`
+
+	UniqueID       string   `bun:"unique_id,notnull"`
+	Name           string   `bun:"name,notnull"`
+	EntityType     string   `bun:"entity_type,notnull"`
+	EntityID       string   `bun:"entity_id,notnull"`
+	CorrelationIDs []string `bun:"correlation_ids,array,notnull"`
+
+	Data []byte `bun:"data,notnull"`
+}
+
+func (m *event) toEvent() (events.Event, error) {
+	pb := &ttnpb.Event{}
+	if err := proto.Unmarshal(m.Data, pb); err != nil {
+		return nil, err
+	}
+	return events.FromProto(pb)
+}
+
+// eventRows returns the rows that store the given event.
+// Events of end devices that propagate to the parent are also stored for the application.
+func eventRows(evt events.Event) ([]*event, error) {
+	pb, err := events.Proto(evt)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, err
+	}
+	correlationIDs := evt.CorrelationIds()
+	if correlationIDs == nil {
+		correlationIDs = []string{}
+	}
+	newRow := func(entityType, entityID string) *event {
+		return &event{
+			Time:           evt.Time(),
+			UniqueID:       evt.UniqueID(),
+			Name:           evt.Name(),
+			EntityType:     entityType,
+			EntityID:       entityID,
+			CorrelationIDs: correlationIDs,
+			Data:           data,
+		}
+	}
+	ids := evt.Identifiers()
+	if len(ids) == 0 {
+		return []*event{newRow("", "")}, nil
+	}
+	definition := events.GetDefinition(evt)
+	rows := make([]*event, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	add := func(id *ttnpb.EntityIdentifiers) {
+		entityType, entityID := id.EntityType(), unique.ID(evt.Context(), id)
+		key := entityType + ":" + entityID
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		rows = append(rows, newRow(entityType, entityID))
+	}
+	for _, id := range ids {
+		add(id)
+		if devID := id.GetDeviceIds(); devID != nil && definition != nil && definition.PropagateToParent() {
+			add(devID.GetApplicationIds().GetEntityIdentifiers())
+		}
+	}
+	return rows, nil
+}
+
+// notifyPayloads joins the unique IDs into notification payloads that fit in a single notification.
+func notifyPayloads(uids []string) []string {
+	var (
+		payloads []string
+		b        strings.Builder
+	)
+	for _, uid := range uids {
+		if b.Len() > 0 && b.Len()+1+len(uid) > maxNotifyPayload {
+			payloads = append(payloads, b.String())
+			b.Reset()
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(uid)
+	}
+	if b.Len() > 0 {
+		payloads = append(payloads, b.String())
+	}
+	return payloads
+}
+
+// NewMigrator returns a new migrator for the events database.
+// The migrations are tracked in dedicated tables, so that the database can be shared with other components.
+func NewMigrator(db *bun.DB) *migrate.Migrator {
+	return migrate.NewMigrator(
+		db,
+		migrations.Migrations,
+		migrate.WithTableName("events_bun_migrations"),
+		migrate.WithLocksTableName("events_bun_migration_locks"),
+	)
+}
+
+// PubSubStore is an events.Store with PostgreSQL backend.
+type PubSubStore struct {
+	*basic.PubSub
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	db          *bun.DB
+	databaseURI string
+
+	taskStarter task.Starter
+	publisher   events.Publisher
+	publishPool workerpool.WorkerPool[[]events.Event]
+
+	retention          time.Duration
+	partitionsAhead    int
+	historyCount       int
+	correlationIDCount int
+}
+
+var _ events.Store = (*PubSubStore)(nil)
+
+// NewPubSub creates a new PubSubStore that stores events in the given database.
+// The database schema must be initialized using the migrations of NewMigrator.
+func NewPubSub(
+	ctx context.Context,
+	component workerpool.Component,
+	db *bun.DB,
+	conf config.PostgresEvents,
+	batchConf config.BatchEvents,
+) (*PubSubStore, error) {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", "events/postgres",
+	))
+	ctx, cancel := context.WithCancel(ctx)
+	ps := &PubSubStore{
+		PubSub: basic.NewPubSub(),
+
+		ctx:    ctx,
+		cancel: cancel,
+
+		db:          db,
+		databaseURI: conf.DatabaseURI,
+
+		taskStarter: component,
+
+		retention:          conf.Store.Retention,
+		partitionsAhead:    conf.Store.PartitionsAhead,
+		historyCount:       conf.Store.HistoryCount,
+		correlationIDCount: conf.Store.CorrelationIDCount,
+	}
+	if ps.retention == 0 {
+		ps.retention = 14 * 24 * time.Hour
+	}
+	if ps.partitionsAhead == 0 {
+		ps.partitionsAhead = 2
+	}
+	if ps.historyCount == 0 {
+		ps.historyCount = 1000
+	}
+	if ps.correlationIDCount == 0 {
+		ps.correlationIDCount = 100
+	}
+
+	if err := ps.createPartitions(ctx, time.Now()); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	component.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "events_postgres_listen",
+		Func:    ps.listenTask,
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	component.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "events_postgres_partitions",
+		Func:    ps.partitionsTask,
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+
+	ps.publishPool = workerpool.NewWorkerPool(workerpool.Config[[]events.Event]{
+		Component: component,
+		Context:   ctx,
+		Name:      "postgres_events_publish",
+		Handler:   ps.storeEvents,
+	})
+
+	ps.publisher = events.PublishFunc(ps.publish)
+	if batchConf.Enable {
+		targetSize, delay := batchConf.TargetSize, batchConf.Delay
+		if targetSize == 0 {
+			targetSize = 64
+		}
+		if delay == 0 {
+			delay = 32 * time.Millisecond
+		}
+		ps.publisher = batch.NewPublisher(ctx, ps.publisher, component, targetSize, delay, runtime.GOMAXPROCS(-1))
+	}
+
+	return ps, nil
+}
+
+// Close the PostgreSQL publisher.
+func (ps *PubSubStore) Close(ctx context.Context) error {
+	ps.cancel()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ps.ctx.Done():
+		if err := ps.db.Close(); err != nil {
+			return err
+		}
+		return ps.ctx.Err()
+	}
+}
+
+// Publish implements events.Publisher.
+func (ps *PubSubStore) Publish(evs ...events.Event) {
+	ps.publisher.Publish(evs...)
+}
+
+func (ps *PubSubStore) publish(evs ...events.Event) {
+	if err := ps.publishPool.Publish(ps.ctx, evs); err != nil {
+		log.FromContext(ps.ctx).WithError(err).Warn("Failed to publish events")
+	}
+}
+
+// storeEvents stores the events and notifies the listeners in a single transaction.
+func (ps *PubSubStore) storeEvents(ctx context.Context, evs []events.Event) {
+	logger := log.FromContext(ctx)
+	rows := make([]*event, 0, len(evs))
+	uids := make([]string, 0, len(evs))
+	for _, evt := range evs {
+		evtRows, err := eventRows(evt)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to encode event")
+			continue
+		}
+		rows = append(rows, evtRows...)
+		uids = append(uids, evt.UniqueID())
+	}
+	if len(rows) == 0 {
+		return
+	}
+	err := ps.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(&rows).Exec(ctx); err != nil {
+			return err
+		}
+		for _, payload := range notifyPayloads(uids) {
+			if _, err := tx.ExecContext(ctx, "SELECT pg_notify(?, ?)", notifyChannel, payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.WithError(storeutil.WrapDriverError(err)).Warn("Failed to store events")
+	}
+}
+
+// loadEvents loads the events with the given unique IDs, in the order of the unique IDs.
+// Events that no longer exist are omitted.
+func (ps *PubSubStore) loadEvents(ctx context.Context, uids []string) ([]events.Event, error) {
+	var rows []*event
+	if err := ps.db.NewSelect().
+		Model(&rows).
+		DistinctOn("?TableAlias.unique_id").
+		Column("unique_id", "data").
+		Where("?TableAlias.unique_id IN (?)", bun.In(uids)).
+		Scan(ctx); err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	byUID := make(map[string]*event, len(rows))
+	for _, row := range rows {
+		byUID[row.UniqueID] = row
+	}
+	evts := make([]events.Event, 0, len(rows))
+	for _, uid := range uids {
+		row, ok := byUID[uid]
+		if !ok {
+			continue
+		}
+		evt, err := row.toEvent()
+		if err != nil {
+			return nil, err
+		}
+		evts = append(evts, evt)
+	}
+	return evts, nil
+}
+
+// listenTask listens for notifications of published events, and publishes the
+// events to the local subscribers.
+func (ps *PubSubStore) listenTask(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	conn, err := pgx.Connect(ctx, ps.databaseURI)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background()) //nolint:errcheck
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return err
+	}
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		evts, err := ps.loadEvents(ctx, strings.Fields(notification.Payload))
+		if err != nil {
+			logger.WithError(err).Warn("Failed to load events")
+			continue
+		}
+		ps.PubSub.Publish(evts...)
+	}
+}
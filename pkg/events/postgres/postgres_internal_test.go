@@ -0,0 +1,100 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var evtPropagated = events.Define("test.postgres.propagated", "propagated", events.WithPropagateToParent())
+
+func TestPartitions(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	start := partitionStart(time.Date(2024, 10, 18, 23, 59, 59, 0, time.FixedZone("", -2*60*60)))
+	a.So(start, should.Equal, time.Date(2024, 10, 19, 0, 0, 0, 0, time.UTC))
+	a.So(partitionName(start), should.Equal, "events_p20241019")
+
+	parsed, ok := parsePartitionName("events_p20241019")
+	a.So(ok, should.BeTrue)
+	a.So(parsed, should.Equal, start)
+
+	for _, name := range []string{"events", "events_p2024", "application_ups"} {
+		_, ok := parsePartitionName(name)
+		a.So(ok, should.BeFalse)
+	}
+}
+
+func TestNotifyPayloads(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	a.So(notifyPayloads(nil), should.BeEmpty)
+	a.So(notifyPayloads([]string{"a", "b"}), should.Resemble, []string{"a b"})
+
+	uid := strings.Repeat("x", 26)
+	uids := make([]string, 1000)
+	for i := range uids {
+		uids[i] = uid
+	}
+	payloads := notifyPayloads(uids)
+	a.So(len(payloads), should.BeGreaterThan, 1)
+	var n int
+	for _, payload := range payloads {
+		a.So(len(payload), should.BeLessThanOrEqualTo, maxNotifyPayload)
+		n += len(strings.Fields(payload))
+	}
+	a.So(n, should.Equal, len(uids))
+}
+
+func TestEventRows(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	devIDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "test-dev"}
+
+	rows, err := eventRows(events.New(ctx, "test.postgres.none", "no identifiers"))
+	a.So(err, should.BeNil)
+	if a.So(rows, should.HaveLength, 1) {
+		a.So(rows[0].EntityType, should.BeEmpty)
+		a.So(rows[0].EntityID, should.BeEmpty)
+		a.So(rows[0].CorrelationIDs, should.NotBeNil)
+	}
+
+	rows, err = eventRows(evtPropagated.NewWithIdentifiersAndData(ctx, devIDs, nil))
+	a.So(err, should.BeNil)
+	if a.So(rows, should.HaveLength, 2) {
+		a.So(rows[0].EntityType, should.Equal, "end device")
+		a.So(rows[0].EntityID, should.Equal, "test-app.test-dev")
+		a.So(rows[1].EntityType, should.Equal, "application")
+		a.So(rows[1].EntityID, should.Equal, "test-app")
+		a.So(rows[0].UniqueID, should.Equal, rows[1].UniqueID)
+	}
+
+	rows, err = eventRows(events.New(
+		ctx, "test.postgres.propagated", "propagated", events.WithIdentifiers(appIDs, devIDs),
+	))
+	a.So(err, should.BeNil)
+	a.So(rows, should.HaveLength, 2)
+}
@@ -0,0 +1,141 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/internal/eventstest"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/postgres"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const schemaName = "events_postgres_test"
+
+type mockComponent struct {
+	task.Starter
+}
+
+func (mockComponent) FromRequestContext(ctx context.Context) context.Context {
+	return ctx
+}
+
+var timeout = (1 << 11) * test.Delay
+
+func TestPostgresPubSubStore(t *testing.T) { //nolint:paralleltest
+	events.IncludeCaller = true
+	taskStarter := task.StartTaskFunc(task.DefaultStartTask)
+
+	test.RunTest(t, test.TestConfig{
+		Timeout: timeout,
+		Func: func(ctx context.Context, a *assertions.Assertion) {
+			baseDSN := storetest.GetDSN("ttn_lorawan_is_test")
+			baseDB, err := storeutil.OpenDB(ctx, baseDSN.String())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer baseDB.Close()
+			if err := baseDB.PingContext(ctx); err != nil {
+				t.Skipf("PostgreSQL not available: %v", err)
+			}
+			if err := storetest.CreateSchema(baseDB, schemaName); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			dsn := storetest.GetSchemaDSN(baseDSN, schemaName).String()
+			sqlDB, err := storeutil.OpenDB(ctx, dsn)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			db := bun.NewDB(sqlDB, pgdialect.New())
+
+			migrator := postgres.NewMigrator(db)
+			if err := migrator.Init(ctx); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if _, err := migrator.Migrate(ctx); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			conf := config.PostgresEvents{
+				DatabaseURI: dsn,
+			}
+			batchConf := config.BatchEvents{Enable: true}
+			pubsub, err := postgres.NewPubSub(ctx, mockComponent{taskStarter}, db, conf, batchConf)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer pubsub.Close(ctx)
+
+			time.Sleep(timeout / 10)
+
+			eventstest.TestBackend(ctx, t, a, pubsub)
+
+			t.Run("OutOfRange", func(t *testing.T) {
+				a := assertions.New(t)
+				// The partitions are only created around the current time, so these events are stored in the
+				// default partition.
+				now := time.Now()
+				var expected []string
+				for i, offset := range []time.Duration{-30 * 24 * time.Hour, 30 * 24 * time.Hour} {
+					evt, err := events.FromProto(&ttnpb.Event{
+						Name:           "test.out_of_range",
+						Time:           timestamppb.New(now.Add(offset)),
+						UniqueId:       fmt.Sprintf("out-of-range-%d", i),
+						CorrelationIds: []string{"test:out_of_range"},
+						Identifiers: []*ttnpb.EntityIdentifiers{
+							(&ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}).GetEntityIdentifiers(),
+						},
+					})
+					if !a.So(err, should.BeNil) {
+						t.FailNow()
+					}
+					expected = append(expected, evt.UniqueID())
+					pubsub.Publish(evt)
+				}
+
+				var actual []string
+				for i := 0; i < 10 && len(actual) < len(expected); i++ {
+					time.Sleep(timeout / 20)
+					related, err := pubsub.FindRelated(ctx, "test:out_of_range")
+					if !a.So(err, should.BeNil) {
+						t.FailNow()
+					}
+					actual = actual[:0]
+					for _, evt := range related {
+						actual = append(actual, evt.UniqueID())
+					}
+				}
+				a.So(actual, should.Resemble, expected)
+			})
+		},
+	})
+}
+
+var _ events.Store = (*postgres.PubSubStore)(nil)
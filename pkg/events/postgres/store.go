@@ -0,0 +1,178 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+func rowsToEvents(rows []*event) ([]events.Event, error) {
+	evts := make([]events.Event, 0, len(rows))
+	for _, row := range rows {
+		evt, err := row.toEvent()
+		if err != nil {
+			return nil, err
+		}
+		evts = append(evts, evt)
+	}
+	return evts, nil
+}
+
+// fetchEntityHistory fetches the most recent events of the entity, in chronological order.
+func (ps *PubSubStore) fetchEntityHistory(
+	ctx context.Context, names []string, id *ttnpb.EntityIdentifiers, after *time.Time, tail int,
+) ([]events.Event, error) {
+	limit := tail
+	if limit <= 0 {
+		limit = ps.historyCount
+	}
+	var rows []*event
+	q := ps.db.NewSelect().
+		Model(&rows).
+		Column("data").
+		Where("?TableAlias.entity_type = ?", id.EntityType()).
+		Where("?TableAlias.entity_id = ?", unique.ID(ctx, id))
+	if len(names) > 0 {
+		q = q.Where("?TableAlias.name IN (?)", bun.In(names))
+	}
+	if after != nil {
+		// The time is truncated to milliseconds to be consistent with the JSON API.
+		q = q.Where("?TableAlias.time >= ?", after.Truncate(time.Millisecond).Add(time.Millisecond))
+	}
+	if err := q.
+		OrderExpr("?TableAlias.time DESC").
+		OrderExpr("?TableAlias.id DESC").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	slices.Reverse(rows)
+	return rowsToEvents(rows)
+}
+
+// FetchHistory implements events.Store.
+func (ps *PubSubStore) FetchHistory(
+	ctx context.Context, names []string, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int,
+) ([]events.Event, error) {
+	if after != nil && after.IsZero() {
+		after = nil
+	}
+	var evts []events.Event
+	for _, id := range ids {
+		entityEvts, err := ps.fetchEntityHistory(ctx, names, id, after, tail)
+		if err != nil {
+			return nil, err
+		}
+		evts = append(evts, entityEvts...)
+	}
+	return evts, nil
+}
+
+// historyHandler buffers live events until the historical events are sent,
+// and skips live events that were already sent as historical events.
+type historyHandler struct {
+	mu       sync.Mutex
+	handler  events.Handler
+	live     bool
+	buffered []events.Event
+	sent     map[string]struct{}
+}
+
+func (h *historyHandler) Notify(evt events.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.live {
+		h.buffered = append(h.buffered, evt)
+		return
+	}
+	if _, ok := h.sent[evt.UniqueID()]; ok {
+		return
+	}
+	h.handler.Notify(evt)
+}
+
+func (h *historyHandler) sendHistory(evts []events.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, evt := range evts {
+		h.sent[evt.UniqueID()] = struct{}{}
+		h.handler.Notify(evt)
+	}
+	for _, evt := range h.buffered {
+		if _, ok := h.sent[evt.UniqueID()]; ok {
+			continue
+		}
+		h.handler.Notify(evt)
+	}
+	h.buffered = nil
+	h.live = true
+}
+
+// SubscribeWithHistory implements events.Store.
+func (ps *PubSubStore) SubscribeWithHistory(
+	ctx context.Context, names []string, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int, hdl events.Handler,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Subscribe before fetching the history, so that no events are missed in between.
+	h := &historyHandler{
+		handler: hdl,
+		sent:    make(map[string]struct{}),
+	}
+	if err := ps.Subscribe(ctx, names, ids, h); err != nil {
+		return err
+	}
+	evts, err := ps.FetchHistory(ctx, names, ids, after, tail)
+	if err != nil {
+		return err
+	}
+	h.sendHistory(evts)
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// FindRelated implements events.Store.
+func (ps *PubSubStore) FindRelated(ctx context.Context, correlationID string) ([]events.Event, error) {
+	var rows []*event
+	if err := ps.db.NewSelect().
+		Model(&rows).
+		ModelTableExpr("(?) AS ?TableAlias", ps.db.NewSelect().
+			Model((*event)(nil)).
+			DistinctOn("?TableAlias.unique_id").
+			Column("unique_id", "time", "data").
+			Where("?TableAlias.correlation_ids @> ?", pgdialect.Array([]string{correlationID})).
+			OrderExpr("?TableAlias.unique_id"),
+		).
+		Column("data").
+		OrderExpr("?TableAlias.time DESC").
+		Limit(ps.correlationIDCount).
+		Scan(ctx); err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	slices.Reverse(rows)
+	return rowsToEvents(rows)
+}
@@ -0,0 +1,93 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a gateway airtime registry backed by an embedded bbolt database.
+package bolt
+
+import (
+	"context"
+	"time"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// Registry implements the gatewayairtime.Registry interface.
+type Registry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the Registry.
+func (r *Registry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (*Registry) key(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+// Set sets or clears the sub bands of a gateway.
+func (r *Registry) Set(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	subBands []*ttnpb.GatewayConnectionStats_SubBand,
+	ttl time.Duration,
+) error {
+	uk := r.key(unique.ID(ctx, ids))
+	return r.Bolt.Watch(ctx, func(tx *ttnbolt.Tx) error {
+		if len(subBands) == 0 {
+			tx.Del(uk)
+			return nil
+		}
+		return tx.SetProto(uk, &ttnpb.GatewayConnectionStats{
+			SubBands: subBands,
+		}, ttl)
+	})
+}
+
+// BatchGet returns the sub bands of a batch of gateways by gateway unique ID.
+// Gateways without sub bands are omitted.
+func (r *Registry) BatchGet(
+	ctx context.Context, ids []*ttnpb.GatewayIdentifiers,
+) (map[string][]*ttnpb.GatewayConnectionStats_SubBand, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	uids := make([]string, 0, len(ids))
+	keys := make([]string, 0, len(ids))
+	for _, gtwIDs := range ids {
+		uid := unique.ID(ctx, gtwIDs)
+		uids = append(uids, uid)
+		keys = append(keys, r.key(uid))
+	}
+	values, err := r.Bolt.MGet(keys...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string][]*ttnpb.GatewayConnectionStats_SubBand, len(ids))
+	for i, val := range values {
+		if val == nil {
+			continue
+		}
+		stats := &ttnpb.GatewayConnectionStats{}
+		if err := ttnbolt.UnmarshalProto(val, stats); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to decode airtime payload")
+			continue
+		}
+		ret[uids[i]] = stats.SubBands
+	}
+	return ret, nil
+}
@@ -0,0 +1,41 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gatewayairtime provides the registry of the downlink airtime usage of the sub bands of gateways.
+// The registry is shared by Gateway Servers, which publish the airtime usage of the connected gateways, and
+// Network Servers, which prefer gateways with remaining downlink airtime.
+package gatewayairtime
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Registry stores the downlink airtime usage of the sub bands of gateways.
+type Registry interface {
+	// Set sets or clears the sub bands of a gateway.
+	Set(
+		ctx context.Context,
+		ids *ttnpb.GatewayIdentifiers,
+		subBands []*ttnpb.GatewayConnectionStats_SubBand,
+		ttl time.Duration,
+	) error
+	// BatchGet returns the sub bands of the given gateways by gateway unique ID.
+	// Gateways without known sub bands are omitted.
+	BatchGet(
+		ctx context.Context, ids []*ttnpb.GatewayIdentifiers,
+	) (map[string][]*ttnpb.GatewayConnectionStats_SubBand, error)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis provides a gateway airtime registry backed by Redis.
+package redis
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// Registry implements the gatewayairtime.Registry interface.
+type Registry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *Registry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Set sets or clears the sub bands of a gateway.
+func (r *Registry) Set(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	subBands []*ttnpb.GatewayConnectionStats_SubBand,
+	ttl time.Duration,
+) error {
+	uk := r.key(unique.ID(ctx, ids))
+	if len(subBands) == 0 {
+		if err := r.Redis.Del(ctx, uk).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		return nil
+	}
+	cmd, err := ttnredis.SetProto(ctx, r.Redis, uk, &ttnpb.GatewayConnectionStats{
+		SubBands: subBands,
+	}, ttl)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// BatchGet returns the sub bands of a batch of gateways by gateway unique ID.
+// Gateways without sub bands are omitted.
+func (r *Registry) BatchGet(
+	ctx context.Context, ids []*ttnpb.GatewayIdentifiers,
+) (map[string][]*ttnpb.GatewayConnectionStats_SubBand, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	uids := make([]string, 0, len(ids))
+	keys := make([]string, 0, len(ids))
+	for _, gtwIDs := range ids {
+		uid := unique.ID(ctx, gtwIDs)
+		uids = append(uids, uid)
+		keys = append(keys, r.key(uid))
+	}
+	rawValues, err := r.Redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	ret := make(map[string][]*ttnpb.GatewayConnectionStats_SubBand, len(ids))
+	for i, val := range rawValues {
+		switch val := val.(type) {
+		case nil:
+		case string:
+			stats := &ttnpb.GatewayConnectionStats{}
+			if err := ttnredis.UnmarshalProto(val, stats); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decode airtime payload")
+				continue
+			}
+			// The result of MGet is in the same order as the input keys passed to it.
+			ret[uids[i]] = stats.SubBands
+		default:
+			log.FromContext(ctx).WithField("element", val).Warn("Invalid element in airtime payloads")
+		}
+	}
+	return ret, nil
+}
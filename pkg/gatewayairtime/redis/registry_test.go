@@ -0,0 +1,75 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ gatewayairtime.Registry = &Registry{}
+
+func TestRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &Registry{
+		Redis: cl,
+	}
+	ids1 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	ids2 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}
+	subBands := []*ttnpb.GatewayConnectionStats_SubBand{
+		{
+			MinFrequency:             863000000,
+			MaxFrequency:             865000000,
+			DownlinkUtilizationLimit: 0.001,
+			DownlinkUtilization:      0.0005,
+		},
+	}
+
+	res, err := registry.BatchGet(ctx, []*ttnpb.GatewayIdentifiers{ids1, ids2})
+	a.So(err, should.BeNil)
+	a.So(res, should.BeEmpty)
+
+	ttl := 10 * test.Delay
+	a.So(registry.Set(ctx, ids1, subBands, ttl), should.BeNil)
+	res, err = registry.BatchGet(ctx, []*ttnpb.GatewayIdentifiers{ids1, ids2})
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, map[string][]*ttnpb.GatewayConnectionStats_SubBand{
+		unique.ID(ctx, ids1): subBands,
+	})
+
+	// Sub bands are cleared when empty.
+	a.So(registry.Set(ctx, ids1, nil, ttl), should.BeNil)
+	res, err = registry.BatchGet(ctx, []*ttnpb.GatewayIdentifiers{ids1})
+	a.So(err, should.BeNil)
+	a.So(res, should.BeEmpty)
+
+	// Sub bands expire after the time to live.
+	a.So(registry.Set(ctx, ids2, subBands, ttl), should.BeNil)
+	time.Sleep(2 * ttl)
+	res, err = registry.BatchGet(ctx, []*ttnpb.GatewayIdentifiers{ids2})
+	a.So(err, should.BeNil)
+	a.So(res, should.BeEmpty)
+}
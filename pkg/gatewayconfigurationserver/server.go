@@ -65,7 +65,10 @@ func New(c *component.Component, conf *Config) (*Server, error) {
 		config:    conf,
 	}
 
-	bsCUPS := conf.BasicStation.NewServer(c)
+	bsCUPS, err := conf.BasicStation.NewServer(c)
+	if err != nil {
+		return nil, err
+	}
 	_ = bsCUPS
 
 	ttkgServer := ttkg.New(c, ttkg.WithConfig(conf.TheThingsKickstarterGateway))
@@ -75,7 +78,6 @@ func New(c *component.Component, conf *Config) (*Server, error) {
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GatewayConfigurationService", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	if ttgcConf := c.GetBaseConfig(c.Context()).TTGC; ttgcConf.Enabled {
-		var err error
 		gcs.managedServer, err = managed.New(c.Context(), c, ttgcConf)
 		if err != nil {
 			return nil, err
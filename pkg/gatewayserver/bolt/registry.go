@@ -0,0 +1,151 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides Gateway Server registries backed by an embedded bbolt database.
+package bolt
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayConnectionStatsRegistry implements the GatewayConnectionStatsRegistry interface.
+type GatewayConnectionStatsRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the GatewayConnectionStatsRegistry.
+func (r *GatewayConnectionStatsRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (r *GatewayConnectionStatsRegistry) key(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+// Set sets or clears the connection stats for a gateway.
+func (r *GatewayConnectionStatsRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	f func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, []string, error),
+	ttl time.Duration,
+	gets ...string,
+) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set gateway connection stats").End()
+
+	uk := r.key(uid)
+	return r.Bolt.LockedWatch(ctx, uk, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.GatewayConnectionStats{}
+		if err := tx.GetProto(uk, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var pb *ttnpb.GatewayConnectionStats
+		var err error
+		if stored != nil {
+			if pb, err = applyGatewayConnectionStatsFieldMask(nil, stored, gets...); err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb == nil {
+			tx.Del(uk)
+			return nil
+		}
+		updated := stored
+		if updated, err = applyGatewayConnectionStatsFieldMask(updated, pb, sets...); err != nil {
+			return err
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+		return tx.SetProto(uk, updated, ttl)
+	})
+}
+
+// Get returns the connection stats for a gateway.
+func (r *GatewayConnectionStatsRegistry) Get(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayConnectionStats, error) {
+	uid := unique.ID(ctx, ids)
+	result := &ttnpb.GatewayConnectionStats{}
+	if err := r.Bolt.GetProto(r.key(uid), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func applyGatewayConnectionStatsFieldMask(
+	dst, src *ttnpb.GatewayConnectionStats,
+	paths ...string,
+) (*ttnpb.GatewayConnectionStats, error) {
+	if dst == nil {
+		dst = &ttnpb.GatewayConnectionStats{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// BatchGet returns the connection stats for a batch of gateways.
+// Gateways without connection stats are omitted.
+func (r *GatewayConnectionStatsRegistry) BatchGet(
+	ctx context.Context,
+	ids []*ttnpb.GatewayIdentifiers,
+	paths ...string,
+) (map[string]*ttnpb.GatewayConnectionStats, error) {
+	keys := make([]string, 0, len(ids))
+	for _, gtwIDs := range ids {
+		keys = append(keys, r.key(unique.ID(ctx, gtwIDs)))
+	}
+	values, err := r.Bolt.MGet(keys...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*ttnpb.GatewayConnectionStats, len(ids))
+	for i, val := range values {
+		if val == nil {
+			continue
+		}
+		stats := &ttnpb.GatewayConnectionStats{}
+		if err := ttnbolt.UnmarshalProto(val, stats); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to decode stats payload")
+			continue
+		}
+		if len(paths) > 0 {
+			if stats, err = applyGatewayConnectionStatsFieldMask(nil, stats, paths...); err != nil {
+				return nil, err
+			}
+		}
+		ret[ids[i].GatewayId] = stats
+	}
+	return ret, nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errInvalidTTL = errors.DefineInvalidArgument("invalid_ttl", "invalid time to live `{ttl}`")
+
+// GatewayConnectionSessionRegistry implements the GatewayConnectionSessionRegistry interface.
+// The sessions of a gateway are stored in a single value, from the most recent to the oldest.
+type GatewayConnectionSessionRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the GatewayConnectionSessionRegistry.
+func (r *GatewayConnectionSessionRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (*GatewayConnectionSessionRegistry) key(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+// Add adds a connection session of a gateway. The session must have a disconnect time.
+// The oldest sessions are removed when the gateway has more than max sessions, or when the sessions are
+// older than the time to live. A time to live of 0 keeps the sessions until they exceed max.
+func (r *GatewayConnectionSessionRegistry) Add(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	session *ttnpb.GatewayConnectionSession,
+	max int,
+	ttl time.Duration,
+) error {
+	if ttl < 0 {
+		return errInvalidTTL.WithAttributes("ttl", ttl)
+	}
+	uk := r.key(unique.ID(ctx, ids))
+	return r.Bolt.LockedWatch(ctx, uk, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.GatewayConnectionSessions{}
+		if err := tx.GetProto(uk, stored); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		sessions := append(stored.Sessions, session)
+		sort.SliceStable(sessions, func(i, j int) bool {
+			return sessions[i].GetDisconnectedAt().AsTime().After(sessions[j].GetDisconnectedAt().AsTime())
+		})
+		if max > 0 && len(sessions) > max {
+			sessions = sessions[:max]
+		}
+		if ttl > 0 {
+			expired := time.Now().Add(-ttl)
+			for i, s := range sessions {
+				if s.GetDisconnectedAt().AsTime().Before(expired) {
+					sessions = sessions[:i]
+					break
+				}
+			}
+		}
+		if len(sessions) == 0 {
+			tx.Del(uk)
+			return nil
+		}
+		return tx.SetProto(uk, &ttnpb.GatewayConnectionSessions{Sessions: sessions}, ttl)
+	})
+}
+
+// List returns the connection sessions of a gateway that ended after the given time, from the most recent to the
+// oldest, starting at offset and limited to limit sessions. A limit of 0 lists all sessions from the offset.
+// It also returns the total number of such sessions.
+func (r *GatewayConnectionSessionRegistry) List(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	disconnectedAfter time.Time,
+	offset, limit int,
+) ([]*ttnpb.GatewayConnectionSession, int64, error) {
+	stored := &ttnpb.GatewayConnectionSessions{}
+	if err := r.Bolt.GetProto(r.key(unique.ID(ctx, ids)), stored); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	sessions := stored.Sessions
+	if !disconnectedAfter.IsZero() {
+		for i, s := range sessions {
+			if !s.GetDisconnectedAt().AsTime().After(disconnectedAfter) {
+				sessions = sessions[:i]
+				break
+			}
+		}
+	}
+	total := int64(len(sessions))
+	if offset >= len(sessions) {
+		return nil, total, nil
+	}
+	sessions = sessions[offset:]
+	if limit > 0 && limit < len(sessions) {
+		sessions = sessions[:limit]
+	}
+	return sessions, total, nil
+}
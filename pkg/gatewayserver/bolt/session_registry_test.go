@@ -0,0 +1,95 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ gatewayserver.GatewayConnectionSessionRegistry = &GatewayConnectionSessionRegistry{}
+
+func TestGatewayConnectionSessionRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, closeFn := test.NewBolt(ctx, "gs", "sessions")
+	defer closeFn()
+
+	registry := &GatewayConnectionSessionRegistry{
+		Bolt: cl,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+
+	list := func(disconnectedAfter time.Time, offset, limit int) []*ttnpb.GatewayConnectionSession {
+		res, _, err := registry.List(ctx, ids, disconnectedAfter, offset, limit)
+		a.So(err, should.BeNil)
+		return res
+	}
+	a.So(list(time.Time{}, 0, 0), should.BeEmpty)
+
+	now := time.Now().UTC()
+	sessions := make([]*ttnpb.GatewayConnectionSession, 0, 4)
+	for i := 0; i < 4; i++ {
+		session := &ttnpb.GatewayConnectionSession{
+			ConnectedAt:    timestamppb.New(now.Add(time.Duration(2*i-8) * time.Hour)),
+			DisconnectedAt: timestamppb.New(now.Add(time.Duration(2*i-7) * time.Hour)),
+			Protocol:       "udp",
+			UplinkCount:    uint64(i),
+		}
+		sessions = append(sessions, session)
+		a.So(registry.Add(ctx, ids, session, 3, 24*time.Hour), should.BeNil)
+	}
+	// The oldest session exceeds the maximum number of sessions.
+	a.So(list(time.Time{}, 0, 0), should.Resemble, []*ttnpb.GatewayConnectionSession{
+		sessions[3], sessions[2], sessions[1],
+	})
+
+	// Sessions are paginated and filtered by disconnect time.
+	res, total, err := registry.List(ctx, ids, time.Time{}, 1, 1)
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, []*ttnpb.GatewayConnectionSession{sessions[2]})
+	a.So(total, should.Equal, 3)
+	a.So(list(time.Time{}, 1, 0), should.Resemble, []*ttnpb.GatewayConnectionSession{sessions[2], sessions[1]})
+	res, total, err = registry.List(ctx, ids, now.Add(-4*time.Hour), 0, 0)
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, []*ttnpb.GatewayConnectionSession{sessions[3], sessions[2]})
+	a.So(total, should.Equal, 2)
+
+	// Sessions that ended before the time to live are removed.
+	a.So(registry.Add(ctx, ids, &ttnpb.GatewayConnectionSession{
+		ConnectedAt:    timestamppb.New(now.Add(-time.Minute)),
+		DisconnectedAt: timestamppb.New(now),
+	}, 3, 2*time.Hour), should.BeNil)
+	a.So(list(time.Time{}, 0, 0), should.HaveLength, 2)
+
+	// Sessions do not expire without time to live.
+	a.So(registry.Add(ctx, ids, &ttnpb.GatewayConnectionSession{
+		ConnectedAt:    timestamppb.New(now.Add(-48 * time.Hour)),
+		DisconnectedAt: timestamppb.New(now.Add(-47 * time.Hour)),
+	}, 0, 0), should.BeNil)
+	a.So(list(time.Time{}, 0, 0), should.HaveLength, 3)
+
+	a.So(errors.IsInvalidArgument(registry.Add(ctx, ids, sessions[0], 3, -time.Hour)), should.BeTrue)
+}
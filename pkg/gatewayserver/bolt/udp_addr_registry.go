@@ -0,0 +1,63 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"context"
+	"net"
+	"time"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// UDPAddrRegistry implements the AddrRegistry interface of the UDP frontend.
+type UDPAddrRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the UDPAddrRegistry.
+func (r *UDPAddrRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (*UDPAddrRegistry) key(eui types.EUI64) string {
+	return ttnbolt.Key("eui", eui.String())
+}
+
+// Bind binds the gateway EUI to the address for the given time to live.
+// If the gateway EUI is bound to another address, the binding is not changed and the bound address is returned.
+func (r *UDPAddrRegistry) Bind(ctx context.Context, eui types.EUI64, ip net.IP, ttl time.Duration) (net.IP, error) {
+	uk := r.key(eui)
+	var bound net.IP
+	if err := r.Bolt.LockedWatch(ctx, uk, func(tx *ttnbolt.Tx) error {
+		b, err := tx.Get(uk)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if b != nil {
+			if stored := net.ParseIP(string(b)); !stored.Equal(ip) {
+				bound = stored
+				return nil
+			}
+		}
+		tx.Set(uk, []byte(ip.String()), ttl)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return bound, nil
+}
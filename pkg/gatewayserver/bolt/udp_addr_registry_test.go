@@ -0,0 +1,69 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ udp.AddrRegistry = &UDPAddrRegistry{}
+
+func TestUDPAddrRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, closeFn := test.NewBolt(ctx, "gs", "udp", "addr")
+	defer closeFn()
+
+	registry := &UDPAddrRegistry{
+		Bolt: cl,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	eui1 := types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+	eui2 := types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02}
+	ip1 := net.IPv4(192, 0, 2, 1)
+	ip2 := net.IPv4(192, 0, 2, 2)
+	ttl := 10 * test.Delay
+
+	bound, err := registry.Bind(ctx, eui1, ip1, ttl)
+	a.So(err, should.BeNil)
+	a.So(bound, should.BeNil)
+
+	bound, err = registry.Bind(ctx, eui1, ip1, ttl)
+	a.So(err, should.BeNil)
+	a.So(bound, should.BeNil)
+
+	bound, err = registry.Bind(ctx, eui1, ip2, ttl)
+	a.So(err, should.BeNil)
+	a.So(bound.Equal(ip1), should.BeTrue)
+
+	bound, err = registry.Bind(ctx, eui2, ip2, ttl)
+	a.So(err, should.BeNil)
+	a.So(bound, should.BeNil)
+
+	time.Sleep(2 * ttl)
+
+	bound, err = registry.Bind(ctx, eui1, ip2, ttl)
+	a.So(err, should.BeNil)
+	a.So(bound, should.BeNil)
+}
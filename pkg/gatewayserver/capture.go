@@ -0,0 +1,83 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errCaptureDisabled = errors.DefineFailedPrecondition("capture_disabled", "gateway traffic capture is disabled")
+
+// capture records the message of the gateway, if capturing traffic is enabled.
+// The message of the record is cloned, as the message is handled concurrently.
+func (gs *GatewayServer) capture(ctx context.Context, ids *ttnpb.GatewayIdentifiers, rec *capture.Record) {
+	if gs.captureRecorder == nil {
+		return
+	}
+	rec.Time = time.Now()
+	switch {
+	case rec.Up != nil:
+		rec.Up = ttnpb.Clone(rec.Up)
+	case rec.Down != nil:
+		rec.Down = ttnpb.Clone(rec.Down)
+	case rec.TxAck != nil:
+		rec.TxAck = ttnpb.Clone(rec.TxAck)
+	}
+	gs.captureRecorder.Record(unique.ID(ctx, ids), rec)
+}
+
+// CaptureGatewayTraffic implements ttnpb.GsCaptureServer.
+func (gs *GatewayServer) CaptureGatewayTraffic(
+	ids *ttnpb.GatewayIdentifiers, stream ttnpb.GsCapture_CaptureGatewayTrafficServer,
+) error {
+	ctx := stream.Context()
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return err
+	}
+	if gs.captureRecorder == nil {
+		return errCaptureDisabled.New()
+	}
+
+	logger := log.FromContext(ctx).WithField("gateway_uid", unique.ID(ctx, ids))
+	history, records, unsubscribe := gs.captureRecorder.Subscribe(unique.ID(ctx, ids))
+	defer unsubscribe()
+	logger.WithField("history", len(history)).Debug("Start capture")
+	send := func(rec *capture.Record) error {
+		return stream.Send(rec.Proto())
+	}
+	for _, rec := range history {
+		if err := send(rec); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Debug("Stop capture")
+			return nil
+		case rec := <-records:
+			if err := send(rec); err != nil {
+				return err
+			}
+		}
+	}
+}
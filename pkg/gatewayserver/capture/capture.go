@@ -0,0 +1,156 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capture implements packet capture of gateway traffic.
+//
+// The Gateway Server records the raw uplink, downlink and transmission acknowledgment traffic of gateways in ring
+// buffers. Captured traffic is streamed to clients, which write it in PCAP or PCAPNG format with a LoRaTap link-layer
+// header, so that captures can be opened in Wireshark.
+package capture
+
+import (
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Record is a captured message. Only one of Up, Down and TxAck is set.
+type Record struct {
+	Time  time.Time
+	Up    *ttnpb.UplinkMessage
+	Down  *ttnpb.DownlinkMessage
+	TxAck *ttnpb.TxAcknowledgment
+}
+
+// subscriptionBufferSize is the number of records that are buffered per subscriber.
+// Records are dropped for subscribers that do not keep up.
+const subscriptionBufferSize = 64
+
+type buffer struct {
+	records     []*Record
+	next        int
+	subscribers map[chan *Record]struct{}
+	expiry      *time.Timer
+}
+
+// history returns the records in the buffer in chronological order.
+func (b *buffer) history() []*Record {
+	res := make([]*Record, 0, len(b.records))
+	for i := range b.records {
+		if rec := b.records[(b.next+i)%len(b.records)]; rec != nil {
+			res = append(res, rec)
+		}
+	}
+	return res
+}
+
+// Recorder records the traffic of gateways in ring buffers.
+type Recorder struct {
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	buffers map[string]*buffer
+}
+
+// NewRecorder returns a new Recorder that keeps the last size records per gateway.
+// The records of disconnected gateways are kept for the given TTL.
+func NewRecorder(size int, ttl time.Duration) *Recorder {
+	return &Recorder{
+		size:    size,
+		ttl:     ttl,
+		buffers: make(map[string]*buffer),
+	}
+}
+
+func (r *Recorder) buffer(uid string) *buffer {
+	b, ok := r.buffers[uid]
+	if !ok {
+		b = &buffer{
+			records:     make([]*Record, r.size),
+			subscribers: make(map[chan *Record]struct{}),
+		}
+		r.buffers[uid] = b
+	}
+	return b
+}
+
+// Record records the given record for the gateway identified by uid and sends it to the subscribers.
+func (r *Recorder) Record(uid string, rec *Record) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b := r.buffer(uid)
+	if b.expiry != nil {
+		b.expiry.Stop()
+		b.expiry = nil
+	}
+	if len(b.records) > 0 {
+		b.records[b.next] = rec
+		b.next = (b.next + 1) % len(b.records)
+	}
+	for ch := range b.subscribers {
+		select {
+		case ch <- rec:
+		default:
+		}
+	}
+}
+
+// Subscribe returns the recorded history of the gateway identified by uid and a channel of the records that are
+// recorded next. The returned function must be called to unsubscribe.
+func (r *Recorder) Subscribe(uid string) (history []*Record, records <-chan *Record, unsubscribe func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b := r.buffer(uid)
+	ch := make(chan *Record, subscriptionBufferSize)
+	b.subscribers[ch] = struct{}{}
+	return b.history(), ch, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(b.subscribers, ch)
+		if len(b.subscribers) == 0 && len(b.history()) == 0 {
+			delete(r.buffers, uid)
+		}
+	}
+}
+
+// Expire deletes the recorded history of the gateway identified by uid after the TTL of the Recorder, unless the
+// gateway records traffic again before that time. Subscribers of the gateway are not affected.
+func (r *Recorder) Expire(uid string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.buffers[uid]
+	if !ok {
+		return
+	}
+	if b.expiry != nil {
+		b.expiry.Stop()
+	}
+	var expiry *time.Timer
+	expiry = time.AfterFunc(r.ttl, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.buffers[uid] != b || b.expiry != expiry {
+			return
+		}
+		b.expiry = nil
+		if len(b.subscribers) == 0 {
+			delete(r.buffers, uid)
+			return
+		}
+		b.records, b.next = make([]*Record, r.size), 0
+	})
+	b.expiry = expiry
+}
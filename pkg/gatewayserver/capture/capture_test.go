@@ -0,0 +1,195 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var testUplink = &ttnpb.UplinkMessage{
+	RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+	Settings: &ttnpb.TxSettings{
+		DataRate: &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{
+					Bandwidth:       125000,
+					SpreadingFactor: 7,
+					CodingRate:      band.Cr4_5,
+				},
+			},
+		},
+		Frequency: 868100000,
+	},
+	RxMetadata: []*ttnpb.RxMetadata{
+		{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"},
+			Rssi:       -100,
+			Snr:        -2.5,
+		},
+	},
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	r := NewRecorder(2, 50*time.Millisecond)
+
+	recs := []*Record{
+		{Up: testUplink},
+		{Down: &ttnpb.DownlinkMessage{RawPayload: []byte{0x60}}},
+		{TxAck: &ttnpb.TxAcknowledgment{Result: ttnpb.TxAcknowledgment_TOO_LATE}},
+	}
+	for _, rec := range recs {
+		r.Record("test-gateway", rec)
+	}
+
+	history, records, unsubscribe := r.Subscribe("test-gateway")
+	a.So(history, should.Resemble, recs[1:])
+
+	r.Record("test-gateway", recs[0])
+	r.Record("other-gateway", recs[1])
+	select {
+	case rec := <-records:
+		a.So(rec, should.Equal, recs[0])
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for record")
+	}
+	select {
+	case rec := <-records:
+		t.Fatalf("Unexpected record: %v", rec)
+	default:
+	}
+
+	unsubscribe()
+
+	r.Expire("test-gateway")
+	history, _, unsubscribe = r.Subscribe("test-gateway")
+	a.So(history, should.Resemble, []*Record{recs[2], recs[0]})
+	unsubscribe()
+
+	// Recording traffic again stops the expiry.
+	r.Record("test-gateway", recs[1])
+	time.Sleep(100 * time.Millisecond)
+	history, _, unsubscribe = r.Subscribe("test-gateway")
+	a.So(history, should.Resemble, []*Record{recs[0], recs[1]})
+	unsubscribe()
+
+	r.Expire("test-gateway")
+	time.Sleep(100 * time.Millisecond)
+	history, _, unsubscribe = r.Subscribe("test-gateway")
+	a.So(history, should.BeEmpty)
+	unsubscribe()
+}
+
+func TestLoRaTap(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	frame := (&Record{Up: testUplink}).LoRaTap()
+	a.So(frame, should.Resemble, []byte{
+		0x00, 0x00, 0x00, 0x0f, // Version, padding and length.
+		0x33, 0xbe, 0x27, 0xa0, // Frequency.
+		0x01, 0x07, // Bandwidth and spreading factor.
+		39, 39, 39, // RSSI.
+		0xf6,                         // SNR.
+		0x34,                         // Sync word.
+		0x40, 0x01, 0x02, 0x03, 0x04, // PHYPayload.
+	})
+
+	a.So((&Record{TxAck: &ttnpb.TxAcknowledgment{}}).LoRaTap(), should.HaveLength, 15)
+}
+
+func TestPCAP(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	rec := &Record{Time: time.Unix(1700000000, 250000000), Up: testUplink}
+
+	var buf bytes.Buffer
+	w, err := NewPCAPWriter(&buf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(w.WriteRecord(rec), should.BeNil)
+	b := buf.Bytes()
+	if !a.So(b, should.HaveLength, 24+16+20) {
+		t.FailNow()
+	}
+	a.So(binary.LittleEndian.Uint32(b[0:]), should.Equal, 0xa1b2c3d4)
+	a.So(binary.LittleEndian.Uint32(b[20:]), should.Equal, LinkTypeLoRaTap)
+	a.So(binary.LittleEndian.Uint32(b[24:]), should.Equal, 1700000000)
+	a.So(binary.LittleEndian.Uint32(b[28:]), should.Equal, 250000)
+	a.So(binary.LittleEndian.Uint32(b[32:]), should.Equal, 20)
+	a.So(b[40:], should.Resemble, rec.LoRaTap())
+}
+
+func TestPCAPNG(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	rec := &Record{
+		Time:  time.Unix(1700000000, 0),
+		TxAck: &ttnpb.TxAcknowledgment{Result: ttnpb.TxAcknowledgment_TOO_LATE},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewPCAPNGWriter(&buf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(w.WriteRecord(rec), should.BeNil)
+
+	// Walk the blocks and check that the lengths are consistent.
+	var types []uint32
+	for b := buf.Bytes(); len(b) > 0; {
+		if !a.So(len(b), should.BeGreaterThanOrEqualTo, 12) {
+			t.FailNow()
+		}
+		length := binary.LittleEndian.Uint32(b[4:])
+		a.So(length%4, should.Equal, 0)
+		a.So(binary.LittleEndian.Uint32(b[length-4:]), should.Equal, length)
+		if binary.LittleEndian.Uint32(b) == 6 {
+			a.So(bytes.Contains(b[:length], []byte("tx_ack: TOO_LATE")), should.BeTrue)
+		}
+		types = append(types, binary.LittleEndian.Uint32(b))
+		b = b[length:]
+	}
+	a.So(types, should.Resemble, []uint32{0x0a0d0d0a, 1, 6})
+}
+
+func TestProto(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	for _, rec := range []*Record{
+		{Time: time.Unix(1700000000, 0).UTC(), Up: testUplink},
+		{Time: time.Unix(1700000001, 0).UTC(), Down: &ttnpb.DownlinkMessage{RawPayload: []byte{0x60}}},
+		{Time: time.Unix(1700000002, 0).UTC(), TxAck: &ttnpb.TxAcknowledgment{Result: ttnpb.TxAcknowledgment_TX_FREQ}},
+	} {
+		actual, err := RecordFromProto(rec.Proto())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(actual, should.Resemble, rec)
+	}
+
+	_, err := RecordFromProto(&ttnpb.GatewayTrafficCaptureRecord{})
+	a.So(err, should.NotBeNil)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// LinkTypeLoRaTap is the PCAP link type of LoRaTap.
+	LinkTypeLoRaTap = 270
+
+	loraTapHeaderLength = 15
+	loraWANSyncWord     = 0x34
+)
+
+// Direction returns the direction of the record: uplink, downlink or tx_ack.
+func (r *Record) Direction() string {
+	switch {
+	case r.Up != nil:
+		return "uplink"
+	case r.Down != nil:
+		return "downlink"
+	case r.TxAck != nil:
+		return "tx_ack"
+	default:
+		return "unknown"
+	}
+}
+
+// Comment returns a human readable description of the record.
+func (r *Record) Comment() string {
+	if r.TxAck != nil {
+		return fmt.Sprintf("%s: %s", r.Direction(), r.TxAck.Result)
+	}
+	return r.Direction()
+}
+
+func clampUint8(v float64) uint8 {
+	return uint8(math.Max(0, math.Min(math.MaxUint8, math.Round(v))))
+}
+
+// LoRaTap returns the record as LoRaTap version 0 frame. The frame consists of the LoRaTap header, followed by the
+// LoRaWAN PHYPayload.
+func (r *Record) LoRaTap() []byte {
+	var (
+		settings *ttnpb.TxSettings
+		md       *ttnpb.RxMetadata
+		payload  []byte
+	)
+	switch {
+	case r.Up != nil:
+		settings, payload = r.Up.Settings, r.Up.RawPayload
+		if len(r.Up.RxMetadata) > 0 {
+			md = r.Up.RxMetadata[0]
+		}
+	case r.Down != nil:
+		settings, payload = r.Down.GetScheduled(), r.Down.RawPayload
+	case r.TxAck != nil:
+		settings, payload = r.TxAck.DownlinkMessage.GetScheduled(), r.TxAck.DownlinkMessage.GetRawPayload()
+	}
+
+	b := make([]byte, loraTapHeaderLength, loraTapHeaderLength+len(payload))
+	binary.BigEndian.PutUint16(b[2:], loraTapHeaderLength)
+	binary.BigEndian.PutUint32(b[4:], uint32(settings.GetFrequency()))
+	if lora := settings.GetDataRate().GetLora(); lora != nil {
+		b[8] = uint8(lora.Bandwidth / 125000)
+		b[9] = uint8(lora.SpreadingFactor)
+	}
+	if md != nil {
+		rssi := md.Rssi
+		if md.ChannelRssi != 0 {
+			rssi = md.ChannelRssi
+		}
+		// RSSI is encoded as offset from -139 dBm.
+		b[10] = clampUint8(float64(rssi) + 139)
+		b[11] = b[10]
+		b[12] = b[10]
+		// SNR is encoded in quarter dB.
+		b[13] = byte(int8(math.Max(math.MinInt8, math.Min(math.MaxInt8, math.Round(float64(md.Snr)*4)))))
+	}
+	b[14] = loraWANSyncWord
+	return append(b, payload...)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Writer writes records.
+type Writer interface {
+	WriteRecord(*Record) error
+}
+
+const (
+	pcapMagic        = 0xa1b2c3d4
+	pcapVersionMajor = 2
+	pcapVersionMinor = 4
+	snapLength       = math.MaxUint16
+)
+
+type pcapWriter struct {
+	w io.Writer
+}
+
+// NewPCAPWriter writes the PCAP file header to w and returns a Writer that writes records as PCAP packets.
+func NewPCAPWriter(w io.Writer) (Writer, error) {
+	b := make([]byte, 24)
+	binary.LittleEndian.PutUint32(b[0:], pcapMagic)
+	binary.LittleEndian.PutUint16(b[4:], pcapVersionMajor)
+	binary.LittleEndian.PutUint16(b[6:], pcapVersionMinor)
+	binary.LittleEndian.PutUint32(b[16:], snapLength)
+	binary.LittleEndian.PutUint32(b[20:], LinkTypeLoRaTap)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	return &pcapWriter{w: w}, nil
+}
+
+// WriteRecord implements Writer.
+func (pw *pcapWriter) WriteRecord(rec *Record) error {
+	data := rec.LoRaTap()
+	b := make([]byte, 16, 16+len(data))
+	binary.LittleEndian.PutUint32(b[0:], uint32(rec.Time.Unix()))
+	binary.LittleEndian.PutUint32(b[4:], uint32(rec.Time.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(b[8:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(b[12:], uint32(len(data)))
+	_, err := pw.w.Write(append(b, data...))
+	return err
+}
+
+const (
+	pcapngSectionHeaderBlock       = 0x0a0d0d0a
+	pcapngInterfaceDescBlock       = 0x00000001
+	pcapngEnhancedPacketBlock      = 0x00000006
+	pcapngByteOrderMagic           = 0x1a2b3c4d
+	pcapngOptionEndOfOptions       = 0
+	pcapngOptionComment            = 1
+	pcapngVersionMajor             = 1
+	pcapngVersionMinor             = 0
+	pcapngUnspecifiedSectionLength = math.MaxUint64
+)
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
+
+// appendPCAPNGOption appends an option with the given code and value, padded to 32 bits.
+func appendPCAPNGOption(b []byte, code uint16, value []byte) []byte {
+	b = binary.LittleEndian.AppendUint16(b, code)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(value)))
+	b = append(b, value...)
+	return append(b, make([]byte, pad4(len(value))-len(value))...)
+}
+
+// writePCAPNGBlock writes a block with the given type and body, which must be padded to 32 bits.
+func writePCAPNGBlock(w io.Writer, blockType uint32, body []byte) error {
+	length := uint32(12 + len(body))
+	b := make([]byte, 0, length)
+	b = binary.LittleEndian.AppendUint32(b, blockType)
+	b = binary.LittleEndian.AppendUint32(b, length)
+	b = append(b, body...)
+	b = binary.LittleEndian.AppendUint32(b, length)
+	_, err := w.Write(b)
+	return err
+}
+
+type pcapngWriter struct {
+	w io.Writer
+}
+
+// NewPCAPNGWriter writes the PCAPNG section header and interface description to w and returns a Writer that writes
+// records as PCAPNG enhanced packets. The packets are annotated with the direction of the records.
+func NewPCAPNGWriter(w io.Writer) (Writer, error) {
+	shb := make([]byte, 0, 16)
+	shb = binary.LittleEndian.AppendUint32(shb, pcapngByteOrderMagic)
+	shb = binary.LittleEndian.AppendUint16(shb, pcapngVersionMajor)
+	shb = binary.LittleEndian.AppendUint16(shb, pcapngVersionMinor)
+	shb = binary.LittleEndian.AppendUint64(shb, pcapngUnspecifiedSectionLength)
+	if err := writePCAPNGBlock(w, pcapngSectionHeaderBlock, shb); err != nil {
+		return nil, err
+	}
+	idb := make([]byte, 0, 8)
+	idb = binary.LittleEndian.AppendUint16(idb, LinkTypeLoRaTap)
+	idb = binary.LittleEndian.AppendUint16(idb, 0)
+	idb = binary.LittleEndian.AppendUint32(idb, snapLength)
+	if err := writePCAPNGBlock(w, pcapngInterfaceDescBlock, idb); err != nil {
+		return nil, err
+	}
+	return &pcapngWriter{w: w}, nil
+}
+
+// WriteRecord implements Writer.
+func (pw *pcapngWriter) WriteRecord(rec *Record) error {
+	data := rec.LoRaTap()
+	// The default timestamp resolution is microseconds.
+	ts := uint64(rec.Time.UnixMicro())
+	epb := make([]byte, 0, 20+pad4(len(data)))
+	epb = binary.LittleEndian.AppendUint32(epb, 0)
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts>>32))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(data)))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(data)))
+	epb = append(epb, data...)
+	epb = append(epb, make([]byte, pad4(len(data))-len(data))...)
+	epb = appendPCAPNGOption(epb, pcapngOptionComment, []byte(rec.Comment()))
+	epb = appendPCAPNGOption(epb, pcapngOptionEndOfOptions, nil)
+	return writePCAPNGBlock(pw.w, pcapngEnhancedPacketBlock, epb)
+}
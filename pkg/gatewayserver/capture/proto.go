@@ -0,0 +1,57 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errRecord = errors.DefineInvalidArgument("record", "invalid capture record")
+
+// Proto returns the record as GatewayTrafficCaptureRecord.
+func (r *Record) Proto() *ttnpb.GatewayTrafficCaptureRecord {
+	pb := &ttnpb.GatewayTrafficCaptureRecord{
+		Time: timestamppb.New(r.Time),
+	}
+	switch {
+	case r.Up != nil:
+		pb.Message = &ttnpb.GatewayTrafficCaptureRecord_UplinkMessage{UplinkMessage: r.Up}
+	case r.Down != nil:
+		pb.Message = &ttnpb.GatewayTrafficCaptureRecord_DownlinkMessage{DownlinkMessage: r.Down}
+	case r.TxAck != nil:
+		pb.Message = &ttnpb.GatewayTrafficCaptureRecord_TxAcknowledgment{TxAcknowledgment: r.TxAck}
+	}
+	return pb
+}
+
+// RecordFromProto returns the record of the GatewayTrafficCaptureRecord.
+func RecordFromProto(pb *ttnpb.GatewayTrafficCaptureRecord) (*Record, error) {
+	rec := &Record{
+		Time: pb.GetTime().AsTime(),
+	}
+	switch msg := pb.GetMessage().(type) {
+	case *ttnpb.GatewayTrafficCaptureRecord_UplinkMessage:
+		rec.Up = msg.UplinkMessage
+	case *ttnpb.GatewayTrafficCaptureRecord_DownlinkMessage:
+		rec.Down = msg.DownlinkMessage
+	case *ttnpb.GatewayTrafficCaptureRecord_TxAcknowledgment:
+		rec.TxAck = msg.TxAcknowledgment
+	default:
+		return nil, errRecord.New()
+	}
+	return rec, nil
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/semtechws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ttigw"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
@@ -47,6 +48,14 @@ type TheThingsIndustriesGatewayConfig struct {
 	ListenTLS               string `name:"listen-tls" description:"Address for The Things Industries gateway frontend to listen on (with TLS)"`
 }
 
+// ChirpStackConfig defines the ChirpStack gateway frontend configuration of the Gateway Server.
+// ChirpStack Gateway Bridge and ChirpStack MQTT Forwarder connect to the MQTT frontend with the gateway ID as username
+// and a gateway API key as password.
+type ChirpStackConfig struct {
+	MQTT        config.MQTT `name:"mqtt"`
+	TopicPrefix string      `name:"topic-prefix" description:"Prefix of the ChirpStack gateway topics, typically the region (i.e. eu868)"`
+}
+
 // PacketBrokerConfig configures the Packet Broker upstream.
 type PacketBrokerConfig struct {
 	UpdateGatewayInterval time.Duration `name:"update-gateway-interval" description:"Update gateway interval"`
@@ -54,11 +63,37 @@ type PacketBrokerConfig struct {
 	OnlineTTLMargin       time.Duration `name:"online-ttl-margin" description:"Time to extend the online status before it expires"`
 }
 
+// AirtimeConfig represents the configuration for publishing the downlink airtime usage of gateways.
+type AirtimeConfig struct {
+	Publish  bool                    `name:"publish" description:"Publish the downlink airtime usage of gateways to Network Servers"`
+	TTL      time.Duration           `name:"ttl" description:"Time to live of the published downlink airtime usage"`
+	Registry gatewayairtime.Registry `name:"-"`
+}
+
+// CaptureConfig represents the configuration for capturing the traffic of gateways.
+type CaptureConfig struct {
+	Enable     bool          `name:"enable" description:"Record the traffic of gateways for packet capture"`
+	BufferSize int           `name:"buffer-size" description:"Number of recorded messages per gateway"`
+	TTL        time.Duration `name:"ttl" description:"Time to keep the recorded messages of disconnected gateways"`
+}
+
+// ConnectionSessionsConfig represents the configuration for storing the past connection sessions of gateways.
+type ConnectionSessionsConfig struct {
+	Enable   bool                             `name:"enable" description:"Store the past connection sessions of gateways"`
+	Max      int                              `name:"max" description:"Maximum number of stored connection sessions per gateway"`
+	TTL      time.Duration                    `name:"ttl" description:"Time to live of the stored connection sessions (0 means no expiry)"`
+	Registry GatewayConnectionSessionRegistry `name:"-"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
-	Stats GatewayConnectionStatsRegistry `name:"-"`
+	Stats   GatewayConnectionStatsRegistry `name:"-"`
+	Airtime AirtimeConfig                  `name:"airtime" description:"Gateway downlink airtime publishing configuration"`
+	Capture CaptureConfig                  `name:"capture" description:"Gateway traffic capture configuration"`
+
+	ConnectionSessions ConnectionSessionsConfig `name:"connection-sessions" description:"Gateway connection sessions configuration"`
 
 	FetchGatewayInterval time.Duration `name:"fetch-gateway-interval" description:"Fetch gateway interval"`
 	FetchGatewayJitter   float64       `name:"fetch-gateway-jitter" description:"Jitter (fraction) to apply to the get interval to randomize intervals"`
@@ -81,6 +116,7 @@ type Config struct {
 	UDP                        UDPConfig                        `name:"udp"`
 	BasicStation               BasicStationConfig               `name:"basic-station"`
 	TheThingsIndustriesGateway TheThingsIndustriesGatewayConfig `name:"ttigw"`
+	ChirpStack                 ChirpStackConfig                 `name:"chirpstack"`
 }
 
 // ForwardDevAddrPrefixes parses the configured forward map.
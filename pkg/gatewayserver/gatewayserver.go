@@ -40,6 +40,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	iogrpc "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
@@ -79,6 +81,8 @@ var (
 type GatewayServer struct {
 	ttnpb.UnimplementedGsServer
 	ttnpb.UnimplementedNsGsServer
+	ttnpb.UnimplementedGsCaptureServer
+	ttnpb.UnimplementedGsSessionsServer
 
 	*component.Component
 	ctx context.Context
@@ -94,7 +98,11 @@ type GatewayServer struct {
 
 	connections sync.Map // string to connectionEntry
 
-	statsRegistry GatewayConnectionStatsRegistry
+	statsRegistry   GatewayConnectionStatsRegistry
+	airtimeRegistry gatewayairtime.Registry
+	sessionRegistry GatewayConnectionSessionRegistry
+
+	captureRecorder *capture.Recorder
 
 	certVerifier CertificateVerifier
 }
@@ -157,6 +165,15 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		entityRegistry:            NewIS(c),
 		certVerifier:              c.CAStore(),
 	}
+	if conf.Airtime.Publish {
+		gs.airtimeRegistry = conf.Airtime.Registry
+	}
+	if conf.ConnectionSessions.Enable {
+		gs.sessionRegistry = conf.ConnectionSessions.Registry
+	}
+	if conf.Capture.Enable {
+		gs.captureRecorder = capture.NewRecorder(conf.Capture.BufferSize, conf.Capture.TTL)
+	}
 	for _, opt := range opts {
 		opt(gs)
 	}
@@ -252,6 +269,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 			Format: mqtt.NewProtobufV2(gs.ctx),
 			Config: conf.MQTTV2,
 		},
+		{
+			Format: mqtt.NewChirpStack(gs.ctx, conf.ChirpStack.TopicPrefix),
+			Config: conf.ChirpStack.MQTT,
+		},
 	} {
 		for _, endpoint := range []component.Endpoint{
 			component.NewTCPEndpoint(version.Config.Listen, "MQTT"),
@@ -431,6 +452,8 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 // RegisterServices registers services provided by gs at s.
 func (gs *GatewayServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterGsServer(s, gs)
+	ttnpb.RegisterGsCaptureServer(s, gs)
+	ttnpb.RegisterGsSessionsServer(s, gs)
 	ttnpb.RegisterNsGsServer(s, gs)
 	ttnpb.RegisterGtwGsServer(s, iogrpc.New(gs,
 		iogrpc.WithMQTTConfigProvider(
@@ -455,6 +478,7 @@ func (gs *GatewayServer) RegisterServices(s *grpc.Server) {
 // RegisterHandlers registers gRPC handlers.
 func (gs *GatewayServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterGsHandler(gs.Context(), s, conn)
+	ttnpb.RegisterGsSessionsHandler(gs.Context(), s, conn)
 	ttnpb.RegisterGtwGsHandler(gs.Context(), s, conn)
 }
 
@@ -975,6 +999,9 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 	)
 	defer func() {
 		gs.connections.Delete(unique.ID(ctx, gtw.GetIds()))
+		if gs.captureRecorder != nil {
+			gs.captureRecorder.Expire(unique.ID(ctx, gtw.GetIds()))
+		}
 		registerGatewayDisconnect(ctx, gtw.GetIds(), protocol, ctx.Err())
 		logger.Info("Disconnected")
 	}()
@@ -1022,6 +1049,7 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 				}
 			}
 			registerReceiveUplink(ctx, gtw, msg, protocol)
+			gs.capture(ctx, gtw.GetIds(), &capture.Record{Up: msg.Message})
 			if crcStatus := msg.Message.CrcStatus; crcStatus != nil && !crcStatus.Value {
 				registerDropUplink(ctx, gtw, msg, "", errMessageCRC.New())
 				continue
@@ -1046,6 +1074,7 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 				d.CorrelationIds = events.CorrelationIDsFromContext(ctx)
 			}
 			registerReceiveTxAck(ctx, gtw, msg, protocol)
+			gs.capture(ctx, gtw.GetIds(), &capture.Record{TxAck: msg})
 			if msg.Result == ttnpb.TxAcknowledgment_SUCCESS {
 				registerSuccessDownlink(ctx, gtw, protocol)
 			} else {
@@ -1122,6 +1151,12 @@ func (gs *GatewayServer) updateConnStats(ctx context.Context, conn connectionEnt
 			DisconnectedAt: timestamppb.Now(),
 		}
 		registerGatewayConnectionStats(decoupledCtx, ids, stats)
+		gs.addConnectionSession(decoupledCtx, conn, stats.DisconnectedAt)
+		if gs.airtimeRegistry != nil {
+			if err := gs.airtimeRegistry.Set(decoupledCtx, ids, nil, 0); err != nil {
+				logger.WithError(err).Warn("Failed to clear airtime")
+			}
+		}
 		if gs.statsRegistry == nil {
 			return
 		}
@@ -1167,6 +1202,11 @@ func (gs *GatewayServer) updateConnStats(ctx context.Context, conn connectionEnt
 
 		stats, paths := conn.Stats()
 		registerGatewayConnectionStats(decoupledCtx, ids, stats)
+		if gs.airtimeRegistry != nil && len(stats.SubBands) > 0 {
+			if err := gs.airtimeRegistry.Set(decoupledCtx, ids, stats.SubBands, gs.config.Airtime.TTL); err != nil {
+				logger.WithError(err).Warn("Failed to update airtime")
+			}
+		}
 		if gs.statsRegistry == nil {
 			continue
 		}
@@ -21,6 +21,7 @@ import (
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/capture"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -94,6 +95,7 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		}
 
 		registerSendDownlink(ctx, conn.Gateway(), connDown, conn.Frontend().Protocol())
+		gs.capture(ctx, ids.GatewayIds, &capture.Record{Down: connDown})
 
 		return &ttnpb.ScheduleDownlinkResponse{
 			Delay: durationpb.New(delay),
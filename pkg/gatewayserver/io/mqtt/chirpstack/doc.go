@@ -0,0 +1,20 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chirpstack contains the gateway messages of ChirpStack Gateway Bridge and ChirpStack MQTT Forwarder,
+// generated from a wire compatible subset of the ChirpStack v4 API.
+//
+// ChirpStack Concentratord is supported through ChirpStack MQTT Forwarder. The ZeroMQ API of Concentratord is
+// not supported.
+package chirpstack
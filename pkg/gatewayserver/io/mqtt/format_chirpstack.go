@@ -0,0 +1,314 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/chirpstack"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	errUplinkMetadata = errors.DefineInvalidArgument("uplink_metadata", "missing uplink metadata")
+	errCodeRate       = errors.DefineInvalidArgument("code_rate", "unknown code rate `{code_rate}`")
+
+	codeRateFromChirpStack = map[chirpstack.CodeRate]string{
+		chirpstack.CodeRate_CR_4_5:    band.Cr4_5,
+		chirpstack.CodeRate_CR_4_6:    band.Cr4_6,
+		chirpstack.CodeRate_CR_4_7:    band.Cr4_7,
+		chirpstack.CodeRate_CR_4_8:    band.Cr4_8,
+		chirpstack.CodeRate_CR_LI_4_8: band.Cr4_8LI,
+	}
+	codeRateToChirpStack = map[string]chirpstack.CodeRate{
+		band.Cr4_5:   chirpstack.CodeRate_CR_4_5,
+		band.Cr4_6:   chirpstack.CodeRate_CR_4_6,
+		band.Cr4_7:   chirpstack.CodeRate_CR_4_7,
+		band.Cr4_8:   chirpstack.CodeRate_CR_4_8,
+		band.Cr4_8LI: chirpstack.CodeRate_CR_LI_4_8,
+	}
+	txAckStatusFromChirpStack = map[chirpstack.TxAckStatus]ttnpb.TxAcknowledgment_Result{
+		chirpstack.TxAckStatus_OK:               ttnpb.TxAcknowledgment_SUCCESS,
+		chirpstack.TxAckStatus_TOO_LATE:         ttnpb.TxAcknowledgment_TOO_LATE,
+		chirpstack.TxAckStatus_TOO_EARLY:        ttnpb.TxAcknowledgment_TOO_EARLY,
+		chirpstack.TxAckStatus_COLLISION_PACKET: ttnpb.TxAcknowledgment_COLLISION_PACKET,
+		chirpstack.TxAckStatus_COLLISION_BEACON: ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		chirpstack.TxAckStatus_TX_FREQ:          ttnpb.TxAcknowledgment_TX_FREQ,
+		chirpstack.TxAckStatus_TX_POWER:         ttnpb.TxAcknowledgment_TX_POWER,
+		chirpstack.TxAckStatus_GPS_UNLOCKED:     ttnpb.TxAcknowledgment_GPS_UNLOCKED,
+	}
+)
+
+type chirpStack struct {
+	topics.Layout
+	tokens io.DownlinkTokens
+}
+
+// TopicID implements topicIdentifier.
+// ChirpStack gateways are identified by the lowercase hexadecimal gateway EUI. If the gateway has no EUI,
+// the gateway ID is used instead.
+func (chirpStack) TopicID(ids *ttnpb.GatewayIdentifiers) string {
+	if eui := types.MustEUI64(ids.GetEui()); eui != nil {
+		return strings.ToLower(eui.String())
+	}
+	return ids.GetGatewayId()
+}
+
+func (cs chirpStack) FromDownlink(down *ttnpb.DownlinkMessage, ids *ttnpb.GatewayIdentifiers) ([]byte, error) {
+	settings := down.GetScheduled()
+	if settings == nil {
+		return nil, errNotScheduled.New()
+	}
+	modulation := &chirpstack.Modulation{}
+	switch dr := settings.GetDataRate().GetModulation().(type) {
+	case *ttnpb.DataRate_Lora:
+		codeRate, ok := codeRateToChirpStack[dr.Lora.CodingRate]
+		if !ok {
+			return nil, errCodeRate.WithAttributes("code_rate", dr.Lora.CodingRate)
+		}
+		modulation.Parameters = &chirpstack.Modulation_Lora{
+			Lora: &chirpstack.LoraModulationInfo{
+				Bandwidth:             dr.Lora.Bandwidth,
+				SpreadingFactor:       dr.Lora.SpreadingFactor,
+				PolarizationInversion: settings.GetDownlink().GetInvertPolarization(),
+				CodeRate:              codeRate,
+			},
+		}
+	case *ttnpb.DataRate_Fsk:
+		modulation.Parameters = &chirpstack.Modulation_Fsk{
+			Fsk: &chirpstack.FskModulationInfo{
+				FrequencyDeviation: dr.Fsk.BitRate / 2,
+				Datarate:           dr.Fsk.BitRate,
+			},
+		}
+	default:
+		return nil, errModulation.New()
+	}
+
+	txInfo := &chirpstack.DownlinkTxInfo{
+		Frequency:  uint32(settings.Frequency),
+		Power:      int32(settings.GetDownlink().GetTxPower() - eirpDelta),
+		Modulation: modulation,
+		Antenna:    settings.GetDownlink().GetAntennaIndex(),
+	}
+	switch {
+	case settings.Timestamp != 0:
+		// The context contains the concentrator counter, to which the delay is relative.
+		txInfo.Context = binary.BigEndian.AppendUint32(nil, settings.Timestamp)
+		txInfo.Timing = &chirpstack.Timing{
+			Parameters: &chirpstack.Timing_Delay{
+				Delay: &chirpstack.DelayTimingInfo{Delay: durationpb.New(0)},
+			},
+		}
+	case settings.Time != nil:
+		txInfo.Timing = &chirpstack.Timing{
+			Parameters: &chirpstack.Timing_GpsEpoch{
+				GpsEpoch: &chirpstack.GPSEpochTimingInfo{
+					TimeSinceGpsEpoch: durationpb.New(gpstime.ToGPS(settings.Time.AsTime())),
+				},
+			},
+		}
+	default:
+		txInfo.Timing = &chirpstack.Timing{
+			Parameters: &chirpstack.Timing_Immediately{
+				Immediately: &chirpstack.ImmediatelyTimingInfo{},
+			},
+		}
+	}
+
+	token, _ := cs.tokens.ParseTokenFromCorrelationIDs(down.CorrelationIds)
+	frame := &chirpstack.DownlinkFrame{
+		DownlinkId: uint32(token),
+		Items: []*chirpstack.DownlinkFrameItem{
+			{
+				PhyPayload: down.RawPayload,
+				TxInfo:     txInfo,
+			},
+		},
+		GatewayId: cs.TopicID(ids),
+	}
+	return proto.Marshal(frame)
+}
+
+func chirpStackLocation(loc *chirpstack.Location) *ttnpb.Location {
+	if loc.GetLatitude() == 0 && loc.GetLongitude() == 0 {
+		return nil
+	}
+	source := ttnpb.LocationSource_SOURCE_REGISTRY
+	if loc.Source == chirpstack.LocationSource_GPS {
+		source = ttnpb.LocationSource_SOURCE_GPS
+	}
+	return &ttnpb.Location{
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Altitude:  int32(loc.Altitude),
+		Accuracy:  int32(loc.Accuracy),
+		Source:    source,
+	}
+}
+
+func (chirpStack) ToUplink(message []byte, ids *ttnpb.GatewayIdentifiers) (*ttnpb.UplinkMessage, error) {
+	frame := &chirpstack.UplinkFrame{}
+	if err := proto.Unmarshal(message, frame); err != nil {
+		return nil, err
+	}
+	txInfo, rxInfo := frame.GetTxInfo(), frame.GetRxInfo()
+	if txInfo == nil || txInfo.Modulation == nil || rxInfo == nil {
+		return nil, errUplinkMetadata.New()
+	}
+
+	settings := &ttnpb.TxSettings{
+		Frequency: uint64(txInfo.Frequency),
+	}
+	switch modulation := txInfo.Modulation.Parameters.(type) {
+	case *chirpstack.Modulation_Lora:
+		codeRate, ok := codeRateFromChirpStack[modulation.Lora.GetCodeRate()]
+		if !ok {
+			return nil, errCodeRate.WithAttributes("code_rate", modulation.Lora.GetCodeRate().String())
+		}
+		settings.DataRate = &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{
+					Bandwidth:       modulation.Lora.GetBandwidth(),
+					SpreadingFactor: modulation.Lora.GetSpreadingFactor(),
+					CodingRate:      codeRate,
+				},
+			},
+		}
+	case *chirpstack.Modulation_Fsk:
+		settings.DataRate = &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Fsk{
+				Fsk: &ttnpb.FSKDataRate{
+					BitRate: modulation.Fsk.GetDatarate(),
+				},
+			},
+		}
+	default:
+		return nil, errModulation.New()
+	}
+
+	md := &ttnpb.RxMetadata{
+		GatewayIds:   ids,
+		AntennaIndex: rxInfo.Antenna,
+		ChannelIndex: rxInfo.Channel,
+		Rssi:         float32(rxInfo.Rssi),
+		ChannelRssi:  float32(rxInfo.Rssi),
+		Snr:          rxInfo.Snr,
+		Location:     chirpStackLocation(rxInfo.Location),
+	}
+	// The first four bytes of the context contain the concentrator counter.
+	if len(rxInfo.Context) >= 4 {
+		md.Timestamp = binary.BigEndian.Uint32(rxInfo.Context)
+		settings.Timestamp = md.Timestamp
+	}
+	if rxInfo.GwTime != nil {
+		md.Time = rxInfo.GwTime
+		settings.Time = md.Time
+	}
+	if rxInfo.TimeSinceGpsEpoch != nil {
+		md.GpsTime = timestamppb.New(gpstime.Parse(rxInfo.TimeSinceGpsEpoch.AsDuration()))
+	}
+	if rxInfo.FineTimeSinceGpsEpoch != nil {
+		md.FineTimestamp = uint64(rxInfo.FineTimeSinceGpsEpoch.AsDuration() % time.Second)
+	}
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: frame.PhyPayload,
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{md},
+	}
+	switch rxInfo.CrcStatus {
+	case chirpstack.CRCStatus_CRC_OK:
+		up.CrcStatus = wrapperspb.Bool(true)
+	case chirpstack.CRCStatus_BAD_CRC:
+		up.CrcStatus = wrapperspb.Bool(false)
+	}
+	return up, nil
+}
+
+func (chirpStack) ToStatus(message []byte, _ *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayStatus, error) {
+	stats := &chirpstack.GatewayStats{}
+	if err := proto.Unmarshal(message, stats); err != nil {
+		return nil, err
+	}
+	status := &ttnpb.GatewayStatus{
+		Metrics: map[string]float32{
+			"rxin": float32(stats.RxPacketsReceived),
+			"rxok": float32(stats.RxPacketsReceivedOk),
+			"txin": float32(stats.TxPacketsReceived),
+			"txok": float32(stats.TxPacketsEmitted),
+		},
+	}
+	if stats.Time != nil {
+		status.Time = stats.Time
+	}
+	if loc := chirpStackLocation(stats.Location); loc != nil {
+		status.AntennaLocations = []*ttnpb.Location{loc}
+	}
+	if len(stats.Metadata) > 0 || stats.ConfigVersion != "" {
+		status.Versions = make(map[string]string, len(stats.Metadata)+1)
+		for k, v := range stats.Metadata {
+			status.Versions[k] = v
+		}
+		if stats.ConfigVersion != "" {
+			status.Versions["config"] = stats.ConfigVersion
+		}
+	}
+	return status, nil
+}
+
+func (cs chirpStack) ToTxAck(message []byte, _ *ttnpb.GatewayIdentifiers) (*ttnpb.TxAcknowledgment, error) {
+	txAck := &chirpstack.DownlinkTxAck{}
+	if err := proto.Unmarshal(message, txAck); err != nil {
+		return nil, err
+	}
+	// The status of the single downlink frame item is the first status that is not ignored.
+	result := ttnpb.TxAcknowledgment_UNKNOWN_ERROR
+	for _, item := range txAck.Items {
+		status := item.GetStatus()
+		if status == chirpstack.TxAckStatus_IGNORED {
+			continue
+		}
+		if r, ok := txAckStatusFromChirpStack[status]; ok {
+			result = r
+		}
+		break
+	}
+	return &ttnpb.TxAcknowledgment{
+		CorrelationIds: []string{cs.tokens.FormatCorrelationID(uint16(txAck.DownlinkId))},
+		Result:         result,
+	}, nil
+}
+
+// NewChirpStack returns a format that uses the ChirpStack Gateway Bridge Protocol Buffers marshaling and
+// unmarshaling and topic structure. The topic prefix is typically the region, i.e. eu868.
+func NewChirpStack(ctx context.Context, topicPrefix string) Format {
+	return &chirpStack{
+		Layout: topics.NewChirpStack(ctx, topicPrefix),
+	}
+}
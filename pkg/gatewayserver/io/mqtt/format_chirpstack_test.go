@@ -0,0 +1,319 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/chirpstack"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var chirpStackGatewayIDs = &ttnpb.GatewayIdentifiers{
+	GatewayId: "gateway-id",
+	Eui:       []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+}
+
+func TestChirpStackTopics(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	format := mqtt.NewChirpStack(test.Context(), "eu868")
+
+	up := format.UplinkTopic("0102030405060708")
+	a.So(up, should.Resemble, []string{"eu868", "gateway", "0102030405060708", "event", "up"})
+	a.So(format.IsUplinkTopic(up), should.BeTrue)
+	a.So(format.IsStatusTopic(up), should.BeFalse)
+	a.So(format.IsUplinkTopic([]string{"gateway", "0102030405060708", "event", "up"}), should.BeFalse)
+	a.So(format.IsStatusTopic(format.StatusTopic("0102030405060708")), should.BeTrue)
+	a.So(format.IsTxAckTopic(format.TxAckTopic("0102030405060708")), should.BeTrue)
+	a.So(format.DownlinkTopic("0102030405060708"), should.Resemble,
+		[]string{"eu868", "gateway", "0102030405060708", "command", "down"})
+
+	a.So(mqtt.NewChirpStack(test.Context(), "").UplinkTopic("0102030405060708"), should.Resemble,
+		[]string{"gateway", "0102030405060708", "event", "up"})
+}
+
+func TestChirpStackDownlink(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	var tokens io.DownlinkTokens
+	down := &ttnpb.DownlinkMessage{
+		RawPayload:     []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+		CorrelationIds: []string{tokens.FormatCorrelationID(42)},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 12,
+							CodingRate:      band.Cr4_5,
+						},
+					},
+				},
+				Frequency: 869525000,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					TxPower:            16.15,
+					InvertPolarization: true,
+				},
+				Timestamp: 0x01020304,
+			},
+		},
+	}
+
+	buf, err := mqtt.NewChirpStack(test.Context(), "eu868").FromDownlink(down, chirpStackGatewayIDs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	actual := &chirpstack.DownlinkFrame{}
+	if !a.So(proto.Unmarshal(buf, actual), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(actual, should.Resemble, &chirpstack.DownlinkFrame{
+		DownlinkId: 42,
+		GatewayId:  "0102030405060708",
+		Items: []*chirpstack.DownlinkFrameItem{
+			{
+				PhyPayload: []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+				TxInfo: &chirpstack.DownlinkTxInfo{
+					Frequency: 869525000,
+					Power:     14,
+					Modulation: &chirpstack.Modulation{
+						Parameters: &chirpstack.Modulation_Lora{
+							Lora: &chirpstack.LoraModulationInfo{
+								Bandwidth:             125000,
+								SpreadingFactor:       12,
+								PolarizationInversion: true,
+								CodeRate:              chirpstack.CodeRate_CR_4_5,
+							},
+						},
+					},
+					Timing: &chirpstack.Timing{
+						Parameters: &chirpstack.Timing_Delay{
+							Delay: &chirpstack.DelayTimingInfo{Delay: durationpb.New(0)},
+						},
+					},
+					Context: []byte{0x01, 0x02, 0x03, 0x04},
+				},
+			},
+		},
+	})
+
+	_, err = mqtt.NewChirpStack(test.Context(), "eu868").FromDownlink(&ttnpb.DownlinkMessage{}, chirpStackGatewayIDs)
+	a.So(err, should.NotBeNil)
+}
+
+func TestChirpStackUplink(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	gwTime := time.Unix(1700000000, 500).UTC()
+	gpsTime := gpstime.ToGPS(gwTime)
+	fineTime := gpsTime + 123456789
+	frame := &chirpstack.UplinkFrame{
+		PhyPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		TxInfo: &chirpstack.UplinkTxInfo{
+			Frequency: 868100000,
+			Modulation: &chirpstack.Modulation{
+				Parameters: &chirpstack.Modulation_Lora{
+					Lora: &chirpstack.LoraModulationInfo{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodeRate:        chirpstack.CodeRate_CR_4_5,
+					},
+				},
+			},
+		},
+		RxInfo: &chirpstack.UplinkRxInfo{
+			GatewayId:             "0102030405060708",
+			UplinkId:              1234,
+			GwTime:                timestamppb.New(gwTime),
+			TimeSinceGpsEpoch:     durationpb.New(gpsTime),
+			FineTimeSinceGpsEpoch: durationpb.New(fineTime),
+			Rssi:                  -95,
+			Snr:                   7.5,
+			Channel:               2,
+			Antenna:               1,
+			Location: &chirpstack.Location{
+				Latitude:  52.37,
+				Longitude: 4.89,
+				Altitude:  10,
+				Source:    chirpstack.LocationSource_GPS,
+			},
+			Context:   []byte{0x00, 0x00, 0x03, 0xe8},
+			CrcStatus: chirpstack.CRCStatus_CRC_OK,
+			Metadata: map[string]string{
+				"region_config_id": "eu868",
+			},
+		},
+	}
+	buf, err := proto.Marshal(frame)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	up, err := mqtt.NewChirpStack(test.Context(), "eu868").ToUplink(buf, chirpStackGatewayIDs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up, should.Resemble, &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodingRate:      band.Cr4_5,
+					},
+				},
+			},
+			Frequency: 868100000,
+			Timestamp: 1000,
+			Time:      timestamppb.New(gwTime),
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIds:    chirpStackGatewayIDs,
+				AntennaIndex:  1,
+				ChannelIndex:  2,
+				Rssi:          -95,
+				ChannelRssi:   -95,
+				Snr:           7.5,
+				Timestamp:     1000,
+				Time:          timestamppb.New(gwTime),
+				GpsTime:       timestamppb.New(gwTime),
+				FineTimestamp: uint64(fineTime % time.Second),
+				Location: &ttnpb.Location{
+					Latitude:  52.37,
+					Longitude: 4.89,
+					Altitude:  10,
+					Source:    ttnpb.LocationSource_SOURCE_GPS,
+				},
+			},
+		},
+		CrcStatus: wrapperspb.Bool(true),
+	})
+
+	frame.TxInfo.Modulation = &chirpstack.Modulation{}
+	buf, err = proto.Marshal(frame)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = mqtt.NewChirpStack(test.Context(), "eu868").ToUplink(buf, chirpStackGatewayIDs)
+	a.So(err, should.NotBeNil)
+}
+
+func TestChirpStackStatus(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	now := time.Unix(1700000000, 0).UTC()
+	stats := &chirpstack.GatewayStats{
+		GatewayId:           "0102030405060708",
+		Time:                timestamppb.New(now),
+		ConfigVersion:       "1.2.3",
+		RxPacketsReceived:   10,
+		RxPacketsReceivedOk: 8,
+		TxPacketsReceived:   3,
+		TxPacketsEmitted:    2,
+		Metadata: map[string]string{
+			"concentratord_version": "4.3.0",
+		},
+	}
+	buf, err := proto.Marshal(stats)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	status, err := mqtt.NewChirpStack(test.Context(), "eu868").ToStatus(buf, chirpStackGatewayIDs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(status, should.Resemble, &ttnpb.GatewayStatus{
+		Time: timestamppb.New(now),
+		Metrics: map[string]float32{
+			"rxin": 10,
+			"rxok": 8,
+			"txin": 3,
+			"txok": 2,
+		},
+		Versions: map[string]string{
+			"concentratord_version": "4.3.0",
+			"config":                "1.2.3",
+		},
+	})
+}
+
+func TestChirpStackTxAck(t *testing.T) {
+	t.Parallel()
+	var tokens io.DownlinkTokens
+	for _, tc := range []struct {
+		Name     string
+		Items    []*chirpstack.DownlinkTxAckItem
+		Expected ttnpb.TxAcknowledgment_Result
+	}{
+		{
+			Name:     "OK",
+			Items:    []*chirpstack.DownlinkTxAckItem{{Status: chirpstack.TxAckStatus_OK}},
+			Expected: ttnpb.TxAcknowledgment_SUCCESS,
+		},
+		{
+			Name: "TooLate",
+			Items: []*chirpstack.DownlinkTxAckItem{
+				{Status: chirpstack.TxAckStatus_IGNORED},
+				{Status: chirpstack.TxAckStatus_TOO_LATE},
+			},
+			Expected: ttnpb.TxAcknowledgment_TOO_LATE,
+		},
+		{
+			Name:     "QueueFull",
+			Items:    []*chirpstack.DownlinkTxAckItem{{Status: chirpstack.TxAckStatus_QUEUE_FULL}},
+			Expected: ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			buf, err := proto.Marshal(&chirpstack.DownlinkTxAck{
+				DownlinkId: 42,
+				Items:      tc.Items,
+				GatewayId:  "0102030405060708",
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ack, err := mqtt.NewChirpStack(test.Context(), "eu868").ToTxAck(buf, chirpStackGatewayIDs)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ack, should.Resemble, &ttnpb.TxAcknowledgment{
+				CorrelationIds: []string{tokens.FormatCorrelationID(42)},
+				Result:         tc.Expected,
+			})
+		})
+	}
+}
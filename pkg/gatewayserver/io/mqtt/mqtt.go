@@ -59,12 +59,19 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 	)
 }
 
+// topicIdentifier is implemented by formats that identify gateways in topics by other means than the
+// gateway unique ID.
+type topicIdentifier interface {
+	TopicID(ids *ttnpb.GatewayIdentifiers) string
+}
+
 type connection struct {
 	format   Format
 	server   io.Server
 	io       *io.Connection
 	tokens   io.DownlinkTokens
 	resource ratelimit.Resource
+	topicID  string
 }
 
 func (*connection) Protocol() string            { return "mqtt" }
@@ -110,7 +117,7 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 					continue
 				}
 				logger.Info("Publish downlink message")
-				topicParts := format.DownlinkTopic(unique.ID(c.io.Context(), c.io.Gateway().GetIds()))
+				topicParts := format.DownlinkTopic(c.topicID)
 				session.Publish(&packet.PublishPacket{
 					TopicName:  topic.Join(topicParts),
 					TopicParts: topicParts,
@@ -177,18 +184,22 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 		return nil, err
 	}
 	c.resource = ratelimit.GatewayUpResource(ctx, ids)
+	c.topicID = uid
+	if ti, ok := c.format.(topicIdentifier); ok {
+		c.topicID = ti.TopicID(c.io.Gateway().GetIds())
+	}
 
 	access := topicAccess{
-		gtwUID: uid,
+		gtwUID: c.topicID,
 		reads: [][]string{
-			c.format.DownlinkTopic(uid),
+			c.format.DownlinkTopic(c.topicID),
 		},
 		writes: [][]string{
-			c.format.BirthTopic(uid),
-			c.format.LastWillTopic(uid),
-			c.format.UplinkTopic(uid),
-			c.format.StatusTopic(uid),
-			c.format.TxAckTopic(uid),
+			c.format.BirthTopic(c.topicID),
+			c.format.LastWillTopic(c.topicID),
+			c.format.UplinkTopic(c.topicID),
+			c.format.StatusTopic(c.topicID),
+			c.format.TxAckTopic(c.topicID),
 		},
 	}
 	info.Metadata = access
@@ -0,0 +1,106 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+	"strings"
+)
+
+type chirpStack struct {
+	prefix []string
+}
+
+func (cs *chirpStack) BirthTopic(id string) []string {
+	return cs.createTopic(id, "event", "conn")
+}
+
+func (cs *chirpStack) IsBirthTopic(path []string) bool {
+	return cs.isTopic(path, "event", "conn")
+}
+
+func (cs *chirpStack) LastWillTopic(id string) []string {
+	return cs.createTopic(id, "event", "conn")
+}
+
+func (cs *chirpStack) IsLastWillTopic(path []string) bool {
+	return cs.isTopic(path, "event", "conn")
+}
+
+func (cs *chirpStack) UplinkTopic(id string) []string {
+	return cs.createTopic(id, "event", "up")
+}
+
+func (cs *chirpStack) IsUplinkTopic(path []string) bool {
+	return cs.isTopic(path, "event", "up")
+}
+
+func (cs *chirpStack) StatusTopic(id string) []string {
+	return cs.createTopic(id, "event", "stats")
+}
+
+func (cs *chirpStack) IsStatusTopic(path []string) bool {
+	return cs.isTopic(path, "event", "stats")
+}
+
+func (cs *chirpStack) TxAckTopic(id string) []string {
+	return cs.createTopic(id, "event", "ack")
+}
+
+func (cs *chirpStack) IsTxAckTopic(path []string) bool {
+	return cs.isTopic(path, "event", "ack")
+}
+
+func (cs *chirpStack) DownlinkTopic(id string) []string {
+	return cs.createTopic(id, "command", "down")
+}
+
+func (cs *chirpStack) createTopic(id string, path ...string) []string {
+	topic := make([]string, 0, len(cs.prefix)+2+len(path))
+	topic = append(topic, cs.prefix...)
+	topic = append(topic, "gateway", id)
+	return append(topic, path...)
+}
+
+func (cs *chirpStack) isTopic(path []string, suffix ...string) bool {
+	if len(path) != len(cs.prefix)+2+len(suffix) {
+		return false
+	}
+	for i, part := range cs.prefix {
+		if path[i] != part {
+			return false
+		}
+	}
+	if path[len(cs.prefix)] != "gateway" {
+		return false
+	}
+	for i, part := range suffix {
+		if path[len(cs.prefix)+2+i] != part {
+			return false
+		}
+	}
+	return true
+}
+
+// NewChirpStack returns a topic layout that uses the ChirpStack Gateway Bridge topic structure
+// ([prefix/]gateway/<gateway-id>/event/<event> and [prefix/]gateway/<gateway-id>/command/<command>).
+// The prefix is typically the region, i.e. eu868.
+func NewChirpStack(ctx context.Context, prefix string) Layout {
+	cs := &chirpStack{}
+	if prefix = strings.Trim(prefix, "/"); prefix != "" {
+		cs.prefix = strings.Split(prefix, "/")
+	}
+	return cs
+}
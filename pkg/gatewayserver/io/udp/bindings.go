@@ -0,0 +1,115 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	// AllowedCIDRsAttribute is the gateway attribute with the comma separated CIDRs from which the gateway may send
+	// traffic. If the attribute is not set, traffic is allowed from any address that is not denied.
+	AllowedCIDRsAttribute = "udp-allowed-cidrs"
+	// DeniedCIDRsAttribute is the gateway attribute with the comma separated CIDRs from which the gateway may not send
+	// traffic. Denied CIDRs take precedence over allowed CIDRs.
+	DeniedCIDRsAttribute = "udp-denied-cidrs"
+)
+
+var (
+	errAddrBindings = errors.DefineInvalidArgument(
+		"addr_bindings", "invalid CIDRs `{value}` in gateway attribute `{attribute}`",
+	)
+	errAddrNotAllowed = errors.DefinePermissionDenied(
+		"addr_not_allowed", "gateway traffic from address `{ip}` is not allowed",
+	)
+)
+
+// addrBindings contains the CIDRs from which a gateway is allowed and denied to send traffic.
+type addrBindings struct {
+	allowed []netip.Prefix
+	denied  []netip.Prefix
+}
+
+func parseCIDRs(attributes map[string]string, attribute string) ([]netip.Prefix, error) {
+	value, ok := attributes[attribute]
+	if !ok {
+		return nil, nil
+	}
+	var prefixes []netip.Prefix
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, errAddrBindings.WithAttributes("attribute", attribute, "value", value).WithCause(err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	if len(prefixes) == 0 {
+		return nil, errAddrBindings.WithAttributes("attribute", attribute, "value", value)
+	}
+	return prefixes, nil
+}
+
+// parseAddrBindings parses the address bindings from the gateway attributes.
+// It returns nil if the gateway has no address bindings.
+func parseAddrBindings(attributes map[string]string) (*addrBindings, error) {
+	allowed, err := parseCIDRs(attributes, AllowedCIDRsAttribute)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := parseCIDRs(attributes, DeniedCIDRsAttribute)
+	if err != nil {
+		return nil, err
+	}
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, nil
+	}
+	return &addrBindings{
+		allowed: allowed,
+		denied:  denied,
+	}, nil
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter returns an error if the gateway is not allowed to send traffic from the given IP address.
+func (b *addrBindings) filter(ip net.IP) error {
+	if b == nil {
+		return nil
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return errNoAddress.New()
+	}
+	addr = addr.Unmap()
+	if containsAddr(b.denied, addr) || len(b.allowed) > 0 && !containsAddr(b.allowed, addr) {
+		return errAddrNotAllowed.WithAttributes("ip", addr.String())
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAddrBindings(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name             string
+		Attributes       map[string]string
+		ParseErrorAssert func(error) bool
+		Allowed          []string
+		NotAllowed       []string
+	}{
+		{
+			Name:    "NoAttributes",
+			Allowed: []string{"192.0.2.1", "2001:db8::1"},
+		},
+		{
+			Name: "Allowed",
+			Attributes: map[string]string{
+				AllowedCIDRsAttribute: "192.0.2.0/24, 2001:db8::/32",
+			},
+			Allowed:    []string{"192.0.2.1", "::ffff:192.0.2.1", "2001:db8::1"},
+			NotAllowed: []string{"198.51.100.1", "2001:db9::1"},
+		},
+		{
+			Name: "Denied",
+			Attributes: map[string]string{
+				DeniedCIDRsAttribute: "198.51.100.0/24",
+			},
+			Allowed:    []string{"192.0.2.1"},
+			NotAllowed: []string{"198.51.100.1"},
+		},
+		{
+			Name: "AllowedAndDenied",
+			Attributes: map[string]string{
+				AllowedCIDRsAttribute: "10.0.0.0/8",
+				DeniedCIDRsAttribute:  "10.1.0.0/16",
+			},
+			Allowed:    []string{"10.0.0.1", "10.2.0.1"},
+			NotAllowed: []string{"10.1.0.1", "192.0.2.1"},
+		},
+		{
+			Name: "Invalid",
+			Attributes: map[string]string{
+				AllowedCIDRsAttribute: "192.0.2.1",
+			},
+			ParseErrorAssert: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Empty",
+			Attributes: map[string]string{
+				DeniedCIDRsAttribute: " , ",
+			},
+			ParseErrorAssert: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			bindings, err := parseAddrBindings(tc.Attributes)
+			if tc.ParseErrorAssert != nil {
+				a.So(tc.ParseErrorAssert(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			for _, ip := range tc.Allowed {
+				a.So(bindings.filter(net.ParseIP(ip)), should.BeNil)
+			}
+			for _, ip := range tc.NotAllowed {
+				a.So(errors.IsPermissionDenied(bindings.filter(net.ParseIP(ip))), should.BeTrue)
+			}
+		})
+	}
+}
@@ -26,6 +26,16 @@ type RateLimitingConfig struct {
 	Threshold time.Duration `name:"threshold" description:"Filter packet if timestamp is not newer than the older timestamps of the previous messages by this threshold"` //nolint:lll
 }
 
+// SharedAddrChangeBlockConfig contains configuration settings for sharing the gateway addresses of the address change
+// firewall between Gateway Server instances.
+type SharedAddrChangeBlockConfig struct {
+	Enable          bool          `name:"enable" description:"Share the gateway addresses between Gateway Server instances to block address changes"`          //nolint:lll
+	RefreshInterval time.Duration `name:"refresh-interval" description:"Interval to refresh the locally cached gateway addresses in the shared registry"`      //nolint:lll
+	FailOpen        bool          `name:"fail-open" description:"Accept traffic from gateways with unknown addresses when the shared registry is unavailable"` //nolint:lll
+
+	Registry AddrRegistry `name:"-"`
+}
+
 // Config contains configuration settings for the UDP gateway frontend.
 // Use DefaultConfig for recommended settings.
 type Config struct {
@@ -49,6 +59,8 @@ type Config struct {
 	ScheduleLateTime time.Duration `name:"schedule-late-time" description:"Time in advance to send downlink to the gateway when scheduling late"`
 	// AddrChangeBlock defines the time to block traffic when the address changes.
 	AddrChangeBlock time.Duration `name:"addr-change-block" description:"Time to block traffic when a gateway's address changes"`
+	// AddrChangeBlockShared is the configuration for sharing the gateway addresses between Gateway Server instances.
+	AddrChangeBlockShared SharedAddrChangeBlockConfig `name:"addr-change-block-shared"`
 	// RateLimitingConfig is the configuration for the rate limiting firewall capabilities.
 	RateLimiting RateLimitingConfig `name:"rate-limiting"`
 }
@@ -64,6 +76,9 @@ var DefaultConfig = Config{
 	ConnectionErrorExpires: 5 * time.Minute,
 	ScheduleLateTime:       800 * time.Millisecond,
 	AddrChangeBlock:        0, // Release address when the connection expires.
+	AddrChangeBlockShared: SharedAddrChangeBlockConfig{
+		RefreshInterval: 10 * time.Second,
+	},
 	RateLimiting: RateLimitingConfig{
 		Enable:    true,
 		Messages:  10,
@@ -0,0 +1,204 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// AddrRegistry stores the addresses of gateways. It allows multiple Gateway Server instances to share the state of
+// the address change firewall, and the state to survive restarts.
+type AddrRegistry interface {
+	// Bind binds the gateway EUI to the address for the given time to live.
+	// If the gateway EUI is bound to another address, the binding is not changed and the bound address is returned.
+	Bind(ctx context.Context, eui types.EUI64, ip net.IP, ttl time.Duration) (net.IP, error)
+}
+
+var errAddrRegistry = errors.DefineUnavailable("addr_registry", "gateway address registry unavailable")
+
+// registryEntry is the locally cached address of a gateway in the registry.
+// If owned is true, the address is bound by this firewall and the binding is refreshed while the gateway sends
+// traffic. Otherwise, the address is bound by another Gateway Server instance.
+type registryEntry struct {
+	ip       net.IP
+	owned    bool
+	lastSeen time.Time
+	expires  time.Time
+}
+
+type registryFirewall struct {
+	ctx             context.Context
+	registry        AddrRegistry
+	addrChangeBlock time.Duration
+	refreshInterval time.Duration
+	failOpen        bool
+
+	mu      sync.Mutex
+	entries map[types.EUI64]*registryEntry
+}
+
+// NewRegistryFirewall returns a Firewall that stores the addresses of gateways in the given registry.
+// The addresses are cached locally, and the bindings of gateways that send traffic are refreshed in the registry
+// every refresh interval, so that packets of known gateways do not wait for the registry.
+// If failOpen is true, traffic of gateways with unknown addresses is accepted when the registry is unavailable.
+// Otherwise, such traffic is dropped.
+func NewRegistryFirewall(
+	ctx context.Context,
+	registry AddrRegistry,
+	addrChangeBlock, refreshInterval time.Duration,
+	failOpen bool,
+) Firewall {
+	if refreshInterval <= 0 || refreshInterval >= addrChangeBlock {
+		refreshInterval = addrChangeBlock / 2
+	}
+	f := &registryFirewall{
+		ctx:             ctx,
+		registry:        registry,
+		addrChangeBlock: addrChangeBlock,
+		refreshInterval: refreshInterval,
+		failOpen:        failOpen,
+		entries:         make(map[types.EUI64]*registryEntry),
+	}
+	go func() {
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				f.refresh()
+			}
+		}
+	}()
+	return f
+}
+
+// Filter implements Firewall.
+func (f *registryFirewall) Filter(packet encoding.Packet) error {
+	if packet.GatewayEUI == nil {
+		return errNoEUI.New()
+	}
+	if packet.GatewayAddr == nil {
+		return errNoAddress.New()
+	}
+	eui, ip := *packet.GatewayEUI, packet.GatewayAddr.IP
+	now := time.Now()
+
+	f.mu.Lock()
+	entry, ok := f.entries[eui]
+	if ok && now.Before(entry.expires) {
+		switch {
+		case !entry.ip.Equal(ip):
+			f.mu.Unlock()
+			return errAlreadyConnected.WithAttributes(
+				"connected_ip", entry.ip.String(),
+				"connecting_ip", ip.String(),
+			)
+		case entry.owned:
+			entry.lastSeen = now
+			f.mu.Unlock()
+			return nil
+		}
+	}
+	f.mu.Unlock()
+
+	bound, err := f.registry.Bind(f.ctx, eui, ip, f.addrChangeBlock)
+	if err != nil {
+		logger := log.FromContext(f.ctx).WithError(err).WithField("gateway_eui", eui)
+		if f.failOpen {
+			logger.Warn("Failed to bind gateway address, accept traffic")
+			return nil
+		}
+		logger.Warn("Failed to bind gateway address, drop traffic")
+		return errAddrRegistry.WithCause(err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if bound != nil {
+		// The address of another Gateway Server instance is cached until the next refresh, as its expiry is unknown.
+		f.entries[eui] = &registryEntry{
+			ip:      bound,
+			expires: now.Add(f.refreshInterval),
+		}
+		return errAlreadyConnected.WithAttributes(
+			"connected_ip", bound.String(),
+			"connecting_ip", ip.String(),
+		)
+	}
+	f.entries[eui] = &registryEntry{
+		ip:       ip,
+		owned:    true,
+		lastSeen: now,
+		expires:  now.Add(f.addrChangeBlock),
+	}
+	return nil
+}
+
+// refresh extends the bindings of the owned addresses in the registry until the address change block passes since
+// the gateways were last seen, and removes the expired entries.
+func (f *registryFirewall) refresh() {
+	now := time.Now()
+	refresh := make(map[types.EUI64]*registryEntry)
+	f.mu.Lock()
+	for eui, entry := range f.entries {
+		switch {
+		case entry.owned && now.Before(entry.lastSeen.Add(f.addrChangeBlock)):
+			refresh[eui] = entry
+		case !now.Before(entry.expires):
+			delete(f.entries, eui)
+		}
+	}
+	f.mu.Unlock()
+
+	for eui, entry := range refresh {
+		f.mu.Lock()
+		ip, expires := entry.ip, entry.lastSeen.Add(f.addrChangeBlock)
+		f.mu.Unlock()
+		ttl := time.Until(expires)
+		if ttl <= 0 {
+			continue
+		}
+		bound, err := f.registry.Bind(f.ctx, eui, ip, ttl)
+		if err != nil {
+			// The cached entry is used until it expires, after which the registry is consulted again.
+			log.FromContext(f.ctx).WithError(err).WithField("gateway_eui", eui).Warn(
+				"Failed to refresh gateway address",
+			)
+			continue
+		}
+		f.mu.Lock()
+		if f.entries[eui] == entry {
+			if bound != nil {
+				f.entries[eui] = &registryEntry{
+					ip:      bound,
+					expires: now.Add(f.refreshInterval),
+				}
+			} else {
+				entry.expires = expires
+			}
+		}
+		f.mu.Unlock()
+	}
+}
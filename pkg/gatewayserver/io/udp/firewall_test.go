@@ -15,8 +15,10 @@
 package udp_test
 
 import (
+	"context"
 	"net"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -149,3 +151,124 @@ func TestMemoryFirewall(t *testing.T) {
 		})
 	}
 }
+
+type mockAddrRegistry struct {
+	mu    sync.Mutex
+	addrs map[types.EUI64]net.IP
+	binds int
+	err   error
+}
+
+func (r *mockAddrRegistry) Bind(_ context.Context, eui types.EUI64, ip net.IP, _ time.Duration) (net.IP, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.binds++
+	if bound, ok := r.addrs[eui]; ok && !bound.Equal(ip) {
+		return bound, nil
+	}
+	r.addrs[eui] = ip
+	return nil, nil
+}
+
+func (r *mockAddrRegistry) setError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+}
+
+func (r *mockAddrRegistry) bindCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.binds
+}
+
+func TestRegistryFirewall(t *testing.T) {
+	t.Parallel()
+
+	eui1 := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	eui2 := types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	addr1 := &net.UDPAddr{IP: []byte{0x01, 0x01, 0x01, 0x01}, Port: 1}
+	addr2 := &net.UDPAddr{IP: []byte{0x02, 0x02, 0x02, 0x02}, Port: 1}
+
+	t.Run("Cache", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+
+		registry := &mockAddrRegistry{
+			addrs: map[types.EUI64]net.IP{
+				eui2: addr2.IP,
+			},
+		}
+		v := NewRegistryFirewall(ctx, registry, time.Minute, 30*time.Second, false)
+
+		a.So(errors.IsInvalidArgument(v.Filter(encoding.Packet{GatewayAddr: addr1})), should.BeTrue)
+		a.So(errors.IsInvalidArgument(v.Filter(encoding.Packet{GatewayEUI: &eui1})), should.BeTrue)
+		a.So(v.Filter(encoding.Packet{GatewayEUI: &eui1, GatewayAddr: addr1}), should.BeNil)
+		a.So(registry.bindCount(), should.Equal, 1)
+
+		// Known gateways are filtered with the local cache.
+		a.So(v.Filter(encoding.Packet{GatewayEUI: &eui1, GatewayAddr: addr1}), should.BeNil)
+		a.So(errors.IsFailedPrecondition(v.Filter(encoding.Packet{GatewayEUI: &eui1, GatewayAddr: addr2})), should.BeTrue)
+		a.So(registry.bindCount(), should.Equal, 1)
+
+		// Addresses bound by other instances are cached too.
+		a.So(errors.IsFailedPrecondition(v.Filter(encoding.Packet{GatewayEUI: &eui2, GatewayAddr: addr1})), should.BeTrue)
+		a.So(errors.IsFailedPrecondition(v.Filter(encoding.Packet{GatewayEUI: &eui2, GatewayAddr: addr1})), should.BeTrue)
+		a.So(registry.bindCount(), should.Equal, 2)
+	})
+
+	t.Run("FailClosed", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+
+		registry := &mockAddrRegistry{
+			addrs: make(map[types.EUI64]net.IP),
+		}
+		v := NewRegistryFirewall(ctx, registry, time.Minute, 30*time.Second, false)
+		a.So(v.Filter(encoding.Packet{GatewayEUI: &eui1, GatewayAddr: addr1}), should.BeNil)
+
+		// Known gateways are not affected when the registry is unavailable, but unknown gateways are dropped.
+		registry.setError(errors.New("unavailable"))
+		a.So(v.Filter(encoding.Packet{GatewayEUI: &eui1, GatewayAddr: addr1}), should.BeNil)
+		a.So(errors.IsUnavailable(v.Filter(encoding.Packet{GatewayEUI: &eui2, GatewayAddr: addr2})), should.BeTrue)
+	})
+
+	t.Run("FailOpen", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+
+		registry := &mockAddrRegistry{
+			addrs: make(map[types.EUI64]net.IP),
+			err:   errors.New("unavailable"),
+		}
+		v := NewRegistryFirewall(ctx, registry, time.Minute, 30*time.Second, true)
+		a.So(v.Filter(encoding.Packet{GatewayEUI: &eui2, GatewayAddr: addr2}), should.BeNil)
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+
+		registry := &mockAddrRegistry{
+			addrs: make(map[types.EUI64]net.IP),
+		}
+		v := NewRegistryFirewall(ctx, registry, 200*time.Millisecond, 20*time.Millisecond, false)
+		a.So(v.Filter(encoding.Packet{GatewayEUI: &eui1, GatewayAddr: addr1}), should.BeNil)
+		time.Sleep(100 * time.Millisecond)
+
+		// The binding of the gateway is refreshed in the background while it is not expired.
+		a.So(registry.bindCount(), should.BeGreaterThan, 1)
+		a.So(errors.IsFailedPrecondition(v.Filter(encoding.Packet{GatewayEUI: &eui1, GatewayAddr: addr2})), should.BeTrue)
+	})
+}
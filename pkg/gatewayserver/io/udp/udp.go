@@ -76,7 +76,13 @@ func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, conf Config
 	defer cancel()
 	var firewall Firewall = noopFirewall{}
 	if conf.AddrChangeBlock > 0 {
-		firewall = NewMemoryFirewall(ctx, conf.AddrChangeBlock)
+		if shared := conf.AddrChangeBlockShared; shared.Registry != nil {
+			firewall = NewRegistryFirewall(
+				ctx, shared.Registry, conf.AddrChangeBlock, shared.RefreshInterval, shared.FailOpen,
+			)
+		} else {
+			firewall = NewMemoryFirewall(ctx, conf.AddrChangeBlock)
+		}
 	}
 	if conf.RateLimiting.Enable {
 		firewall = NewRateLimitingFirewall(firewall, conf.RateLimiting.Messages, conf.RateLimiting.Threshold)
@@ -275,6 +281,11 @@ func (s *srv) handlePacket(ctx context.Context, packet encoding.Packet) {
 		logger.WithError(err).Warn("Failed to connect")
 		return
 	}
+	if err := cs.bindings.filter(packet.GatewayAddr.IP); err != nil {
+		logger.WithError(err).Warn("Packet filtered")
+		registerMessageDropped(ctx, err)
+		return
+	}
 
 	if err := s.handleUp(cs.io.Context(), cs, packet); err != nil {
 		logger.WithError(err).Warn("Failed to handle upstream packet")
@@ -299,7 +310,9 @@ func (s *srv) connect(ctx context.Context, eui types.EUI64, addr *net.UDPAddr) (
 		defer func() {
 			if err != nil {
 				del := func() { s.connections.Delete(eui) }
-				if expiration := s.config.ConnectionErrorExpires; expiration != 0 {
+				// Errors caused by the address of the gateway are not cached, as the gateway may send traffic from
+				// another address that is allowed.
+				if expiration := s.config.ConnectionErrorExpires; expiration != 0 && !errors.Is(err, errAddrNotAllowed) {
 					time.AfterFunc(expiration, del)
 				} else {
 					del()
@@ -330,6 +343,14 @@ func (s *srv) connect(ctx context.Context, eui types.EUI64, addr *net.UDPAddr) (
 		if err != nil {
 			return nil, err
 		}
+		if cs.bindings, err = parseAddrBindings(conn.Gateway().GetAttributes()); err != nil {
+			conn.Disconnect(err)
+			return nil, err
+		}
+		if err = cs.bindings.filter(addr.IP); err != nil {
+			conn.Disconnect(err)
+			return nil, err
+		}
 	} else {
 		select {
 		case <-cs.ioWait:
@@ -615,6 +636,8 @@ type state struct {
 	io     *io.Connection
 	ioErr  error
 
+	bindings *addrBindings
+
 	clock   scheduling.RolloverClock
 	clockMu sync.RWMutex
 
@@ -0,0 +1,127 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errInvalidTTL = errors.DefineInvalidArgument("invalid_ttl", "invalid time to live `{ttl}`")
+
+// GatewayConnectionSessionRegistry implements the GatewayConnectionSessionRegistry interface.
+// The sessions of a gateway are stored in a sorted set, scored by the disconnect time in milliseconds.
+type GatewayConnectionSessionRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayConnectionSessionRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Add adds a connection session of a gateway. The session must have a disconnect time.
+// The oldest sessions are removed when the gateway has more than max sessions, or when the sessions are
+// older than the time to live. A time to live of 0 keeps the sessions until they exceed max.
+func (r *GatewayConnectionSessionRegistry) Add(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	session *ttnpb.GatewayConnectionSession,
+	max int,
+	ttl time.Duration,
+) error {
+	if ttl < 0 {
+		return errInvalidTTL.WithAttributes("ttl", ttl)
+	}
+	s, err := ttnredis.MarshalProto(session)
+	if err != nil {
+		return err
+	}
+	uk := r.key(unique.ID(ctx, ids))
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.ZAdd(ctx, uk, redis.Z{
+			Score:  float64(session.GetDisconnectedAt().AsTime().UnixMilli()),
+			Member: s,
+		})
+		if max > 0 {
+			p.ZRemRangeByRank(ctx, uk, 0, int64(-max-1))
+		}
+		if ttl == 0 {
+			p.Persist(ctx, uk)
+			return nil
+		}
+		expired := time.Now().Add(-ttl).UnixMilli()
+		p.ZRemRangeByScore(ctx, uk, "-inf", "("+strconv.FormatInt(expired, 10))
+		p.PExpire(ctx, uk, ttl)
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// List returns the connection sessions of a gateway that ended after the given time, from the most recent to the
+// oldest, starting at offset and limited to limit sessions. A limit of 0 lists all sessions from the offset.
+// It also returns the total number of such sessions.
+func (r *GatewayConnectionSessionRegistry) List(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	disconnectedAfter time.Time,
+	offset, limit int,
+) ([]*ttnpb.GatewayConnectionSession, int64, error) {
+	uk := r.key(unique.ID(ctx, ids))
+	count := int64(limit)
+	if limit == 0 {
+		count = -1
+	}
+	min := "-inf"
+	if !disconnectedAfter.IsZero() {
+		min = "(" + strconv.FormatInt(disconnectedAfter.UnixMilli(), 10)
+	}
+	var (
+		totalCmd *redis.IntCmd
+		valsCmd  *redis.StringSliceCmd
+	)
+	if _, err := r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		totalCmd = p.ZCount(ctx, uk, min, "+inf")
+		valsCmd = p.ZRevRangeByScore(ctx, uk, &redis.ZRangeBy{
+			Min:    min,
+			Max:    "+inf",
+			Offset: int64(offset),
+			Count:  count,
+		})
+		return nil
+	}); err != nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	vals := valsCmd.Val()
+	res := make([]*ttnpb.GatewayConnectionSession, 0, len(vals))
+	for _, val := range vals {
+		session := &ttnpb.GatewayConnectionSession{}
+		if err := ttnredis.UnmarshalProto(val, session); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to decode connection session")
+			continue
+		}
+		res = append(res, session)
+	}
+	return res, totalCmd.Val(), nil
+}
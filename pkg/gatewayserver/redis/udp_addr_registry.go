@@ -0,0 +1,57 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// bindAddrScript binds the address in ARGV[1] to KEYS[1] for ARGV[2] milliseconds.
+// It returns the bound address if another address is bound, and nil otherwise.
+var bindAddrScript = redis.NewScript(`local ip = redis.call('get', KEYS[1])
+if ip and ip ~= ARGV[1] then
+	return ip
+end
+redis.call('set', KEYS[1], ARGV[1], 'px', ARGV[2])
+return false`)
+
+// UDPAddrRegistry implements the AddrRegistry interface of the UDP frontend.
+type UDPAddrRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *UDPAddrRegistry) key(eui types.EUI64) string {
+	return r.Redis.Key("eui", eui.String())
+}
+
+// Bind binds the gateway EUI to the address for the given time to live.
+// If the gateway EUI is bound to another address, the binding is not changed and the bound address is returned.
+func (r *UDPAddrRegistry) Bind(ctx context.Context, eui types.EUI64, ip net.IP, ttl time.Duration) (net.IP, error) {
+	bound, err := bindAddrScript.Run(ctx, r.Redis, []string{r.key(eui)}, ip.String(), ttl.Milliseconds()).Text()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	return net.ParseIP(bound), nil
+}
@@ -41,6 +41,29 @@ type GatewayConnectionStatsRegistry interface {
 	) error
 }
 
+// GatewayConnectionSessionRegistry stores the past connection sessions of gateways.
+type GatewayConnectionSessionRegistry interface {
+	// Add adds a connection session of a gateway. The session must have a disconnect time.
+	// The oldest sessions are removed when the gateway has more than max sessions, or when the sessions are
+	// older than the time to live. A time to live of 0 keeps the sessions until they exceed max.
+	Add(
+		ctx context.Context,
+		ids *ttnpb.GatewayIdentifiers,
+		session *ttnpb.GatewayConnectionSession,
+		max int,
+		ttl time.Duration,
+	) error
+	// List returns the connection sessions of a gateway that ended after the given time, from the most recent to the
+	// oldest, starting at offset and limited to limit sessions. A limit of 0 lists all sessions from the offset.
+	// It also returns the total number of such sessions.
+	List(
+		ctx context.Context,
+		ids *ttnpb.GatewayIdentifiers,
+		disconnectedAfter time.Time,
+		offset, limit int,
+	) ([]*ttnpb.GatewayConnectionSession, int64, error)
+}
+
 // EntityRegistry abstracts the Identity server gateway functions.
 type EntityRegistry interface {
 	// AssertGatewayRights checks whether the gateway authentication (provied in the context) contains the required rights.
@@ -0,0 +1,90 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/sessions"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errConnectionSessionsDisabled = errors.DefineFailedPrecondition(
+	"connection_sessions_disabled", "gateway connection sessions are disabled",
+)
+
+// connectionSessionsPageSize is the number of connection sessions that are listed when no limit is requested.
+const connectionSessionsPageSize = 100
+
+// addConnectionSession adds the connection session of the disconnected gateway, if storing connection sessions is
+// enabled.
+func (gs *GatewayServer) addConnectionSession(
+	ctx context.Context, conn connectionEntry, disconnectedAt *timestamppb.Timestamp,
+) {
+	if gs.sessionRegistry == nil {
+		return
+	}
+	if err := gs.sessionRegistry.Add(
+		ctx,
+		conn.Gateway().GetIds(),
+		sessions.FromConnection(conn.Connection, disconnectedAt.AsTime()),
+		gs.config.ConnectionSessions.Max,
+		gs.config.ConnectionSessions.TTL,
+	); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to add connection session")
+	}
+}
+
+// ListGatewayConnectionSessions implements ttnpb.GsSessionsServer.
+func (gs *GatewayServer) ListGatewayConnectionSessions(
+	ctx context.Context, req *ttnpb.ListGatewayConnectionSessionsRequest,
+) (*ttnpb.GatewayConnectionSessions, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GetGatewayIds(), ttnpb.Right_RIGHT_GATEWAY_STATUS_READ,
+	); err != nil {
+		return nil, err
+	}
+	if gs.sessionRegistry == nil {
+		return nil, errConnectionSessionsDisabled.New()
+	}
+	limit, page := int(req.GetLimit()), int(req.GetPage())
+	if limit == 0 {
+		limit = connectionSessionsPageSize
+	}
+	if page == 0 {
+		page = 1
+	}
+	var disconnectedAfter time.Time
+	if ts := req.GetDisconnectedAfter(); ts != nil {
+		disconnectedAfter = ts.AsTime()
+	}
+	res, total, err := gs.sessionRegistry.List(
+		ctx, req.GetGatewayIds(), disconnectedAfter, (page-1)*limit, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.FormatInt(total, 10)))
+	return &ttnpb.GatewayConnectionSessions{
+		Sessions: res,
+	}, nil
+}
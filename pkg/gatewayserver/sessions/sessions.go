@@ -0,0 +1,136 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sessions provides the past connection sessions of gateways and the analytics of gateway reconnects.
+package sessions
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromConnection returns the connection session of the gateway connection that disconnected at the given time.
+func FromConnection(conn *io.Connection, disconnectedAt time.Time) *ttnpb.GatewayConnectionSession {
+	session := &ttnpb.GatewayConnectionSession{
+		ConnectedAt:          timestamppb.New(conn.ConnectTime()),
+		DisconnectedAt:       timestamppb.New(disconnectedAt),
+		Protocol:             conn.Frontend().Protocol(),
+		GatewayRemoteAddress: conn.GatewayRemoteAddress(),
+	}
+	if count, _, ok := conn.UpStats(); ok {
+		session.UplinkCount = count
+	}
+	if count, _, ok := conn.DownStats(); ok {
+		session.DownlinkCount = count
+	}
+	if count, _, ok := conn.TxAckStats(); ok {
+		session.TxAcknowledgmentCount = count
+	}
+	if min, max, median, p90, count := conn.RTTStats(90, disconnectedAt); count > 0 {
+		_, _, _, p99, _ := conn.RTTStats(99, disconnectedAt)
+		session.RoundTripTimes = &ttnpb.GatewayConnectionSession_RoundTripTimes{
+			Min:    durationpb.New(min),
+			Max:    durationpb.New(max),
+			Median: durationpb.New(median),
+			P90:    durationpb.New(p90),
+			P99:    durationpb.New(p99),
+			Count:  uint32(count),
+		}
+	}
+	return session
+}
+
+// Summary is the summary of the connection sessions of a gateway.
+type Summary struct {
+	// Sessions is the number of connection sessions.
+	Sessions int `json:"sessions"`
+	// Since is the connect time of the oldest session.
+	Since time.Time `json:"since"`
+	// Until is the disconnect time of the most recent session.
+	Until time.Time `json:"until"`
+	// Connected is the total time that the gateway was connected.
+	Connected time.Duration `json:"connected"`
+	// MeanDuration is the mean duration of the sessions.
+	MeanDuration time.Duration `json:"mean_duration"`
+	// MeanReconnectDelay is the mean time between a disconnect and the next connect.
+	MeanReconnectDelay time.Duration `json:"mean_reconnect_delay"`
+	// ShortSessions is the number of sessions that lasted shorter than the short session duration.
+	ShortSessions int `json:"short_sessions"`
+	// UplinkCount is the total number of uplink messages.
+	UplinkCount uint64 `json:"uplink_count"`
+	// DownlinkCount is the total number of downlink messages.
+	DownlinkCount uint64 `json:"downlink_count"`
+	// Protocols is the number of sessions by frontend protocol.
+	Protocols map[string]int `json:"protocols,omitempty"`
+	// RemoteAddresses is the number of sessions by remote address.
+	RemoteAddresses map[string]int `json:"remote_addresses,omitempty"`
+}
+
+// Summarize returns the summary of the given connection sessions, which are ordered from the most recent to the
+// oldest. Sessions shorter than short are counted as short sessions, which indicate a flapping gateway.
+func Summarize(sessions []*ttnpb.GatewayConnectionSession, short time.Duration) *Summary {
+	s := &Summary{
+		Sessions:        len(sessions),
+		Protocols:       make(map[string]int),
+		RemoteAddresses: make(map[string]int),
+	}
+	if len(sessions) == 0 {
+		return s
+	}
+	s.Since = sessions[len(sessions)-1].GetConnectedAt().AsTime()
+	s.Until = sessions[0].GetDisconnectedAt().AsTime()
+	var reconnectDelay time.Duration
+	for i, session := range sessions {
+		duration := session.GetDisconnectedAt().AsTime().Sub(session.GetConnectedAt().AsTime())
+		s.Connected += duration
+		if duration < short {
+			s.ShortSessions++
+		}
+		if i > 0 {
+			reconnectDelay += sessions[i-1].GetConnectedAt().AsTime().Sub(session.GetDisconnectedAt().AsTime())
+		}
+		s.UplinkCount += session.GetUplinkCount()
+		s.DownlinkCount += session.GetDownlinkCount()
+		s.Protocols[session.GetProtocol()]++
+		if ip := session.GetGatewayRemoteAddress().GetIp(); ip != "" {
+			s.RemoteAddresses[ip]++
+		}
+	}
+	s.MeanDuration = s.Connected / time.Duration(len(sessions))
+	if len(sessions) > 1 {
+		s.MeanReconnectDelay = reconnectDelay / time.Duration(len(sessions)-1)
+	}
+	return s
+}
+
+// MarshalJSON implements json.Marshaler. Durations are formatted as strings.
+func (s *Summary) MarshalJSON() ([]byte, error) {
+	type alias Summary
+	return json.Marshal(struct {
+		*alias
+		Connected          string `json:"connected"`
+		MeanDuration       string `json:"mean_duration"`
+		MeanReconnectDelay string `json:"mean_reconnect_delay"`
+	}{
+		alias:              (*alias)(s),
+		Connected:          s.Connected.String(),
+		MeanDuration:       s.MeanDuration.String(),
+		MeanReconnectDelay: s.MeanReconnectDelay.String(),
+	})
+}
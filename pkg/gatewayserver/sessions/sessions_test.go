@@ -0,0 +1,73 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessions_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/sessions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	session := func(connect, disconnect time.Duration, protocol, ip string, up uint64) *ttnpb.GatewayConnectionSession {
+		return &ttnpb.GatewayConnectionSession{
+			ConnectedAt:          timestamppb.New(start.Add(connect)),
+			DisconnectedAt:       timestamppb.New(start.Add(disconnect)),
+			Protocol:             protocol,
+			GatewayRemoteAddress: &ttnpb.GatewayRemoteAddress{Ip: ip},
+			UplinkCount:          up,
+			DownlinkCount:        1,
+		}
+	}
+	// Sessions are ordered from the most recent to the oldest.
+	summary := Summarize([]*ttnpb.GatewayConnectionSession{
+		session(3*time.Hour, 4*time.Hour, "udp", "192.0.2.2", 10),
+		session(2*time.Hour+2*time.Minute, 2*time.Hour+3*time.Minute, "udp", "192.0.2.1", 1),
+		session(0, 2*time.Hour, "mqtt", "192.0.2.1", 100),
+	}, 5*time.Minute)
+
+	a.So(summary, should.Resemble, &Summary{
+		Sessions:           3,
+		Since:              start,
+		Until:              start.Add(4 * time.Hour),
+		Connected:          3*time.Hour + time.Minute,
+		MeanDuration:       time.Hour + 20*time.Second,
+		MeanReconnectDelay: 29*time.Minute + 30*time.Second,
+		ShortSessions:      1,
+		UplinkCount:        111,
+		DownlinkCount:      3,
+		Protocols:          map[string]int{"udp": 2, "mqtt": 1},
+		RemoteAddresses:    map[string]int{"192.0.2.1": 2, "192.0.2.2": 1},
+	})
+
+	b, err := json.Marshal(summary)
+	a.So(err, should.BeNil)
+	var m map[string]any
+	a.So(json.Unmarshal(b, &m), should.BeNil)
+	a.So(m["mean_reconnect_delay"], should.Equal, "29m30s")
+	a.So(m["sessions"], should.Equal, 3)
+
+	a.So(Summarize(nil, time.Minute).Sessions, should.Equal, 0)
+}
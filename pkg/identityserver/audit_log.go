@@ -0,0 +1,512 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// auditLogHook is the name of the hook that records mutating registry RPCs in the audit log.
+const auditLogHook = "audit-log"
+
+// maxAuditEntries is the number of audit log entries per page if no limit is given.
+const maxAuditEntries = 1000
+
+// auditedServices are the services of which the mutating RPCs are recorded in the audit log.
+var auditedServices = []string{
+	"/ttn.lorawan.v3.ApplicationRegistry",
+	"/ttn.lorawan.v3.ApplicationAccess",
+	"/ttn.lorawan.v3.ClientRegistry",
+	"/ttn.lorawan.v3.ClientAccess",
+	"/ttn.lorawan.v3.GatewayRegistry",
+	"/ttn.lorawan.v3.GatewayAccess",
+	"/ttn.lorawan.v3.OrganizationRegistry",
+	"/ttn.lorawan.v3.OrganizationAccess",
+	"/ttn.lorawan.v3.UserRegistry",
+	"/ttn.lorawan.v3.UserAccess",
+}
+
+// auditActions maps the full method names of the audited RPCs to the actions in the audit log.
+// The actions are named after the events that the RPCs publish.
+var auditActions = func() map[string]string {
+	actions := map[string]string{
+		"/ttn.lorawan.v3.UserRegistry/UpdatePassword":          "user.update.password",
+		"/ttn.lorawan.v3.UserRegistry/CreateTemporaryPassword": "user.temporary-password.create",
+		"/ttn.lorawan.v3.UserRegistry/ResetMFA":                "user.mfa.reset",
+	}
+	for entityType, service := range map[string]string{
+		"application":  "Application",
+		"client":       "Client",
+		"gateway":      "Gateway",
+		"organization": "Organization",
+		"user":         "User",
+	} {
+		for method, action := range map[string]string{
+			"Registry/Create":           "create",
+			"Registry/Update":           "update",
+			"Registry/Delete":           "delete",
+			"Registry/Restore":          "restore",
+			"Registry/Purge":            "purge",
+			"Access/CreateAPIKey":       "api-key.create",
+			"Access/UpdateAPIKey":       "api-key.update",
+			"Access/DeleteAPIKey":       "api-key.delete",
+			"Access/SetCollaborator":    "collaborator.update",
+			"Access/DeleteCollaborator": "collaborator.delete",
+		} {
+			actions["/ttn.lorawan.v3."+service+method] = entityType + "." + action
+		}
+	}
+	return actions
+}()
+
+// auditRedactedFields are the names of fields of which the values are not recorded in the audit log.
+var auditRedactedFields = map[string]struct{}{
+	"claim_authentication_code": {},
+	"key":                       {},
+	"lbs_lns_secret":            {},
+	"password":                  {},
+	"secret":                    {},
+	"target_cups_key":           {},
+	"temporary_password":        {},
+}
+
+const auditRedacted = "<redacted>"
+
+// auditEntityIDs returns the identifiers of the entity that is changed by the request.
+func auditEntityIDs(req any) *ttnpb.EntityIdentifiers {
+	switch req := req.(type) {
+	case interface {
+		GetEntityIdentifiers() *ttnpb.EntityIdentifiers
+	}:
+		return req.GetEntityIdentifiers()
+	case interface {
+		GetApplicationIds() *ttnpb.ApplicationIdentifiers
+	}:
+		return req.GetApplicationIds().GetEntityIdentifiers()
+	case interface {
+		GetClientIds() *ttnpb.ClientIdentifiers
+	}:
+		return req.GetClientIds().GetEntityIdentifiers()
+	case interface {
+		GetGatewayIds() *ttnpb.GatewayIdentifiers
+	}:
+		return req.GetGatewayIds().GetEntityIdentifiers()
+	case interface {
+		GetOrganizationIds() *ttnpb.OrganizationIdentifiers
+	}:
+		return req.GetOrganizationIds().GetEntityIdentifiers()
+	case interface{ GetUserIds() *ttnpb.UserIdentifiers }:
+		return req.GetUserIds().GetEntityIdentifiers()
+	case interface{ GetApplication() *ttnpb.Application }:
+		return req.GetApplication().GetIds().GetEntityIdentifiers()
+	case interface{ GetClient() *ttnpb.Client }:
+		return req.GetClient().GetIds().GetEntityIdentifiers()
+	case interface{ GetGateway() *ttnpb.Gateway }:
+		return req.GetGateway().GetIds().GetEntityIdentifiers()
+	case interface{ GetOrganization() *ttnpb.Organization }:
+		return req.GetOrganization().GetIds().GetEntityIdentifiers()
+	case interface{ GetUser() *ttnpb.User }:
+		return req.GetUser().GetIds().GetEntityIdentifiers()
+	}
+	return nil
+}
+
+// auditAuth returns the authentication method and the ID of the API key, OAuth client or user session.
+func auditAuth(authInfo *ttnpb.AuthInfoResponse) (method, id string) {
+	switch accessMethod := authInfo.GetAccessMethod().(type) {
+	case *ttnpb.AuthInfoResponse_ApiKey:
+		return "api_key", accessMethod.ApiKey.GetApiKey().GetId()
+	case *ttnpb.AuthInfoResponse_OauthAccessToken:
+		return "oauth_access_token", accessMethod.OauthAccessToken.GetClientIds().GetClientId()
+	case *ttnpb.AuthInfoResponse_UserSession:
+		return "user_session", accessMethod.UserSession.GetSessionId()
+	case *ttnpb.AuthInfoResponse_GatewayToken_:
+		return "gateway_token", ""
+	}
+	if authInfo.GetUniversalRights() != nil {
+		return "cluster", ""
+	}
+	return "", ""
+}
+
+// auditBefore returns the state that is changed by the request, before the change.
+func (is *IdentityServer) auditBefore(ctx context.Context, req any) (before proto.Message, err error) {
+	entityIDs := auditEntityIDs(req)
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		switch req := req.(type) {
+		case *ttnpb.UpdateApplicationRequest:
+			before, err = st.GetApplication(ctx, req.GetApplication().GetIds(), req.GetFieldMask().GetPaths())
+		case *ttnpb.UpdateClientRequest:
+			before, err = st.GetClient(ctx, req.GetClient().GetIds(), req.GetFieldMask().GetPaths())
+		case *ttnpb.UpdateGatewayRequest:
+			before, err = st.GetGateway(ctx, req.GetGateway().GetIds(), req.GetFieldMask().GetPaths())
+		case *ttnpb.UpdateOrganizationRequest:
+			before, err = st.GetOrganization(ctx, req.GetOrganization().GetIds(), req.GetFieldMask().GetPaths())
+		case *ttnpb.UpdateUserRequest:
+			before, err = st.GetUser(ctx, req.GetUser().GetIds(), req.GetFieldMask().GetPaths())
+		case interface{ GetApiKey() *ttnpb.APIKey }: // Update API key.
+			before, err = st.GetAPIKey(ctx, entityIDs, req.GetApiKey().GetId())
+		case interface{ GetKeyId() string }: // Delete API key.
+			before, err = st.GetAPIKey(ctx, entityIDs, req.GetKeyId())
+		case interface{ GetCollaborator() *ttnpb.Collaborator }: // Set collaborator.
+			before, err = auditCollaborator(ctx, st, req.GetCollaborator().GetIds(), entityIDs)
+		case interface { // Delete collaborator.
+			GetCollaboratorIds() *ttnpb.OrganizationOrUserIdentifiers
+		}:
+			before, err = auditCollaborator(ctx, st, req.GetCollaboratorIds(), entityIDs)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return before, nil
+}
+
+func auditCollaborator(
+	ctx context.Context, st store.Store, ids *ttnpb.OrganizationOrUserIdentifiers, entityIDs *ttnpb.EntityIdentifiers,
+) (*ttnpb.Collaborator, error) {
+	memberRights, err := st.GetMember(ctx, ids, entityIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.Collaborator{Ids: ids, Rights: memberRights.GetRights()}, nil
+}
+
+// auditAfter returns the state that is changed by the request, after the change.
+// It is called with the store of the transaction of the change, before it commits.
+func auditAfter(ctx context.Context, st store.Store, req any) (proto.Message, error) {
+	entityIDs := auditEntityIDs(req)
+	switch req := req.(type) {
+	case *ttnpb.CreateApplicationRequest:
+		return req.GetApplication(), nil
+	case *ttnpb.CreateClientRequest:
+		return req.GetClient(), nil
+	case *ttnpb.CreateGatewayRequest:
+		return req.GetGateway(), nil
+	case *ttnpb.CreateOrganizationRequest:
+		return req.GetOrganization(), nil
+	case *ttnpb.CreateUserRequest:
+		return req.GetUser(), nil
+	case *ttnpb.UpdateApplicationRequest:
+		return st.GetApplication(ctx, req.GetApplication().GetIds(), req.GetFieldMask().GetPaths())
+	case *ttnpb.UpdateClientRequest:
+		return st.GetClient(ctx, req.GetClient().GetIds(), req.GetFieldMask().GetPaths())
+	case *ttnpb.UpdateGatewayRequest:
+		return st.GetGateway(ctx, req.GetGateway().GetIds(), req.GetFieldMask().GetPaths())
+	case *ttnpb.UpdateOrganizationRequest:
+		return st.GetOrganization(ctx, req.GetOrganization().GetIds(), req.GetFieldMask().GetPaths())
+	case *ttnpb.UpdateUserRequest:
+		return st.GetUser(ctx, req.GetUser().GetIds(), req.GetFieldMask().GetPaths())
+	case interface{ GetApiKey() *ttnpb.APIKey }: // Update API key.
+		return st.GetAPIKey(ctx, entityIDs, req.GetApiKey().GetId())
+	case interface { // Create API key.
+		GetName() string
+		GetRights() []ttnpb.Right
+	}:
+		// The key that is created in this transaction is the most recently created key of the entity.
+		keys, err := st.FindAPIKeys(ctx, entityIDs)
+		if err != nil {
+			return nil, err
+		}
+		var created *ttnpb.APIKey
+		for _, key := range keys {
+			if created == nil || key.GetCreatedAt().AsTime().After(created.GetCreatedAt().AsTime()) {
+				created = key
+			}
+		}
+		return created, nil
+	case interface{ GetCollaborator() *ttnpb.Collaborator }: // Set collaborator.
+		return req.GetCollaborator(), nil
+	}
+	return nil, nil
+}
+
+func auditValues(msg proto.Message) (map[string]any, error) {
+	if msg == nil || reflect.ValueOf(msg).IsNil() {
+		return nil, nil
+	}
+	b, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]any
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func auditValue(values map[string]any, path string) (any, bool) {
+	var value any = values
+	for _, field := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[field]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func auditRedact(path string) bool {
+	for _, field := range strings.Split(path, ".") {
+		if _, ok := auditRedactedFields[field]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// auditDiff returns the values of the fields that changed.
+// If no paths are given, the top-level fields of before and after are compared.
+func auditDiff(before, after proto.Message, paths []string) (beforeDiff, afterDiff map[string]any, err error) {
+	beforeValues, err := auditValues(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterValues, err := auditValues(after)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(paths) == 0 {
+		fields := make(map[string]struct{})
+		for field := range beforeValues {
+			fields[field] = struct{}{}
+		}
+		for field := range afterValues {
+			fields[field] = struct{}{}
+		}
+		for field := range fields {
+			paths = append(paths, field)
+		}
+		sort.Strings(paths)
+	}
+	for _, path := range paths {
+		beforeValue, beforeOK := auditValue(beforeValues, path)
+		afterValue, afterOK := auditValue(afterValues, path)
+		if beforeOK == afterOK && reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+		if auditRedact(path) {
+			beforeValue, afterValue = auditRedacted, auditRedacted
+		}
+		if beforeOK {
+			if beforeDiff == nil {
+				beforeDiff = make(map[string]any)
+			}
+			beforeDiff[path] = beforeValue
+		}
+		if afterOK {
+			if afterDiff == nil {
+				afterDiff = make(map[string]any)
+			}
+			afterDiff[path] = afterValue
+		}
+	}
+	return beforeDiff, afterDiff, nil
+}
+
+var errAuditLogEntity = errors.DefineInternal(
+	"audit_log_entity", "could not determine the entity for the audit log of action `{action}`",
+)
+
+// auditLogUnaryHook records successful mutating RPCs in the audit log.
+// The audit log entry is written by the store in the transaction of the change, so that if the entry
+// can not be written, the change is rolled back and the RPC fails.
+func (is *IdentityServer) auditLogUnaryHook(next grpc.UnaryHandler) grpc.UnaryHandler {
+	return func(ctx context.Context, req any) (any, error) {
+		method, _ := grpc.Method(ctx)
+		action, ok := auditActions[method]
+		if !ok {
+			return next(ctx, req)
+		}
+		entityIDs := auditEntityIDs(req)
+		if entityIDs == nil {
+			return nil, errAuditLogEntity.WithAttributes("action", action)
+		}
+		logger := log.FromContext(ctx).WithField("action", action)
+
+		entry := &store.AuditEntry{
+			EntityIDs: entityIDs,
+			Action:    action,
+		}
+		if authInfo, err := is.authInfo(ctx); err == nil {
+			entry.ActorIDs = authInfo.GetEntityIdentifiers()
+			entry.AuthMethod, entry.AuthID = auditAuth(authInfo)
+		}
+		if req, ok := req.(interface{ GetFieldMask() *fieldmaskpb.FieldMask }); ok {
+			entry.FieldMask = req.GetFieldMask().GetPaths()
+		}
+		before, err := is.auditBefore(ctx, req)
+		if err != nil && !errors.IsNotFound(err) {
+			logger.WithError(err).Debug("Failed to get state before change for audit log")
+		}
+
+		ctx = store.WithAudit(ctx, func(ctx context.Context, st store.Store) error {
+			after, err := auditAfter(ctx, st, req)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.WithError(err).Debug("Failed to get state after change for audit log")
+			}
+			entry.Before, entry.After, err = auditDiff(before, after, entry.FieldMask)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to determine changes for audit log")
+			}
+			return st.CreateAuditEntry(ctx, entry)
+		})
+		return next(ctx, req)
+	}
+}
+
+type auditLog struct {
+	ttnpb.UnimplementedAuditLogServer
+
+	*IdentityServer
+}
+
+// requireAuditLogRights checks that the caller has the rights to read the audit log of the entity.
+// These are the rights to manage the settings, API keys and collaborators of the entity.
+func (al *auditLog) requireAuditLogRights(ctx context.Context, ids *ttnpb.EntityIdentifiers) error {
+	switch id := ids.GetIds().(type) {
+	case *ttnpb.EntityIdentifiers_ApplicationIds:
+		return rights.RequireApplication(ctx, id.ApplicationIds,
+			ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_APPLICATION_SETTINGS_API_KEYS,
+			ttnpb.Right_RIGHT_APPLICATION_SETTINGS_COLLABORATORS,
+		)
+	case *ttnpb.EntityIdentifiers_ClientIds:
+		return rights.RequireClient(ctx, id.ClientIds,
+			ttnpb.Right_RIGHT_CLIENT_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_CLIENT_SETTINGS_COLLABORATORS,
+		)
+	case *ttnpb.EntityIdentifiers_GatewayIds:
+		return rights.RequireGateway(ctx, id.GatewayIds,
+			ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_GATEWAY_SETTINGS_API_KEYS,
+			ttnpb.Right_RIGHT_GATEWAY_SETTINGS_COLLABORATORS,
+		)
+	case *ttnpb.EntityIdentifiers_OrganizationIds:
+		return rights.RequireOrganization(ctx, id.OrganizationIds,
+			ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_API_KEYS,
+			ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS,
+		)
+	case *ttnpb.EntityIdentifiers_UserIds:
+		return rights.RequireUser(ctx, id.UserIds,
+			ttnpb.Right_RIGHT_USER_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_USER_SETTINGS_API_KEYS,
+		)
+	}
+	return errAuditLogEntityType.WithAttributes("entity_type", ids.EntityType())
+}
+
+var errAuditLogEntityType = errors.DefineInvalidArgument(
+	"audit_log_entity_type", "no audit log for entity type `{entity_type}`",
+)
+
+func auditEntryToProto(entry *store.AuditEntry) (*ttnpb.AuditEntry, error) {
+	pb := &ttnpb.AuditEntry{
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+		ActorIds:   entry.ActorIDs,
+		AuthMethod: entry.AuthMethod,
+		AuthId:     entry.AuthID,
+		EntityIds:  entry.EntityIDs,
+		Action:     entry.Action,
+	}
+	if len(entry.FieldMask) > 0 {
+		pb.FieldMask = ttnpb.FieldMask(entry.FieldMask...)
+	}
+	var err error
+	if entry.Before != nil {
+		if pb.Before, err = structpb.NewStruct(entry.Before); err != nil {
+			return nil, err
+		}
+	}
+	if entry.After != nil {
+		if pb.After, err = structpb.NewStruct(entry.After); err != nil {
+			return nil, err
+		}
+	}
+	return pb, nil
+}
+
+func (al *auditLog) ListAuditEntries(
+	ctx context.Context, req *ttnpb.ListAuditEntriesRequest,
+) (res *ttnpb.AuditEntries, err error) {
+	if len(req.GetEntityIds()) == 0 {
+		if err := al.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	} else if !al.IsAdmin(ctx) {
+		for _, ids := range req.GetEntityIds() {
+			if err := al.requireAuditLogRights(ctx, ids); err != nil {
+				return nil, err
+			}
+		}
+	}
+	filter := &store.AuditEntryFilter{
+		EntityIDs: req.GetEntityIds(),
+		Actions:   req.GetActions(),
+		After:     ttnpb.StdTime(req.GetAfter()),
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = maxAuditEntries
+	}
+	var total uint64
+	ctx = store.WithPagination(ctx, limit, req.GetPage(), &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	var entries []*store.AuditEntry
+	err = al.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		entries, err = st.FindAuditEntries(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = &ttnpb.AuditEntries{
+		Entries: make([]*ttnpb.AuditEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		pb, err := auditEntryToProto(entry)
+		if err != nil {
+			return nil, err
+		}
+		res.Entries = append(res.Entries, pb)
+	}
+	return res, nil
+}
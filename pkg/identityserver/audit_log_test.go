@@ -0,0 +1,157 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuditDiff(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	before := &ttnpb.Application{
+		Ids:         &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		Name:        "Old Name",
+		Description: "Description",
+	}
+	after := &ttnpb.Application{
+		Ids:         &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		Name:        "New Name",
+		Description: "Description",
+	}
+
+	beforeDiff, afterDiff, err := auditDiff(before, after, []string{"name", "description"})
+	a.So(err, should.BeNil)
+	a.So(beforeDiff, should.Resemble, map[string]any{"name": "Old Name"})
+	a.So(afterDiff, should.Resemble, map[string]any{"name": "New Name"})
+
+	beforeDiff, afterDiff, err = auditDiff(nil, &ttnpb.APIKey{Id: "KEYID", Key: "secret"}, nil)
+	a.So(err, should.BeNil)
+	a.So(beforeDiff, should.BeNil)
+	a.So(afterDiff, should.Resemble, map[string]any{"id": "KEYID", "key": auditRedacted})
+
+	beforeDiff, afterDiff, err = auditDiff(before, before, nil)
+	a.So(err, should.BeNil)
+	a.So(beforeDiff, should.BeNil)
+	a.So(afterDiff, should.BeNil)
+}
+
+func TestAuditLog(t *testing.T) {
+	p := &storetest.Population{}
+
+	adminUsr := p.NewUser()
+	adminUsr.Admin = true
+	adminKey, _ := p.NewAPIKey(adminUsr.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	adminCreds := rpcCreds(adminKey)
+
+	usr1 := p.NewUser()
+	app1 := p.NewApplication(usr1.GetOrganizationOrUserIdentifiers())
+	key, _ := p.NewAPIKey(usr1.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	creds := rpcCreds(key)
+
+	usr2 := p.NewUser()
+	usr2Key, _ := p.NewAPIKey(usr2.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	usr2Creds := rpcCreds(usr2Key)
+
+	t.Parallel()
+
+	testWithIdentityServer(t, func(_ *IdentityServer, cc *grpc.ClientConn) {
+		a, ctx := test.New(t)
+		reg := ttnpb.NewApplicationRegistryClient(cc)
+
+		_, err := reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: &ttnpb.Application{
+				Ids:  app1.GetIds(),
+				Name: "Updated Name",
+			},
+			FieldMask: ttnpb.FieldMask("name"),
+		}, creds)
+		a.So(err, should.BeNil)
+
+		req := &ttnpb.ListAuditEntriesRequest{
+			EntityIds: []*ttnpb.EntityIdentifiers{app1.GetEntityIdentifiers()},
+		}
+
+		auditLog := ttnpb.NewAuditLogClient(cc)
+
+		_, err = auditLog.ListAuditEntries(ctx, req, usr2Creds)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		_, err = auditLog.ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{}, creds)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		for _, opt := range []grpc.CallOption{creds, adminCreds} {
+			res, err := auditLog.ListAuditEntries(ctx, req, opt)
+			if !a.So(err, should.BeNil) || !a.So(res.GetEntries(), should.HaveLength, 1) {
+				continue
+			}
+			entry := res.GetEntries()[0]
+			a.So(entry.Action, should.Equal, "application.update")
+			a.So(entry.EntityIds, should.Resemble, app1.GetEntityIdentifiers())
+			a.So(entry.ActorIds, should.Resemble, usr1.GetEntityIdentifiers())
+			a.So(entry.AuthMethod, should.Equal, "api_key")
+			a.So(entry.AuthId, should.Equal, key.GetId())
+			a.So(entry.FieldMask.GetPaths(), should.Resemble, []string{"name"})
+			a.So(entry.After.AsMap(), should.Resemble, map[string]any{"name": "Updated Name"})
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: &ttnpb.Application{
+				Ids:         app1.GetIds(),
+				Description: "Updated Description",
+			},
+			FieldMask: ttnpb.FieldMask("description"),
+		}, creds)
+		a.So(err, should.BeNil)
+
+		var md metadata.MD
+		res, err := auditLog.ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{
+			EntityIds: []*ttnpb.EntityIdentifiers{app1.GetEntityIdentifiers()},
+			Limit:     1,
+			Page:      2,
+		}, creds, grpc.Header(&md))
+		if a.So(err, should.BeNil) && a.So(res.GetEntries(), should.HaveLength, 1) {
+			a.So(res.GetEntries()[0].GetFieldMask().GetPaths(), should.Resemble, []string{"name"})
+			a.So(md.Get("x-total-count"), should.Resemble, []string{"2"})
+		}
+
+		created, err := ttnpb.NewApplicationAccessClient(cc).CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIds: app1.GetIds(),
+			Name:           "Audited Key",
+			Rights:         []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_INFO},
+		}, creds)
+		if a.So(err, should.BeNil) {
+			res, err := auditLog.ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{
+				EntityIds: []*ttnpb.EntityIdentifiers{app1.GetEntityIdentifiers()},
+				Limit:     1,
+			}, creds)
+			if a.So(err, should.BeNil) && a.So(res.GetEntries(), should.HaveLength, 1) {
+				entry := res.GetEntries()[0]
+				a.So(entry.Action, should.Equal, "application.api-key.create")
+				a.So(entry.After.AsMap()["id"], should.Equal, created.GetId())
+				a.So(entry.After.AsMap()["key"], should.Equal, auditRedacted)
+			}
+		}
+	}, withPrivateTestDatabase(p))
+}
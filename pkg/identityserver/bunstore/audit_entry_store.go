@@ -0,0 +1,178 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// AuditEntry is the audit log entry model in the database.
+type AuditEntry struct {
+	bun.BaseModel `bun:"table:audit_entries,alias:ae"`
+
+	UUID
+
+	CreatedAt time.Time `bun:"created_at,notnull"`
+
+	ActorType  string         `bun:"actor_type,nullzero"`
+	ActorID    string         `bun:"actor_id,nullzero"`
+	AuthMethod string         `bun:"auth_method,nullzero"`
+	AuthID     string         `bun:"auth_id,nullzero"`
+	EntityType string         `bun:"entity_type,notnull"`
+	EntityID   string         `bun:"entity_id,notnull"`
+	Action     string         `bun:"action,notnull"`
+	FieldMask  []string       `bun:"field_mask,array,nullzero"`
+	Before     map[string]any `bun:"before_values,type:jsonb,nullzero"`
+	After      map[string]any `bun:"after_values,type:jsonb,nullzero"`
+}
+
+func (AuditEntry) _isModel() {}
+
+// BeforeAppendModel is a hook that modifies the model on INSERT queries.
+func (m *AuditEntry) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); ok && m.CreatedAt.IsZero() {
+		m.CreatedAt = now()
+	}
+	return nil
+}
+
+func auditEntryToModel(pb *store.AuditEntry) *AuditEntry {
+	m := &AuditEntry{
+		CreatedAt:  cleanTime(pb.CreatedAt),
+		AuthMethod: pb.AuthMethod,
+		AuthID:     pb.AuthID,
+		EntityType: pb.EntityIDs.EntityType(),
+		EntityID:   pb.EntityIDs.IDString(),
+		Action:     pb.Action,
+		FieldMask:  pb.FieldMask,
+		Before:     pb.Before,
+		After:      pb.After,
+	}
+	if pb.ActorIDs != nil {
+		m.ActorType, m.ActorID = pb.ActorIDs.EntityType(), pb.ActorIDs.IDString()
+	}
+	return m
+}
+
+func auditEntryFromModel(m *AuditEntry) *store.AuditEntry {
+	pb := &store.AuditEntry{
+		CreatedAt:  m.CreatedAt,
+		AuthMethod: m.AuthMethod,
+		AuthID:     m.AuthID,
+		EntityIDs:  getEntityIdentifiers(m.EntityType, m.EntityID),
+		Action:     m.Action,
+		FieldMask:  m.FieldMask,
+		Before:     m.Before,
+		After:      m.After,
+	}
+	if m.ActorType != "" {
+		pb.ActorIDs = getEntityIdentifiers(m.ActorType, m.ActorID)
+	}
+	return pb
+}
+
+type auditEntryStore struct {
+	*baseStore
+}
+
+func newAuditEntryStore(baseStore *baseStore) *auditEntryStore {
+	return &auditEntryStore{
+		baseStore: baseStore,
+	}
+}
+
+func (s *auditEntryStore) CreateAuditEntry(ctx context.Context, entry *store.AuditEntry) error {
+	ctx, span := tracer.StartFromContext(ctx, "CreateAuditEntry", trace.WithAttributes(
+		attribute.String("entity_type", entry.EntityIDs.EntityType()),
+		attribute.String("entity_id", entry.EntityIDs.IDString()),
+		attribute.String("action", entry.Action),
+	))
+	defer span.End()
+
+	_, err := s.DB.NewInsert().
+		Model(auditEntryToModel(entry)).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	return nil
+}
+
+func (*auditEntryStore) selectWithEntityIDs(ids ...*ttnpb.EntityIdentifiers) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		if len(ids) == 0 {
+			return q
+		}
+		return q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for _, id := range ids {
+				q = q.WhereOr(
+					"?TableAlias.entity_type = ? AND ?TableAlias.entity_id = ?",
+					id.EntityType(), id.IDString(),
+				)
+			}
+			return q
+		})
+	}
+}
+
+func (s *auditEntryStore) FindAuditEntries(
+	ctx context.Context, filter *store.AuditEntryFilter,
+) ([]*store.AuditEntry, error) {
+	ctx, span := tracer.StartFromContext(ctx, "FindAuditEntries")
+	defer span.End()
+
+	models := []*AuditEntry{}
+	selectQuery := newSelectModels(ctx, s.DB, &models).
+		Apply(s.selectWithEntityIDs(filter.EntityIDs...))
+	if len(filter.Actions) > 0 {
+		selectQuery = selectQuery.Where("?TableAlias.action IN (?)", bun.In(filter.Actions))
+	}
+
+	if filter.After != nil {
+		selectQuery = selectQuery.Where("?TableAlias.created_at > ?", cleanTime(*filter.After))
+	}
+
+	// Count the total number of results.
+	count, err := selectQuery.Count(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	store.SetTotal(ctx, uint64(count))
+
+	// Return the most recent entries first.
+	err = selectQuery.
+		Order("created_at DESC", "id DESC").
+		Apply(selectWithLimitAndOffsetFromContext(ctx)).
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	pbs := make([]*store.AuditEntry, len(models))
+	for i, model := range models {
+		pbs[i] = auditEntryFromModel(model)
+	}
+	return pbs, nil
+}
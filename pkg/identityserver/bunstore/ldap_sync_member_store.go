@@ -0,0 +1,146 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"sort"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// LDAPSyncMember is the model of a member of an organization that is managed by the LDAP synchronization.
+type LDAPSyncMember struct {
+	bun.BaseModel `bun:"table:ldap_sync_members,alias:lsm"`
+
+	Model
+
+	OrganizationID string `bun:"organization_id,notnull"`
+	UserID         string `bun:"user_id,notnull"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *LDAPSyncMember) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ldapSyncMemberStore struct {
+	*entityStore
+}
+
+func newLDAPSyncMemberStore(baseStore *baseStore) *ldapSyncMemberStore {
+	return &ldapSyncMemberStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *ldapSyncMemberStore) FindLDAPSyncMembers(
+	ctx context.Context, ids *ttnpb.OrganizationIdentifiers,
+) ([]*ttnpb.UserIdentifiers, error) {
+	ctx, span := tracer.StartFromContext(ctx, "FindLDAPSyncMembers", trace.WithAttributes(
+		attribute.String("organization_id", ids.GetOrganizationId()),
+	))
+	defer span.End()
+
+	_, organizationUUID, err := s.getEntity(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIDs []string
+	err = s.newSelectModel(ctx, &Account{}).
+		Column("uid").
+		Where("?TableAlias.account_type = ?", store.EntityUser).
+		Where("?TableAlias.account_id IN (?)", s.DB.NewSelect().
+			Model(&LDAPSyncMember{}).
+			Column("user_id").
+			Where("organization_id = ?", organizationUUID),
+		).
+		Scan(ctx, &userIDs)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	sort.Strings(userIDs)
+
+	pbs := make([]*ttnpb.UserIdentifiers, len(userIDs))
+	for i, userID := range userIDs {
+		pbs[i] = &ttnpb.UserIdentifiers{UserId: userID}
+	}
+	return pbs, nil
+}
+
+func (s *ldapSyncMemberStore) SetLDAPSyncMembers(
+	ctx context.Context, ids *ttnpb.OrganizationIdentifiers, userIDs []*ttnpb.UserIdentifiers,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "SetLDAPSyncMembers", trace.WithAttributes(
+		attribute.String("organization_id", ids.GetOrganizationId()),
+		attribute.Int("user_count", len(userIDs)),
+	))
+	defer span.End()
+
+	_, organizationUUID, err := s.getEntity(store.WithSoftDeleted(ctx, false), ids)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(&LDAPSyncMember{}).
+		Where("organization_id = ?", organizationUUID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	friendlyIDs := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		friendlyIDs[i] = userID.GetUserId()
+	}
+	userUUIDs, err := s.getEntityUUIDs(ctx, store.EntityUser, friendlyIDs...)
+	if err != nil {
+		return err
+	}
+	if len(userUUIDs) == 0 {
+		return nil
+	}
+
+	models := make([]*LDAPSyncMember, len(userUUIDs))
+	for i, userUUID := range userUUIDs {
+		models[i] = &LDAPSyncMember{
+			OrganizationID: organizationUUID,
+			UserID:         userUUID,
+		}
+	}
+	_, err = s.DB.NewInsert().
+		Model(&models).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	return nil
+}
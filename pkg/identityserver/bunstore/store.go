@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/uptrace/bun"
@@ -118,6 +119,7 @@ func newStore(baseStore *baseStore) *Store {
 		baseStore: baseStore,
 
 		apiKeyStore:          newAPIKeyStore(baseStore),
+		auditEntryStore:      newAuditEntryStore(baseStore),
 		applicationStore:     newApplicationStore(baseStore),
 		clientStore:          newClientStore(baseStore),
 		contactInfoStore:     newContactInfoStore(baseStore),
@@ -127,12 +129,15 @@ func newStore(baseStore *baseStore) *Store {
 		euiStore:             newEUIStore(baseStore),
 		gatewayStore:         newGatewayStore(baseStore),
 		invitationStore:      newInvitationStore(baseStore),
+		ldapSyncMemberStore:  newLDAPSyncMemberStore(baseStore),
 		loginTokenStore:      newLoginTokenStore(baseStore),
 		membershipStore:      newMembershipStore(baseStore),
 		notificationStore:    newNotificationStore(baseStore),
 		oauthStore:           newOAuthStore(baseStore),
 		organizationStore:    newOrganizationStore(baseStore),
 		userBookmarkStore:    newUserBookmarkStore(baseStore),
+		userMFAStore:         newUserMFAStore(baseStore),
+		userOIDCLinkStore:    newUserOIDCLinkStore(baseStore),
 		userSessionStore:     newUserSessionStore(baseStore),
 		userStore:            newUserStore(baseStore),
 	}
@@ -144,6 +149,7 @@ func NewStore(ctx context.Context, db *bun.DB) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
+	db.AddQueryHook(changesHook{})
 	return newStore(baseDB.baseStore()), nil
 }
 
@@ -155,6 +161,7 @@ type Store struct {
 
 	*apiKeyStore
 	*applicationStore
+	*auditEntryStore
 	*clientStore
 	*contactInfoStore
 	*emailValidationStore
@@ -163,12 +170,15 @@ type Store struct {
 	*euiStore
 	*gatewayStore
 	*invitationStore
+	*ldapSyncMemberStore
 	*loginTokenStore
 	*membershipStore
 	*notificationStore
 	*oauthStore
 	*organizationStore
 	*userBookmarkStore
+	*userMFAStore
+	*userOIDCLinkStore
 	*userSessionStore
 	*userStore
 }
@@ -178,15 +188,60 @@ const (
 	maxAttempts               = 3
 )
 
+type transactionChangesKeyType struct{}
+
+var transactionChangesKey transactionChangesKeyType
+
+// transactionChanges records whether queries in a transaction changed data.
+type transactionChanges struct {
+	changed atomic.Bool
+}
+
+// changesHook is a bun.QueryHook that records the queries that change data in the transaction
+// of the query context.
+type changesHook struct{}
+
+func (changesHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	changes, ok := ctx.Value(transactionChangesKey).(*transactionChanges)
+	if !ok {
+		return ctx
+	}
+	switch event.Operation() {
+	case "INSERT", "UPDATE", "DELETE":
+		changes.changed.Store(true)
+	}
+	return ctx
+}
+
+func (changesHook) AfterQuery(context.Context, *bun.QueryEvent) {}
+
 // Transact implements the store.TransactionalStore interface.
+// If the context has a pending audit log entry, it is written in the first transaction that changes data.
 func (s *Store) Transact(ctx context.Context, fc func(context.Context, store.Store) error) (err error) {
+	if _, ok := s.DB.(*bun.DB); !ok { // Already in a transaction, just call the func.
+		return fc(ctx, s)
+	}
+	audit := store.AuditFromContext(ctx)
 	delayOnUnavailable := initialDelayOnUnavailable
 	for i := 0; i < maxAttempts; i++ {
-		err = s.baseStore.transact(ctx, func(ctx context.Context, idb bun.IDB) error {
+		var audited bool
+		err = s.baseStore.transact(ctx, func(ctx context.Context, idb bun.IDB) (err error) {
+			changes := &transactionChanges{}
+			ctx = context.WithValue(ctx, transactionChangesKey, changes)
 			baseStore := s.baseDB.baseStore()
 			baseStore.DB = idb
-			return fc(ctx, newStore(baseStore))
+			st := newStore(baseStore)
+			if err := fc(ctx, st); err != nil {
+				return err
+			}
+			if audit != nil && changes.changed.Load() {
+				audited, err = audit.Write(ctx, st)
+			}
+			return err
 		})
+		if err == nil && audited {
+			audit.Commit()
+		}
 		if !errors.Is(err, storeutil.ErrUnavailable) {
 			break
 		}
@@ -172,6 +172,34 @@ func TestLoginTokenStore(t *testing.T) {
 	st.TestLoginTokenStore(t)
 }
 
+func TestUserMFAStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestUserMFAStore(t)
+}
+
+func TestUserOIDCLinkStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestUserOIDCLinkStore(t)
+}
+
+func TestLDAPSyncMemberStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestLDAPSyncMemberStore(t)
+}
+
+func TestAuditEntryStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestAuditEntryStore(t)
+}
+
 func TestOAuthStore(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,176 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// UserMFA is the user multi-factor authentication model in the database.
+type UserMFA struct {
+	bun.BaseModel `bun:"table:user_mfa,alias:mfa"`
+
+	Model
+
+	UserID string `bun:"user_id,notnull"`
+
+	TOTPSecret      []byte     `bun:"totp_secret,notnull"`
+	TOTPSecretKeyID string     `bun:"totp_secret_key_id,nullzero"`
+	EnabledAt       *time.Time `bun:"enabled_at"`
+	RecoveryCodes   []string   `bun:"recovery_codes,array,nullzero"`
+	LastUsedStep    int64      `bun:"last_used_step,notnull"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *UserMFA) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+func userMFAFromModel(m *UserMFA) *store.UserMFA {
+	return &store.UserMFA{
+		TOTPSecret:      m.TOTPSecret,
+		TOTPSecretKeyID: m.TOTPSecretKeyID,
+		EnabledAt:       m.EnabledAt,
+		RecoveryCodes:   m.RecoveryCodes,
+		LastUsedStep:    m.LastUsedStep,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+type userMFAStore struct {
+	*entityStore
+}
+
+func newUserMFAStore(baseStore *baseStore) *userMFAStore {
+	return &userMFAStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *userMFAStore) getUserMFAModel(ctx context.Context, userUUID string) (*UserMFA, error) {
+	model := &UserMFA{}
+	err := s.newSelectModel(ctx, model).
+		Where("user_id = ?", userUUID).
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	return model, nil
+}
+
+func (s *userMFAStore) GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*store.UserMFA, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetUserMFA", trace.WithAttributes(
+		attribute.String("user_id", userIDs.GetUserId()),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := s.getUserMFAModel(ctx, userUUID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, store.ErrUserMFANotFound.WithAttributes("user_id", userIDs.GetUserId())
+		}
+		return nil, err
+	}
+
+	return userMFAFromModel(model), nil
+}
+
+func (s *userMFAStore) SetUserMFA(
+	ctx context.Context, userIDs *ttnpb.UserIdentifiers, mfa *store.UserMFA,
+) (*store.UserMFA, error) {
+	ctx, span := tracer.StartFromContext(ctx, "SetUserMFA", trace.WithAttributes(
+		attribute.String("user_id", userIDs.GetUserId()),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := s.getUserMFAModel(ctx, userUUID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if model == nil {
+		model = &UserMFA{UserID: userUUID}
+	}
+	model.TOTPSecret = mfa.TOTPSecret
+	model.TOTPSecretKeyID = mfa.TOTPSecretKeyID
+	model.EnabledAt = cleanTimePtr(mfa.EnabledAt)
+	model.RecoveryCodes = mfa.RecoveryCodes
+	model.LastUsedStep = mfa.LastUsedStep
+
+	if model.ID == "" {
+		_, err = s.DB.NewInsert().
+			Model(model).
+			Exec(ctx)
+	} else {
+		_, err = s.DB.NewUpdate().
+			Model(model).
+			WherePK().
+			Column(
+				"updated_at", "totp_secret", "totp_secret_key_id", "enabled_at", "recovery_codes", "last_used_step",
+			).
+			Exec(ctx)
+	}
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	return userMFAFromModel(model), nil
+}
+
+func (s *userMFAStore) DeleteUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteUserMFA", trace.WithAttributes(
+		attribute.String("user_id", userIDs.GetUserId()),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(store.WithSoftDeleted(ctx, false), userIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(&UserMFA{}).
+		Where("user_id = ?", userUUID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	return nil
+}
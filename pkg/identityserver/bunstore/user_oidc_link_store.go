@@ -0,0 +1,141 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// UserOIDCLink is the model of a link between a user and an account of an OpenID Connect provider in the database.
+type UserOIDCLink struct {
+	bun.BaseModel `bun:"table:user_oidc_links,alias:oidc"`
+
+	Model
+
+	UserID string `bun:"user_id,notnull"`
+
+	Provider string `bun:"provider,notnull"`
+	Subject  string `bun:"subject,notnull"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *UserOIDCLink) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+type userOIDCLinkStore struct {
+	*entityStore
+}
+
+func newUserOIDCLinkStore(baseStore *baseStore) *userOIDCLinkStore {
+	return &userOIDCLinkStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *userOIDCLinkStore) GetUserIDsByOIDCSubject(
+	ctx context.Context, provider, subject string,
+) (*ttnpb.UserIdentifiers, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetUserIDsByOIDCSubject", trace.WithAttributes(
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	model := &UserOIDCLink{}
+	err := s.newSelectModel(ctx, model).
+		Where("provider = ?", provider).
+		Where("subject = ?", subject).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrUserOIDCLinkNotFound.WithAttributes("provider", provider)
+		}
+		return nil, err
+	}
+
+	userID, err := s.getEntityID(ctx, store.EntityUser, model.UserID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, store.ErrUserOIDCLinkNotFound.WithAttributes("provider", provider)
+		}
+		return nil, err
+	}
+
+	return &ttnpb.UserIdentifiers{UserId: userID}, nil
+}
+
+func (s *userOIDCLinkStore) CreateUserOIDCLink(
+	ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, subject string,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "CreateUserOIDCLink", trace.WithAttributes(
+		attribute.String("user_id", userIDs.GetUserId()),
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	model := &UserOIDCLink{
+		UserID:   userUUID,
+		Provider: provider,
+		Subject:  subject,
+	}
+	_, err = s.DB.NewInsert().
+		Model(model).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	return nil
+}
+
+func (s *userOIDCLinkStore) DeleteUserOIDCLinks(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteUserOIDCLinks", trace.WithAttributes(
+		attribute.String("user_id", userIDs.GetUserId()),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(store.WithSoftDeleted(ctx, false), userIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(&UserOIDCLink{}).
+		Where("user_id = ?", userUUID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	return nil
+}
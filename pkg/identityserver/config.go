@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/email/smtp"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/ldapsync"
 	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -109,6 +110,12 @@ type Config struct {
 	Delete struct {
 		Restore time.Duration `name:"restore" description:"How long after soft-deletion an entity can be restored"`
 	} `name:"delete"`
+	MFA struct {
+		Issuer           string `name:"issuer" description:"Issuer name that authenticator apps show for TOTP secrets (default site name)"` //nolint:lll
+		EncryptionKeyID  string `name:"encryption-key-id" description:"ID of the key used to encrypt TOTP secrets (required)"`
+		RequireForAdmins bool   `name:"require-for-admins" description:"Require admins to enable multi-factor authentication before they can authorize OAuth clients"` //nolint:lll
+	} `name:"mfa"`
+	LDAPSync    ldapsync.Config `name:"ldap-sync" description:"Synchronization of LDAP groups to organizations"`
 	DevEUIBlock struct {
 		Enabled          bool                 `name:"enabled" description:"Enable DevEUI address issuing from IEEE MAC block"`
 		ApplicationLimit int                  `name:"application-limit" description:"Maximum DevEUI addresses to be issued per application"`
@@ -159,6 +159,16 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 
 	is.config.OAuth.CSRFAuthKey = is.GetBaseConfig(is.Context()).HTTP.Cookie.HashKey
 	is.config.OAuth.UI.FrontendConfig.EnableUserRegistration = is.config.UserRegistration.Enabled
+	is.config.OAuth.MFA = oauth.MFAConfig{
+		Issuer:           is.config.MFA.Issuer,
+		EncryptionKeyID:  is.config.MFA.EncryptionKeyID,
+		RequireForAdmins: is.config.MFA.RequireForAdmins,
+	}
+	is.config.OAuth.OIDC.UserRegistration = oauth.OIDCUserRegistrationConfig{
+		Enabled:               is.config.UserRegistration.Enabled,
+		InvitationRequired:    is.config.UserRegistration.Invitation.Required,
+		AdminApprovalRequired: is.config.UserRegistration.AdminApproval.Required,
+	}
 	is.oauth, err = oauth.NewServer(c, &oauthAppStore{is.store}, is.config.OAuth, GenerateCSPString)
 	if err != nil {
 		return nil, err
@@ -180,6 +190,9 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	if err := is.initializeTelemetryTasks(is.Context()); err != nil {
 		return nil, err
 	}
+	if err := is.initializeLDAPSyncTask(is.Context()); err != nil {
+		return nil, err
+	}
 
 	for _, hook := range []struct {
 		name       string
@@ -222,6 +235,7 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		for _, filter := range []string{
 			"/ttn.lorawan.v3.UserInvitationRegistry",
 			"/ttn.lorawan.v3.UserBookmarkRegistry",
+			"/ttn.lorawan.v3.AuditLog",
 			"/ttn.lorawan.v3.EntityRegistrySearch",
 			"/ttn.lorawan.v3.EndDeviceRegistrySearch",
 			"/ttn.lorawan.v3.ContactInfoRegistry",
@@ -231,7 +245,9 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
 	}
-
+	for _, filter := range auditedServices {
+		c.GRPC.RegisterUnaryHook(filter, auditLogHook, is.auditLogUnaryHook)
+	}
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 	c.RegisterWeb(is.account)
@@ -259,6 +275,7 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterUserBookmarkRegistryServer(s, &userBookmarkRegistry{IdentityServer: is})
 	ttnpb.RegisterUserInvitationRegistryServer(s, &invitationRegistry{IdentityServer: is})
 	ttnpb.RegisterUserRegistryServer(s, &userRegistry{IdentityServer: is})
+	ttnpb.RegisterAuditLogServer(s, &auditLog{IdentityServer: is})
 	ttnpb.RegisterUserSessionRegistryServer(s, &userSessionRegistry{IdentityServer: is})
 	ttnpb.RegisterEntityRegistrySearchServer(s, &registrySearch{IdentityServer: is})
 	ttnpb.RegisterEndDeviceRegistrySearchServer(s, &registrySearch{IdentityServer: is})
@@ -289,6 +306,7 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterUserInvitationRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterUserRegistryHandler(is.Context(), s, conn)        // nolint:errcheck
 	ttnpb.RegisterUserSessionRegistryHandler(is.Context(), s, conn) // nolint:errcheck
+	ttnpb.RegisterAuditLogHandler(is.Context(), s, conn)            // nolint:errcheck
 	ttnpb.RegisterEntityRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterEndDeviceRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterOAuthAuthorizationRegistryHandler(is.Context(), s, conn)
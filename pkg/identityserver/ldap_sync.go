@@ -0,0 +1,345 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/ldapsync"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ldapSyncNotificationType is the type of the notifications that report the drift between LDAP groups and
+// organizations. The notifications are sent to the admins.
+const ldapSyncNotificationType = "organization_ldap_sync"
+
+// ldapSyncReport is the result of the synchronization of an organization with its LDAP groups.
+type ldapSyncReport struct {
+	groups              []string
+	missingGroups       []string
+	missingOrganization bool
+	createdOrganization bool
+	unknownUsers        []string
+	changes             []*ldapsync.Change
+}
+
+// data returns the notification data of the report, or nil if there is nothing to report.
+func (r *ldapSyncReport) data(dryRun bool) (*structpb.Struct, error) {
+	if len(r.missingGroups) == 0 && !r.missingOrganization && !r.createdOrganization &&
+		len(r.unknownUsers) == 0 && len(r.changes) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	changes := make([]any, 0, len(r.changes))
+	for _, change := range r.changes {
+		rights := make([]any, 0, len(change.Rights.GetRights()))
+		for _, right := range change.Rights.GetRights() {
+			rights = append(rights, right.String())
+		}
+		changes = append(changes, map[string]any{
+			"action":  string(change.Action),
+			"user_id": change.UserID,
+			"rights":  rights,
+		})
+	}
+	return structpb.NewStruct(map[string]any{
+		"groups":               stringsToAny(r.groups),
+		"missing_groups":       stringsToAny(r.missingGroups),
+		"missing_organization": r.missingOrganization,
+		"created_organization": r.createdOrganization,
+		"unknown_users":        stringsToAny(r.unknownUsers),
+		"changes":              changes,
+		"dry_run":              dryRun,
+	})
+}
+
+func stringsToAny(values []string) []any {
+	res := make([]any, len(values))
+	for i, v := range values {
+		res[i] = v
+	}
+	return res
+}
+
+type ldapSync struct {
+	is        *IdentityServer
+	config    ldapsync.Config
+	directory *ldapsync.Directory
+	mappings  map[string][]*ldapsync.Mapping // By organization ID.
+	reports   map[string]*structpb.Struct    // Last reported drift by organization ID.
+}
+
+func newLDAPSync(is *IdentityServer, conf ldapsync.Config) (*ldapSync, error) {
+	mappings, err := conf.Mappings()
+	if err != nil {
+		return nil, err
+	}
+	s := &ldapSync{
+		is:        is,
+		config:    conf,
+		directory: ldapsync.NewDirectory(conf),
+		mappings:  make(map[string][]*ldapsync.Mapping),
+		reports:   make(map[string]*structpb.Struct),
+	}
+	for _, mapping := range mappings {
+		s.mappings[mapping.OrganizationID] = append(s.mappings[mapping.OrganizationID], mapping)
+	}
+	return s, nil
+}
+
+func (is *IdentityServer) initializeLDAPSyncTask(ctx context.Context) error {
+	conf := is.config.LDAPSync
+	if !conf.Enabled {
+		return nil
+	}
+	s, err := newLDAPSync(is, conf)
+	if err != nil {
+		return err
+	}
+	is.RegisterTask(&task.Config{
+		Context: ctx,
+		ID:      "is_ldap_sync",
+		Func:    s.run,
+		Restart: task.RestartAlways,
+		Backoff: &task.BackoffConfig{
+			Jitter: task.DefaultBackoffJitter,
+			IntervalFunc: func(context.Context, time.Duration, uint, error) time.Duration {
+				return conf.Interval
+			},
+		},
+	})
+	return nil
+}
+
+// run synchronizes all mapped organizations with their LDAP groups.
+func (s *ldapSync) run(ctx context.Context) error {
+	groupNames := make([]string, 0, len(s.config.Organizations))
+	for group := range s.config.Organizations {
+		groupNames = append(groupNames, group)
+	}
+	groups, err := s.directory.Groups(ctx, groupNames...)
+	if err != nil {
+		return err
+	}
+
+	organizationIDs := make([]string, 0, len(s.mappings))
+	for organizationID := range s.mappings {
+		organizationIDs = append(organizationIDs, organizationID)
+	}
+	sort.Strings(organizationIDs)
+	for _, organizationID := range organizationIDs {
+		logger := log.FromContext(ctx).WithField("organization_id", organizationID)
+		report, err := s.syncOrganization(ctx, organizationID, groups)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to synchronize organization with LDAP groups")
+			continue
+		}
+		if err := s.notify(ctx, organizationID, report); err != nil {
+			logger.WithError(err).Warn("Failed to report LDAP synchronization of organization")
+		}
+	}
+	return nil
+}
+
+// syncOrganization synchronizes the members of the organization with its LDAP groups.
+// When the configuration is a dry run, the drift is reported without changing the organization.
+func (s *ldapSync) syncOrganization(
+	ctx context.Context, organizationID string, groups map[string][]string,
+) (*ldapSyncReport, error) {
+	ids := &ttnpb.OrganizationIdentifiers{OrganizationId: organizationID}
+	mappings := s.mappings[organizationID]
+	report := &ldapSyncReport{}
+	for _, mapping := range mappings {
+		report.groups = append(report.groups, mapping.Group)
+		if _, ok := groups[mapping.Group]; !ok {
+			report.missingGroups = append(report.missingGroups, mapping.Group)
+		}
+	}
+	if len(report.missingGroups) > 0 {
+		// Members are not removed when a group is missing, as it may have been renamed or be temporarily unavailable.
+		return report, nil
+	}
+	desired := ldapsync.DesiredMembers(mappings, groups)
+
+	err := s.is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		report.missingOrganization, report.createdOrganization = false, false
+		report.unknownUsers, report.changes = nil, nil
+
+		_, err := st.GetOrganization(ctx, ids, []string{"ids"})
+		switch {
+		case errors.IsNotFound(err):
+			if !s.config.CreateOrganizations || s.config.DryRun {
+				report.missingOrganization = true
+				return nil
+			}
+			_, err = st.CreateOrganization(ctx, &ttnpb.Organization{
+				Ids:  ids,
+				Name: mappings[0].Group,
+			})
+			if err != nil {
+				return err
+			}
+			ownerIDs := &ttnpb.UserIdentifiers{UserId: s.config.OrganizationOwner}
+			err = st.SetMember(
+				ctx,
+				ownerIDs.GetOrganizationOrUserIdentifiers(),
+				ids.GetEntityIdentifiers(),
+				ttnpb.RightsFrom(ttnpb.Right_RIGHT_ALL),
+			)
+			if err != nil {
+				return err
+			}
+			report.createdOrganization = true
+		case err != nil:
+			return err
+		}
+
+		known := make(map[string]*ttnpb.Rights, len(desired))
+		if len(desired) > 0 {
+			userIDs := make([]*ttnpb.UserIdentifiers, 0, len(desired))
+			for userID := range desired {
+				userIDs = append(userIDs, &ttnpb.UserIdentifiers{UserId: userID})
+			}
+			users, err := st.FindUsers(ctx, userIDs, []string{"ids"})
+			if err != nil {
+				return err
+			}
+			found := make(map[string]struct{}, len(users))
+			for _, usr := range users {
+				found[usr.GetIds().GetUserId()] = struct{}{}
+			}
+			for userID, rights := range desired {
+				if _, ok := found[userID]; !ok {
+					report.unknownUsers = append(report.unknownUsers, userID)
+					continue
+				}
+				known[userID] = rights
+			}
+			sort.Strings(report.unknownUsers)
+		}
+
+		members, err := st.FindMembers(ctx, ids.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		current := make(map[string]*ttnpb.Rights, len(members))
+		for _, member := range members {
+			if member.Ids.EntityType() != store.EntityUser {
+				continue
+			}
+			current[member.Ids.IDString()] = member.Rights
+		}
+		managedIDs, err := st.FindLDAPSyncMembers(ctx, ids)
+		if err != nil {
+			return err
+		}
+		managed := make(map[string]struct{}, len(managedIDs))
+		for _, userIDs := range managedIDs {
+			managed[userIDs.GetUserId()] = struct{}{}
+		}
+		// Only the members that were added by the synchronization are updated and removed.
+		report.changes = ldapsync.Diff(current, known, managed)
+		if s.config.DryRun {
+			return nil
+		}
+
+		for _, change := range report.changes {
+			memberIDs := (&ttnpb.UserIdentifiers{UserId: change.UserID}).GetOrganizationOrUserIdentifiers()
+			if change.Action == ldapsync.ActionRemove {
+				err = st.DeleteMember(ctx, memberIDs, ids.GetEntityIdentifiers())
+			} else {
+				err = st.SetMember(ctx, memberIDs, ids.GetEntityIdentifiers(), change.Rights)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		userIDs := ldapsync.Managed(current, known, managed)
+		managedIDs = make([]*ttnpb.UserIdentifiers, len(userIDs))
+		for i, userID := range userIDs {
+			managedIDs[i] = &ttnpb.UserIdentifiers{UserId: userID}
+		}
+		return st.SetLDAPSyncMembers(ctx, ids, managedIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.config.DryRun {
+		return report, nil
+	}
+	evs := make([]events.Event, 0, len(report.changes)+1)
+	if report.createdOrganization {
+		evs = append(evs, evtCreateOrganization.NewWithIdentifiersAndData(ctx, ids, nil))
+	}
+	for _, change := range report.changes {
+		memberIDs := (&ttnpb.UserIdentifiers{UserId: change.UserID}).GetOrganizationOrUserIdentifiers()
+		if change.Action == ldapsync.ActionRemove {
+			evs = append(evs, evtDeleteOrganizationCollaborator.New(
+				ctx, events.WithIdentifiers(ids, memberIDs),
+			))
+			continue
+		}
+		evs = append(evs, evtUpdateOrganizationCollaborator.New(
+			ctx,
+			events.WithIdentifiers(ids, memberIDs),
+			events.WithData(&ttnpb.Collaborator{Ids: memberIDs, Rights: change.Rights.GetRights()}),
+		))
+	}
+	events.Publish(evs...)
+	return report, nil
+}
+
+// notify notifies the admins of the report, unless it is empty or equal to the last report of the organization.
+func (s *ldapSync) notify(ctx context.Context, organizationID string, report *ldapSyncReport) error {
+	data, err := report.data(s.config.DryRun)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		delete(s.reports, organizationID)
+		return nil
+	}
+	if last, ok := s.reports[organizationID]; ok && proto.Equal(last, data) {
+		return nil
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"organization_id", organizationID,
+		"changes", len(report.changes),
+		"unknown_users", len(report.unknownUsers),
+		"dry_run", s.config.DryRun,
+	)).Info("Organization synchronized with LDAP groups")
+	err = s.is.notifyAdminsInternal(ctx, &ttnpb.CreateNotificationRequest{
+		EntityIds:        (&ttnpb.OrganizationIdentifiers{OrganizationId: organizationID}).GetEntityIdentifiers(),
+		NotificationType: ldapSyncNotificationType,
+		Data:             ttnpb.MustMarshalAny(data),
+		Receivers: []ttnpb.NotificationReceiver{
+			ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_ADMINISTRATIVE_CONTACT,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	s.reports[organizationID] = data
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/ldapsync"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/ldapsync/ldaptest"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestLDAPSync(t *testing.T) {
+	p := &storetest.Population{}
+
+	adminUsr := p.NewUser()
+	adminUsr.Admin = true
+	adminKey, _ := p.NewAPIKey(adminUsr.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	adminCreds := rpcCreds(adminKey)
+
+	usr1 := p.NewUser()
+	usr2 := p.NewUser()
+	org1 := p.NewOrganization(usr2.GetOrganizationOrUserIdentifiers())
+
+	t.Parallel()
+
+	srv, err := ldaptest.New("cn=admin,dc=example,dc=com", "secret",
+		&ldaptest.Entry{
+			DN: "cn=engineers,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"engineers"},
+				"member": {
+					"uid=" + usr1.GetIds().GetUserId() + ",ou=people,dc=example,dc=com",
+					"uid=unknown,ou=people,dc=example,dc=com",
+				},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=operators,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"operators"},
+				"member":      {"uid=" + usr2.GetIds().GetUserId() + ",ou=people,dc=example,dc=com"},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	conf := ldapsync.Config{
+		DryRun:              true,
+		CreateOrganizations: true,
+		OrganizationOwner:   adminUsr.GetIds().GetUserId(),
+		URL:                 srv.URL(),
+		Timeout:             5 * time.Second,
+		BindDN:              "cn=admin,dc=example,dc=com",
+		BindPassword:        "secret",
+		GroupBaseDN:         "ou=groups,dc=example,dc=com",
+		GroupFilter:         "(objectClass=groupOfNames)",
+		GroupNameAttribute:  "cn",
+		MemberAttribute:     "member",
+		UserIDAttribute:     "uid",
+		Organizations: map[string]string{
+			"engineers": org1.GetIds().GetOrganizationId(),
+			"operators": "operators",
+		},
+		Rights: map[string][]string{
+			"engineers": {"RIGHT_ORGANIZATION_ALL"},
+			"operators": {"RIGHT_ORGANIZATION_INFO"},
+		},
+	}
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		a, ctx := test.New(t)
+		orgAccess := ttnpb.NewOrganizationAccessClient(cc)
+		notifications := ttnpb.NewNotificationServiceClient(cc)
+
+		listCollaborators := func(ids *ttnpb.OrganizationIdentifiers) map[string][]ttnpb.Right {
+			res, err := orgAccess.ListCollaborators(ctx, &ttnpb.ListOrganizationCollaboratorsRequest{
+				OrganizationIds: ids,
+			}, adminCreds)
+			if !a.So(err, should.BeNil) {
+				return nil
+			}
+			collaborators := make(map[string][]ttnpb.Right)
+			for _, collaborator := range res.Collaborators {
+				collaborators[collaborator.GetIds().IDString()] = collaborator.Rights
+			}
+			return collaborators
+		}
+
+		s, err := newLDAPSync(is, conf)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(s.run(ctx), should.BeNil)
+
+		// A dry run reports the drift without changing organizations.
+		a.So(listCollaborators(org1.GetIds()), should.Resemble, map[string][]ttnpb.Right{
+			usr2.GetIds().GetUserId(): {ttnpb.Right_RIGHT_ALL},
+		})
+		res, err := notifications.List(ctx, &ttnpb.ListNotificationsRequest{
+			ReceiverIds: adminUsr.GetIds(),
+		}, adminCreds)
+		if a.So(err, should.BeNil) && a.So(res.Notifications, should.HaveLength, 2) {
+			for _, notification := range res.Notifications {
+				a.So(notification.NotificationType, should.Equal, ldapSyncNotificationType)
+				data := &structpb.Struct{}
+				if !a.So(notification.Data.UnmarshalTo(data), should.BeNil) {
+					continue
+				}
+				values := data.AsMap()
+				a.So(values["dry_run"], should.BeTrue)
+				switch notification.GetEntityIds().GetOrganizationIds().GetOrganizationId() {
+				case org1.GetIds().GetOrganizationId():
+					a.So(values["unknown_users"], should.Resemble, []any{"unknown"})
+					// The collaborator that was added by hand is not removed.
+					a.So(values["changes"], should.HaveLength, 1)
+				case "operators":
+					a.So(values["missing_organization"], should.BeTrue)
+				default:
+					t.Errorf("Unexpected notification for %v", notification.GetEntityIds())
+				}
+			}
+		}
+
+		// The same drift is not reported again.
+		a.So(s.run(ctx), should.BeNil)
+		res, err = notifications.List(ctx, &ttnpb.ListNotificationsRequest{
+			ReceiverIds: adminUsr.GetIds(),
+		}, adminCreds)
+		if a.So(err, should.BeNil) {
+			a.So(res.Notifications, should.HaveLength, 2)
+		}
+
+		conf.DryRun = false
+		s, err = newLDAPSync(is, conf)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(s.run(ctx), should.BeNil)
+
+		a.So(listCollaborators(org1.GetIds()), should.Resemble, map[string][]ttnpb.Right{
+			usr1.GetIds().GetUserId(): {ttnpb.Right_RIGHT_ORGANIZATION_ALL},
+			usr2.GetIds().GetUserId(): {ttnpb.Right_RIGHT_ALL},
+		})
+		// Created organizations are owned by the configured owner.
+		a.So(listCollaborators(&ttnpb.OrganizationIdentifiers{OrganizationId: "operators"}), should.Resemble,
+			map[string][]ttnpb.Right{
+				adminUsr.GetIds().GetUserId(): {ttnpb.Right_RIGHT_ALL},
+				usr2.GetIds().GetUserId():     {ttnpb.Right_RIGHT_ORGANIZATION_INFO},
+			},
+		)
+
+		// The rights of the members that were added by the synchronization are updated.
+		conf.Rights["engineers"] = []string{"RIGHT_ORGANIZATION_INFO", "RIGHT_APPLICATION_ALL"}
+		s, err = newLDAPSync(is, conf)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(s.run(ctx), should.BeNil)
+
+		a.So(listCollaborators(org1.GetIds()), should.Resemble, map[string][]ttnpb.Right{
+			usr1.GetIds().GetUserId(): {ttnpb.Right_RIGHT_APPLICATION_ALL, ttnpb.Right_RIGHT_ORGANIZATION_INFO},
+			usr2.GetIds().GetUserId(): {ttnpb.Right_RIGHT_ALL},
+		})
+	}, withPrivateTestDatabase(p))
+}
@@ -0,0 +1,109 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ldapsync reads groups from an LDAP directory, such as Active Directory, and computes the changes that are
+// needed to mirror their members to the collaborators of organizations.
+package ldapsync
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Config is the configuration of the synchronization of LDAP groups to organizations.
+type Config struct {
+	Enabled             bool                `name:"enabled" description:"Enable synchronization of LDAP groups"`
+	Interval            time.Duration       `name:"interval" description:"Interval between synchronizations"`
+	DryRun              bool                `name:"dry-run" description:"Only report drift without changing organizations"`
+	CreateOrganizations bool                `name:"create-organizations" description:"Create missing organizations"`
+	OrganizationOwner   string              `name:"organization-owner" description:"Owner (user ID) of new organizations"`
+	URL                 string              `name:"url" description:"URL of the LDAP server (ldap:// or ldaps://)"`
+	StartTLS            bool                `name:"start-tls" description:"Use StartTLS for ldap:// connections"`
+	Timeout             time.Duration       `name:"timeout" description:"Timeout of LDAP requests"`
+	BindDN              string              `name:"bind-dn" description:"DN to bind with"`
+	BindPassword        string              `name:"bind-password" description:"Password to bind with"`
+	GroupBaseDN         string              `name:"group-base-dn" description:"Base DN of the groups"`
+	GroupFilter         string              `name:"group-filter" description:"LDAP filter that matches groups"`
+	GroupNameAttribute  string              `name:"group-name-attribute" description:"Attribute with the group name"`
+	MemberAttribute     string              `name:"member-attribute" description:"Attribute with the group members"`
+	UserIDAttribute     string              `name:"user-id-attribute" description:"Attribute with the user ID of members"`
+	Organizations       map[string]string   `name:"organizations" description:"Map of LDAP group names to organizations"`
+	Rights              map[string][]string `name:"rights" description:"Map of LDAP group names to organization rights"`
+}
+
+// Mapping maps an LDAP group to an organization.
+type Mapping struct {
+	Group          string
+	OrganizationID string
+	Rights         *ttnpb.Rights
+}
+
+var (
+	errNoRights = errors.DefineInvalidArgument(
+		"no_rights", "no rights configured for LDAP group `{group}`",
+	)
+	errInvalidRight = errors.DefineInvalidArgument(
+		"invalid_right", "invalid right `{right}` for LDAP group `{group}`",
+	)
+	errNotOrganizationRight = errors.DefineInvalidArgument(
+		"not_organization_right", "right `{right}` for LDAP group `{group}` can not be granted to organization members",
+	)
+	errNoOrganizationOwner = errors.DefineInvalidArgument(
+		"no_organization_owner", "no owner configured for created organizations",
+	)
+)
+
+// memberRights are the rights that members of organizations can have.
+var memberRights = ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights)
+
+// Mappings validates the configuration and returns the mappings of LDAP groups to organizations,
+// sorted by group name.
+func (c Config) Mappings() ([]*Mapping, error) {
+	if c.CreateOrganizations && c.OrganizationOwner == "" {
+		return nil, errNoOrganizationOwner.New()
+	}
+	mappings := make([]*Mapping, 0, len(c.Organizations))
+	for group, organizationID := range c.Organizations {
+		names := c.Rights[group]
+		if len(names) == 0 {
+			return nil, errNoRights.WithAttributes("group", group)
+		}
+		rights := &ttnpb.Rights{}
+		for _, name := range names {
+			name = strings.ToUpper(name)
+			if !strings.HasPrefix(name, "RIGHT_") {
+				name = "RIGHT_" + name
+			}
+			right, ok := ttnpb.Right_value[name]
+			if !ok {
+				return nil, errInvalidRight.WithAttributes("right", name, "group", group)
+			}
+			if !memberRights.IncludesAll(ttnpb.Right(right)) {
+				return nil, errNotOrganizationRight.WithAttributes("right", name, "group", group)
+			}
+			rights.Rights = append(rights.Rights, ttnpb.Right(right))
+		}
+		mappings = append(mappings, &Mapping{
+			Group:          group,
+			OrganizationID: organizationID,
+			Rights:         rights.Unique().Sorted(),
+		})
+	}
+	sort.Slice(mappings, func(i, j int) bool { return mappings[i].Group < mappings[j].Group })
+	return mappings, nil
+}
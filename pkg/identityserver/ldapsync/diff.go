@@ -0,0 +1,103 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldapsync
+
+import (
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Action is the action of a Change.
+type Action string
+
+// Actions of a Change.
+const (
+	ActionAdd    Action = "add"
+	ActionUpdate Action = "update"
+	ActionRemove Action = "remove"
+)
+
+// Change is a change to the rights of a member of an organization.
+type Change struct {
+	Action Action
+	UserID string
+	Rights *ttnpb.Rights // Rights is nil when the member is removed.
+}
+
+// DesiredMembers returns the rights of the users in the organization of the given mappings.
+// Users that are member of multiple groups that map to the same organization get the union of the rights.
+func DesiredMembers(mappings []*Mapping, groups map[string][]string) map[string]*ttnpb.Rights {
+	members := make(map[string]*ttnpb.Rights)
+	for _, mapping := range mappings {
+		for _, userID := range groups[mapping.Group] {
+			if rights, ok := members[userID]; ok {
+				members[userID] = rights.Union(mapping.Rights)
+				continue
+			}
+			members[userID] = mapping.Rights
+		}
+	}
+	for userID, rights := range members {
+		members[userID] = rights.Unique().Sorted()
+	}
+	return members
+}
+
+// Diff returns the changes that make the current members equal to the desired members, sorted by user ID.
+// Only the managed members, which were added by the synchronization, are updated and removed. Current members
+// that are not managed were added by other means, and are left as is.
+func Diff(current, desired map[string]*ttnpb.Rights, managed map[string]struct{}) []*Change {
+	var changes []*Change
+	for userID, rights := range desired {
+		currentRights, ok := current[userID]
+		if !ok {
+			changes = append(changes, &Change{Action: ActionAdd, UserID: userID, Rights: rights})
+			continue
+		}
+		if _, ok := managed[userID]; ok && !equalRights(currentRights, rights) {
+			changes = append(changes, &Change{Action: ActionUpdate, UserID: userID, Rights: rights})
+		}
+	}
+	for userID := range current {
+		if _, ok := managed[userID]; !ok {
+			continue
+		}
+		if _, ok := desired[userID]; !ok {
+			changes = append(changes, &Change{Action: ActionRemove, UserID: userID})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].UserID < changes[j].UserID })
+	return changes
+}
+
+// Managed returns the members that are managed by the synchronization after the changes of Diff are applied,
+// sorted by user ID.
+func Managed(current, desired map[string]*ttnpb.Rights, managed map[string]struct{}) []string {
+	var userIDs []string
+	for userID := range desired {
+		_, isCurrent := current[userID]
+		_, isManaged := managed[userID]
+		if !isCurrent || isManaged {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
+
+func equalRights(a, b *ttnpb.Rights) bool {
+	return len(a.Sub(b).GetRights()) == 0 && len(b.Sub(a).GetRights()) == 0
+}
@@ -0,0 +1,160 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldapsync
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+var (
+	errDial   = errors.DefineUnavailable("dial", "dial LDAP server `{url}`")
+	errBind   = errors.DefineUnauthenticated("bind", "bind to LDAP server as `{dn}`")
+	errSearch = errors.DefineUnavailable("search", "search LDAP server")
+)
+
+// Directory reads groups from an LDAP directory.
+type Directory struct {
+	config Config
+}
+
+// NewDirectory returns a new Directory.
+func NewDirectory(config Config) *Directory {
+	return &Directory{config: config}
+}
+
+func (d *Directory) dial(ctx context.Context) (*ldap.Conn, error) {
+	dialer := &net.Dialer{Timeout: d.config.Timeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+	conn, err := ldap.DialURL(d.config.URL, ldap.DialWithDialer(dialer))
+	if err != nil {
+		return nil, errDial.WithAttributes("url", d.config.URL).WithCause(err)
+	}
+	conn.SetTimeout(d.config.Timeout)
+	if d.config.StartTLS {
+		u, err := url.Parse(d.config.URL)
+		if err != nil {
+			conn.Close()
+			return nil, errDial.WithAttributes("url", d.config.URL).WithCause(err)
+		}
+		if err := conn.StartTLS(&tls.Config{ServerName: u.Hostname()}); err != nil { //nolint:gosec
+			conn.Close()
+			return nil, errDial.WithAttributes("url", d.config.URL).WithCause(err)
+		}
+	}
+	if d.config.BindDN != "" {
+		if err := conn.Bind(d.config.BindDN, d.config.BindPassword); err != nil {
+			conn.Close()
+			return nil, errBind.WithAttributes("dn", d.config.BindDN).WithCause(err)
+		}
+	}
+	return conn, nil
+}
+
+// Groups returns the user IDs of the members of the given groups, by group name.
+// Groups that are not found in the directory are omitted. User IDs are lowercase and sorted.
+func (d *Directory) Groups(ctx context.Context, names ...string) (map[string][]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	conn, err := d.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var nameFilter strings.Builder
+	nameFilter.WriteString("(|")
+	for _, name := range names {
+		nameFilter.WriteString("(" + d.config.GroupNameAttribute + "=" + ldap.EscapeFilter(name) + ")")
+	}
+	nameFilter.WriteString(")")
+	filter := nameFilter.String()
+	if d.config.GroupFilter != "" {
+		filter = "(&" + d.config.GroupFilter + filter + ")"
+	}
+
+	res, err := conn.Search(ldap.NewSearchRequest(
+		d.config.GroupBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		filter, []string{d.config.GroupNameAttribute, d.config.MemberAttribute}, nil,
+	))
+	if err != nil {
+		return nil, errSearch.WithCause(err)
+	}
+
+	userIDs := make(map[string]string) // Member DN to user ID.
+	groups := make(map[string][]string, len(res.Entries))
+	for _, entry := range res.Entries {
+		name := entry.GetEqualFoldAttributeValue(d.config.GroupNameAttribute)
+		members := make([]string, 0)
+		for _, member := range entry.GetEqualFoldAttributeValues(d.config.MemberAttribute) {
+			userID, ok := userIDs[member]
+			if !ok {
+				userID, err = d.userID(ctx, conn, member)
+				if err != nil {
+					return nil, err
+				}
+				userIDs[member] = userID
+			}
+			if userID != "" {
+				members = append(members, userID)
+			}
+		}
+		sort.Strings(members)
+		groups[name] = members
+	}
+	return groups, nil
+}
+
+// userID returns the user ID of a group member. The member is either a DN or a user ID.
+// If the first attribute of the DN is the user ID attribute, its value is the user ID.
+// Otherwise, the user ID attribute of the entry of the DN is looked up.
+// An empty user ID is returned if the member has no user ID.
+func (d *Directory) userID(ctx context.Context, conn *ldap.Conn, member string) (string, error) {
+	dn, err := ldap.ParseDN(member)
+	if err != nil || len(dn.RDNs) == 0 {
+		return strings.ToLower(member), nil
+	}
+	for _, attr := range dn.RDNs[0].Attributes {
+		if strings.EqualFold(attr.Type, d.config.UserIDAttribute) {
+			return strings.ToLower(attr.Value), nil
+		}
+	}
+	res, err := conn.Search(ldap.NewSearchRequest(
+		member, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 1, 0, false,
+		"(objectClass=*)", []string{d.config.UserIDAttribute}, nil,
+	))
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			log.FromContext(ctx).WithField("dn", member).Debug("Group member not found")
+			return "", nil
+		}
+		return "", errSearch.WithCause(err)
+	}
+	if len(res.Entries) == 0 {
+		return "", nil
+	}
+	return strings.ToLower(res.Entries[0].GetEqualFoldAttributeValue(d.config.UserIDAttribute)), nil
+}
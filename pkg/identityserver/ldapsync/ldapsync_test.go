@@ -0,0 +1,216 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldapsync_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/ldapsync"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/ldapsync/ldaptest"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+const (
+	bindDN       = "cn=admin,dc=example,dc=com"
+	bindPassword = "secret"
+)
+
+func testConfig(url string) ldapsync.Config {
+	return ldapsync.Config{
+		URL:                url,
+		Timeout:            5 * time.Second,
+		BindDN:             bindDN,
+		BindPassword:       bindPassword,
+		GroupBaseDN:        "ou=groups,dc=example,dc=com",
+		GroupFilter:        "(objectClass=groupOfNames)",
+		GroupNameAttribute: "cn",
+		MemberAttribute:    "member",
+		UserIDAttribute:    "uid",
+		Organizations: map[string]string{
+			"engineers": "engineering",
+			"leads":     "engineering",
+		},
+		Rights: map[string][]string{
+			"engineers": {"RIGHT_ORGANIZATION_INFO", "organization_applications_list"},
+			"leads":     {"RIGHT_ORGANIZATION_ALL"},
+		},
+	}
+}
+
+func TestMappings(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	mappings, err := testConfig("").Mappings()
+	if a.So(err, should.BeNil) && a.So(mappings, should.HaveLength, 2) {
+		a.So(mappings[0], should.Resemble, &ldapsync.Mapping{
+			Group:          "engineers",
+			OrganizationID: "engineering",
+			Rights: ttnpb.RightsFrom(
+				ttnpb.Right_RIGHT_ORGANIZATION_APPLICATIONS_LIST,
+				ttnpb.Right_RIGHT_ORGANIZATION_INFO,
+			),
+		})
+		a.So(mappings[1].Group, should.Equal, "leads")
+	}
+
+	conf := testConfig("")
+	conf.Rights["leads"] = nil
+	_, err = conf.Mappings()
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Organization members can have the rights of the entities of the organization.
+	conf.Rights["leads"] = []string{"RIGHT_APPLICATION_ALL", "gateway_info"}
+	mappings, err = conf.Mappings()
+	if a.So(err, should.BeNil) && a.So(mappings, should.HaveLength, 2) {
+		a.So(mappings[1].Rights, should.Resemble, ttnpb.RightsFrom(
+			ttnpb.Right_RIGHT_APPLICATION_ALL,
+			ttnpb.Right_RIGHT_GATEWAY_INFO,
+		).Sorted())
+	}
+
+	conf.Rights["leads"] = []string{"RIGHT_USER_ALL"}
+	_, err = conf.Mappings()
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	conf.Rights["leads"] = []string{"RIGHT_UNKNOWN"}
+	_, err = conf.Mappings()
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	conf = testConfig("")
+	conf.CreateOrganizations = true
+	_, err = conf.Mappings()
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	conf.OrganizationOwner = "admin"
+	_, err = conf.Mappings()
+	a.So(err, should.BeNil)
+}
+
+func TestDirectory(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	srv, err := ldaptest.New(bindDN, bindPassword,
+		&ldaptest.Entry{
+			DN: "uid=alice,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"alice"},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=Bob Smith,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"Bob"},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=engineers,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"engineers"},
+				"member": {
+					"uid=alice,ou=people,dc=example,dc=com",
+					"cn=Bob Smith,ou=people,dc=example,dc=com",
+					"cn=Unknown,ou=people,dc=example,dc=com",
+				},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=leads,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"leads"},
+				"member":      {"uid=alice,ou=people,dc=example,dc=com"},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=other,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"other"},
+				"member":      {"uid=carol,ou=people,dc=example,dc=com"},
+			},
+		},
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer srv.Close()
+
+	conf := testConfig(srv.URL())
+	groups, err := ldapsync.NewDirectory(conf).Groups(ctx, "engineers", "leads", "missing")
+	a.So(err, should.BeNil)
+	a.So(groups, should.Resemble, map[string][]string{
+		"engineers": {"alice", "bob"},
+		"leads":     {"alice"},
+	})
+
+	conf.BindPassword = "wrong"
+	_, err = ldapsync.NewDirectory(conf).Groups(ctx, "engineers")
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	mappings, err := testConfig("").Mappings()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	desired := ldapsync.DesiredMembers(mappings, map[string][]string{
+		"engineers": {"alice", "bob", "dave", "frank"},
+		"leads":     {"alice"},
+	})
+	a.So(desired, should.HaveLength, 4)
+	a.So(desired["alice"].IncludesAll(
+		ttnpb.Right_RIGHT_ORGANIZATION_ALL,
+		ttnpb.Right_RIGHT_ORGANIZATION_INFO,
+		ttnpb.Right_RIGHT_ORGANIZATION_APPLICATIONS_LIST,
+	), should.BeTrue)
+
+	current := map[string]*ttnpb.Rights{
+		"alice": ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_ALL),
+		"bob":   desired["bob"],
+		"carol": ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_ALL),
+		"erin":  ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_ALL),
+		"frank": ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_ALL),
+	}
+	// Erin and Frank were added by hand, so they are not changed.
+	managed := map[string]struct{}{
+		"alice": {},
+		"bob":   {},
+		"carol": {},
+	}
+	changes := ldapsync.Diff(current, desired, managed)
+	if a.So(changes, should.HaveLength, 3) {
+		a.So(changes[0].Action, should.Equal, ldapsync.ActionUpdate)
+		a.So(changes[0].UserID, should.Equal, "alice")
+		a.So(changes[1].Action, should.Equal, ldapsync.ActionRemove)
+		a.So(changes[1].UserID, should.Equal, "carol")
+		a.So(changes[1].Rights, should.BeNil)
+		a.So(changes[2].Action, should.Equal, ldapsync.ActionAdd)
+		a.So(changes[2].UserID, should.Equal, "dave")
+		a.So(changes[2].Rights, should.Resemble, desired["dave"])
+	}
+	a.So(ldapsync.Managed(current, desired, managed), should.Resemble, []string{"alice", "bob", "dave"})
+}
@@ -0,0 +1,308 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ldaptest implements an in-process LDAP server for testing.
+//
+// The server supports simple binds and searches with and, or, not, equality and presence filters.
+// Attribute names and values are matched case-insensitively.
+package ldaptest
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// Entry is an entry in the directory.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Server is an in-process LDAP server.
+type Server struct {
+	bindDN, bindPassword string
+	lis                  net.Listener
+
+	mu      sync.RWMutex
+	entries []*Entry
+}
+
+// New starts a new Server on a random local port. If bindDN is set, searches require a bind with bindDN and
+// bindPassword.
+func New(bindDN, bindPassword string, entries ...*Entry) (*Server, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		bindDN:       bindDN,
+		bindPassword: bindPassword,
+		lis:          lis,
+		entries:      entries,
+	}
+	go s.serve()
+	return s, nil
+}
+
+// URL returns the URL of the server.
+func (s *Server) URL() string {
+	return "ldap://" + s.lis.Addr().String()
+}
+
+// SetEntries replaces the entries in the directory.
+func (s *Server) SetEntries(entries ...*Entry) {
+	s.mu.Lock()
+	s.entries = entries
+	s.mu.Unlock()
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	return s.lis.Close()
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.lis.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+// LDAP protocol operations.
+const (
+	opBindRequest       ber.Tag = 0
+	opBindResponse      ber.Tag = 1
+	opUnbindRequest     ber.Tag = 2
+	opSearchRequest     ber.Tag = 3
+	opSearchResultEntry ber.Tag = 4
+	opSearchResultDone  ber.Tag = 5
+	opExtendedResponse  ber.Tag = 24
+)
+
+// LDAP result codes.
+const (
+	resultSuccess            = 0
+	resultProtocolError      = 2
+	resultNoSuchObject       = 32
+	resultInvalidCredentials = 49
+	resultInsufficientAccess = 50
+	resultUnwillingToPerform = 53
+)
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	var bound bool
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID, _ := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		switch op.Tag {
+		case opBindRequest:
+			code := int64(resultInvalidCredentials)
+			if len(op.Children) >= 3 &&
+				strings.EqualFold(stringValue(op.Children[1]), s.bindDN) &&
+				string(op.Children[2].Data.Bytes()) == s.bindPassword {
+				code, bound = resultSuccess, true
+			}
+			if err := writeResult(conn, messageID, opBindResponse, code, ""); err != nil {
+				return
+			}
+		case opUnbindRequest:
+			return
+		case opSearchRequest:
+			if s.bindDN != "" && !bound {
+				if err := writeResult(conn, messageID, opSearchResultDone, resultInsufficientAccess, ""); err != nil {
+					return
+				}
+				continue
+			}
+			if err := s.search(conn, messageID, op); err != nil {
+				return
+			}
+		default:
+			// Extended operations, such as StartTLS, are not supported.
+			if err := writeResult(conn, messageID, opExtendedResponse, resultUnwillingToPerform, ""); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) search(conn net.Conn, messageID int64, op *ber.Packet) error {
+	if len(op.Children) < 8 {
+		return writeResult(conn, messageID, opSearchResultDone, resultProtocolError, "")
+	}
+	base := stringValue(op.Children[0])
+	scope, _ := op.Children[1].Value.(int64)
+	filter := op.Children[6]
+	var attributes []string
+	for _, attribute := range op.Children[7].Children {
+		attributes = append(attributes, stringValue(attribute))
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var found bool
+	for _, entry := range s.entries {
+		if strings.EqualFold(entry.DN, base) {
+			found = true
+		}
+		if !inScope(entry.DN, base, scope) || !matches(entry, filter) {
+			continue
+		}
+		if _, err := conn.Write(entryPacket(messageID, entry, attributes).Bytes()); err != nil {
+			return err
+		}
+	}
+	if scope == 0 && !found {
+		return writeResult(conn, messageID, opSearchResultDone, resultNoSuchObject, base)
+	}
+	return writeResult(conn, messageID, opSearchResultDone, resultSuccess, "")
+}
+
+func inScope(dn, base string, scope int64) bool {
+	dn, base = strings.ToLower(dn), strings.ToLower(base)
+	switch scope {
+	case 0: // Base object.
+		return dn == base
+	case 1: // Single level.
+		i := strings.Index(dn, ",")
+		return i >= 0 && dn[i+1:] == base
+	default: // Whole subtree.
+		return base == "" || dn == base || strings.HasSuffix(dn, ","+base)
+	}
+}
+
+// Filter types.
+const (
+	filterAnd      ber.Tag = 0
+	filterOr       ber.Tag = 1
+	filterNot      ber.Tag = 2
+	filterEquality ber.Tag = 3
+	filterPresent  ber.Tag = 7
+)
+
+func matches(entry *Entry, filter *ber.Packet) bool {
+	switch filter.Tag {
+	case filterAnd:
+		for _, child := range filter.Children {
+			if !matches(entry, child) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, child := range filter.Children {
+			if matches(entry, child) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return len(filter.Children) == 1 && !matches(entry, filter.Children[0])
+	case filterEquality:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		value := stringValue(filter.Children[1])
+		for _, v := range attributeValues(entry, stringValue(filter.Children[0])) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case filterPresent:
+		attribute := string(filter.Data.Bytes())
+		return strings.EqualFold(attribute, "objectClass") || len(attributeValues(entry, attribute)) > 0
+	default:
+		return false
+	}
+}
+
+func attributeValues(entry *Entry, name string) []string {
+	for k, v := range entry.Attributes {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+func stringValue(p *ber.Packet) string {
+	if v, ok := p.Value.(string); ok {
+		return v
+	}
+	return string(p.Data.Bytes())
+}
+
+func envelope(messageID int64) *ber.Packet {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	return p
+}
+
+func writeResult(conn net.Conn, messageID int64, tag ber.Tag, code int64, matchedDN string) error {
+	p := envelope(messageID)
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, matchedDN, "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	p.AppendChild(op)
+	_, err := conn.Write(p.Bytes())
+	return err
+}
+
+func entryPacket(messageID int64, entry *Entry, attributes []string) *ber.Packet {
+	p := envelope(messageID)
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, opSearchResultEntry, nil, "Search Result Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "Object Name"))
+	attrs := ber.NewSequence("Attributes")
+	for name, values := range entry.Attributes {
+		if !selected(name, attributes) {
+			continue
+		}
+		attr := ber.NewSequence("Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attr.AppendChild(set)
+		attrs.AppendChild(attr)
+	}
+	op.AppendChild(attrs)
+	p.AppendChild(op)
+	return p
+}
+
+func selected(name string, attributes []string) bool {
+	if len(attributes) == 0 {
+		return true
+	}
+	for _, attribute := range attributes {
+		if attribute == "*" || strings.EqualFold(attribute, name) {
+			return true
+		}
+	}
+	return false
+}
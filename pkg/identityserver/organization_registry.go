@@ -388,6 +388,9 @@ func (is *IdentityServer) purgeOrganization(
 		if err != nil {
 			return err
 		}
+		if err := st.SetLDAPSyncMembers(ctx, ids, nil); err != nil {
+			return err
+		}
 		if err := st.PurgeEntityBookmarks(ctx, ids.GetEntityIdentifiers()); err != nil {
 			return err
 		}
@@ -56,6 +56,12 @@ var (
 	ErrUserSessionNotFound = errors.DefineNotFound(
 		"user_session_not_found", "user session with id `{session_id}` not found", "user_id",
 	)
+	ErrUserMFANotFound = errors.DefineNotFound(
+		"user_mfa_not_found", "multi-factor authentication of user `{user_id}` not found",
+	)
+	ErrUserOIDCLinkNotFound = errors.DefineNotFound(
+		"user_oidc_link_not_found", "no user linked to account of OpenID Connect provider `{provider}`",
+	)
 	ErrLastAdmin = errors.DefineFailedPrecondition(
 		"last_admin", "user `{user_id}` is the last admin",
 	)
@@ -0,0 +1,14 @@
+CREATE TABLE user_mfa (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+
+  user_id uuid NOT NULL,
+  totp_secret bytea NOT NULL,
+  totp_secret_key_id character varying,
+  enabled_at timestamp with time zone,
+  recovery_codes character varying[],
+  last_used_step bigint NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX user_mfa_user_id_idx ON user_mfa (user_id);
@@ -0,0 +1,18 @@
+CREATE TABLE audit_entries (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+
+  actor_type character varying,
+  actor_id character varying,
+  auth_method character varying,
+  auth_id character varying,
+  entity_type character varying NOT NULL,
+  entity_id character varying NOT NULL,
+  action character varying NOT NULL,
+  field_mask character varying[],
+  before_values jsonb,
+  after_values jsonb
+);
+
+CREATE INDEX audit_entries_created_at_idx ON audit_entries (created_at);
+CREATE INDEX audit_entries_entity_idx ON audit_entries (entity_type, entity_id, created_at);
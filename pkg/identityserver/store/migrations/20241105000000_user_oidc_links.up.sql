@@ -0,0 +1,12 @@
+CREATE TABLE user_oidc_links (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+
+  user_id uuid NOT NULL,
+  provider character varying NOT NULL,
+  subject character varying NOT NULL
+);
+
+CREATE UNIQUE INDEX user_oidc_links_provider_subject_idx ON user_oidc_links (provider, subject);
+CREATE UNIQUE INDEX user_oidc_links_user_id_provider_idx ON user_oidc_links (user_id, provider);
@@ -0,0 +1,10 @@
+CREATE TABLE ldap_sync_members (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+
+  organization_id uuid NOT NULL,
+  user_id uuid NOT NULL
+);
+
+CREATE UNIQUE INDEX ldap_sync_members_organization_id_user_id_idx ON ldap_sync_members (organization_id, user_id);
@@ -186,6 +186,98 @@ type UserSessionStore interface {
 	DeleteAllUserSessions(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
 }
 
+// UserMFA is the multi-factor authentication configuration of a user.
+type UserMFA struct {
+	// TOTPSecret is the TOTP secret, encrypted with the key with ID TOTPSecretKeyID.
+	// If TOTPSecretKeyID is empty, the secret is stored in plaintext.
+	TOTPSecret      []byte
+	TOTPSecretKeyID string
+	// EnabledAt is the time at which the enrollment was confirmed, or nil while the enrollment is pending.
+	EnabledAt *time.Time
+	// RecoveryCodes are the hashes of the recovery codes that have not been used.
+	RecoveryCodes []string
+	// LastUsedStep is the TOTP time step that was last used, to prevent replay of codes.
+	LastUsedStep int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UserMFAStore interface for storing the multi-factor authentication configuration of users.
+//
+// For internal use (by the account app and the OAuth server) only.
+type UserMFAStore interface {
+	GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*UserMFA, error)
+	// SetUserMFA creates or replaces the multi-factor authentication configuration of the user.
+	SetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers, mfa *UserMFA) (*UserMFA, error)
+	DeleteUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
+}
+
+// UserOIDCLinkStore interface for storing the links between users and the accounts of OpenID Connect providers.
+//
+// For internal use (by the account app) only.
+type UserOIDCLinkStore interface {
+	// GetUserIDsByOIDCSubject returns the identifiers of the user that is linked to the subject of the provider.
+	GetUserIDsByOIDCSubject(ctx context.Context, provider, subject string) (*ttnpb.UserIdentifiers, error)
+	// CreateUserOIDCLink links the user to the subject of the provider.
+	CreateUserOIDCLink(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, subject string) error
+	// DeleteUserOIDCLinks deletes all links of the user.
+	DeleteUserOIDCLinks(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
+}
+
+// LDAPSyncMemberStore interface for storing which members of organizations are managed by the LDAP synchronization.
+//
+// For internal use (by the LDAP synchronization) only.
+type LDAPSyncMemberStore interface {
+	// FindLDAPSyncMembers returns the users of which the membership of the organization is managed by the LDAP
+	// synchronization.
+	FindLDAPSyncMembers(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) ([]*ttnpb.UserIdentifiers, error)
+	// SetLDAPSyncMembers replaces the users of which the membership of the organization is managed by the LDAP
+	// synchronization.
+	SetLDAPSyncMembers(
+		ctx context.Context, ids *ttnpb.OrganizationIdentifiers, userIDs []*ttnpb.UserIdentifiers,
+	) error
+}
+
+// AuditEntry is an entry in the audit log of changes to entities.
+type AuditEntry struct {
+	CreatedAt time.Time
+	// ActorIDs are the identifiers of the user, organization or entity that performed the action.
+	// This is nil for unauthenticated requests and requests from the cluster.
+	ActorIDs *ttnpb.EntityIdentifiers
+	// AuthMethod is the method that the actor authenticated with, for example api_key or user_session.
+	AuthMethod string
+	// AuthID is the ID of the API key, the ID of the OAuth client or the ID of the user session.
+	AuthID string
+	// EntityIDs are the identifiers of the entity that was changed.
+	EntityIDs *ttnpb.EntityIdentifiers
+	// Action is the name of the action, for example application.update or gateway.api-key.create.
+	Action string
+	// FieldMask contains the paths of the fields that were updated, if any.
+	FieldMask []string
+	// Before and After contain the values before and after the change, of the fields that changed.
+	Before map[string]any
+	After  map[string]any
+}
+
+// AuditEntryFilter filters audit entries.
+type AuditEntryFilter struct {
+	// EntityIDs are the entities of which entries are returned. All entities match if empty.
+	EntityIDs []*ttnpb.EntityIdentifiers
+	// Actions are the actions of which entries are returned. All actions match if empty.
+	Actions []string
+	// After is the time after which entries are returned.
+	After *time.Time
+}
+
+// AuditEntryStore interface for storing the audit log.
+type AuditEntryStore interface {
+	CreateAuditEntry(ctx context.Context, entry *AuditEntry) error
+	// FindAuditEntries returns the audit entries that match the filter, most recent first.
+	// The results are paginated with the options from store.WithPagination.
+	FindAuditEntries(ctx context.Context, filter *AuditEntryFilter) ([]*AuditEntry, error)
+}
+
 // UserBookmarkStore interface for storing user bookmarks.
 type UserBookmarkStore interface {
 	CreateBookmark(context.Context, *ttnpb.UserBookmark) (*ttnpb.UserBookmark, error)
@@ -422,7 +514,11 @@ type Store interface {
 	OrganizationStore
 	UserBookmarkStore
 	UserSessionStore
+	UserMFAStore
+	UserOIDCLinkStore
 	UserStore
+	LDAPSyncMemberStore
+	AuditEntryStore
 	MembershipStore
 	APIKeyStore
 	OAuthStore
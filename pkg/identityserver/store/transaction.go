@@ -0,0 +1,69 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"sync"
+)
+
+// AuditFunc writes the audit log entry of a change with the store of the transaction of the change.
+type AuditFunc func(ctx context.Context, st Store) error
+
+// Audit is an audit log entry that is pending until it is committed with a change.
+type Audit struct {
+	write AuditFunc
+
+	mu        sync.Mutex
+	committed bool
+}
+
+// Write writes the audit log entry, unless it was already committed with an earlier change.
+func (a *Audit) Write(ctx context.Context, st Store) (written bool, err error) {
+	a.mu.Lock()
+	committed := a.committed
+	a.mu.Unlock()
+	if committed {
+		return false, nil
+	}
+	if err := a.write(ctx, st); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Commit marks the audit log entry as committed, so that it is not written again.
+func (a *Audit) Commit() {
+	a.mu.Lock()
+	a.committed = true
+	a.mu.Unlock()
+}
+
+type auditKeyType struct{}
+
+var auditKey auditKeyType
+
+// WithAudit returns a context that tells the store to write the audit log entry with write in the
+// first transaction started with the (derived) context that changes data, before it commits.
+// If the audit log entry can not be written, the transaction is rolled back.
+func WithAudit(ctx context.Context, write AuditFunc) context.Context {
+	return context.WithValue(ctx, auditKey, &Audit{write: write})
+}
+
+// AuditFromContext returns the pending audit log entry of the context, if any.
+func AuditFromContext(ctx context.Context) *Audit {
+	audit, _ := ctx.Value(auditKey).(*Audit)
+	return audit
+}
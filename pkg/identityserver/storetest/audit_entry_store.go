@@ -0,0 +1,193 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"context"
+	. "testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestAuditEntryStore(t *T) {
+	app1IDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	app2IDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "bar-app"}
+	usrIDs := &ttnpb.UserIdentifiers{UserId: "foo-usr"}
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.AuditEntryStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement AuditEntryStore")
+	}
+	defer s.Close()
+
+	start := time.Now().Truncate(time.Second)
+
+	t.Run("CreateAuditEntry", func(t *T) {
+		a, ctx := test.New(t)
+		for i, entry := range []*is.AuditEntry{
+			{
+				ActorIDs:   usrIDs.GetEntityIdentifiers(),
+				AuthMethod: "user_session",
+				AuthID:     "session-id",
+				EntityIDs:  app1IDs.GetEntityIdentifiers(),
+				Action:     "application.create",
+				After:      map[string]any{"name": "Foo"},
+			},
+			{
+				ActorIDs:   usrIDs.GetEntityIdentifiers(),
+				AuthMethod: "api_key",
+				AuthID:     "key-id",
+				EntityIDs:  app1IDs.GetEntityIdentifiers(),
+				Action:     "application.update",
+				FieldMask:  []string{"name"},
+				Before:     map[string]any{"name": "Foo"},
+				After:      map[string]any{"name": "Bar"},
+			},
+			{
+				EntityIDs: app2IDs.GetEntityIdentifiers(),
+				Action:    "application.delete",
+			},
+		} {
+			entry.CreatedAt = start.Add(time.Duration(i) * time.Second)
+			err := s.CreateAuditEntry(ctx, entry)
+			a.So(err, should.BeNil)
+		}
+	})
+
+	t.Run("FindAuditEntries", func(t *T) {
+		a, ctx := test.New(t)
+
+		// The most recent entries are returned first.
+		entries, err := s.FindAuditEntries(ctx, &is.AuditEntryFilter{})
+		if a.So(err, should.BeNil) && a.So(entries, should.HaveLength, 3) {
+			a.So(entries[2].Action, should.Equal, "application.create")
+			a.So(entries[2].CreatedAt, should.Equal, start)
+			a.So(entries[2].ActorIDs, should.Resemble, usrIDs.GetEntityIdentifiers())
+			a.So(entries[2].AuthMethod, should.Equal, "user_session")
+			a.So(entries[2].AuthID, should.Equal, "session-id")
+			a.So(entries[2].After, should.Resemble, map[string]any{"name": "Foo"})
+			a.So(entries[1].FieldMask, should.Resemble, []string{"name"})
+			a.So(entries[1].Before, should.Resemble, map[string]any{"name": "Foo"})
+			a.So(entries[1].After, should.Resemble, map[string]any{"name": "Bar"})
+			a.So(entries[0].EntityIDs, should.Resemble, app2IDs.GetEntityIdentifiers())
+			a.So(entries[0].ActorIDs, should.BeNil)
+		}
+
+		entries, err = s.FindAuditEntries(ctx, &is.AuditEntryFilter{
+			EntityIDs: []*ttnpb.EntityIdentifiers{app1IDs.GetEntityIdentifiers()},
+		})
+		if a.So(err, should.BeNil) && a.So(entries, should.HaveLength, 2) {
+			a.So(entries[0].Action, should.Equal, "application.update")
+			a.So(entries[1].Action, should.Equal, "application.create")
+		}
+
+		entries, err = s.FindAuditEntries(ctx, &is.AuditEntryFilter{
+			Actions: []string{"application.update", "application.delete"},
+		})
+		if a.So(err, should.BeNil) && a.So(entries, should.HaveLength, 2) {
+			a.So(entries[0].Action, should.Equal, "application.delete")
+			a.So(entries[1].Action, should.Equal, "application.update")
+		}
+
+		entries, err = s.FindAuditEntries(ctx, &is.AuditEntryFilter{After: &start})
+		if a.So(err, should.BeNil) && a.So(entries, should.HaveLength, 2) {
+			a.So(entries[0].Action, should.Equal, "application.delete")
+			a.So(entries[1].Action, should.Equal, "application.update")
+		}
+	})
+
+	t.Run("FindAuditEntries_Paginated", func(t *T) {
+		a, ctx := test.New(t)
+
+		var total uint64
+		for page, action := range []string{"application.delete", "application.update", "application.create"} {
+			entries, err := s.FindAuditEntries(
+				is.WithPagination(ctx, 1, uint32(page+1), &total), &is.AuditEntryFilter{},
+			)
+			if a.So(err, should.BeNil) && a.So(entries, should.HaveLength, 1) {
+				a.So(entries[0].Action, should.Equal, action)
+			}
+			a.So(total, should.Equal, 3)
+		}
+	})
+
+	if ts, ok := s.(interface {
+		Transact(context.Context, func(context.Context, is.Store) error) error
+	}); ok {
+		t.Run("Audit", func(t *T) {
+			a, ctx := test.New(t)
+			errRollback := errors.New("rollback")
+
+			auditCtx := func() context.Context {
+				return is.WithAudit(ctx, func(ctx context.Context, st is.Store) error {
+					return st.CreateAuditEntry(ctx, &is.AuditEntry{
+						EntityIDs: app2IDs.GetEntityIdentifiers(),
+						Action:    "application.purge",
+					})
+				})
+			}
+			findPurges := func() []*is.AuditEntry {
+				entries, err := s.FindAuditEntries(ctx, &is.AuditEntryFilter{
+					Actions: []string{"application.purge"},
+				})
+				a.So(err, should.BeNil)
+				return entries
+			}
+
+			// The audit log entry is rolled back with the change.
+			err := ts.Transact(auditCtx(), func(ctx context.Context, st is.Store) error {
+				if err := st.CreateAuditEntry(ctx, &is.AuditEntry{
+					EntityIDs: app2IDs.GetEntityIdentifiers(),
+					Action:    "application.restore",
+				}); err != nil {
+					return err
+				}
+				return errRollback
+			})
+			a.So(err, should.Equal, errRollback)
+			a.So(findPurges(), should.BeEmpty)
+
+			// The audit log entry is not written in transactions that do not change data.
+			writeCtx := auditCtx()
+			err = ts.Transact(writeCtx, func(ctx context.Context, st is.Store) error {
+				_, err := st.FindAuditEntries(ctx, &is.AuditEntryFilter{})
+				return err
+			})
+			a.So(err, should.BeNil)
+			a.So(findPurges(), should.BeEmpty)
+
+			// The audit log entry is written once, in the first transaction that changes data.
+			for i := 0; i < 2; i++ {
+				err = ts.Transact(writeCtx, func(ctx context.Context, st is.Store) error {
+					return st.CreateAuditEntry(ctx, &is.AuditEntry{
+						EntityIDs: app2IDs.GetEntityIdentifiers(),
+						Action:    "application.restore",
+					})
+				})
+				a.So(err, should.BeNil)
+			}
+			a.So(findPurges(), should.HaveLength, 1)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	"sort"
+	. "testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestLDAPSyncMemberStore(t *T) {
+	usr1 := st.population.NewUser()
+	usr2 := st.population.NewUser()
+	org1 := st.population.NewOrganization(usr1.GetOrganizationOrUserIdentifiers())
+	org2 := st.population.NewOrganization(usr1.GetOrganizationOrUserIdentifiers())
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.LDAPSyncMemberStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement LDAPSyncMemberStore")
+	}
+	defer s.Close()
+
+	t.Run("FindLDAPSyncMembers_Empty", func(t *T) {
+		a, ctx := test.New(t)
+		got, err := s.FindLDAPSyncMembers(ctx, org1.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.BeEmpty)
+		}
+	})
+
+	t.Run("FindLDAPSyncMembers_OrganizationNotFound", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.FindLDAPSyncMembers(ctx, &ttnpb.OrganizationIdentifiers{OrganizationId: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+
+	t.Run("SetLDAPSyncMembers", func(t *T) {
+		a, ctx := test.New(t)
+		a.So(s.SetLDAPSyncMembers(ctx, org1.GetIds(), []*ttnpb.UserIdentifiers{
+			usr2.GetIds(), usr1.GetIds(), {UserId: "unknown"},
+		}), should.BeNil)
+		a.So(s.SetLDAPSyncMembers(ctx, org2.GetIds(), []*ttnpb.UserIdentifiers{usr2.GetIds()}), should.BeNil)
+
+		got, err := s.FindLDAPSyncMembers(ctx, org1.GetIds())
+		if a.So(err, should.BeNil) {
+			expected := []*ttnpb.UserIdentifiers{usr1.GetIds(), usr2.GetIds()}
+			sort.Slice(expected, func(i, j int) bool { return expected[i].UserId < expected[j].UserId })
+			a.So(got, should.Resemble, expected)
+		}
+
+		a.So(s.SetLDAPSyncMembers(ctx, org1.GetIds(), []*ttnpb.UserIdentifiers{usr1.GetIds()}), should.BeNil)
+		got, err = s.FindLDAPSyncMembers(ctx, org1.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.Resemble, []*ttnpb.UserIdentifiers{usr1.GetIds()})
+		}
+
+		// The members of other organizations are not changed.
+		got, err = s.FindLDAPSyncMembers(ctx, org2.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.Resemble, []*ttnpb.UserIdentifiers{usr2.GetIds()})
+		}
+
+		a.So(s.SetLDAPSyncMembers(ctx, org1.GetIds(), nil), should.BeNil)
+		got, err = s.FindLDAPSyncMembers(ctx, org1.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.BeEmpty)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestUserMFAStore(t *T) {
+	usr1 := st.population.NewUser()
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.UserMFAStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement UserMFAStore")
+	}
+	defer s.Close()
+
+	t.Run("GetUserMFA_NotFound", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.GetUserMFA(ctx, usr1.GetIds())
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+
+	t.Run("SetUserMFA_Pending", func(t *T) {
+		a, ctx := test.New(t)
+		start := time.Now().Truncate(time.Second)
+		created, err := s.SetUserMFA(ctx, usr1.GetIds(), &is.UserMFA{
+			TOTPSecret: []byte("12345678901234567890"),
+		})
+		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+			a.So(created.TOTPSecret, should.Resemble, []byte("12345678901234567890"))
+			a.So(created.EnabledAt, should.BeNil)
+			a.So(created.CreatedAt, should.HappenWithin, 5*time.Second, start)
+		}
+	})
+
+	enabledAt := time.Now().Truncate(time.Second)
+
+	t.Run("SetUserMFA_Enabled", func(t *T) {
+		a, ctx := test.New(t)
+		updated, err := s.SetUserMFA(ctx, usr1.GetIds(), &is.UserMFA{
+			TOTPSecret:      []byte("encrypted"),
+			TOTPSecretKeyID: "test",
+			EnabledAt:       &enabledAt,
+			RecoveryCodes:   []string{"hash1", "hash2"},
+			LastUsedStep:    42,
+		})
+		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+			a.So(updated.TOTPSecretKeyID, should.Equal, "test")
+			a.So(updated.RecoveryCodes, should.Resemble, []string{"hash1", "hash2"})
+		}
+	})
+
+	t.Run("GetUserMFA", func(t *T) {
+		a, ctx := test.New(t)
+		got, err := s.GetUserMFA(ctx, usr1.GetIds())
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.TOTPSecret, should.Resemble, []byte("encrypted"))
+			a.So(got.TOTPSecretKeyID, should.Equal, "test")
+			if a.So(got.EnabledAt, should.NotBeNil) {
+				a.So(*got.EnabledAt, should.Equal, enabledAt)
+			}
+			a.So(got.RecoveryCodes, should.Resemble, []string{"hash1", "hash2"})
+			a.So(got.LastUsedStep, should.Equal, int64(42))
+		}
+	})
+
+	t.Run("DeleteUserMFA", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.DeleteUserMFA(ctx, usr1.GetIds())
+		a.So(err, should.BeNil)
+		_, err = s.GetUserMFA(ctx, usr1.GetIds())
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
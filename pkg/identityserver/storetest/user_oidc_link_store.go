@@ -0,0 +1,82 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestUserOIDCLinkStore(t *T) {
+	usr1 := st.population.NewUser()
+	usr2 := st.population.NewUser()
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.UserOIDCLinkStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement UserOIDCLinkStore")
+	}
+	defer s.Close()
+
+	t.Run("GetUserIDsByOIDCSubject_NotFound", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.GetUserIDsByOIDCSubject(ctx, "idp", "subject1")
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+
+	t.Run("CreateUserOIDCLink", func(t *T) {
+		a, ctx := test.New(t)
+		a.So(s.CreateUserOIDCLink(ctx, usr1.GetIds(), "idp", "subject1"), should.BeNil)
+		a.So(s.CreateUserOIDCLink(ctx, usr1.GetIds(), "other-idp", "subject1"), should.BeNil)
+
+		// A subject can only be linked to one user, and a user can only be linked to one subject of a provider.
+		err := s.CreateUserOIDCLink(ctx, usr2.GetIds(), "idp", "subject1")
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+		err = s.CreateUserOIDCLink(ctx, usr1.GetIds(), "idp", "subject2")
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+	})
+
+	t.Run("GetUserIDsByOIDCSubject", func(t *T) {
+		a, ctx := test.New(t)
+		got, err := s.GetUserIDsByOIDCSubject(ctx, "idp", "subject1")
+		if a.So(err, should.BeNil) {
+			a.So(got, should.Resemble, usr1.GetIds())
+		}
+	})
+
+	t.Run("DeleteUserOIDCLinks", func(t *T) {
+		a, ctx := test.New(t)
+		a.So(s.DeleteUserOIDCLinks(ctx, usr1.GetIds()), should.BeNil)
+		for _, provider := range []string{"idp", "other-idp"} {
+			_, err := s.GetUserIDsByOIDCSubject(ctx, provider, "subject1")
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+		}
+	})
+}
@@ -76,6 +76,12 @@ var (
 		events.WithAuthFromContext(),
 		events.WithClientInfoFromContext(),
 	)
+	evtResetUserMFA = events.Define(
+		"user.mfa.reset", "reset multi-factor authentication of user",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_INFO),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
 )
 
 var (
@@ -693,6 +699,23 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) resetUserMFA(ctx context.Context, ids *ttnpb.UserIdentifiers) (*emptypb.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		if _, err := st.GetUser(ctx, ids, []string{"ids"}); err != nil {
+			return err
+		}
+		return st.DeleteUserMFA(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtResetUserMFA.NewWithIdentifiersAndData(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 func (is *IdentityServer) deleteUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*emptypb.Empty, error) {
 	if err := rights.RequireUser(ctx, ids, ttnpb.Right_RIGHT_USER_DELETE); err != nil {
 		return nil, err
@@ -776,6 +799,12 @@ func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifi
 		if err := st.PurgeUserBookmarks(ctx, ids); err != nil {
 			return err
 		}
+		if err := st.DeleteUserMFA(ctx, ids); err != nil {
+			return err
+		}
+		if err := st.DeleteUserOIDCLinks(ctx, ids); err != nil {
+			return err
+		}
 		return st.PurgeUser(ctx, ids)
 	})
 	if err != nil {
@@ -815,6 +844,10 @@ func (ur *userRegistry) CreateTemporaryPassword(ctx context.Context, req *ttnpb.
 	return ur.createTemporaryPassword(ctx, req)
 }
 
+func (ur *userRegistry) ResetMFA(ctx context.Context, req *ttnpb.UserIdentifiers) (*emptypb.Empty, error) {
+	return ur.resetUserMFA(ctx, req)
+}
+
 func (ur *userRegistry) Delete(ctx context.Context, req *ttnpb.UserIdentifiers) (*emptypb.Empty, error) {
 	return ur.deleteUser(ctx, req)
 }
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -239,6 +240,54 @@ func TestUserUpdateInvalidPassword(t *testing.T) {
 	}, withPrivateTestDatabase(p))
 }
 
+func TestUserResetMFA(t *testing.T) {
+	t.Parallel()
+
+	p := &storetest.Population{}
+
+	adminUsr := p.NewUser()
+	adminUsr.Admin = true
+	adminUsrKey, _ := p.NewAPIKey(adminUsr.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	adminUsrCreds := rpcCreds(adminUsrKey)
+
+	usr := p.NewUser()
+	usrKey, _ := p.NewAPIKey(usr.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ALL)
+	usrCreds := rpcCreds(usrKey)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		a, ctx := test.New(t)
+
+		enabledAt := time.Now()
+		_, err := is.store.SetUserMFA(ctx, usr.GetIds(), &store.UserMFA{
+			TOTPSecret: []byte("12345678901234567890"),
+			EnabledAt:  &enabledAt,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		reg := ttnpb.NewUserRegistryClient(cc)
+
+		_, err = reg.ResetMFA(ctx, usr.GetIds(), usrCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.ResetMFA(ctx, &ttnpb.UserIdentifiers{UserId: "unknown-user"}, adminUsrCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = reg.ResetMFA(ctx, usr.GetIds(), adminUsrCreds)
+		a.So(err, should.BeNil)
+
+		_, err = is.store.GetUserMFA(ctx, usr.GetIds())
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	}, withPrivateTestDatabase(p))
+}
+
 func TestUsersCRUD(t *testing.T) {
 	t.Parallel()
 
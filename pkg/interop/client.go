@@ -59,6 +59,43 @@ func (p jsRPCPaths) appSKey() string {
 	return p.AppSKey
 }
 
+func (p jsRPCPaths) homeNS() string {
+	return p.HomeNS
+}
+
+type nsRPCPaths struct {
+	PRStart  string `yaml:"pr-start"`
+	PRStop   string `yaml:"pr-stop"`
+	XmitData string `yaml:"xmit-data"`
+	Profile  string `yaml:"profile"`
+	HRStart  string `yaml:"hr-start"`
+	HRStop   string `yaml:"hr-stop"`
+}
+
+func (p nsRPCPaths) prStart() string {
+	return p.PRStart
+}
+
+func (p nsRPCPaths) prStop() string {
+	return p.PRStop
+}
+
+func (p nsRPCPaths) xmitData() string {
+	return p.XmitData
+}
+
+func (p nsRPCPaths) profile() string {
+	return p.Profile
+}
+
+func (p nsRPCPaths) hrStart() string {
+	return p.HRStart
+}
+
+func (p nsRPCPaths) hrStop() string {
+	return p.HRStop
+}
+
 func serverURL(scheme, fqdn, path string, port uint32) string {
 	if scheme == "" {
 		scheme = "https"
@@ -313,16 +350,230 @@ func (cl joinServerHTTPClient) HandleJoinRequest(
 	}, nil
 }
 
+// HomeNSRequest performs home Network Server request according to LoRaWAN Backend Interfaces specification.
+func (cl joinServerHTTPClient) HomeNSRequest(
+	ctx context.Context, netID types.NetID, nsID *types.EUI64, joinEUI, devEUI types.EUI64,
+) (*TTIHomeNSAns, error) {
+	if cl.senderNSID != nil {
+		nsID = cl.senderNSID
+	}
+	if cl.protocol.RequiresNSID() && nsID == nil {
+		return nil, errMissingNSID.New()
+	}
+	if !cl.protocol.RequiresNSID() {
+		if cl.senderNSID != nil {
+			// This is bad configuration that should fail to avoid unintended behavior.
+			return nil, errNSIDNotSupported.New()
+		}
+		// If the protocol does not require NSID, we can safely set it to nil.
+		nsID = nil
+	}
+
+	interopAns := &TTIHomeNSAns{}
+	if err := cl.exchange(ctx, jsRPCPaths.homeNS, &HomeNSReq{
+		NsJsMessageHeader: NsJsMessageHeader{
+			MessageHeader: MessageHeader{
+				ProtocolVersion: cl.protocol,
+				MessageType:     MessageTypeHomeNSReq,
+			},
+			SenderID:   NetID(netID),
+			SenderNSID: (*EUI64)(nsID),
+			ReceiverID: EUI64(joinEUI),
+		},
+		DevEUI: EUI64(devEUI),
+	}, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	return interopAns, nil
+}
+
 // GeneratedSessionKeyID returns whether the session key ID is generated locally and not by the Join Server.
 func GeneratedSessionKeyID(id []byte) bool {
 	return bytes.HasPrefix(id, generatedSessionKeyIDPrefix)
 }
 
+type networkServerHTTPClient struct {
+	clientProvider     httpclient.Provider
+	clientOpts         []httpclient.Option
+	protocol           ProtocolVersion
+	scheme, fqdn       string
+	port               uint32
+	paths              nsRPCPaths
+	headers            map[string]string
+	username, password string
+	senderNSID         *types.EUI64
+	receiverNSID       *types.EUI64
+}
+
+func (cl networkServerHTTPClient) exchange(
+	ctx context.Context, pathFunc func(nsRPCPaths) string, pld, res any,
+) error {
+	client, err := cl.clientProvider.HTTPClient(ctx, cl.clientOpts...)
+	if err != nil {
+		return err
+	}
+	scheme := cl.scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	if scheme != "https" {
+		log.FromContext(ctx).WithField("scheme", scheme).Warn("Use non-https scheme for contacting interop Network Server")
+	}
+	req, err := newHTTPRequest(
+		serverURL(scheme, cl.fqdn, pathFunc(cl.paths), cl.port), pld, cl.headers, cl.username, cl.password,
+	)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, req.WithContext(ctx), res, client.Do)
+}
+
+// header returns the message header with the protocol version, message type and NSIDs set according to the
+// configuration of the Network Server.
+func (cl networkServerHTTPClient) header(h NsNsMessageHeader, messageType MessageType) (NsNsMessageHeader, error) {
+	h.ProtocolVersion = cl.protocol
+	h.MessageType = messageType
+	if cl.senderNSID != nil {
+		h.SenderNSID = (*EUI64)(cl.senderNSID)
+	}
+	if !cl.protocol.RequiresNSID() {
+		if cl.senderNSID != nil || cl.receiverNSID != nil {
+			// This is bad configuration that should fail to avoid unintended behavior.
+			return NsNsMessageHeader{}, errNSIDNotSupported.New()
+		}
+		// If the protocol does not require NSID, we can safely set it to nil.
+		h.SenderNSID, h.ReceiverNSID = nil, nil
+		return h, nil
+	}
+	if h.SenderNSID == nil {
+		return NsNsMessageHeader{}, errMissingNSID.New()
+	}
+	h.ReceiverNSID = (*EUI64)(cl.receiverNSID)
+	return h, nil
+}
+
+// PRStartRequest performs passive roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	header, err := cl.header(req.NsNsMessageHeader, MessageTypePRStartReq)
+	if err != nil {
+		return nil, err
+	}
+	req.NsNsMessageHeader = header
+	ans := &PRStartAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.prStart, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// PRStopRequest performs passive roaming stop request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStopRequest(ctx context.Context, req *PRStopReq) (*PRStopAns, error) {
+	header, err := cl.header(req.NsNsMessageHeader, MessageTypePRStopReq)
+	if err != nil {
+		return nil, err
+	}
+	req.NsNsMessageHeader = header
+	ans := &PRStopAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.prStop, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// XmitDataRequest performs data transmission request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) XmitDataRequest(ctx context.Context, req *TTIXmitDataReq) (*XmitDataAns, error) {
+	header, err := cl.header(req.NsNsMessageHeader, MessageTypeXmitDataReq)
+	if err != nil {
+		return nil, err
+	}
+	req.NsNsMessageHeader = header
+	ans := &XmitDataAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.xmitData, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// ProfileRequest performs device profile request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) ProfileRequest(ctx context.Context, req *TTIProfileReq) (*ProfileAns, error) {
+	header, err := cl.header(req.NsNsMessageHeader, MessageTypeProfileReq)
+	if err != nil {
+		return nil, err
+	}
+	req.NsNsMessageHeader = header
+	ans := &ProfileAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.profile, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// HRStartRequest performs handover roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) HRStartRequest(ctx context.Context, req *HRStartReq) (*HRStartAns, error) {
+	header, err := cl.header(req.NsNsMessageHeader, MessageTypeHRStartReq)
+	if err != nil {
+		return nil, err
+	}
+	req.NsNsMessageHeader = header
+	ans := &HRStartAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.hrStart, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// HRStopRequest performs handover roaming stop request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) HRStopRequest(ctx context.Context, req *TTIHRStopReq) (*HRStopAns, error) {
+	header, err := cl.header(req.NsNsMessageHeader, MessageTypeHRStopReq)
+	if err != nil {
+		return nil, err
+	}
+	req.NsNsMessageHeader = header
+	ans := &HRStopAns{}
+	if err := cl.exchange(ctx, nsRPCPaths.hrStop, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+type networkServerClient interface {
+	PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error)
+	PRStopRequest(ctx context.Context, req *PRStopReq) (*PRStopAns, error)
+	XmitDataRequest(ctx context.Context, req *TTIXmitDataReq) (*XmitDataAns, error)
+	ProfileRequest(ctx context.Context, req *TTIProfileReq) (*ProfileAns, error)
+	HRStartRequest(ctx context.Context, req *HRStartReq) (*HRStartAns, error)
+	HRStopRequest(ctx context.Context, req *TTIHRStopReq) (*HRStopAns, error)
+}
+
 type joinServerClient interface {
 	HandleJoinRequest(
 		ctx context.Context, netID types.NetID, nsID *types.EUI64, req *ttnpb.JoinRequest,
 	) (*ttnpb.JoinResponse, error)
 	GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error)
+	HomeNSRequest(
+		ctx context.Context, netID types.NetID, nsID *types.EUI64, joinEUI, devEUI types.EUI64,
+	) (*TTIHomeNSAns, error)
 }
 
 type prefixJoinServerClient struct {
@@ -332,7 +583,8 @@ type prefixJoinServerClient struct {
 
 // Client is an interop client.
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	networkServers map[types.NetID]networkServerClient
 }
 
 var (
@@ -377,6 +629,10 @@ func NewClient(
 			Components []ComponentSelector `yaml:"components"`
 			JoinEUIs   []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -473,8 +729,68 @@ func NewClient(
 		}
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
+
+	var nss map[types.NetID]networkServerClient
+	if selector == SelectorNetworkServer {
+		nss = make(map[types.NetID]networkServerClient)
+		for _, nsEntry := range yamlConf.NetworkServers {
+			fileParts := strings.Split(filepath.ToSlash(nsEntry.File), "/")
+			fetcher := fetch.WithBasePath(fetcher, fileParts[:len(fileParts)-1]...)
+			nsFileBytes, err := fetcher.File(fileParts[len(fileParts)-1])
+			if err != nil {
+				return nil, err
+			}
+
+			var nsConf struct {
+				ComponentConfig `yaml:",inline"`
+				Paths           nsRPCPaths      `yaml:"paths"`
+				Protocol        ProtocolVersion `yaml:"protocol"`
+				SenderNSID      *types.EUI64    `yaml:"sender-ns-id,omitempty"`
+				ReceiverNSID    *types.EUI64    `yaml:"receiver-ns-id,omitempty"`
+			}
+			if err := yaml.UnmarshalStrict(nsFileBytes, &nsConf); err != nil {
+				return nil, err
+			}
+
+			var ns networkServerClient
+			switch nsConf.Protocol {
+			case ProtocolV1_0, ProtocolV1_1:
+				var opts []httpclient.Option
+				if !nsConf.TLS.IsZero() {
+					tlsConf, err := nsConf.TLS.TLSConfig(fetcher, c.KeyService())
+					if err != nil {
+						return nil, err
+					}
+					opts = append(opts, httpclient.WithTLSConfig(tlsConf))
+				}
+				if nsConf.DNSSuffix != "" || nsConf.FQDN == "" {
+					return nil, errDNSLookupNotSupported.New()
+				}
+				ns = &networkServerHTTPClient{
+					clientProvider: c,
+					clientOpts:     opts,
+					protocol:       nsConf.Protocol,
+					senderNSID:     nsConf.SenderNSID,
+					receiverNSID:   nsConf.ReceiverNSID,
+					scheme:         nsConf.Scheme,
+					fqdn:           nsConf.FQDN,
+					port:           nsConf.Port,
+					paths:          nsConf.Paths,
+					headers:        nsConf.Headers,
+					username:       nsConf.BasicAuth.Username,
+					password:       nsConf.BasicAuth.Password,
+				}
+			default:
+				return nil, errUnknownProtocol.New()
+			}
+			for _, netID := range nsEntry.NetIDs {
+				nss[netID] = ns
+			}
+		}
+	}
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
 	}, nil
 }
 
@@ -554,3 +870,76 @@ func (cl Client) HandleJoinRequest(
 		return js.HandleJoinRequest(ctx, netID, nsID, req)
 	}, jss)
 }
+
+// HomeNSRequest performs home Network Server request to Join Server associated with joinEUI.
+func (cl Client) HomeNSRequest(
+	ctx context.Context, netID types.NetID, nsID *types.EUI64, joinEUI, devEUI types.EUI64,
+) (*TTIHomeNSAns, error) {
+	jss := cl.matchingJoinServerClients(joinEUI)
+	if len(jss) == 0 {
+		return nil, errNotRegistered.New()
+	}
+	return joinServerRace(ctx, func(js joinServerClient) (*TTIHomeNSAns, error) {
+		return js.HomeNSRequest(ctx, netID, nsID, joinEUI, devEUI)
+	}, jss)
+}
+
+// HasNetworkServer returns whether a Network Server is configured for the NetID.
+func (cl Client) HasNetworkServer(netID types.NetID) bool {
+	_, ok := cl.networkServers[netID]
+	return ok
+}
+
+// PRStartRequest performs passive roaming start request to the Network Server associated with req.ReceiverID.
+func (cl Client) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.PRStartRequest(ctx, req)
+}
+
+// PRStopRequest performs passive roaming stop request to the Network Server associated with req.ReceiverID.
+func (cl Client) PRStopRequest(ctx context.Context, req *PRStopReq) (*PRStopAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.PRStopRequest(ctx, req)
+}
+
+// XmitDataRequest performs data transmission request to the Network Server associated with req.ReceiverID.
+func (cl Client) XmitDataRequest(ctx context.Context, req *TTIXmitDataReq) (*XmitDataAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.XmitDataRequest(ctx, req)
+}
+
+// ProfileRequest performs device profile request to the Network Server associated with req.ReceiverID.
+func (cl Client) ProfileRequest(ctx context.Context, req *TTIProfileReq) (*ProfileAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.ProfileRequest(ctx, req)
+}
+
+// HRStartRequest performs handover roaming start request to the Network Server associated with req.ReceiverID.
+func (cl Client) HRStartRequest(ctx context.Context, req *HRStartReq) (*HRStartAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.HRStartRequest(ctx, req)
+}
+
+// HRStopRequest performs handover roaming stop request to the Network Server associated with req.ReceiverID.
+func (cl Client) HRStopRequest(ctx context.Context, req *TTIHRStopReq) (*HRStopAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.HRStopRequest(ctx, req)
+}
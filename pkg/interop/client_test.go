@@ -865,3 +865,377 @@ func TestJoinServerRace(t *testing.T) { //nolint:paralleltest
 		})
 	}
 }
+
+func TestPRStartRequest(t *testing.T) { //nolint:paralleltest
+	for _, tc := range []struct { //nolint:paralleltest
+		Name              string
+		NewServer         func(*assertions.Assertion) *httptest.Server
+		Request           *PRStartReq
+		ResponseAssertion func(*assertions.Assertion, *PRStartAns) bool
+		ErrorAssertion    func(*assertions.Assertion, error) bool
+	}{
+		{
+			Name: "NotRegistered",
+			NewServer: func(a *assertions.Assertion) *httptest.Server {
+				return newTLSServer(9183, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					a.So(r, should.BeNil)
+				}))
+			},
+			Request: &PRStartReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					SenderID:   NetID{0x00, 0x00, 0x13},
+					ReceiverID: NetID{0x00, 0x00, 0x42},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, ans *PRStartAns) bool {
+				return a.So(ans, should.BeNil)
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(errors.IsNotFound(err), should.BeTrue)
+			},
+		},
+		{
+			Name: "Backend Interfaces 1.0/UnknownDevAddr",
+			NewServer: func(a *assertions.Assertion) *httptest.Server {
+				return newTLSServer(9183, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					a.So(r.URL.Path, should.Equal, "/test-pr-start-path")
+					test.Must[any](nil, json.NewEncoder(w).Encode(map[string]any{
+						"ProtocolVersion": "1.0",
+						"TransactionID":   0.0,
+						"MessageType":     "PRStartAns",
+						"SenderID":        "000013",
+						"ReceiverID":      "000042",
+						"Result": map[string]any{
+							"ResultCode": "UnknownDevAddr",
+						},
+					}))
+				}))
+			},
+			Request: &PRStartReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					SenderID:   NetID{0x00, 0x00, 0x42},
+					ReceiverID: NetID{0x00, 0x00, 0x13},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, ans *PRStartAns) bool {
+				return a.So(ans, should.BeNil)
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.HaveSameErrorDefinitionAs, ErrUnknownDevAddr)
+			},
+		},
+		{
+			Name: "Backend Interfaces 1.0/Success",
+			NewServer: func(a *assertions.Assertion) *httptest.Server {
+				return newTLSServer(9183, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					a.So(r.Method, should.Equal, http.MethodPost)
+					a.So(r.URL.Path, should.Equal, "/test-pr-start-path")
+					b := test.Must(io.ReadAll(r.Body))
+					var req map[string]any
+					test.Must[any](nil, json.Unmarshal(b, &req))
+					a.So(req, should.Resemble, map[string]any{
+						"ProtocolVersion": "1.0",
+						"TransactionID":   0.0,
+						"MessageType":     "PRStartReq",
+						"SenderID":        "000042",
+						"ReceiverID":      "000013",
+						"PHYPayload":      "40040000260000010107",
+						"ULMetaData": map[string]any{
+							"DevAddr":   "26000004",
+							"FPort":     1.0,
+							"FCntUp":    1.0,
+							"Confirmed": false,
+							"DataRate":  5.0,
+							"ULFreq":    868.1,
+							"RecvTime":  "2024-01-02T03:04:05Z",
+							"RFRegion":  "EU868",
+							"GWCnt":     1.0,
+							"GWInfo": []any{
+								map[string]any{
+									"RSSI":      -42.0,
+									"SNR":       5.5,
+									"ULToken":   "01020304",
+									"DLAllowed": true,
+								},
+							},
+						},
+					})
+					test.Must[any](nil, json.NewEncoder(w).Encode(map[string]any{
+						"ProtocolVersion": "1.0",
+						"TransactionID":   0.0,
+						"MessageType":     "PRStartAns",
+						"SenderID":        "000013",
+						"ReceiverID":      "000042",
+						"Result": map[string]any{
+							"ResultCode": "Success",
+						},
+						"Lifetime": 0,
+					}))
+				}))
+			},
+			Request: &PRStartReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					SenderID:   NetID{0x00, 0x00, 0x42},
+					ReceiverID: NetID{0x00, 0x00, 0x13},
+				},
+				PHYPayload: Buffer{0x40, 0x04, 0x00, 0x00, 0x26, 0x00, 0x00, 0x01, 0x01, 0x07},
+				ULMetaData: ULMetaData{
+					DevAddr:  &DevAddr{0x26, 0x00, 0x00, 0x04},
+					FPort:    func(v uint8) *uint8 { return &v }(1),
+					FCntUp:   func(v uint32) *uint32 { return &v }(1),
+					DataRate: 5,
+					ULFreq:   868.1,
+					RecvTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+					RFRegion: "EU868",
+					GWCnt:    1,
+					GWInfo: []GWInfoElement{
+						{
+							RSSI:      -42,
+							SNR:       5.5,
+							ULToken:   Buffer{0x01, 0x02, 0x03, 0x04},
+							DLAllowed: true,
+						},
+					},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, ans *PRStartAns) bool {
+				return a.So(ans, should.NotBeNil) &&
+					a.So(ans.Result.ResultCode, should.Equal, ResultSuccess) &&
+					a.So(ans.Lifetime, should.Resemble, func(v uint32) *uint32 { return &v }(0))
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.BeNil)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := test.Context()
+			ctx = log.NewContext(ctx, test.GetLogger(t))
+
+			srv := tc.NewServer(a)
+			defer srv.Close()
+
+			c := componenttest.NewComponent(t, &component.Config{})
+			componenttest.StartComponent(t, c)
+			defer c.Close()
+
+			cl, err := NewClient(ctx, config.InteropClient{
+				ConfigSource: "directory",
+				Directory:    "testdata/client",
+			}, c, SelectorNetworkServer)
+			if !a.So(err, should.BeNil) {
+				t.Fatalf("Failed to create new client: %s", err)
+			}
+
+			res, err := cl.PRStartRequest(ctx, tc.Request)
+			if a.So(tc.ErrorAssertion(a, err), should.BeTrue) {
+				a.So(tc.ResponseAssertion(a, res), should.BeTrue)
+			} else if err != nil {
+				t.Errorf("Received unexpected error: %v", errors.Stack(err))
+			}
+		})
+	}
+}
+
+func TestXmitDataRequest(t *testing.T) { //nolint:paralleltest
+	a, ctx := test.New(t)
+	ctx = log.NewContext(ctx, test.GetLogger(t))
+
+	srv := newTLSServer(9183, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.So(r.URL.Path, should.Equal, "/test-xmit-data-path")
+		b := test.Must(io.ReadAll(r.Body))
+		var req map[string]any
+		test.Must[any](nil, json.Unmarshal(b, &req))
+		a.So(req, should.Resemble, map[string]any{
+			"ProtocolVersion": "1.0",
+			"TransactionID":   0.0,
+			"MessageType":     "XmitDataReq",
+			"SenderID":        "000042",
+			"ReceiverID":      "000014",
+			"PHYPayload":      "60040000260000000A",
+			"DLMetaData": map[string]any{
+				"DLFreq1":    868.1,
+				"RXDelay1":   1.0,
+				"ClassMode":  "A",
+				"DataRate1":  5.0,
+				"FNSULToken": "AABB",
+				"GWInfo": []any{
+					map[string]any{
+						"RSSI":      0.0,
+						"SNR":       0.0,
+						"ULToken":   "01020304",
+						"DLAllowed": true,
+					},
+				},
+			},
+		})
+		test.Must[any](nil, json.NewEncoder(w).Encode(map[string]any{
+			"ProtocolVersion": "1.0",
+			"TransactionID":   0.0,
+			"MessageType":     "XmitDataAns",
+			"SenderID":        "000014",
+			"ReceiverID":      "000042",
+			"Result": map[string]any{
+				"ResultCode": "XmitFailed",
+			},
+		}))
+	}))
+	defer srv.Close()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		ConfigSource: "directory",
+		Directory:    "testdata/client",
+	}, c, SelectorNetworkServer)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+	a.So(cl.HasNetworkServer(types.NetID{0x00, 0x00, 0x14}), should.BeTrue)
+	a.So(cl.HasNetworkServer(types.NetID{0x00, 0x00, 0x15}), should.BeFalse)
+
+	ans, err := cl.XmitDataRequest(ctx, &TTIXmitDataReq{
+		XmitDataReq: XmitDataReq{
+			NsNsMessageHeader: NsNsMessageHeader{
+				SenderID:   NetID{0x00, 0x00, 0x42},
+				ReceiverID: NetID{0x00, 0x00, 0x14},
+			},
+			PHYPayload: Buffer{0x60, 0x04, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00, 0x0a},
+			DLMetaData: &DLMetaData{
+				DLFreq1:    func(v float64) *float64 { return &v }(868.1),
+				RXDelay1:   1,
+				ClassMode:  "A",
+				DataRate1:  func(v uint8) *uint8 { return &v }(5),
+				FNSULToken: Buffer{0xaa, 0xbb},
+				GWInfo: []GWInfoElement{
+					{
+						ULToken:   Buffer{0x01, 0x02, 0x03, 0x04},
+						DLAllowed: true,
+					},
+				},
+			},
+		},
+	})
+	a.So(ans, should.BeNil)
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrTransmitFailed)
+}
+
+func TestHRStartRequest(t *testing.T) { //nolint:paralleltest
+	a, ctx := test.New(t)
+	ctx = log.NewContext(ctx, test.GetLogger(t))
+
+	srv := newTLSServer(9183, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.So(r.URL.Path, should.Equal, "/test-hr-start-path")
+		b := test.Must(io.ReadAll(r.Body))
+		var req map[string]any
+		test.Must[any](nil, json.Unmarshal(b, &req))
+		a.So(req, should.Resemble, map[string]any{
+			"ProtocolVersion": "1.0",
+			"TransactionID":   0.0,
+			"MessageType":     "HRStartReq",
+			"SenderID":        "000042",
+			"ReceiverID":      "000013",
+			"PHYPayload":      "00000000000000000001010101010101010000BB0E7C44",
+			"ULMetaData": map[string]any{
+				"DevEUI":    "0101010101010101",
+				"Confirmed": false,
+				"DataRate":  5.0,
+				"ULFreq":    868.1,
+				"RecvTime":  "2024-01-02T03:04:05Z",
+				"RFRegion":  "EU868",
+				"GWCnt":     0.0,
+				"GWInfo":    nil,
+			},
+			"DevAddr":    "26000004",
+			"DLSettings": "00",
+			"RxDelay":    1.0,
+		})
+		test.Must[any](nil, json.NewEncoder(w).Encode(map[string]any{
+			"ProtocolVersion": "1.0",
+			"TransactionID":   0.0,
+			"MessageType":     "HRStartAns",
+			"SenderID":        "000013",
+			"ReceiverID":      "000042",
+			"Result": map[string]any{
+				"ResultCode": "Success",
+			},
+			"PHYPayload": "20D2BDB6F5D2A6C3C4D55BFC6C",
+			"Lifetime":   0,
+			"NwkSKey": map[string]any{
+				"AESKey": "000102030405060708090A0B0C0D0E0F",
+			},
+			"SessionKeyID": "0102",
+			"DeviceProfile": map[string]any{
+				"SupportsClassB":    false,
+				"SupportsClassC":    false,
+				"MACVersion":        "1.0.3",
+				"RegParamsRevision": "RP001-1.0.3-A",
+				"SupportsJoin":      true,
+				"RXDelay1":          1,
+				"RXDROffset1":       0,
+				"RXDataRate2":       0,
+				"RXFreq2":           869.525,
+				"RFRegion":          "EU868",
+				"Supports32bitFCnt": true,
+			},
+		}))
+	}))
+	defer srv.Close()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		ConfigSource: "directory",
+		Directory:    "testdata/client",
+	}, c, SelectorNetworkServer)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+
+	ans, err := cl.HRStartRequest(ctx, &HRStartReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			SenderID:   NetID{0x00, 0x00, 0x42},
+			ReceiverID: NetID{0x00, 0x00, 0x13},
+		},
+		PHYPayload: Buffer{
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x01, 0x01,
+			0x01, 0x01, 0x01, 0x01, 0x00, 0x00, 0xbb, 0x0e, 0x7c, 0x44,
+		},
+		ULMetaData: ULMetaData{
+			DevEUI:   &EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01},
+			DataRate: 5,
+			ULFreq:   868.1,
+			RecvTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			RFRegion: "EU868",
+		},
+		DevAddr:    DevAddr{0x26, 0x00, 0x00, 0x04},
+		DLSettings: Buffer{0x00},
+		RxDelay:    ttnpb.RxDelay_RX_DELAY_1,
+	})
+	if !a.So(err, should.BeNil) || !a.So(ans, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(ans.Result.ResultCode, should.Equal, ResultSuccess)
+	if a.So(ans.NwkSKey, should.NotBeNil) {
+		a.So(ans.NwkSKey.Key, should.Resemble, []byte{
+			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+		})
+	}
+	a.So(ans.SessionKeyID, should.Resemble, Buffer{0x01, 0x02})
+	a.So(ans.DeviceProfile, should.Resemble, &DeviceProfile{
+		MACVersion:        MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+		RegParamsRevision: RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_3_REV_A),
+		SupportsJoin:      true,
+		RXDelay1:          1,
+		RXFreq2:           869.525,
+		RFRegion:          "EU868",
+		Supports32bitFCnt: true,
+	})
+}
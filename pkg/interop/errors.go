@@ -22,7 +22,10 @@ var (
 	errNoPublicTLSAddress = errors.DefineFailedPrecondition("no_public_tls_address",
 		"no public TLS address configured for interop",
 	)
-	errUnknownMACVersion  = errors.DefineInvalidArgument("unknown_mac_version", "unknown MAC version")
+	errUnknownMACVersion        = errors.DefineInvalidArgument("unknown_mac_version", "unknown MAC version")
+	errUnknownRegParamsRevision = errors.DefineInvalidArgument("unknown_reg_params_revision",
+		"unknown Regional Parameters revision",
+	)
 	errInvalidLength      = errors.DefineInvalidArgument("invalid_length", "invalid length")
 	errInvalidRequestType = errors.DefineInvalidArgument("invalid_request_type", "invalid request type `{type}`")
 	errNotRegistered      = errors.DefineNotFound("not_registered", "not registered")
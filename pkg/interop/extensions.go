@@ -104,3 +104,171 @@ func (m *TTIHomeNSAns) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+
+// TTIProfileReq is ProfileReq with vendor extension of The Things Industries.
+// The Things Stack registers end devices by JoinEUI and DevEUI, so the home Network Server needs the JoinEUI to look up
+// the end device.
+type TTIProfileReq struct {
+	ProfileReq
+	JoinEUI *EUI64
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m TTIProfileReq) MarshalJSON() ([]byte, error) {
+	if m.JoinEUI == nil {
+		return json.Marshal(m.ProfileReq)
+	}
+	aux := struct {
+		ProfileReq
+		VSExtension struct {
+			VendorID TTIVendorIDType
+			Object   struct {
+				TTSV3 struct {
+					JoinEUI *EUI64 `json:",omitempty"`
+				}
+			}
+		}
+	}{
+		ProfileReq: m.ProfileReq,
+	}
+	aux.VSExtension.Object.TTSV3.JoinEUI = m.JoinEUI
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The vendor extension is optional, as the message may be sent by a Network Server of another vendor.
+func (m *TTIProfileReq) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ProfileReq
+		VSExtension *struct {
+			VendorID VendorID
+			Object   struct {
+				TTSV3 struct {
+					JoinEUI *EUI64 `json:",omitempty"`
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*m = TTIProfileReq{
+		ProfileReq: aux.ProfileReq,
+	}
+	if ext := aux.VSExtension; ext != nil && bytes.Equal(ext.VendorID[:], TTIVendorID[:]) {
+		m.JoinEUI = ext.Object.TTSV3.JoinEUI
+	}
+	return nil
+}
+
+// TTIHRStopReq is HRStopReq with vendor extension of The Things Industries.
+// The Things Stack registers end devices by JoinEUI and DevEUI, so the Network Server needs the JoinEUI to look up
+// the end device.
+type TTIHRStopReq struct {
+	HRStopReq
+	JoinEUI *EUI64
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m TTIHRStopReq) MarshalJSON() ([]byte, error) {
+	if m.JoinEUI == nil {
+		return json.Marshal(m.HRStopReq)
+	}
+	aux := struct {
+		HRStopReq
+		VSExtension struct {
+			VendorID TTIVendorIDType
+			Object   struct {
+				TTSV3 struct {
+					JoinEUI *EUI64 `json:",omitempty"`
+				}
+			}
+		}
+	}{
+		HRStopReq: m.HRStopReq,
+	}
+	aux.VSExtension.Object.TTSV3.JoinEUI = m.JoinEUI
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The vendor extension is optional, as the message may be sent by a Network Server of another vendor.
+func (m *TTIHRStopReq) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		HRStopReq
+		VSExtension *struct {
+			VendorID VendorID
+			Object   struct {
+				TTSV3 struct {
+					JoinEUI *EUI64 `json:",omitempty"`
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*m = TTIHRStopReq{
+		HRStopReq: aux.HRStopReq,
+	}
+	if ext := aux.VSExtension; ext != nil && bytes.Equal(ext.VendorID[:], TTIVendorID[:]) {
+		m.JoinEUI = ext.Object.TTSV3.JoinEUI
+	}
+	return nil
+}
+
+// TTIXmitDataReq is XmitDataReq with vendor extension of The Things Industries.
+// The Things Stack registers end devices by JoinEUI and DevEUI, so the home Network Server needs the JoinEUI to look up
+// the end device of the uplink message that the serving Network Server forwards in handover roaming.
+type TTIXmitDataReq struct {
+	XmitDataReq
+	JoinEUI *EUI64
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m TTIXmitDataReq) MarshalJSON() ([]byte, error) {
+	if m.JoinEUI == nil {
+		return json.Marshal(m.XmitDataReq)
+	}
+	aux := struct {
+		XmitDataReq
+		VSExtension struct {
+			VendorID TTIVendorIDType
+			Object   struct {
+				TTSV3 struct {
+					JoinEUI *EUI64 `json:",omitempty"`
+				}
+			}
+		}
+	}{
+		XmitDataReq: m.XmitDataReq,
+	}
+	aux.VSExtension.Object.TTSV3.JoinEUI = m.JoinEUI
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The vendor extension is optional, as the message may be sent by a Network Server of another vendor.
+func (m *TTIXmitDataReq) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		XmitDataReq
+		VSExtension *struct {
+			VendorID VendorID
+			Object   struct {
+				TTSV3 struct {
+					JoinEUI *EUI64 `json:",omitempty"`
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*m = TTIXmitDataReq{
+		XmitDataReq: aux.XmitDataReq,
+	}
+	if ext := aux.VSExtension; ext != nil && bytes.Equal(ext.VendorID[:], TTIVendorID[:]) {
+		m.JoinEUI = ext.Object.TTSV3.JoinEUI
+	}
+	return nil
+}
@@ -90,3 +90,65 @@ func TestExtensions(t *testing.T) { //nolint:paralleltest
 
 	a.So(actual, should.Resemble, expected)
 }
+
+func TestXmitDataReqExtension(t *testing.T) { //nolint:paralleltest
+	a := assertions.New(t)
+
+	fPort, fCnt := uint8(1), uint32(42)
+	actual := TTIXmitDataReq{
+		XmitDataReq: XmitDataReq{
+			NsNsMessageHeader: NsNsMessageHeader{
+				MessageHeader: MessageHeader{
+					ProtocolVersion: ProtocolV1_1,
+					TransactionID:   42,
+					MessageType:     MessageTypeXmitDataReq,
+				},
+				SenderID:   NetID{0x42, 0x0, 0x0},
+				ReceiverID: NetID{0x42, 0x42, 0x0},
+			},
+			FRMPayload: Buffer{0x1, 0x2, 0x3},
+			ULMetaData: &ULMetaData{
+				DevEUI: &EUI64{0x42, 0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+				FPort:  &fPort,
+				FCntUp: &fCnt,
+			},
+		},
+		JoinEUI: &EUI64{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+	}
+
+	data, err := json.Marshal(actual)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var fields map[string]any
+	if !a.So(json.Unmarshal(data, &fields), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(fields["VSExtension"], should.Resemble, map[string]any{
+		"VendorID": "EC656E",
+		"Object": map[string]any{
+			"TTSV3": map[string]any{
+				"JoinEUI": "4200000000000000",
+			},
+		},
+	})
+
+	var expected TTIXmitDataReq
+	if !a.So(json.Unmarshal(data, &expected), should.BeNil) {
+		t.FailNow()
+	}
+	expected.ULMetaData.RecvTime = actual.ULMetaData.RecvTime
+	a.So(expected, should.Resemble, actual)
+
+	// The vendor extension is optional.
+	data, err = json.Marshal(actual.XmitDataReq)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expected = TTIXmitDataReq{}
+	if !a.So(json.Unmarshal(data, &expected), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(expected.JoinEUI, should.BeNil)
+	a.So(expected.FRMPayload, should.Resemble, actual.FRMPayload)
+}
@@ -15,6 +15,8 @@
 package interop
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -75,6 +77,30 @@ type NsMessageHeader struct {
 	SenderNSID *EUI64 `json:",omitempty"`
 }
 
+// NsNsMessageHeader contains the message header for NS to NS messages.
+type NsNsMessageHeader struct {
+	MessageHeader
+	SenderID     NetID
+	SenderNSID   *EUI64 `json:",omitempty"`
+	ReceiverID   NetID
+	ReceiverNSID *EUI64 `json:",omitempty"`
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsNsMessageHeader) AnswerHeader() (NsNsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsNsMessageHeader{}, err
+	}
+	return NsNsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		SenderNSID:    h.ReceiverNSID,
+		ReceiverID:    h.SenderID,
+		ReceiverNSID:  h.SenderNSID,
+	}, nil
+}
+
 // AsMessageHeader contains the message header for AS messages.
 type AsMessageHeader struct {
 	MessageHeader
@@ -169,3 +195,174 @@ type HomeNSAns struct {
 	HNSID  *EUI64 `json:",omitempty"`
 	HNetID NetID
 }
+
+// GWInfoElement contains the metadata of a gateway that received an uplink message.
+type GWInfoElement struct {
+	ID        Buffer   `json:",omitempty"`
+	RFRegion  RFRegion `json:",omitempty"`
+	RSSI      int32
+	SNR       float32
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevEUI     *EUI64   `json:",omitempty"`
+	DevAddr    *DevAddr `json:",omitempty"`
+	FPort      *uint8   `json:",omitempty"`
+	FCntUp     *uint32  `json:",omitempty"`
+	Confirmed  bool
+	DataRate   uint8
+	ULFreq     float64 // MHz.
+	RecvTime   time.Time
+	RFRegion   RFRegion
+	FNSULToken Buffer `json:",omitempty"`
+	GWCnt      int
+	GWInfo     []GWInfoElement
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevEUI         *EUI64   `json:",omitempty"`
+	FPort          *uint8   `json:",omitempty"`
+	FCntDown       *uint32  `json:",omitempty"`
+	Confirmed      bool     `json:",omitempty"`
+	DLFreq1        *float64 `json:",omitempty"` // MHz.
+	DLFreq2        *float64 `json:",omitempty"` // MHz.
+	RXDelay1       uint8    `json:",omitempty"` // Seconds.
+	ClassMode      string   `json:",omitempty"`
+	DataRate1      *uint8   `json:",omitempty"`
+	DataRate2      *uint8   `json:",omitempty"`
+	FNSULToken     Buffer   `json:",omitempty"`
+	GWInfo         []GWInfoElement
+	HiPriorityFlag bool `json:",omitempty"`
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+type PRStartAns struct {
+	NsNsMessageHeader
+	Result      Result
+	PHYPayload  Buffer       `json:",omitempty"`
+	DevEUI      *EUI64       `json:",omitempty"`
+	Lifetime    *uint32      `json:",omitempty"`
+	FNwkSIntKey *KeyEnvelope `json:",omitempty"`
+	NwkSKey     *KeyEnvelope `json:",omitempty"`
+	FCntUp      *uint32      `json:",omitempty"`
+	DLMetaData  *DLMetaData  `json:",omitempty"`
+}
+
+// PRStopReq is a passive roaming stop request message.
+type PRStopReq struct {
+	NsNsMessageHeader
+	DevEUI   EUI64
+	Lifetime *uint32 `json:",omitempty"`
+}
+
+// PRStopAns is an answer to a PRStopReq message.
+type PRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
+
+// XmitDataReq is a data transmission request message.
+type XmitDataReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer      `json:",omitempty"`
+	FRMPayload Buffer      `json:",omitempty"`
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsNsMessageHeader
+	Result  Result
+	DLFreq1 *float64 `json:",omitempty"` // MHz.
+	DLFreq2 *float64 `json:",omitempty"` // MHz.
+}
+
+// DeviceProfile is the device profile of an end device.
+type DeviceProfile struct {
+	DeviceProfileID    string `json:",omitempty"`
+	SupportsClassB     bool
+	ClassBTimeout      uint32   `json:",omitempty"` // Seconds.
+	PingSlotPeriod     uint32   `json:",omitempty"`
+	PingSlotDR         *uint8   `json:",omitempty"`
+	PingSlotFreq       *float64 `json:",omitempty"` // MHz.
+	SupportsClassC     bool
+	ClassCTimeout      uint32 `json:",omitempty"` // Seconds.
+	MACVersion         MACVersion
+	RegParamsRevision  RegParamsRevision
+	SupportsJoin       bool
+	RXDelay1           uint8 // Seconds.
+	RXDROffset1        uint8
+	RXDataRate2        uint8
+	RXFreq2            float64   // MHz.
+	FactoryPresetFreqs []float64 `json:",omitempty"` // MHz.
+	RFRegion           RFRegion
+	Supports32bitFCnt  bool
+}
+
+// ProfileReq is a device profile request message.
+type ProfileReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// ProfileAns is an answer to a ProfileReq message.
+type ProfileAns struct {
+	NsNsMessageHeader
+	Result                 Result
+	DeviceProfile          *DeviceProfile        `json:",omitempty"`
+	DeviceProfileTimestamp *time.Time            `json:",omitempty"`
+	RoamingActivationType  RoamingActivationType `json:",omitempty"`
+}
+
+// HRStartReq is a handover roaming start request message.
+type HRStartReq struct {
+	NsNsMessageHeader
+	PHYPayload             Buffer
+	ULMetaData             ULMetaData
+	DeviceProfileTimestamp *time.Time `json:",omitempty"`
+	DevAddr                DevAddr
+	DLSettings             Buffer
+	RxDelay                ttnpb.RxDelay
+	CFList                 Buffer `json:",omitempty"`
+}
+
+// HRStartAns is an answer to a HRStartReq message.
+type HRStartAns struct {
+	NsNsMessageHeader
+	Result                 Result
+	PHYPayload             Buffer         `json:",omitempty"`
+	Lifetime               *uint32        `json:",omitempty"`
+	SNwkSIntKey            *KeyEnvelope   `json:",omitempty"`
+	FNwkSIntKey            *KeyEnvelope   `json:",omitempty"`
+	NwkSEncKey             *KeyEnvelope   `json:",omitempty"`
+	NwkSKey                *KeyEnvelope   `json:",omitempty"`
+	SessionKeyID           Buffer         `json:",omitempty"`
+	DeviceProfile          *DeviceProfile `json:",omitempty"`
+	DeviceProfileTimestamp *time.Time     `json:",omitempty"`
+}
+
+// HRStopReq is a handover roaming stop request message.
+type HRStopReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// HRStopAns is an answer to a HRStopReq message.
+type HRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
@@ -52,9 +52,15 @@ func (*mockComponent) HTTPClient(context.Context, ...httpclient.Option) (*http.C
 }
 
 type mockTarget struct {
-	JoinRequestFunc    func(context.Context, *interop.JoinReq) (*interop.JoinAns, error)
-	AppSKeyRequestFunc func(context.Context, *interop.AppSKeyReq) (*interop.AppSKeyAns, error)
-	HomeNSRequestFunc  func(context.Context, *interop.HomeNSReq) (*interop.TTIHomeNSAns, error)
+	JoinRequestFunc     func(context.Context, *interop.JoinReq) (*interop.JoinAns, error)
+	AppSKeyRequestFunc  func(context.Context, *interop.AppSKeyReq) (*interop.AppSKeyAns, error)
+	HomeNSRequestFunc   func(context.Context, *interop.HomeNSReq) (*interop.TTIHomeNSAns, error)
+	PRStartRequestFunc  func(context.Context, *interop.PRStartReq) (*interop.PRStartAns, error)
+	PRStopRequestFunc   func(context.Context, *interop.PRStopReq) (*interop.PRStopAns, error)
+	XmitDataRequestFunc func(context.Context, *interop.TTIXmitDataReq) (*interop.XmitDataAns, error)
+	ProfileRequestFunc  func(context.Context, *interop.TTIProfileReq) (*interop.ProfileAns, error)
+	HRStartRequestFunc  func(context.Context, *interop.HRStartReq) (*interop.HRStartAns, error)
+	HRStopRequestFunc   func(context.Context, *interop.TTIHRStopReq) (*interop.HRStopAns, error)
 }
 
 func (m mockTarget) JoinRequest(ctx context.Context, req *interop.JoinReq) (*interop.JoinAns, error) {
@@ -78,6 +84,48 @@ func (m mockTarget) HomeNSRequest(ctx context.Context, req *interop.HomeNSReq) (
 	panic("HomeNSRequest called but not registered")
 }
 
+func (m mockTarget) PRStartRequest(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	if m.PRStartRequestFunc != nil {
+		return m.PRStartRequestFunc(ctx, req)
+	}
+	panic("PRStartRequest called but not registered")
+}
+
+func (m mockTarget) PRStopRequest(ctx context.Context, req *interop.PRStopReq) (*interop.PRStopAns, error) {
+	if m.PRStopRequestFunc != nil {
+		return m.PRStopRequestFunc(ctx, req)
+	}
+	panic("PRStopRequest called but not registered")
+}
+
+func (m mockTarget) XmitDataRequest(ctx context.Context, req *interop.TTIXmitDataReq) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc != nil {
+		return m.XmitDataRequestFunc(ctx, req)
+	}
+	panic("XmitDataRequest called but not registered")
+}
+
+func (m mockTarget) ProfileRequest(ctx context.Context, req *interop.TTIProfileReq) (*interop.ProfileAns, error) {
+	if m.ProfileRequestFunc != nil {
+		return m.ProfileRequestFunc(ctx, req)
+	}
+	panic("ProfileRequest called but not registered")
+}
+
+func (m mockTarget) HRStartRequest(ctx context.Context, req *interop.HRStartReq) (*interop.HRStartAns, error) {
+	if m.HRStartRequestFunc != nil {
+		return m.HRStartRequestFunc(ctx, req)
+	}
+	panic("HRStartRequest called but not registered")
+}
+
+func (m mockTarget) HRStopRequest(ctx context.Context, req *interop.TTIHRStopReq) (*interop.HRStopAns, error) {
+	if m.HRStopRequestFunc != nil {
+		return m.HRStopRequestFunc(ctx, req)
+	}
+	panic("HRStopRequest called but not registered")
+}
+
 func TestServer(t *testing.T) { //nolint:gocyclo
 	t.Parallel()
 
@@ -86,6 +134,7 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 	for _, tc := range []struct {
 		Name              string
 		JS                interop.JoinServer
+		NS                interop.NetworkServer
 		ClientTLSConfig   *tls.Config
 		PacketBrokerToken bool
 		RequestBody       any
@@ -306,6 +355,215 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 					a.So(msg.HNSID, should.Resemble, &interop.EUI64{0x42, 0x42, 0x42, 0x0, 0x0, 0x0, 0x0, 0x0})
 			},
 		},
+		{
+			Name:            "ClientTLS/PRStartReq/NotRegistered",
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.PRStartReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypePRStartReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypePRStartAns) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultMalformedRequest)
+			},
+		},
+		{
+			Name: "ClientTLS/PRStartReq/Success",
+			NS: mockTarget{
+				PRStartRequestFunc: func(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					if !types.DevAddr(*req.ULMetaData.DevAddr).Equal(types.DevAddr{0x26, 0x0, 0x0, 0x1}) {
+						return nil, interop.ErrUnknownDevAddr.New()
+					}
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &interop.PRStartAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+					}, nil
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.PRStartReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypePRStartReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				PHYPayload: interop.Buffer{0x40, 0x01, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04},
+				ULMetaData: interop.ULMetaData{
+					DevAddr:  &interop.DevAddr{0x26, 0x0, 0x0, 0x1},
+					RFRegion: "EU868",
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.PRStartAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypePRStartAns) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.SenderID, should.Resemble, interop.NetID{0x0, 0x0, 0x13}) &&
+					a.So(msg.ReceiverID, should.Resemble, interop.NetID{0x0, 0x0, 0x01})
+			},
+		},
+		{
+			Name: "ClientTLS/XmitDataReq/XmitFailed",
+			NS: mockTarget{
+				XmitDataRequestFunc: func(ctx context.Context, req *interop.TTIXmitDataReq) (*interop.XmitDataAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					return nil, interop.ErrTransmitFailed.New()
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.XmitDataReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeXmitDataReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				PHYPayload: interop.Buffer{0x60, 0x01, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypeXmitDataAns) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultXmitFailed)
+			},
+		},
+		{
+			Name: "ClientTLS/ProfileReq/Success",
+			NS: mockTarget{
+				ProfileRequestFunc: func(ctx context.Context, req *interop.TTIProfileReq) (*interop.ProfileAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					if req.JoinEUI == nil || !types.EUI64(*req.JoinEUI).Equal(types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}) {
+						return nil, interop.ErrUnknownDevEUI.New()
+					}
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &interop.ProfileAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+						DeviceProfile: &interop.DeviceProfile{
+							MACVersion:        interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+							RegParamsRevision: interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_3_REV_A),
+							SupportsJoin:      true,
+							RFRegion:          "EU868",
+						},
+						RoamingActivationType: interop.RoamingActivationHandover,
+					}, nil
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.TTIProfileReq{
+				ProfileReq: interop.ProfileReq{
+					NsNsMessageHeader: interop.NsNsMessageHeader{
+						MessageHeader: interop.MessageHeader{
+							MessageType:     interop.MessageTypeProfileReq,
+							ProtocolVersion: interop.ProtocolV1_0,
+						},
+						SenderID:   interop.NetID{0x0, 0x0, 0x01},
+						ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+					},
+					DevEUI: interop.EUI64{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1},
+				},
+				JoinEUI: &interop.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ProfileAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypeProfileAns) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.RoamingActivationType, should.Equal, interop.RoamingActivationHandover) &&
+					a.So(msg.DeviceProfile, should.Resemble, &interop.DeviceProfile{
+						MACVersion:        interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+						RegParamsRevision: interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_3_REV_A),
+						SupportsJoin:      true,
+						RFRegion:          "EU868",
+					})
+			},
+		},
+		{
+			Name: "ClientTLS/HRStopReq/UnknownDevEUI",
+			NS: mockTarget{
+				HRStopRequestFunc: func(ctx context.Context, req *interop.TTIHRStopReq) (*interop.HRStopAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					if req.JoinEUI == nil || !types.EUI64(*req.JoinEUI).Equal(types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}) {
+						return nil, interop.ErrMalformedMessage.New()
+					}
+					return nil, interop.ErrUnknownDevEUI.New()
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.TTIHRStopReq{
+				HRStopReq: interop.HRStopReq{
+					NsNsMessageHeader: interop.NsNsMessageHeader{
+						MessageHeader: interop.MessageHeader{
+							MessageType:     interop.MessageTypeHRStopReq,
+							ProtocolVersion: interop.ProtocolV1_0,
+						},
+						SenderID:   interop.NetID{0x0, 0x0, 0x01},
+						ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+					},
+					DevEUI: interop.EUI64{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1},
+				},
+				JoinEUI: &interop.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypeHRStopAns) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultUnknownDevEUI)
+			},
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -334,6 +592,9 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				if tc.JS != nil {
 					s.RegisterJS(tc.JS)
 				}
+				if tc.NS != nil {
+					s.RegisterNS(tc.NS)
+				}
 
 				srv := newTLSServer(0, s)
 				defer srv.Close()
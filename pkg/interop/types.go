@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"strings"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -419,3 +420,85 @@ func (n *DevAddr) UnmarshalText(data []byte) error {
 	copy(n[:], buf)
 	return nil
 }
+
+// RFRegion is the radio frequency region of a gateway.
+type RFRegion string
+
+var bandIDRFRegions = map[string]RFRegion{
+	band.EU_863_870: "EU868",
+	band.US_902_928: "US902",
+	band.CN_779_787: "China779",
+	band.EU_433:     "EU433",
+	band.AU_915_928: "Australia915",
+	band.CN_470_510: "China470",
+	band.AS_923:     "AS923",
+	band.AS_923_2:   "AS923-2",
+	band.AS_923_3:   "AS923-3",
+	band.AS_923_4:   "AS923-4",
+	band.KR_920_923: "SouthKorea920",
+	band.IN_865_867: "India865",
+	band.RU_864_870: "RU864",
+}
+
+// RFRegionFromBandID returns the RF region of the given band ID.
+func RFRegionFromBandID(id string) (RFRegion, bool) {
+	r, ok := bandIDRFRegions[id]
+	return r, ok
+}
+
+// BandID returns the band ID of the RF region.
+func (r RFRegion) BandID() (string, bool) {
+	for id, region := range bandIDRFRegions {
+		if region == r {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// RoamingActivationType is the type of roaming activation.
+type RoamingActivationType string
+
+// LoRaWAN Backend Interfaces roaming activation types.
+const (
+	RoamingActivationPassive  RoamingActivationType = "Passive"
+	RoamingActivationHandover RoamingActivationType = "Handover"
+)
+
+var phyVersionRegParamsRevisions = map[ttnpb.PHYVersion]string{
+	ttnpb.PHYVersion_TS001_V1_0:         "TS001-1.0",
+	ttnpb.PHYVersion_TS001_V1_0_1:       "TS001-1.0.1",
+	ttnpb.PHYVersion_RP001_V1_0_2:       "A",
+	ttnpb.PHYVersion_RP001_V1_0_2_REV_B: "B",
+	ttnpb.PHYVersion_RP001_V1_0_3_REV_A: "RP001-1.0.3-A",
+	ttnpb.PHYVersion_RP001_V1_1_REV_A:   "RP001-1.1-A",
+	ttnpb.PHYVersion_RP001_V1_1_REV_B:   "RP001-1.1-B",
+	ttnpb.PHYVersion_RP002_V1_0_0:       "RP002-1.0.0",
+	ttnpb.PHYVersion_RP002_V1_0_1:       "RP002-1.0.1",
+	ttnpb.PHYVersion_RP002_V1_0_2:       "RP002-1.0.2",
+	ttnpb.PHYVersion_RP002_V1_0_3:       "RP002-1.0.3",
+	ttnpb.PHYVersion_RP002_V1_0_4:       "RP002-1.0.4",
+}
+
+// RegParamsRevision is the revision of the Regional Parameters.
+type RegParamsRevision ttnpb.PHYVersion
+
+// MarshalText implements encoding.TextMarshaler.
+func (v RegParamsRevision) MarshalText() ([]byte, error) {
+	res, ok := phyVersionRegParamsRevisions[ttnpb.PHYVersion(v)]
+	if !ok {
+		return nil, errUnknownRegParamsRevision.New()
+	}
+	return []byte(res), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *RegParamsRevision) UnmarshalText(data []byte) error {
+	for phyVersion, revision := range phyVersionRegParamsRevisions {
+		if revision == string(data) {
+			*v = RegParamsRevision(phyVersion)
+			return nil
+		}
+	}
+	return errUnknownRegParamsRevision.New()
+}
@@ -0,0 +1,832 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides Join Server registries backed by an embedded bbolt database.
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"runtime/trace"
+	"strings"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errAlreadyProvisioned  = errors.DefineAlreadyExists("already_provisioned", "device already provisioned")
+	errInvalidFieldmask    = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers  = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField       = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errProvisionerNotFound = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
+)
+
+// DeviceRegistry is an implementation of joinserver.DeviceRegistry.
+type DeviceRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the DeviceRegistry.
+func (r *DeviceRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func provisionerUniqueID(dev *ttnpb.EndDevice) (string, error) {
+	if dev.ProvisionerId == "" {
+		return "", nil
+	}
+	provisioner := provisioning.Get(dev.ProvisionerId)
+	if provisioner == nil {
+		return "", errProvisionerNotFound.WithAttributes("id", dev.ProvisionerId)
+	}
+	return provisioner.UniqueID(dev.ProvisioningData)
+}
+
+func (r *DeviceRegistry) uidKey(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
+	return ttnbolt.Key("eui", joinEUI.String(), devEUI.String())
+}
+
+func (r *DeviceRegistry) provisionerKey(provisionerID, pid string) string {
+	return ttnbolt.Key("provisioner", provisionerID, pid)
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string,
+) (*ttnpb.EndDevice, error) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.GetProto(r.uidKey(unique.ID(ctx, ids)), pb); err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(
+	ctx context.Context, joinEUI, devEUI types.EUI64, paths []string,
+) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	uid, err := r.Bolt.Get(r.euiKey(joinEUI, devEUI))
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = unique.WithContext(ctx, string(uid))
+	if err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.GetProto(r.uidKey(string(uid)), pb); err != nil {
+		return nil, err
+	}
+	filtered, err := ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: filtered,
+	}, nil
+}
+
+func (r *DeviceRegistry) set(
+	ctx context.Context,
+	tx *ttnbolt.Tx,
+	uid string,
+	gets []string,
+	f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.ContextualEndDevice, error) {
+	ctx, err := unique.WithContext(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	uk := r.uidKey(uid)
+
+	stored := &ttnpb.EndDevice{}
+	if err := tx.GetProto(uk, stored); errors.IsNotFound(err) {
+		stored = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.EndDevice
+	if stored != nil {
+		pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sets []string
+	pb, sets, err = f(ctx, pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := ttnpb.ProhibitFields(sets,
+		"created_at",
+		"updated_at",
+	); err != nil {
+		return nil, errInvalidFieldmask.WithCause(err)
+	}
+
+	if stored == nil && pb == nil {
+		return nil, nil
+	}
+	if pb != nil && len(sets) == 0 {
+		filtered, err := ttnpb.FilterGetEndDevice(stored, gets...)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.ContextualEndDevice{
+			Context:   ctx,
+			EndDevice: filtered,
+		}, nil
+	}
+
+	if pb == nil && len(sets) == 0 {
+		tx.Del(uk)
+		if stored.Ids.JoinEui != nil && stored.Ids.DevEui != nil {
+			tx.Del(r.euiKey(types.MustEUI64(stored.Ids.JoinEui).OrZero(), types.MustEUI64(stored.Ids.DevEui).OrZero()))
+		}
+		pid, err := provisionerUniqueID(stored)
+		if err != nil {
+			return nil, err
+		}
+		if pid != "" {
+			tx.Del(r.provisionerKey(stored.ProvisionerId, pid))
+		}
+		return &ttnpb.ContextualEndDevice{
+			Context: ctx,
+		}, nil
+	}
+
+	if pb == nil {
+		pb = &ttnpb.EndDevice{}
+	}
+
+	pb.UpdatedAt = timestamppb.Now()
+	sets = append(append(sets[:0:0], sets...),
+		"updated_at",
+	)
+
+	updated := &ttnpb.EndDevice{}
+	var updatedPID string
+	if stored == nil {
+		if err := ttnpb.RequireFields(sets,
+			"ids.application_ids",
+			"ids.dev_eui",
+			"ids.device_id",
+			"ids.join_eui",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+
+		pb.CreatedAt = pb.UpdatedAt
+		sets = append(sets, "created_at")
+
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+		updatedPID, err = provisionerUniqueID(updated)
+		if err != nil {
+			return nil, err
+		}
+		if updated.Ids.JoinEui == nil || types.MustEUI64(updated.Ids.DevEui).OrZero().IsZero() {
+			return nil, errInvalidIdentifiers.New()
+		}
+	} else {
+		if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+			pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.device_id") && pb.Ids.DeviceId != stored.Ids.DeviceId {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.join_eui") && !bytes.Equal(pb.Ids.JoinEui, stored.Ids.JoinEui) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+		}
+		if ttnpb.HasAnyField(sets, "ids.dev_eui") && !bytes.Equal(pb.Ids.DevEui, stored.Ids.DevEui) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+		}
+		if ttnpb.HasAnyField(sets, "provisioner_id") && pb.ProvisionerId != stored.ProvisionerId {
+			return nil, errReadOnlyField.WithAttributes("field", "provisioner_id")
+		}
+		if ttnpb.HasAnyField(sets, "provisioning_data") && !proto.Equal(pb.ProvisioningData, stored.ProvisioningData) {
+			return nil, errReadOnlyField.WithAttributes("field", "provisioning_data")
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(stored, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := updated.ValidateFields(); err != nil {
+		return nil, err
+	}
+
+	if stored == nil {
+		joinEUI := types.MustEUI64(updated.Ids.JoinEui).OrZero()
+		devEUI := types.MustEUI64(updated.Ids.DevEui).OrZero()
+		ek := r.euiKey(joinEUI, devEUI)
+		storedUID, err := tx.Get(ek)
+		switch {
+		case errors.IsNotFound(err):
+			tx.Set(ek, []byte(uid), 0)
+		case err != nil:
+			return nil, err
+		default:
+			return nil, registry.UniqueEUIViolationErr(ctx, joinEUI, devEUI, string(storedUID))
+		}
+	}
+	if updatedPID != "" {
+		pk := r.provisionerKey(updated.ProvisionerId, updatedPID)
+		_, err := tx.Get(pk)
+		switch {
+		case errors.IsNotFound(err):
+			tx.Set(pk, []byte(uid), 0)
+		case err != nil:
+			return nil, err
+		default:
+			return nil, errAlreadyProvisioned.New()
+		}
+	}
+	if err := tx.SetProto(uk, updated, 0); err != nil {
+		return nil, err
+	}
+	pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: pb,
+	}, nil
+}
+
+// SetByEUI sets device by joinEUI, devEUI.
+// SetByEUI will only succeed if the device is set via SetByID first.
+func (r *DeviceRegistry) SetByEUI(
+	ctx context.Context,
+	joinEUI types.EUI64,
+	devEUI types.EUI64,
+	gets []string,
+	f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+	ek := r.euiKey(joinEUI, devEUI)
+
+	defer trace.StartRegion(ctx, "set end device by eui").End()
+
+	var pb *ttnpb.ContextualEndDevice
+	err := r.Bolt.LockedWatch(ctx, ek, func(tx *ttnbolt.Tx) error {
+		uid, err := tx.Get(ek)
+		if err != nil {
+			return err
+		}
+		pb, err = r.set(ctx, tx, string(uid), gets, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil || pb.EndDevice == nil {
+		return nil, nil
+	}
+	return pb, nil
+}
+
+// SetByID sets device by appID, devID.
+func (r *DeviceRegistry) SetByID(
+	ctx context.Context,
+	appID *ttnpb.ApplicationIdentifiers,
+	devID string,
+	gets []string,
+	f func(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, error) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var pb *ttnpb.ContextualEndDevice
+	err := r.Bolt.LockedWatch(ctx, r.uidKey(uid), func(tx *ttnbolt.Tx) error {
+		var err error
+		pb, err = r.set(ctx, tx, uid, gets,
+			func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				updated, sets, err := f(stored)
+				if err != nil {
+					return nil, nil, err
+				}
+				if stored == nil && updated != nil &&
+					(updated.Ids.ApplicationIds.ApplicationId != appID.ApplicationId || updated.Ids.DeviceId != devID) {
+					return nil, nil, errInvalidIdentifiers.New()
+				}
+				return updated, sets, nil
+			},
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		return nil, nil
+	}
+	return pb.EndDevice, nil
+}
+
+// RangeByID ranges over the end devices and calls the callback function, until false is returned.
+func (r *DeviceRegistry) RangeByID(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	return r.Bolt.Range(r.uidKey(""), func(_ string, v []byte) (bool, error) {
+		dev := &ttnpb.EndDevice{}
+		if err := ttnbolt.UnmarshalProto(v, dev); err != nil {
+			return false, err
+		}
+		dev, err := ttnpb.FilterGetEndDevice(dev, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, dev.Ids, dev), nil
+	})
+}
+
+// BatchDelete implements DeviceRegistry.
+// This function deletes all the devices in a single transaction.
+func (r *DeviceRegistry) BatchDelete(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	deviceIDs []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	uidKeys := make([]string, 0, len(deviceIDs))
+	for _, devID := range deviceIDs {
+		uidKeys = append(uidKeys, r.uidKey(unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       devID,
+		})))
+	}
+
+	defer trace.StartRegion(ctx, "batch delete end devices").End()
+
+	var ret []*ttnpb.EndDeviceIdentifiers
+	err := r.Bolt.Watch(ctx, func(tx *ttnbolt.Tx) error {
+		ret = make([]*ttnpb.EndDeviceIdentifiers, 0, len(uidKeys))
+		values, err := tx.MGet(uidKeys...)
+		if err != nil {
+			return err
+		}
+		for _, val := range values {
+			if val == nil {
+				continue
+			}
+			dev := &ttnpb.EndDevice{}
+			if err := ttnbolt.UnmarshalProto(val, dev); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decode stored end device")
+				continue
+			}
+			ret = append(ret, dev.Ids)
+			if dev.Ids.JoinEui != nil && dev.Ids.DevEui != nil {
+				tx.Del(r.euiKey(
+					types.MustEUI64(dev.GetIds().GetJoinEui()).OrZero(),
+					types.MustEUI64(dev.GetIds().GetDevEui()).OrZero(),
+				))
+			}
+			pid, err := provisionerUniqueID(dev)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to get provisioner unique ID")
+				continue
+			}
+			if pid != "" {
+				tx.Del(r.provisionerKey(dev.ProvisionerId, pid))
+			}
+		}
+		tx.Del(uidKeys...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// KeyRegistry is an implementation of joinserver.KeyRegistry.
+type KeyRegistry struct {
+	Bolt *ttnbolt.Client
+	// Limit is the maximum number of session keys to store per JoinEUI and DevEUI combination.
+	Limit int
+}
+
+// Init initializes the KeyRegistry.
+func (r *KeyRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (r *KeyRegistry) idValue(id []byte) string {
+	return base64.RawStdEncoding.EncodeToString(id)
+}
+
+func (r *KeyRegistry) idKey(joinEUI, devEUI types.EUI64, id string) string {
+	return ttnbolt.Key("id", joinEUI.String(), devEUI.String(), id)
+}
+
+func (r *KeyRegistry) idListKey(joinEUI, devEUI types.EUI64) string {
+	return ttnbolt.Key("ids", joinEUI.String(), devEUI.String())
+}
+
+// getIDList returns the session key IDs stored for the JoinEUI and DevEUI combination, oldest first.
+func (r *KeyRegistry) getIDList(tx *ttnbolt.Tx, lk string) ([]string, error) {
+	b, err := tx.Get(lk)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return strings.Split(string(b), ","), nil
+}
+
+// GetByID gets session keys by joinEUI, devEUI, id.
+func (r *KeyRegistry) GetByID(
+	ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string,
+) (*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() || len(id) == 0 {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "get session keys").End()
+
+	pb := &ttnpb.SessionKeys{}
+	if err := r.Bolt.GetProto(r.idKey(joinEUI, devEUI, r.idValue(id)), pb); err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetSessionKeys(pb, paths...)
+}
+
+// SetByID sets session keys by joinEUI, devEUI, id.
+func (r *KeyRegistry) SetByID(
+	ctx context.Context,
+	joinEUI, devEUI types.EUI64,
+	id []byte,
+	gets []string,
+	f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error),
+) (*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() || len(id) == 0 {
+		return nil, errInvalidIdentifiers.New()
+	}
+	ik, lk := r.idKey(joinEUI, devEUI, r.idValue(id)), r.idListKey(joinEUI, devEUI)
+
+	defer trace.StartRegion(ctx, "set session keys").End()
+
+	var pb *ttnpb.SessionKeys
+	err := r.Bolt.LockedWatch(ctx, lk, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.SessionKeys{}
+		if err := tx.GetProto(ik, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb = nil
+		if stored != nil {
+			pb, err = ttnpb.FilterGetSessionKeys(stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetSessionKeys(stored, gets...)
+			return err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			tx.Del(ik)
+			if r.Limit > 0 {
+				ids, err := r.getIDList(tx, lk)
+				if err != nil {
+					return err
+				}
+				remaining := ids[:0:0]
+				for _, sid := range ids {
+					if sid != r.idValue(id) {
+						remaining = append(remaining, sid)
+					}
+				}
+				tx.Set(lk, []byte(strings.Join(remaining, ",")), 0)
+			}
+			return nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.SessionKeys{}
+		}
+
+		updated := &ttnpb.SessionKeys{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"session_key_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+			updated, err = ttnpb.ApplySessionKeysFieldMask(updated, pb, sets...)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(updated.SessionKeyId, id) {
+				return errInvalidIdentifiers.New()
+			}
+			if r.Limit > 0 {
+				ids, err := r.getIDList(tx, lk)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, r.idValue(id))
+				if d := len(ids) - r.Limit; d > 0 {
+					for _, oldID := range ids[:d] {
+						tx.Del(r.idKey(joinEUI, devEUI, oldID))
+					}
+					ids = ids[d:]
+				}
+				tx.Set(lk, []byte(strings.Join(ids, ",")), 0)
+			}
+		} else {
+			if err := ttnpb.ProhibitFields(sets,
+				"session_key_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+			updated, err = ttnpb.ApplySessionKeysFieldMask(stored, pb, sets...)
+			if err != nil {
+				return err
+			}
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+
+		pb, err = ttnpb.FilterGetSessionKeys(updated, gets...)
+		if err != nil {
+			return err
+		}
+		return tx.SetProto(ik, updated, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Delete implements KeyRegistry.
+func (r *KeyRegistry) Delete(ctx context.Context, joinEUI, devEUI types.EUI64) error {
+	if r.Limit == 0 {
+		return nil
+	}
+	if devEUI.IsZero() {
+		return errInvalidIdentifiers.New()
+	}
+	lk := r.idListKey(joinEUI, devEUI)
+
+	defer trace.StartRegion(ctx, "delete session keys").End()
+
+	return r.Bolt.LockedWatch(ctx, lk, func(tx *ttnbolt.Tx) error {
+		ids, err := r.getIDList(tx, lk)
+		if err != nil {
+			return err
+		}
+		for _, sid := range ids {
+			tx.Del(r.idKey(joinEUI, devEUI, sid))
+		}
+		tx.Del(lk)
+		return nil
+	})
+}
+
+// BatchDelete implements KeyRegistry.
+func (r *KeyRegistry) BatchDelete(ctx context.Context, devIDs []*ttnpb.EndDeviceIdentifiers) error {
+	if r.Limit == 0 {
+		return nil
+	}
+
+	defer trace.StartRegion(ctx, "batch delete session keys").End()
+
+	return r.Bolt.Watch(ctx, func(tx *ttnbolt.Tx) error {
+		for _, devID := range devIDs {
+			joinEUI := types.MustEUI64(devID.JoinEui).OrZero()
+			devEUI := types.MustEUI64(devID.DevEui).OrZero()
+			lk := r.idListKey(joinEUI, devEUI)
+			ids, err := r.getIDList(tx, lk)
+			if err != nil {
+				return err
+			}
+			for _, sid := range ids {
+				tx.Del(r.idKey(joinEUI, devEUI, sid))
+			}
+			tx.Del(lk)
+		}
+		return nil
+	})
+}
+
+// applyApplicationActivationSettingsFieldMask applies fields specified by paths from src to dst and returns the result.
+// If dst is nil, a new ApplicationActivationSettings is created.
+func applyApplicationActivationSettingsFieldMask(
+	dst, src *ttnpb.ApplicationActivationSettings, paths ...string,
+) (*ttnpb.ApplicationActivationSettings, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationActivationSettings{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// ApplicationActivationSettingRegistry is an implementation of joinserver.ApplicationActivationSettingRegistry.
+type ApplicationActivationSettingRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the ApplicationActivationSettingRegistry.
+func (r *ApplicationActivationSettingRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (r *ApplicationActivationSettingRegistry) uidKey(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+// GetByID gets application activation settings by appID.
+func (r *ApplicationActivationSettingRegistry) GetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, paths []string,
+) (*ttnpb.ApplicationActivationSettings, error) {
+	if appID.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "get application activation settings").End()
+
+	pb := &ttnpb.ApplicationActivationSettings{}
+	if err := r.Bolt.GetProto(r.uidKey(unique.ID(ctx, appID)), pb); err != nil {
+		return nil, err
+	}
+	return applyApplicationActivationSettingsFieldMask(nil, pb, paths...)
+}
+
+// SetByID sets application activation settings by appID.
+func (r *ApplicationActivationSettingRegistry) SetByID(
+	ctx context.Context,
+	appID *ttnpb.ApplicationIdentifiers,
+	gets []string,
+	f func(*ttnpb.ApplicationActivationSettings) (*ttnpb.ApplicationActivationSettings, []string, error),
+) (*ttnpb.ApplicationActivationSettings, error) {
+	if appID.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+	uk := r.uidKey(unique.ID(ctx, appID))
+
+	defer trace.StartRegion(ctx, "set application activation settings").End()
+
+	var pb *ttnpb.ApplicationActivationSettings
+	err := r.Bolt.LockedWatch(ctx, uk, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.ApplicationActivationSettings{}
+		if err := tx.GetProto(uk, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb = nil
+		if stored != nil {
+			pb, err = applyApplicationActivationSettingsFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyApplicationActivationSettingsFieldMask(nil, stored, gets...)
+			return err
+		}
+		if pb == nil && len(sets) == 0 {
+			tx.Del(uk)
+			return nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationActivationSettings{}
+		}
+		updated, err := applyApplicationActivationSettingsFieldMask(stored, pb, sets...)
+		if err != nil {
+			return err
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+		if err := tx.SetProto(uk, updated, 0); err != nil {
+			return err
+		}
+		pb, err = applyApplicationActivationSettingsFieldMask(nil, updated, gets...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Range ranges over the application activation settings and calls the callback function, until false is returned.
+func (r *ApplicationActivationSettingRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationActivationSettings) bool,
+) error {
+	prefix := r.uidKey("")
+	return r.Bolt.Range(prefix, func(k string, v []byte) (bool, error) {
+		appUID := strings.TrimPrefix(k, prefix)
+		appIDs, err := unique.ToApplicationID(appUID)
+		if err != nil {
+			return false, err
+		}
+		ctx, err := unique.WithContext(ctx, appUID)
+		if err != nil {
+			return false, err
+		}
+		pb := &ttnpb.ApplicationActivationSettings{}
+		if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+			return false, err
+		}
+		pb, err = applyApplicationActivationSettingsFieldMask(nil, pb, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, appIDs, pb), nil
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/smarty/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	. "go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/bolt"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -328,6 +329,23 @@ func TestDeviceRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "Bolt",
+			New: func(ctx context.Context) (DeviceRegistry, func() error, error) {
+				cl, closeFn := test.NewBolt(ctx, namespace[:]...)
+				devReg := &bolt.DeviceRegistry{
+					Bolt: cl,
+				}
+				if err := devReg.Init(ctx); err != nil {
+					return nil, nil, err
+				}
+				return devReg, func() error {
+					closeFn()
+					return nil
+				}, nil
+			},
+			N: 8,
+		},
 	} {
 		tc := tc
 		for i := 0; i < int(tc.N); i++ {
@@ -632,6 +650,24 @@ func TestSessionKeyRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "Bolt",
+			New: func(ctx context.Context) (KeyRegistry, func() error, error) {
+				cl, closeFn := test.NewBolt(ctx, namespace[:]...)
+				keyReg := &bolt.KeyRegistry{
+					Bolt:  cl,
+					Limit: 10,
+				}
+				if err := keyReg.Init(ctx); err != nil {
+					return nil, nil, err
+				}
+				return keyReg, func() error {
+					closeFn()
+					return nil
+				}, nil
+			},
+			N: 8,
+		},
 	} {
 		tc := tc
 		for i := 0; i < int(tc.N); i++ {
@@ -0,0 +1,130 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/packetbroker"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/relayspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+)
+
+// remainingAirtime returns the largest fraction of remaining downlink airtime of the sub bands
+// that contain any of the given frequencies, and whether any such sub band is found.
+func remainingAirtime(subBands []*ttnpb.GatewayConnectionStats_SubBand, frequencies ...uint64) (float32, bool) {
+	var (
+		remaining float32
+		found     bool
+	)
+	for _, freq := range frequencies {
+		for _, sb := range subBands {
+			if freq < sb.MinFrequency || freq > sb.MaxFrequency || sb.DownlinkUtilizationLimit <= 0 {
+				continue
+			}
+			r := (sb.DownlinkUtilizationLimit - sb.DownlinkUtilization) / sb.DownlinkUtilizationLimit
+			if r < 0 {
+				r = 0
+			}
+			if !found || r > remaining {
+				remaining, found = r, true
+			}
+		}
+	}
+	return remaining, found
+}
+
+// isGatewayServerPath returns whether the downlink path is served by a Gateway Server.
+func isGatewayServerPath(path downlinkPath) bool {
+	switch {
+	case path.GatewayIdentifiers == nil,
+		proto.Equal(path.GatewayIdentifiers, packetbroker.GatewayIdentifiers),
+		proto.Equal(path.GatewayIdentifiers, passiveRoamingGatewayIdentifiers),
+		proto.Equal(path.GatewayIdentifiers, relayspec.GatewayIdentifiers):
+		return false
+	default:
+		return true
+	}
+}
+
+// preferGatewaysWithAirtime reorders the downlink paths of each group, such that Gateway Server paths
+// with sufficient remaining downlink airtime on the given frequencies come first.
+// Paths of gateways without known airtime usage are considered to have sufficient remaining airtime.
+// The relative order of the paths within each class is retained.
+func (ns *NetworkServer) preferGatewaysWithAirtime(
+	ctx context.Context, groupedPaths map[uint32][]downlinkPath, frequencies ...uint64,
+) map[uint32][]downlinkPath {
+	var ids []*ttnpb.GatewayIdentifiers
+	for _, paths := range groupedPaths {
+		for _, path := range paths {
+			if isGatewayServerPath(path) {
+				ids = append(ids, path.GatewayIdentifiers)
+			}
+		}
+	}
+	if len(ids) == 0 || len(frequencies) == 0 {
+		return groupedPaths
+	}
+	logger := log.FromContext(ctx)
+	subBands, err := ns.gatewayAirtime.Registry.BatchGet(ctx, ids)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get gateway airtime")
+		return groupedPaths
+	}
+	if len(subBands) == 0 {
+		return groupedPaths
+	}
+
+	const (
+		classAvailable = iota
+		classDepleted
+		classOther
+	)
+	class := func(path downlinkPath) int {
+		if !isGatewayServerPath(path) {
+			return classOther
+		}
+		remaining, ok := remainingAirtime(subBands[unique.ID(ctx, path.GatewayIdentifiers)], frequencies...)
+		if ok && remaining < ns.gatewayAirtime.MinRemaining {
+			return classDepleted
+		}
+		return classAvailable
+	}
+	reordered := make(map[uint32][]downlinkPath, len(groupedPaths))
+	for groupIdx, paths := range groupedPaths {
+		classes := make([]int, len(paths))
+		sorted := make([]downlinkPath, len(paths))
+		idxs := make([]int, len(paths))
+		for i, path := range paths {
+			classes[i] = class(path)
+			idxs[i] = i
+		}
+		sort.SliceStable(idxs, func(i, j int) bool { return classes[idxs[i]] < classes[idxs[j]] })
+		reorder := false
+		for i, idx := range idxs {
+			sorted[i] = paths[idx]
+			reorder = reorder || idx != i
+		}
+		if reorder {
+			logger.WithField("group", groupIdx).Debug("Reorder downlink paths by remaining gateway airtime")
+		}
+		reordered[groupIdx] = sorted
+	}
+	return reordered
+}
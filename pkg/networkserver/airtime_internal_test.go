@@ -0,0 +1,201 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/packetbroker"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockGatewayAirtimeRegistry map[string][]*ttnpb.GatewayConnectionStats_SubBand
+
+func (mockGatewayAirtimeRegistry) Set(
+	context.Context, *ttnpb.GatewayIdentifiers, []*ttnpb.GatewayConnectionStats_SubBand, time.Duration,
+) error {
+	return errors.New("not implemented")
+}
+
+func (r mockGatewayAirtimeRegistry) BatchGet(
+	context.Context, []*ttnpb.GatewayIdentifiers,
+) (map[string][]*ttnpb.GatewayConnectionStats_SubBand, error) {
+	if r == nil {
+		return nil, errors.New("registry unavailable")
+	}
+	return r, nil
+}
+
+var testAirtimeSubBands = []*ttnpb.GatewayConnectionStats_SubBand{
+	{
+		MinFrequency:             863000000,
+		MaxFrequency:             868600000,
+		DownlinkUtilizationLimit: 0.01,
+		DownlinkUtilization:      0.0095,
+	},
+	{
+		MinFrequency:             869400000,
+		MaxFrequency:             869650000,
+		DownlinkUtilizationLimit: 0.1,
+		DownlinkUtilization:      0.02,
+	},
+}
+
+func TestRemainingAirtime(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name        string
+		Frequencies []uint64
+		Remaining   float32
+		Found       bool
+	}{
+		{
+			Name:        "No frequencies",
+			Frequencies: nil,
+		},
+		{
+			Name:        "Unknown sub band",
+			Frequencies: []uint64{923200000},
+		},
+		{
+			Name:        "Depleted",
+			Frequencies: []uint64{868100000},
+			Remaining:   0.05,
+			Found:       true,
+		},
+		{
+			Name:        "Maximum",
+			Frequencies: []uint64{868100000, 869525000},
+			Remaining:   0.8,
+			Found:       true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			remaining, found := remainingAirtime(testAirtimeSubBands, tc.Frequencies...)
+			a.So(found, should.Equal, tc.Found)
+			a.So(remaining, should.AlmostEqual, tc.Remaining, 1e-6)
+		})
+	}
+}
+
+func TestPreferGatewaysWithAirtime(t *testing.T) {
+	t.Parallel()
+
+	ctx := test.Context()
+	depletedIDs := &ttnpb.GatewayIdentifiers{GatewayId: "depleted"}
+	availableIDs := &ttnpb.GatewayIdentifiers{GatewayId: "available"}
+	unknownIDs := &ttnpb.GatewayIdentifiers{GatewayId: "unknown"}
+	depleted := downlinkPath{
+		GatewayIdentifiers: depletedIDs,
+		DownlinkPath:       &ttnpb.DownlinkPath{Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("depleted")}},
+	}
+	available := downlinkPath{
+		GatewayIdentifiers: availableIDs,
+		DownlinkPath:       &ttnpb.DownlinkPath{Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("available")}},
+	}
+	unknown := downlinkPath{
+		GatewayIdentifiers: unknownIDs,
+		DownlinkPath:       &ttnpb.DownlinkPath{Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("unknown")}},
+	}
+	packetBroker := downlinkPath{
+		GatewayIdentifiers: packetbroker.GatewayIdentifiers,
+		DownlinkPath:       &ttnpb.DownlinkPath{Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("pb")}},
+	}
+	registry := mockGatewayAirtimeRegistry{
+		unique.ID(ctx, depletedIDs):  testAirtimeSubBands[:1],
+		unique.ID(ctx, availableIDs): testAirtimeSubBands,
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		Registry    gatewayairtime.Registry
+		Frequencies []uint64
+		Paths       map[uint32][]downlinkPath
+		Expected    map[uint32][]downlinkPath
+	}{
+		{
+			Name:        "Reorder",
+			Registry:    registry,
+			Frequencies: []uint64{868100000},
+			Paths: map[uint32][]downlinkPath{
+				0: {depleted, packetBroker, unknown},
+				1: {available, depleted},
+			},
+			Expected: map[uint32][]downlinkPath{
+				0: {unknown, depleted, packetBroker},
+				1: {available, depleted},
+			},
+		},
+		{
+			Name:        "RX2 available",
+			Registry:    registry,
+			Frequencies: []uint64{868100000, 869525000},
+			Paths: map[uint32][]downlinkPath{
+				0: {depleted, available},
+			},
+			Expected: map[uint32][]downlinkPath{
+				0: {available, depleted},
+			},
+		},
+		{
+			Name:        "No frequencies",
+			Registry:    registry,
+			Frequencies: nil,
+			Paths: map[uint32][]downlinkPath{
+				0: {depleted, available},
+			},
+			Expected: map[uint32][]downlinkPath{
+				0: {depleted, available},
+			},
+		},
+		{
+			Name:        "Registry failure",
+			Registry:    mockGatewayAirtimeRegistry(nil),
+			Frequencies: []uint64{868100000},
+			Paths: map[uint32][]downlinkPath{
+				0: {depleted, available},
+			},
+			Expected: map[uint32][]downlinkPath{
+				0: {depleted, available},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			ns := &NetworkServer{
+				gatewayAirtime: GatewayAirtimeConfig{
+					Prefer:       true,
+					MinRemaining: 0.1,
+					Registry:     tc.Registry,
+				},
+			}
+			a.So(ns.preferGatewaysWithAirtime(ctx, tc.Paths, tc.Frequencies...), should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,690 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a Network Server device registry backed by an embedded bbolt database.
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"runtime/trace"
+	"sort"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/uplinkmatch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database is corrupted")
+	errInvalidDevice      = errors.DefineInvalidArgument("invalid_device", "device is invalid")
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errNoUplinkMatch      = errors.DefineNotFound("no_uplink_match", "no device matches uplink")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errRelayServed        = errors.DefineAlreadyExists("relay_served", "`{served}` is already served by `{serving}`")
+	errSessionEntry       = errors.DefineCorruption("session_entry", "invalid session entry", "uid")
+)
+
+// DeviceRegistry is an implementation of networkserver.DeviceRegistry.
+//
+// The sessions are indexed by DevAddr using a key per device. The values of the index hold the score used
+// for ordering the matches, followed by the session data used for matching.
+type DeviceRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+// Init initializes the DeviceRegistry.
+func (r *DeviceRegistry) Init(ctx context.Context) error {
+	return r.Bolt.Init(ctx)
+}
+
+func (r *DeviceRegistry) uidKey(uid string) string {
+	return ttnbolt.Key("uid", uid)
+}
+
+func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
+	return ttnbolt.Key("eui", joinEUI.String(), devEUI.String())
+}
+
+func (r *DeviceRegistry) currentAddrKey(addr []byte, uid string) string {
+	return ttnbolt.Key("addr", types.MustDevAddr(addr).OrZero().String(), "current", uid)
+}
+
+func (r *DeviceRegistry) pendingAddrKey(addr []byte, uid string) string {
+	return ttnbolt.Key("addr", types.MustDevAddr(addr).OrZero().String(), "pending", uid)
+}
+
+func (r *DeviceRegistry) relayRulesMapping(ctx context.Context, dev *ttnpb.EndDevice) map[string]string {
+	m := make(map[string]string)
+	add := func(rules []*ttnpb.RelayUplinkForwardingRule) {
+		for _, rule := range rules {
+			if rule.GetDeviceId() == "" {
+				continue
+			}
+			servedUID := unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: dev.Ids.ApplicationIds,
+				DeviceId:       rule.DeviceId,
+			})
+			m[ttnbolt.Key("relay", "rules", servedUID)] = servedUID
+		}
+	}
+	for _, rules := range [][]*ttnpb.RelayUplinkForwardingRule{
+		dev.GetMacSettings().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetMacSettings().GetDesiredRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetMacState().GetCurrentParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetMacState().GetDesiredParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetPendingMacState().GetCurrentParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetPendingMacState().GetDesiredParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+	} {
+		add(rules)
+	}
+	return m
+}
+
+// encodeSessionEntry encodes the session entry with the given score.
+func encodeSessionEntry(score uint64, session []byte) []byte {
+	b := make([]byte, 8+len(session))
+	binary.BigEndian.PutUint64(b, score)
+	copy(b[8:], session)
+	return b
+}
+
+// decodeSessionEntry decodes the score and the session of the session entry.
+func decodeSessionEntry(b []byte) (uint64, []byte, bool) {
+	if len(b) < 8 {
+		return 0, nil, false
+	}
+	return binary.BigEndian.Uint64(b), b[8:], true
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string,
+) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.GetProto(r.uidKey(unique.ID(ctx, ids)), pb); err != nil {
+		return nil, ctx, err
+	}
+	pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// BatchGetByID gets devices by appID, deviceIDs.
+func (r *DeviceRegistry) BatchGetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, deviceIDs []string, paths []string,
+) ([]*ttnpb.EndDevice, error) {
+	defer trace.StartRegion(ctx, "batch get end device by id").End()
+
+	keys := make([]string, len(deviceIDs))
+	for i, devID := range deviceIDs {
+		ids := &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appID,
+			DeviceId:       devID,
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			return nil, err
+		}
+		keys[i] = r.uidKey(unique.ID(ctx, ids))
+	}
+
+	values, err := r.Bolt.MGet(keys...)
+	if err != nil {
+		return nil, err
+	}
+	protos := make([]*ttnpb.EndDevice, len(deviceIDs))
+	for i, val := range values {
+		if val == nil {
+			continue
+		}
+		dev := &ttnpb.EndDevice{}
+		if err := ttnbolt.UnmarshalProto(val, dev); err != nil {
+			return nil, err
+		}
+		dev, err = ttnpb.FilterGetEndDevice(dev, paths...)
+		if err != nil {
+			return nil, err
+		}
+		protos[i] = dev
+	}
+	return protos, nil
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(
+	ctx context.Context, joinEUI, devEUI types.EUI64, paths []string,
+) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	uid, err := r.Bolt.Get(r.euiKey(joinEUI, devEUI))
+	if err != nil {
+		return nil, ctx, err
+	}
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.GetProto(r.uidKey(string(uid)), pb); err != nil {
+		return nil, ctx, err
+	}
+	pb, err = ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+type sessionEntry struct {
+	UID     string
+	Score   uint64
+	Session []byte
+}
+
+// sessionEntries returns the session entries stored under prefix, ordered by descending score.
+// If usePivot is set, the entries with a score smaller or equal to pivot are ordered first.
+func (r *DeviceRegistry) sessionEntries(prefix string, usePivot bool, pivot uint64) ([]sessionEntry, error) {
+	var entries []sessionEntry
+	if err := r.Bolt.Range(prefix, func(k string, v []byte) (bool, error) {
+		uid := strings.TrimPrefix(k, prefix)
+		score, session, ok := decodeSessionEntry(v)
+		if !ok {
+			return false, errDatabaseCorruption.WithCause(errSessionEntry.WithAttributes("uid", uid))
+		}
+		entries = append(entries, sessionEntry{
+			UID:     uid,
+			Score:   score,
+			Session: session,
+		})
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].UID > entries[j].UID
+	})
+	if !usePivot {
+		return entries, nil
+	}
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Score <= pivot })
+	return append(entries[i:len(entries):len(entries)], entries[:i]...), nil
+}
+
+// RangeByUplinkMatches ranges over devices matching the uplink.
+func (r *DeviceRegistry) RangeByUplinkMatches(
+	ctx context.Context, up *ttnpb.UplinkMessage, f func(context.Context, *networkserver.UplinkMatch) (bool, error),
+) error {
+	defer trace.StartRegion(ctx, "range end devices by uplink matches").End()
+
+	pld := up.Payload.GetMacPayload()
+	ackFlag := pld.FHdr.FCtrl.Ack
+	lsb := uint16(pld.FHdr.FCnt)
+
+	currentSessionSet, err := r.sessionEntries(r.currentAddrKey(pld.FHdr.DevAddr, ""), true, uint64(lsb))
+	if err != nil {
+		return err
+	}
+	var pendingSessionSet []sessionEntry
+	if !ackFlag {
+		pendingSessionSet, err = r.sessionEntries(r.pendingAddrKey(pld.FHdr.DevAddr, ""), false, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	parseUID := func(uid string) (*ttnpb.EndDeviceIdentifiers, error) {
+		ids, err := unique.ToDeviceID(uid)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("Failed to parse UID as device identifiers")
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		return ids, nil
+	}
+
+	for _, session := range currentSessionSet {
+		ids, err := parseUID(session.UID)
+		if err != nil {
+			return err
+		}
+
+		ses := &uplinkmatch.Session{}
+		if err := msgpack.Unmarshal(session.Session, ses); err != nil {
+			continue
+		}
+
+		if uint16(ses.LastFCnt) > lsb {
+			if ses.Supports32BitFCnt != nil && !ses.Supports32BitFCnt.Value &&
+				(ackFlag || ses.ResetsFCnt == nil || !ses.ResetsFCnt.Value) {
+				continue
+			}
+		}
+
+		stop, err := f(ctx, &networkserver.UplinkMatch{
+			ApplicationIdentifiers: ids.ApplicationIds,
+			DeviceID:               ids.DeviceId,
+			LoRaWANVersion:         ses.LoRaWANVersion,
+			FNwkSIntKey:            ses.FNwkSIntKey,
+			LastFCnt:               ses.LastFCnt,
+			ResetsFCnt:             ses.ResetsFCnt,
+			Supports32BitFCnt:      ses.Supports32BitFCnt,
+		})
+		if err != nil || stop {
+			return err
+		}
+	}
+	for _, session := range pendingSessionSet {
+		ids, err := parseUID(session.UID)
+		if err != nil {
+			return err
+		}
+
+		ses := &uplinkmatch.PendingSession{}
+		if err := msgpack.Unmarshal(session.Session, ses); err != nil {
+			continue
+		}
+		stop, err := f(ctx, &networkserver.UplinkMatch{
+			ApplicationIdentifiers: ids.ApplicationIds,
+			DeviceID:               ids.DeviceId,
+			LoRaWANVersion:         ses.LoRaWANVersion,
+			FNwkSIntKey:            ses.FNwkSIntKey,
+			IsPending:              true,
+		})
+		if err != nil || stop {
+			return err
+		}
+	}
+
+	return errNoUplinkMatch.New()
+}
+
+// checkRelayRules returns an error if any of the relay rules in keys is already set.
+func checkRelayRules(tx *ttnbolt.Tx, mapping map[string]string, keys []string) error {
+	ruleUIDs, err := tx.MGet(keys...)
+	if err != nil {
+		return err
+	}
+	for i, ruleUID := range ruleUIDs {
+		if ruleUID != nil {
+			return errRelayServed.WithAttributes(
+				"served", mapping[keys[i]],
+				"serving", string(ruleUID),
+			)
+		}
+	}
+	return nil
+}
+
+// sessionIndexChanges returns whether the stored session index entry should be removed, whether the score should be
+// set and whether the session data should be set.
+func sessionIndexChanges(
+	updatedSession, storedSession *ttnpb.Session, scoreChanged bool, fieldsChanged func() bool,
+) (removeStored, setScore, setFields bool) {
+	switch {
+	case updatedSession == nil:
+		return true, false, false
+	case storedSession == nil:
+		return false, true, true
+	case !bytes.Equal(updatedSession.DevAddr, storedSession.DevAddr):
+		return true, true, true
+	case scoreChanged:
+		return false, true, true
+	}
+	return false, false, fieldsChanged()
+}
+
+// setSessionEntry sets the session entry at key k. If setScore is false, the stored score is retained.
+func setSessionEntry(tx *ttnbolt.Tx, k string, setScore bool, score uint64, session []byte) error {
+	if !setScore {
+		b, err := tx.Get(k)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if storedScore, _, ok := decodeSessionEntry(b); ok {
+			score = storedScore
+		}
+	}
+	tx.Set(k, encodeSessionEntry(score, session), 0)
+	return nil
+}
+
+// SetByID sets device by appID, devID.
+func (r *DeviceRegistry) SetByID(
+	ctx context.Context,
+	appID *ttnpb.ApplicationIdentifiers,
+	devID string,
+	gets []string,
+	f func(ctx context.Context, pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, context.Context, error) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var pb *ttnpb.EndDevice
+	if err := r.Bolt.LockedWatch(ctx, uk, func(tx *ttnbolt.Tx) error {
+		stored := &ttnpb.EndDevice{}
+		if err := tx.GetProto(uk, stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb = nil
+		if stored != nil {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(ctx, pb)
+		if err != nil {
+			return err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return errInvalidFieldmask.WithCause(err)
+		}
+
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			return err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			trace.Log(ctx, "ns:bolt", "delete end device")
+			tx.Del(uk)
+			if stored.Ids.JoinEui != nil && stored.Ids.DevEui != nil {
+				tx.Del(r.euiKey(
+					types.MustEUI64(stored.Ids.JoinEui).OrZero(),
+					types.MustEUI64(stored.Ids.DevEui).OrZero(),
+				))
+			}
+			if stored.PendingSession != nil {
+				tx.Del(r.pendingAddrKey(stored.PendingSession.DevAddr, uid))
+			}
+			if stored.Session != nil {
+				tx.Del(r.currentAddrKey(stored.Session.DevAddr, uid))
+			}
+			tx.Del(maps.Keys(r.relayRulesMapping(ctx, stored))...)
+			return nil
+		}
+
+		if stored == nil {
+			trace.Log(ctx, "ns:bolt", "create end device")
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.device_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+			if pb.Ids.ApplicationIds.ApplicationId != appID.ApplicationId || pb.Ids.DeviceId != devID {
+				return errInvalidIdentifiers.New()
+			}
+			if pb.Ids.JoinEui != nil && pb.Ids.DevEui != nil {
+				joinEUI := types.MustEUI64(pb.Ids.JoinEui).OrZero()
+				devEUI := types.MustEUI64(pb.Ids.DevEui).OrZero()
+				ek := r.euiKey(joinEUI, devEUI)
+				storedUID, err := tx.Get(ek)
+				switch {
+				case errors.IsNotFound(err):
+					tx.Set(ek, []byte(uid), 0)
+				case err != nil:
+					return err
+				default:
+					return registry.UniqueEUIViolationErr(ctx, joinEUI, devEUI, string(storedUID))
+				}
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+				pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+				return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.device_id") && pb.Ids.DeviceId != stored.Ids.DeviceId {
+				return errReadOnlyField.WithAttributes("field", "ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.join_eui") && !bytes.Equal(pb.Ids.JoinEui, stored.Ids.JoinEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.join_eui")
+			}
+			if ttnpb.HasAnyField(sets, "ids.dev_eui") && !bytes.Equal(pb.Ids.DevEui, stored.Ids.DevEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+			}
+		}
+
+		updated := &ttnpb.EndDevice{}
+		if stored != nil {
+			updated = proto.Clone(stored).(*ttnpb.EndDevice)
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+		if err != nil {
+			return err
+		}
+		updated.UpdatedAt = timestamppb.New(time.Now()) // NOTE: This is not equivalent to timestamppb.Now().
+		if stored == nil {
+			updated.CreatedAt = updated.UpdatedAt
+		}
+
+		if updated.Session != nil && updated.MacState == nil ||
+			updated.PendingSession != nil && updated.PendingMacState == nil {
+			return errInvalidDevice.New()
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+
+		storedPendingSession := stored.GetPendingSession()
+		if updated.PendingSession != nil || storedPendingSession != nil {
+			removeStored, setScore, setFields := sessionIndexChanges(
+				updated.PendingSession, storedPendingSession, false,
+				func() bool {
+					storedPendingMACState := stored.GetPendingMacState()
+					return storedPendingMACState == nil ||
+						updated.PendingMacState.LorawanVersion != storedPendingMACState.LorawanVersion ||
+						!proto.Equal(updated.PendingSession.Keys.FNwkSIntKey, storedPendingSession.Keys.FNwkSIntKey)
+				},
+			)
+			if removeStored {
+				tx.Del(r.pendingAddrKey(storedPendingSession.DevAddr, uid))
+			}
+			if setFields {
+				b, err := uplinkmatch.MarshalPendingSession(updated)
+				if err != nil {
+					return err
+				}
+				k := r.pendingAddrKey(updated.PendingSession.DevAddr, uid)
+				if err := setSessionEntry(tx, k, setScore, uint64(time.Now().UnixNano()), b); err != nil {
+					return err
+				}
+			}
+		}
+
+		storedSession := stored.GetSession()
+		if updated.Session != nil || storedSession != nil {
+			removeStored, setScore, setFields := sessionIndexChanges(
+				updated.Session, storedSession,
+				storedSession != nil && updated.Session.GetLastFCntUp() != storedSession.LastFCntUp,
+				func() bool {
+					storedMACState := stored.GetMacState()
+					storedMACSettings := stored.GetMacSettings()
+					return storedMACState == nil ||
+						updated.MacState.LorawanVersion != storedMACState.LorawanVersion ||
+						!proto.Equal(updated.Session.Keys.FNwkSIntKey, storedSession.Keys.FNwkSIntKey) ||
+						!proto.Equal(updated.MacSettings.GetResetsFCnt(), storedMACSettings.GetResetsFCnt()) ||
+						!proto.Equal(updated.MacSettings.GetSupports_32BitFCnt(), storedMACSettings.GetSupports_32BitFCnt())
+				},
+			)
+			if removeStored {
+				tx.Del(r.currentAddrKey(storedSession.DevAddr, uid))
+			}
+			if setFields {
+				b, err := uplinkmatch.MarshalCurrentSession(updated)
+				if err != nil {
+					return err
+				}
+				k := r.currentAddrKey(updated.Session.DevAddr, uid)
+				if err := setSessionEntry(tx, k, setScore, uint64(updated.Session.LastFCntUp&0xffff), b); err != nil {
+					return err
+				}
+			}
+		}
+
+		storedRelayRulesMapping := r.relayRulesMapping(ctx, stored)
+		updatedRelayRulesMapping := r.relayRulesMapping(ctx, updated)
+		if !maps.Equal(storedRelayRulesMapping, updatedRelayRulesMapping) {
+			for storedKey := range storedRelayRulesMapping {
+				if _, ok := updatedRelayRulesMapping[storedKey]; !ok {
+					tx.Del(storedKey)
+				}
+			}
+			added := make([]string, 0, len(updatedRelayRulesMapping))
+			for updatedKey := range updatedRelayRulesMapping {
+				if _, ok := storedRelayRulesMapping[updatedKey]; !ok {
+					added = append(added, updatedKey)
+				}
+			}
+			if len(added) > 0 {
+				if err := checkRelayRules(tx, updatedRelayRulesMapping, added); err != nil {
+					return err
+				}
+				for _, addedKey := range added {
+					tx.Set(addedKey, []byte(uid), 0)
+				}
+			}
+		}
+
+		if err := tx.SetProto(uk, updated, 0); err != nil {
+			return err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+		return err
+	}); err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// Range ranges over device uid keys in DeviceRegistry.
+func (r *DeviceRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	return r.Bolt.Range(r.uidKey(""), func(_ string, v []byte) (bool, error) {
+		dev := &ttnpb.EndDevice{}
+		if err := ttnbolt.UnmarshalProto(v, dev); err != nil {
+			return false, err
+		}
+		dev, err := ttnpb.FilterGetEndDevice(dev, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, dev.Ids, dev), nil
+	})
+}
+
+// BatchDelete implements DeviceRegistry.
+// This function deletes all the devices in a single transaction.
+func (r *DeviceRegistry) BatchDelete(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	deviceIDs []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	uidKeys := make([]string, 0, len(deviceIDs))
+	for _, devID := range deviceIDs {
+		uidKeys = append(uidKeys, r.uidKey(unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       devID,
+		})))
+	}
+
+	defer trace.StartRegion(ctx, "batch delete end devices").End()
+
+	var ret []*ttnpb.EndDeviceIdentifiers
+	if err := r.Bolt.Watch(ctx, func(tx *ttnbolt.Tx) error {
+		ret = make([]*ttnpb.EndDeviceIdentifiers, 0, len(uidKeys))
+		values, err := tx.MGet(uidKeys...)
+		if err != nil {
+			return err
+		}
+		for _, val := range values {
+			if val == nil {
+				continue
+			}
+			dev := &ttnpb.EndDevice{}
+			if err := ttnbolt.UnmarshalProto(val, dev); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decode stored end device")
+				continue
+			}
+			ret = append(ret, dev.Ids)
+			uid := unique.ID(ctx, dev.GetIds())
+			if dev.Ids.JoinEui != nil && dev.Ids.DevEui != nil {
+				tx.Del(r.euiKey(
+					types.MustEUI64(dev.GetIds().GetJoinEui()).OrZero(),
+					types.MustEUI64(dev.GetIds().GetDevEui()).OrZero(),
+				))
+			}
+			if dev.PendingSession != nil {
+				tx.Del(r.pendingAddrKey(dev.PendingSession.DevAddr, uid))
+			}
+			if dev.Session != nil {
+				tx.Del(r.currentAddrKey(dev.Session.DevAddr, uid))
+			}
+			tx.Del(maps.Keys(r.relayRulesMapping(ctx, dev))...)
+		}
+		tx.Del(uidKeys...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,39 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/bolt"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test/shared"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+var _ networkserver.DeviceRegistry = &DeviceRegistry{}
+
+func TestDeviceRegistry(t *testing.T) {
+	_, ctx := test.New(t)
+	cl, closeFn := test.NewBolt(ctx, "ns", "devices")
+	defer closeFn()
+	reg := &DeviceRegistry{
+		Bolt: cl,
+	}
+	if err := reg.Init(ctx); err != nil {
+		t.Fatalf("Failed to initialize bolt device registry: %s", test.FormatError(err))
+	}
+	HandleDeviceRegistryTest(t, reg)
+}
@@ -17,6 +17,7 @@ package networkserver
 import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayairtime"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -43,6 +44,7 @@ type DownlinkTaskQueueConfig struct {
 // MACSettingConfig defines MAC-layer configuration.
 type MACSettingConfig struct {
 	ADRMargin                  *float32                   `name:"adr-margin" description:"The default margin Network Server should add in ADR requests if not configured in device's MAC settings"`
+	ADRAlgorithm               string                     `name:"adr-algorithm" description:"ADR algorithm Network Server should use if not configured in device's MAC settings (default, loss-aware)"`
 	DesiredRx1Delay            *ttnpb.RxDelay             `name:"desired-rx1-delay" description:"Desired Rx1Delay value Network Server should use if not configured in device's MAC settings"`
 	DesiredMaxDutyCycle        *ttnpb.AggregatedDutyCycle `name:"desired-max-duty-cycle" description:"Desired MaxDutyCycle value Network Server should use if not configured in device's MAC settings"`
 	DesiredADRAckLimitExponent *ttnpb.ADRAckLimitExponent `name:"desired-adr-ack-limit-exponent" description:"Desired ADR_ACK_LIMIT value Network Server should use if not configured in device's MAC settings"`
@@ -63,6 +65,18 @@ func (c MACSettingConfig) Parse() (*ttnpb.MACSettings, error) {
 	if c.ADRMargin != nil {
 		p.AdrMargin = &wrapperspb.FloatValue{Value: *c.ADRMargin}
 	}
+	if c.ADRAlgorithm != "" {
+		if _, ok := mac.GetADRAlgorithm(c.ADRAlgorithm); !ok {
+			return nil, errUnknownADRAlgorithm.WithAttributes("name", c.ADRAlgorithm)
+		}
+		p.Adr = &ttnpb.ADRSettings{
+			Mode: &ttnpb.ADRSettings_Dynamic{
+				Dynamic: &ttnpb.ADRSettings_DynamicMode{
+					Algorithm: c.ADRAlgorithm,
+				},
+			},
+		}
+	}
 	if c.DesiredRx1Delay != nil {
 		p.DesiredRx1Delay = &ttnpb.RxDelayValue{Value: *c.DesiredRx1Delay}
 	}
@@ -131,6 +145,41 @@ type InteropConfig struct {
 	ID                   *types.EUI64 `name:"id" description:"NSID of this Network Server (EUI)"`
 }
 
+// PassiveRoamingConfig represents the passive roaming configuration.
+// Roaming partners are configured as Network Servers in the interoperability client configuration.
+type PassiveRoamingConfig struct {
+	Forward bool     `name:"forward" description:"Forward uplink messages of devices of roaming partners as forwarding Network Server"`
+	Serve   bool     `name:"serve" description:"Serve devices that roam in networks of roaming partners as serving Network Server"`
+	BandIDs []string `name:"band-ids" description:"Band IDs used to determine the band of uplink messages forwarded to roaming partners"`
+}
+
+// Enabled returns whether passive roaming is enabled.
+func (c PassiveRoamingConfig) Enabled() bool {
+	return c.Forward || c.Serve
+}
+
+// HandoverRoamingConfig represents the handover roaming configuration.
+// Roaming partners are configured as Network Servers in the interoperability client configuration.
+type HandoverRoamingConfig struct {
+	HandOver        bool   `name:"hand-over" description:"Hand over devices that join in networks of roaming partners as home Network Server"`
+	Accept          bool   `name:"accept" description:"Accept devices of roaming partners as serving Network Server"`
+	ApplicationID   string `name:"application-id" description:"Application ID of accepted devices of roaming partners"`
+	FrequencyPlanID string `name:"frequency-plan-id" description:"Frequency plan ID of accepted devices of roaming partners"`
+}
+
+// Enabled returns whether handover roaming is enabled.
+func (c HandoverRoamingConfig) Enabled() bool {
+	return c.HandOver || c.Accept
+}
+
+// GatewayAirtimeConfig represents the configuration for preferring gateways with remaining downlink airtime.
+// The downlink airtime usage of gateways is published by Gateway Servers.
+type GatewayAirtimeConfig struct {
+	Prefer       bool                    `name:"prefer" description:"Prefer gateways with remaining downlink airtime"`
+	MinRemaining float32                 `name:"min-remaining" description:"Minimum fraction of remaining downlink airtime of preferred gateways"`
+	Registry     gatewayairtime.Registry `name:"-"`
+}
+
 // Config represents the NetworkServer configuration.
 type Config struct {
 	ApplicationUplinkQueue   ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
@@ -145,7 +194,10 @@ type Config struct {
 	CooldownWindow           time.Duration                `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
 	DownlinkPriorities       DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`
 	DefaultMACSettings       MACSettingConfig             `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
+	GatewayAirtime           GatewayAirtimeConfig         `name:"gateway-airtime" description:"Gateway downlink airtime configuration"`
 	Interop                  InteropConfig                `name:"interop" description:"Interop client configuration"`
+	PassiveRoaming           PassiveRoamingConfig         `name:"passive-roaming" description:"Passive roaming configuration"`
+	HandoverRoaming          HandoverRoamingConfig        `name:"handover-roaming" description:"Handover roaming configuration"`
 	DeviceKEKLabel           string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DownlinkQueueCapacity    int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
 }
@@ -177,5 +229,8 @@ var DefaultConfig = Config{
 		StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(mac.DefaultStatusTimePeriodicity),
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
+	GatewayAirtime: GatewayAirtimeConfig{
+		MinRemaining: 0.1,
+	},
 	DownlinkQueueCapacity: 10000,
 }
@@ -741,6 +741,9 @@ func downlinkPathsFromMetadata(
 		case md.Relay != nil:
 			path.GatewayIdentifiers = relayspec.GatewayIdentifiers
 			tail = append(tail, path)
+		case proto.Equal(md.GatewayIds, passiveRoamingGatewayIdentifiers):
+			path.GatewayIdentifiers = passiveRoamingGatewayIdentifiers
+			tail = append(tail, path)
 		default:
 			path.GatewayIdentifiers = md.GatewayIds
 			switch md.DownlinkPathConstraint {
@@ -912,6 +915,16 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 		return nil, nil, errNoPath.New()
 	}
 
+	if ns.gatewayAirtime.Prefer {
+		var frequencies []uint64
+		for _, freq := range []uint64{req.Rx1Frequency, req.Rx2Frequency} {
+			if freq != 0 {
+				frequencies = append(frequencies, freq)
+			}
+		}
+		groupedPaths = ns.preferGatewaysWithAirtime(ctx, groupedPaths, frequencies...)
+	}
+
 	logger := log.FromContext(ctx)
 
 	type attempt struct {
@@ -938,6 +951,14 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 					continue
 				}
 				target = &packetBrokerDownlinkTarget{peer: peer}
+			case proto.Equal(path.GatewayIdentifiers, passiveRoamingGatewayIdentifiers):
+				logger := logger.WithField("target", "passive_roaming")
+				prTarget, err := ns.newPassiveRoamingDownlinkTarget(ctx, path.DownlinkPath)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to get passive roaming target")
+					continue
+				}
+				target = prTarget
 			case proto.Equal(path.GatewayIdentifiers, relayspec.GatewayIdentifiers):
 				target = &relayDownlinkTarget{
 					servedEndDeviceIDs: req.EndDeviceIdentifiers,
@@ -1917,6 +1938,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 		dev, ctx, err := ns.devices.SetByID(ctx, devID.ApplicationIds, devID.DeviceId,
 			[]string{
 				"frequency_plan_id",
+				"handover_roaming",
 				"last_dev_status_received_at",
 				"lorawan_phy_version",
 				"mac_settings",
@@ -2079,30 +2101,34 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 						}, nil
 					}
 
-					var invalidatedQueue []*ttnpb.ApplicationDownlink
-					if dev.Session != nil {
-						invalidatedQueue = dev.Session.QueuedApplicationDownlinks
-					} else {
-						invalidatedQueue = dev.GetPendingSession().GetQueuedApplicationDownlinks()
-					}
-					queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
-						EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
-							ApplicationIds: dev.Ids.ApplicationIds,
-							DeviceId:       dev.Ids.DeviceId,
-							DevEui:         dev.Ids.DevEui,
-							JoinEui:        dev.Ids.JoinEui,
-							DevAddr:        dev.PendingMacState.QueuedJoinAccept.DevAddr,
-						},
-						CorrelationIds: events.CorrelationIDsFromContext(ctx),
-						Up: &ttnpb.ApplicationUp_JoinAccept{
-							JoinAccept: &ttnpb.ApplicationJoinAccept{
-								AppSKey:              dev.PendingMacState.QueuedJoinAccept.Keys.AppSKey,
-								InvalidatedDownlinks: invalidatedQueue,
-								SessionKeyId:         dev.PendingMacState.QueuedJoinAccept.Keys.SessionKeyId,
-								ReceivedAt:           up.ReceivedAt,
+					if !dev.HandoverRoaming.GetServing() {
+						// The join-accept of a device that is served on behalf of a roaming partner is sent to the
+						// Application Server by the home Network Server, which has the AppSKey.
+						var invalidatedQueue []*ttnpb.ApplicationDownlink
+						if dev.Session != nil {
+							invalidatedQueue = dev.Session.QueuedApplicationDownlinks
+						} else {
+							invalidatedQueue = dev.GetPendingSession().GetQueuedApplicationDownlinks()
+						}
+						queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
+							EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+								ApplicationIds: dev.Ids.ApplicationIds,
+								DeviceId:       dev.Ids.DeviceId,
+								DevEui:         dev.Ids.DevEui,
+								JoinEui:        dev.Ids.JoinEui,
+								DevAddr:        dev.PendingMacState.QueuedJoinAccept.DevAddr,
 							},
-						},
-					})
+							CorrelationIds: events.CorrelationIDsFromContext(ctx),
+							Up: &ttnpb.ApplicationUp_JoinAccept{
+								JoinAccept: &ttnpb.ApplicationJoinAccept{
+									AppSKey:              dev.PendingMacState.QueuedJoinAccept.Keys.AppSKey,
+									InvalidatedDownlinks: invalidatedQueue,
+									SessionKeyId:         dev.PendingMacState.QueuedJoinAccept.Keys.SessionKeyId,
+									ReceivedAt:           up.ReceivedAt,
+								},
+							},
+						})
+					}
 
 					dev.PendingSession = &ttnpb.Session{
 						DevAddr: dev.PendingMacState.QueuedJoinAccept.DevAddr,
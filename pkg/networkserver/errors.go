@@ -55,6 +55,25 @@ var (
 	errFCntTooLow = errors.DefineInvalidArgument(
 		"f_cnt_too_low", "FCnt `{f_cnt}` is lower than minimum of `{min_f_cnt}`",
 	)
+	errForwardRoamingUplink      = errors.Define("forward_roaming_uplink", "forward uplink to roaming partner")
+	errHandoverRoamingActivation = errors.DefineFailedPrecondition(
+		"handover_roaming_activation", "roaming activation type `{type}` is not handover roaming",
+	)
+	errHandoverRoamingBand = errors.DefineFailedPrecondition(
+		"handover_roaming_band", "band `{band_id}` is not supported for handover roaming",
+	)
+	errHandoverRoamingKEK = errors.DefineFailedPrecondition(
+		"handover_roaming_kek", "no KEK configured for handover roaming partner `{net_id}`",
+	)
+	errHandoverRoamingRFRegion = errors.DefineFailedPrecondition(
+		"handover_roaming_rf_region", "RF region `{rf_region}` does not match the handover roaming frequency plan",
+	)
+	errHandoverRoamingSessionKeys = errors.DefineInvalidArgument(
+		"handover_roaming_session_keys", "missing session keys in handover roaming answer",
+	)
+	errInteropClientRequired = errors.DefineFailedPrecondition(
+		"interop_client_required", "{feature} requires interop client configuration",
+	)
 	errInvalidAbsoluteTime = errors.DefineInvalidArgument(
 		"absolute_time", "invalid absolute time set in application downlink",
 	)
@@ -67,10 +86,26 @@ var (
 	errInvalidFixedPaths = errors.DefineInvalidArgument(
 		"fixed_paths", "invalid fixed paths set in application downlink",
 	)
-	errInvalidPayload     = errors.DefineInvalidArgument("payload", "invalid payload")
-	errJoinServerNotFound = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errInvalidPayload           = errors.DefineInvalidArgument("payload", "invalid payload")
+	errJoinServerNotFound       = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errNoHandoverRoamingPartner = errors.DefineNotFound(
+		"no_handover_roaming_partner", "home Network Server `{net_id}` is not a handover roaming partner",
+	)
 	errNoPath             = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errOutdatedData       = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
+	errPassiveRoamingBand = errors.DefineFailedPrecondition(
+		"passive_roaming_band", "band `{band_id}` is not supported for passive roaming",
+	)
+	errPassiveRoamingBandNotFound = errors.DefineNotFound(
+		"passive_roaming_band_not_found", "passive roaming band for frequency `{frequency}` not found",
+	)
+	errPassiveRoamingDisabled = errors.DefineFailedPrecondition("passive_roaming_disabled", "passive roaming is disabled")
+	errPassiveRoamingRFRegion = errors.DefineInvalidArgument(
+		"passive_roaming_rf_region", "unknown passive roaming RF region `{rf_region}`",
+	)
+	errPassiveRoamingUplinkToken = errors.DefineInvalidArgument(
+		"passive_roaming_uplink_token", "invalid passive roaming uplink token",
+	)
 	errRawPayloadTooShort = errors.Define(
 		"raw_payload_too_short", "length of RawPayload must not be less than 4",
 	)
@@ -79,6 +114,9 @@ var (
 	errUnknownMACVersion = errors.DefineFailedPrecondition(
 		"unknown_mac_version", "MAC version is unknown",
 	)
+	errUnknownADRAlgorithm = errors.DefineInvalidArgument(
+		"unknown_adr_algorithm", "unknown ADR algorithm `{name}`",
+	)
 	errUnknownNwkSEncKey     = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
 	errUnknownSession        = errors.DefineNotFound("unknown_session", "unknown session")
 	errUnknownSNwkSIntKey    = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
@@ -1136,6 +1136,7 @@ var (
 
 	adrSettingsFields = []string{
 		"mac_settings.adr.mode.disabled",
+		"mac_settings.adr.mode.dynamic.algorithm",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.lora_narrow",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode",
@@ -1208,6 +1209,7 @@ var (
 	}
 
 	dynamicADRSettingsFields = []string{
+		"mac_settings.adr.mode.dynamic.algorithm",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.lora_narrow",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode",
@@ -2614,6 +2616,17 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	); err != nil {
 		return nil, err
 	}
+	// Ensure the ADR algorithm is registered.
+	if err := st.ValidateSetField(func() bool {
+		algorithm := st.Device.GetMacSettings().GetAdr().GetDynamic().GetAlgorithm()
+		if algorithm == "" {
+			return true
+		}
+		_, ok := mac.GetADRAlgorithm(algorithm)
+		return ok
+	}, "mac_settings.adr.mode.dynamic.algorithm"); err != nil {
+		return nil, err
+	}
 
 	var getTransforms []func(*ttnpb.EndDevice)
 	if st.Device.Session != nil {
@@ -836,6 +836,7 @@ func appendRecentUplink(
 
 var handleDataUplinkGetPaths = [...]string{
 	"frequency_plan_id",
+	"handover_roaming",
 	"last_dev_status_received_at",
 	"lorawan_phy_version",
 	"lorawan_version",
@@ -1030,7 +1031,10 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		matched.QueuedEventBuilders = append(matched.QueuedEventBuilders, evs...)
 	}
 
-	var queuedApplicationUplinks []*ttnpb.ApplicationUp
+	var (
+		queuedApplicationUplinks []*ttnpb.ApplicationUp
+		handoverRoaming          *ttnpb.EndDeviceHandoverRoaming
+	)
 	defer func() { ns.submitApplicationUplinks(ctx, queuedApplicationUplinks...) }()
 
 	stored, _, err := ns.devices.SetByID(ctx, matched.Device.Ids.ApplicationIds, matched.Device.Ids.DeviceId, handleDataUplinkGetPaths[:],
@@ -1058,6 +1062,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			queuedApplicationUplinks = append(queuedApplicationUplinks, matched.QueuedApplicationUplinks...)
 			queuedEvents = append(queuedEvents, matched.QueuedEventBuilders.New(ctx, events.WithIdentifiers(matched.Device.Ids))...)
 
+			handoverRoaming = stored.HandoverRoaming
 			stored = matched.Device
 			paths := ttnpb.AddFields(matched.SetPaths,
 				"mac_state.recent_uplinks",
@@ -1136,25 +1141,37 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		default:
 			frmPayload = pld.FrmPayload
 		}
-		queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
-			EndDeviceIds:   stored.Ids,
-			CorrelationIds: up.CorrelationIds,
-			Up: &ttnpb.ApplicationUp_UplinkMessage{
-				UplinkMessage: &ttnpb.ApplicationUplink{
-					Confirmed:       up.Payload.MHdr.MType == ttnpb.MType_CONFIRMED_UP,
-					FCnt:            pld.FullFCnt,
-					FPort:           pld.FPort,
-					FrmPayload:      frmPayload,
-					RxMetadata:      up.RxMetadata,
-					SessionKeyId:    stored.Session.Keys.SessionKeyId,
-					Settings:        up.Settings,
-					ReceivedAt:      up.ReceivedAt,
-					ConsumedAirtime: up.ConsumedAirtime,
-					PacketErrorRate: mac.LossRate(stored.MacState, matched.phy),
-					NetworkIds:      ns.networkIdentifiers(ctx),
+		if handoverRoaming.GetServing() {
+			// The application payload is forwarded to the Application Server by the home Network Server.
+			err := ns.forwardHandoverRoamingUplink(ctx, stored, handoverRoaming, up, frmPayload, matched.phy)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to forward data uplink to home Network Server")
+			} else {
+				queuedEvents = append(queuedEvents,
+					evtForwardHandoverRoamingUplink.NewWithIdentifiersAndData(ctx, stored.Ids, nil),
+				)
+			}
+		} else {
+			queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
+				EndDeviceIds:   stored.Ids,
+				CorrelationIds: up.CorrelationIds,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						Confirmed:       up.Payload.MHdr.MType == ttnpb.MType_CONFIRMED_UP,
+						FCnt:            pld.FullFCnt,
+						FPort:           pld.FPort,
+						FrmPayload:      frmPayload,
+						RxMetadata:      up.RxMetadata,
+						SessionKeyId:    stored.Session.Keys.SessionKeyId,
+						Settings:        up.Settings,
+						ReceivedAt:      up.ReceivedAt,
+						ConsumedAirtime: up.ConsumedAirtime,
+						PacketErrorRate: mac.LossRate(stored.MacState, matched.phy),
+						NetworkIds:      ns.networkIdentifiers(ctx),
+					},
 				},
-			},
-		})
+			})
+		}
 	}
 	queuedEvents = append(queuedEvents, evtProcessDataUplink.NewWithIdentifiersAndData(ctx, matched.Device.Ids, up))
 	registerProcessUplink(ctx, up)
@@ -1242,6 +1259,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	matched, matchedCtx, err := ns.devices.GetByEUI(ctx, joinEUI, devEUI,
 		[]string{
 			"frequency_plan_id",
+			"handover_roaming",
 			"lorawan_phy_version",
 			"lorawan_version",
 			"mac_settings",
@@ -1251,7 +1269,25 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 			"supports_join",
 		},
 	)
-	if err != nil {
+	var (
+		roaming  *ttnpb.EndDeviceHandoverRoaming
+		accepted bool
+	)
+	switch {
+	case err == nil:
+		roaming, err = ns.servingHandoverRoaming(matchedCtx, matched)
+		if err != nil {
+			return err
+		}
+	case errors.IsNotFound(err) && ns.handoverRoaming.Accept:
+		matched, err = ns.newHandoverRoamingDevice(ctx, joinEUI, devEUI)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Debug("Failed to accept device of roaming partner")
+			return errDeviceNotFound.WithCause(err)
+		}
+		roaming, accepted = matched.HandoverRoaming, true
+		matchedCtx = ctx
+	default:
 		logRegistryRPCError(ctx, err, "Failed to load device from registry by EUIs")
 		return errDeviceNotFound.WithCause(err)
 	}
@@ -1343,7 +1379,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		OptNeg:      macspec.UseRekeyInd(matched.LorawanVersion),
 	}
 
-	resp, joinEvents, err := ns.sendJoinRequest(ctx, matched.Ids, &ttnpb.JoinRequest{
+	joinReq := &ttnpb.JoinRequest{
 		Payload:            up.Payload,
 		CfList:             cfList,
 		CorrelationIds:     events.CorrelationIDsFromContext(ctx),
@@ -1354,7 +1390,16 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		SelectedMacVersion: matched.LorawanVersion, // Assume NS version is always higher than the version of the device
 		ConsumedAirtime:    up.ConsumedAirtime,
 		DownlinkSettings:   dlSettings,
-	})
+	}
+	var (
+		resp       *ttnpb.JoinResponse
+		joinEvents []events.Event
+	)
+	if roaming != nil {
+		resp, joinEvents, err = ns.sendHandoverRoamingJoinRequest(ctx, matched.Ids, roaming, up, phy, joinReq)
+	} else {
+		resp, joinEvents, err = ns.sendJoinRequest(ctx, matched.Ids, joinReq)
+	}
 
 	queuedEvents = append(queuedEvents, joinEvents...)
 	if err != nil {
@@ -1423,8 +1468,17 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		},
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if stored == nil {
-				logger.Warn("Device deleted during join-request handling, drop")
-				return nil, nil, errOutdatedData.New()
+				if !accepted {
+					logger.Warn("Device deleted during join-request handling, drop")
+					return nil, nil, errOutdatedData.New()
+				}
+				// The device of the roaming partner is created when the home Network Server accepts the join-request.
+				stored = matched
+				stored.HandoverRoaming.StartedAt = timestamppb.New(time.Now())
+				stored.PendingMacState = macState
+				return stored, append([]string{
+					"pending_mac_state",
+				}, handoverRoamingDevicePaths...), nil
 			}
 			stored.PendingMacState = macState
 			return stored, []string{
@@ -1463,11 +1517,20 @@ func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.Upli
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
-func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (_ *emptypb.Empty, err error) {
+func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*emptypb.Empty, error) {
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	if err := ns.handleUplink(ctx, up, ns.routeUplink); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
 
+// handleUplink decodes the uplink message and passes it to handle.
+func (ns *NetworkServer) handleUplink(
+	ctx context.Context, up *ttnpb.UplinkMessage, handle func(context.Context, *ttnpb.UplinkMessage) error,
+) (err error) {
 	ctx = events.ContextWithCorrelationID(ctx, up.CorrelationIds...)
 	ctx = appendUplinkCorrelationID(ctx)
 	up.CorrelationIds = events.CorrelationIDsFromContext(ctx)
@@ -1477,10 +1540,10 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	if err := up.Payload.ValidateFields(); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	registerReceiveUplink(ctx, up)
 	defer func() {
@@ -1517,7 +1580,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"ocw", dr.Lrfhss.GetOperatingChannelWidth(),
 		))
 	default:
-		return nil, errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
+		return errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
 	}
 	ctx = log.NewContext(ctx, logger)
 
@@ -1526,16 +1589,25 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	} else {
 		up.ConsumedAirtime = durationpb.New(t)
 	}
+	return handle(ctx, up)
+}
+
+// routeUplink passes the uplink message to the handler of its message type.
+// Data uplink messages of roaming partners are forwarded to the serving Network Server.
+func (ns *NetworkServer) routeUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
 	switch up.Payload.MHdr.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		if netID, ok := ns.passiveRoamingNetID(ctx, up); ok {
+			return ns.forwardDataUplink(ctx, up, netID)
+		}
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
-	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	log.FromContext(ctx).Debug("Unmatched MType")
+	return nil
 }
 
 var errTransmission = errors.Define("transmission", "downlink transmission failed with result `{result}`")
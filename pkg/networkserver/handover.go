@@ -0,0 +1,718 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// HandoverRoamingClient is a client, which Network Server can use for handover roaming.
+type HandoverRoamingClient interface {
+	HasNetworkServer(netID types.NetID) bool
+	HomeNSRequest(
+		ctx context.Context, netID types.NetID, nsID *types.EUI64, joinEUI, devEUI types.EUI64,
+	) (*interop.TTIHomeNSAns, error)
+	ProfileRequest(ctx context.Context, req *interop.TTIProfileReq) (*interop.ProfileAns, error)
+	HRStartRequest(ctx context.Context, req *interop.HRStartReq) (*interop.HRStartAns, error)
+	HRStopRequest(ctx context.Context, req *interop.TTIHRStopReq) (*interop.HRStopAns, error)
+	XmitDataRequest(ctx context.Context, req *interop.TTIXmitDataReq) (*interop.XmitDataAns, error)
+}
+
+// deviceProfilePaths are the end device field paths needed to construct the device profile.
+var deviceProfilePaths = []string{
+	"frequency_plan_id",
+	"ids",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+	"updated_at",
+}
+
+// handoverRoamingDevicePaths are the end device field paths set when a device of a roaming partner is accepted.
+var handoverRoamingDevicePaths = []string{
+	"frequency_plan_id",
+	"ids.application_ids",
+	"ids.dev_eui",
+	"ids.device_id",
+	"handover_roaming",
+	"ids.join_eui",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// handedOverDevicePaths are the end device field paths set when a device is handed over to a roaming partner.
+var handedOverDevicePaths = []string{
+	"handover_roaming",
+	"mac_state",
+	"pending_mac_state",
+	"pending_session",
+	"session",
+}
+
+// nsIDBytes returns the bytes of the Network Server ID of a roaming partner, or nil if it is not set.
+func nsIDBytes(nsID *interop.EUI64) []byte {
+	if nsID == nil {
+		return nil
+	}
+	return types.EUI64(*nsID).Bytes()
+}
+
+// deviceProfile returns the Backend Interfaces device profile of dev.
+func (ns *NetworkServer) deviceProfile(ctx context.Context, dev *ttnpb.EndDevice) (*interop.DeviceProfile, error) {
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, phy, err := DeviceFrequencyPlanAndBand(dev, fps)
+	if err != nil {
+		return nil, err
+	}
+	rfRegion, ok := interop.RFRegionFromBandID(phy.ID)
+	if !ok {
+		return nil, errHandoverRoamingBand.WithAttributes("band_id", phy.ID)
+	}
+	macState, err := mac.NewState(dev, fps, ns.defaultMACSettings)
+	if err != nil {
+		return nil, err
+	}
+	params := macState.CurrentParameters
+	profile := &interop.DeviceProfile{
+		SupportsClassB:    dev.SupportsClassB,
+		SupportsClassC:    dev.SupportsClassC,
+		MACVersion:        interop.MACVersion(dev.LorawanVersion),
+		RegParamsRevision: interop.RegParamsRevision(dev.LorawanPhyVersion),
+		SupportsJoin:      dev.SupportsJoin,
+		RXDelay1:          uint8(params.Rx1Delay),
+		RXDROffset1:       uint8(params.Rx1DataRateOffset),
+		RXDataRate2:       uint8(params.Rx2DataRateIndex),
+		RXFreq2:           mhz(params.Rx2Frequency),
+		RFRegion:          rfRegion,
+		Supports32bitFCnt: mac.DeviceSupports32BitFCnt(dev, ns.defaultMACSettings),
+	}
+	if dev.SupportsClassB {
+		profile.ClassBTimeout = uint32(mac.DeviceClassBTimeout(dev, ns.defaultMACSettings) / time.Second)
+		if v := mac.DeviceDefaultPingSlotPeriodicity(dev, ns.defaultMACSettings); v != nil {
+			profile.PingSlotPeriod = 1 << uint32(v.Value)
+		}
+		if v := params.PingSlotDataRateIndexValue; v != nil {
+			dr := uint8(v.Value)
+			profile.PingSlotDR = &dr
+		}
+		if params.PingSlotFrequency > 0 {
+			freq := mhz(params.PingSlotFrequency)
+			profile.PingSlotFreq = &freq
+		}
+	}
+	if dev.SupportsClassC {
+		profile.ClassCTimeout = uint32(mac.DeviceClassCTimeout(dev, ns.defaultMACSettings) / time.Second)
+	}
+	for _, freq := range dev.GetMacSettings().GetFactoryPresetFrequencies() {
+		profile.FactoryPresetFreqs = append(profile.FactoryPresetFreqs, mhz(freq))
+	}
+	return profile, nil
+}
+
+// endDeviceFromProfile returns the end device of a roaming partner with the given device profile.
+func endDeviceFromProfile(
+	ids *ttnpb.EndDeviceIdentifiers, frequencyPlanID string, profile *interop.DeviceProfile,
+) *ttnpb.EndDevice {
+	macSettings := &ttnpb.MACSettings{
+		Rx1Delay:           &ttnpb.RxDelayValue{Value: ttnpb.RxDelay(profile.RXDelay1)},
+		Rx1DataRateOffset:  &ttnpb.DataRateOffsetValue{Value: ttnpb.DataRateOffset(profile.RXDROffset1)},
+		Rx2DataRateIndex:   &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(profile.RXDataRate2)},
+		Supports_32BitFCnt: &ttnpb.BoolValue{Value: profile.Supports32bitFCnt},
+	}
+	if profile.RXFreq2 > 0 {
+		macSettings.Rx2Frequency = &ttnpb.FrequencyValue{Value: hz(profile.RXFreq2)}
+	}
+	for _, freq := range profile.FactoryPresetFreqs {
+		macSettings.FactoryPresetFrequencies = append(macSettings.FactoryPresetFrequencies, hz(freq))
+	}
+	if profile.SupportsClassB {
+		if profile.ClassBTimeout > 0 {
+			macSettings.ClassBTimeout = durationpb.New(time.Duration(profile.ClassBTimeout) * time.Second)
+		}
+		for period := ttnpb.PingSlotPeriod_PING_EVERY_1S; period <= ttnpb.PingSlotPeriod_PING_EVERY_128S; period++ {
+			if profile.PingSlotPeriod == 1<<uint32(period) {
+				macSettings.PingSlotPeriodicity = &ttnpb.PingSlotPeriodValue{Value: period}
+			}
+		}
+		if profile.PingSlotDR != nil {
+			macSettings.PingSlotDataRateIndex = &ttnpb.DataRateIndexValue{
+				Value: ttnpb.DataRateIndex(*profile.PingSlotDR),
+			}
+		}
+		if profile.PingSlotFreq != nil {
+			macSettings.PingSlotFrequency = &ttnpb.ZeroableFrequencyValue{Value: hz(*profile.PingSlotFreq)}
+		}
+	}
+	if profile.SupportsClassC && profile.ClassCTimeout > 0 {
+		macSettings.ClassCTimeout = durationpb.New(time.Duration(profile.ClassCTimeout) * time.Second)
+	}
+	return &ttnpb.EndDevice{
+		Ids:               ids,
+		FrequencyPlanId:   frequencyPlanID,
+		LorawanVersion:    ttnpb.MACVersion(profile.MACVersion),
+		LorawanPhyVersion: ttnpb.PHYVersion(profile.RegParamsRevision),
+		SupportsJoin:      profile.SupportsJoin,
+		SupportsClassB:    profile.SupportsClassB,
+		SupportsClassC:    profile.SupportsClassC,
+		MacSettings:       macSettings,
+	}
+}
+
+// wrapHandoverRoamingKey wraps the session key for the roaming partner identified by netID.
+// The handover is refused if there is no KEK configured for the roaming partner, as session keys are never sent in
+// plaintext.
+func (ns *NetworkServer) wrapHandoverRoamingKey(
+	ctx context.Context, env *ttnpb.KeyEnvelope, netID types.NetID,
+) (*interop.KeyEnvelope, error) {
+	if env == nil {
+		return nil, nil
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, env, ns.KeyService())
+	if err != nil {
+		return nil, err
+	}
+	kekLabel := ns.ComponentKEKLabeler().NsKEKLabel(ctx, &netID, "")
+	if kekLabel == "" {
+		return nil, errHandoverRoamingKEK.WithAttributes("net_id", netID)
+	}
+	wrapped, err := cryptoutil.WrapAES128Key(ctx, key, kekLabel, ns.KeyService())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errHandoverRoamingKEK.WithAttributes("net_id", netID).WithCause(err)
+		}
+		return nil, err
+	}
+	if wrapped.KekLabel == "" {
+		return nil, errHandoverRoamingKEK.WithAttributes("net_id", netID)
+	}
+	return (*interop.KeyEnvelope)(wrapped), nil
+}
+
+// handOverDevice hands over the device that sent the join-request in req to the serving Network Server of a roaming
+// partner. The join-request is handled by the Join Server and the network session keys are returned to the serving
+// Network Server. The session and MAC state of the device are cleared, as the device is served by the roaming partner,
+// and the roaming partner and the session are stored in the handover roaming state of the device. The AppSKey is sent
+// to the Application Server in the join-accept, as the application payload is forwarded by this Network Server.
+func (ns *NetworkServer) handOverDevice(ctx context.Context, req *interop.HRStartReq) (*interop.HRStartAns, error) {
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(req.PHYPayload, msg); err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	pld := msg.GetJoinRequestPayload()
+	if pld == nil {
+		return nil, interop.ErrRoamingActivation.New()
+	}
+	dlSettings := &ttnpb.DLSettings{}
+	if err := lorawan.UnmarshalDLSettings(req.DLSettings, dlSettings); err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	var cfList *ttnpb.CFList
+	if len(req.CFList) > 0 {
+		cfList = &ttnpb.CFList{}
+		if err := lorawan.UnmarshalCFList(req.CFList, cfList); err != nil {
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		}
+	}
+
+	joinEUI, devEUI := types.MustEUI64(pld.JoinEui).OrZero(), types.MustEUI64(pld.DevEui).OrZero()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", devEUI,
+		"join_eui", joinEUI,
+	))
+	dev, ctx, err := ns.devices.GetByEUI(ctx, joinEUI, devEUI, deviceProfilePaths)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return nil, err
+	}
+	if !dev.SupportsJoin {
+		return nil, interop.ErrRoamingActivation.New()
+	}
+	profile, err := ns.deviceProfile(ctx, dev)
+	if err != nil {
+		return nil, err
+	}
+
+	partnerNetID := types.NetID(req.SenderID)
+	resp, joinEvents, err := ns.sendJoinRequest(ctx, dev.Ids, &ttnpb.JoinRequest{
+		RawPayload:         req.PHYPayload,
+		Payload:            msg,
+		DevAddr:            types.DevAddr(req.DevAddr).Bytes(),
+		SelectedMacVersion: dev.LorawanVersion,
+		NetId:              partnerNetID.Bytes(),
+		DownlinkSettings:   dlSettings,
+		RxDelay:            req.RxDelay,
+		CfList:             cfList,
+		CorrelationIds:     events.CorrelationIDsFromContext(ctx),
+	})
+	publishEvents(ctx, joinEvents...)
+	if err != nil {
+		return nil, interop.ErrJoinReq.WithCause(err)
+	}
+
+	keys := resp.SessionKeys
+	ans := &interop.HRStartAns{
+		PHYPayload:             interop.Buffer(resp.RawPayload),
+		SessionKeyID:           interop.Buffer(keys.SessionKeyId),
+		DeviceProfile:          profile,
+		DeviceProfileTimestamp: ttnpb.StdTime(dev.UpdatedAt),
+	}
+	if resp.Lifetime != nil {
+		lifetime := uint32(resp.Lifetime.AsDuration() / time.Second)
+		ans.Lifetime = &lifetime
+	}
+	if macspec.UseNwkKey(dev.LorawanVersion) {
+		for _, key := range []struct {
+			env *ttnpb.KeyEnvelope
+			dst **interop.KeyEnvelope
+		}{
+			{keys.FNwkSIntKey, &ans.FNwkSIntKey},
+			{keys.SNwkSIntKey, &ans.SNwkSIntKey},
+			{keys.NwkSEncKey, &ans.NwkSEncKey},
+		} {
+			if *key.dst, err = ns.wrapHandoverRoamingKey(ctx, key.env, partnerNetID); err != nil {
+				return nil, err
+			}
+		}
+	} else if ans.NwkSKey, err = ns.wrapHandoverRoamingKey(ctx, keys.FNwkSIntKey, partnerNetID); err != nil {
+		return nil, err
+	}
+
+	devAddr := types.DevAddr(req.DevAddr)
+	receivedAt := req.ULMetaData.RecvTime
+	if receivedAt.IsZero() {
+		receivedAt = time.Now()
+	}
+	var invalidatedQueue []*ttnpb.ApplicationDownlink
+	if _, _, err := ns.devices.SetByID(ctx, dev.Ids.ApplicationIds, dev.Ids.DeviceId,
+		[]string{
+			"pending_session.queued_application_downlinks",
+			"session.queued_application_downlinks",
+		},
+		func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				return nil, nil, errOutdatedData.New()
+			}
+			if stored.Session != nil {
+				invalidatedQueue = stored.Session.QueuedApplicationDownlinks
+			} else {
+				invalidatedQueue = stored.GetPendingSession().GetQueuedApplicationDownlinks()
+			}
+			stored.Session, stored.MacState = nil, nil
+			stored.PendingSession, stored.PendingMacState = nil, nil
+			stored.HandoverRoaming = &ttnpb.EndDeviceHandoverRoaming{
+				PartnerNetId: partnerNetID.Bytes(),
+				PartnerNsId:  nsIDBytes(req.SenderNSID),
+				StartedAt:    timestamppb.New(time.Now()),
+				DevAddr:      devAddr.Bytes(),
+				SessionKeyId: keys.SessionKeyId,
+			}
+			return stored, handedOverDevicePaths, nil
+		},
+	); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to hand over device")
+		return nil, err
+	}
+	ns.submitApplicationUplinks(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: dev.Ids.ApplicationIds,
+			DeviceId:       dev.Ids.DeviceId,
+			DevEui:         dev.Ids.DevEui,
+			JoinEui:        dev.Ids.JoinEui,
+			DevAddr:        devAddr.Bytes(),
+		},
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{
+				AppSKey:              keys.AppSKey,
+				InvalidatedDownlinks: invalidatedQueue,
+				SessionKeyId:         keys.SessionKeyId,
+				ReceivedAt:           timestamppb.New(receivedAt),
+			},
+		},
+	})
+	publishEvents(ctx, evtHandOverDevice.NewWithIdentifiersAndData(ctx, dev.Ids, nil))
+	log.FromContext(ctx).WithField("serving_net_id", partnerNetID).Info("Handed over device to roaming partner")
+	return ans, nil
+}
+
+// stopHandoverRoaming stops the handover roaming of the device identified by ids, which has been handed over to a
+// roaming partner. The roaming partner is notified with a HRStopReq; failure to notify the roaming partner is logged
+// but not returned.
+func (ns *NetworkServer) stopHandoverRoaming(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, roaming *ttnpb.EndDeviceHandoverRoaming,
+) error {
+	if _, err := ns.handoverRoamingClient.HRStopRequest(ctx, &interop.TTIHRStopReq{
+		HRStopReq: interop.HRStopReq{
+			NsNsMessageHeader: interop.NsNsMessageHeader{
+				SenderID:     interop.NetID(ns.netID(ctx)),
+				SenderNSID:   (*interop.EUI64)(ns.nsID(ctx)),
+				ReceiverID:   interop.NetID(types.MustNetID(roaming.PartnerNetId).OrZero()),
+				ReceiverNSID: (*interop.EUI64)(types.MustEUI64(roaming.PartnerNsId)),
+			},
+			DevEUI: interop.EUI64(types.MustEUI64(ids.DevEui).OrZero()),
+		},
+		JoinEUI: (*interop.EUI64)(types.MustEUI64(ids.JoinEui)),
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to stop handover roaming with roaming partner")
+	}
+	if err := ns.clearHandoverRoaming(ctx, ids, roaming.PartnerNetId); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to clear handover roaming state of device")
+		return err
+	}
+	publishEvents(ctx, evtStopHandoverRoaming.NewWithIdentifiersAndData(ctx, ids, nil))
+	return nil
+}
+
+// clearHandoverRoaming clears the handover roaming state of the device identified by ids if the device still roams
+// with the roaming partner identified by partnerNetID.
+func (ns *NetworkServer) clearHandoverRoaming(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, partnerNetID []byte,
+) error {
+	_, _, err := ns.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{"handover_roaming"},
+		func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil || !bytes.Equal(stored.HandoverRoaming.GetPartnerNetId(), partnerNetID) {
+				return stored, nil, nil
+			}
+			stored.HandoverRoaming = nil
+			return stored, []string{"handover_roaming"}, nil
+		},
+	)
+	return err
+}
+
+// handleStopHandoverRoaming stops the handover roaming of dev on request of the roaming partner.
+// If this Network Server serves the device, the device is deleted.
+func (ns *NetworkServer) handleStopHandoverRoaming(ctx context.Context, dev *ttnpb.EndDevice) error {
+	if dev.HandoverRoaming.GetServing() {
+		if err := DeleteDevice(ctx, ns.devices, dev.Ids.ApplicationIds, dev.Ids.DeviceId); err != nil {
+			logRegistryRPCError(ctx, err, "Failed to delete device of roaming partner")
+			return err
+		}
+	} else if err := ns.clearHandoverRoaming(ctx, dev.Ids, dev.HandoverRoaming.GetPartnerNetId()); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to clear handover roaming state of device")
+		return err
+	}
+	publishEvents(ctx, evtStopHandoverRoaming.NewWithIdentifiersAndData(ctx, dev.Ids, nil))
+	log.FromContext(ctx).WithField(
+		"partner_net_id", types.MustNetID(dev.HandoverRoaming.GetPartnerNetId()).OrZero(),
+	).Info("Stopped handover roaming")
+	return nil
+}
+
+// servingHandoverRoaming returns the handover roaming state of dev if this Network Server serves the device on behalf
+// of a roaming partner. If the device of this home Network Server has been handed over to a roaming partner and it
+// joins this Network Server again, the handover roaming is stopped.
+func (ns *NetworkServer) servingHandoverRoaming(
+	ctx context.Context, dev *ttnpb.EndDevice,
+) (*ttnpb.EndDeviceHandoverRoaming, error) {
+	roaming := dev.HandoverRoaming
+	if roaming == nil || roaming.Serving {
+		return roaming, nil
+	}
+	if ns.handoverRoamingClient == nil {
+		return nil, nil
+	}
+	if err := ns.stopHandoverRoaming(ctx, dev.Ids, roaming); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// newHandoverRoamingDevice returns the device of the roaming partner that is the home Network Server of the device
+// identified by joinEUI and devEUI. The home Network Server is looked up via the Join Server of the device.
+func (ns *NetworkServer) newHandoverRoamingDevice(
+	ctx context.Context, joinEUI, devEUI types.EUI64,
+) (*ttnpb.EndDevice, error) {
+	homeNS, err := ns.handoverRoamingClient.HomeNSRequest(ctx, ns.netID(ctx), ns.nsID(ctx), joinEUI, devEUI)
+	if err != nil {
+		return nil, err
+	}
+	homeNetID := types.NetID(homeNS.HNetID)
+	if homeNetID.Equal(ns.netID(ctx)) || !ns.handoverRoamingClient.HasNetworkServer(homeNetID) {
+		return nil, errNoHandoverRoamingPartner.WithAttributes("net_id", homeNetID)
+	}
+	ans, err := ns.handoverRoamingClient.ProfileRequest(ctx, &interop.TTIProfileReq{
+		ProfileReq: interop.ProfileReq{
+			NsNsMessageHeader: interop.NsNsMessageHeader{
+				SenderID:     interop.NetID(ns.netID(ctx)),
+				SenderNSID:   (*interop.EUI64)(ns.nsID(ctx)),
+				ReceiverID:   homeNS.HNetID,
+				ReceiverNSID: homeNS.HNSID,
+			},
+			DevEUI: interop.EUI64(devEUI),
+		},
+		JoinEUI: (*interop.EUI64)(&joinEUI),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ans.RoamingActivationType != interop.RoamingActivationHandover || ans.DeviceProfile == nil {
+		return nil, errHandoverRoamingActivation.WithAttributes("type", ans.RoamingActivationType)
+	}
+
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fp, err := fps.GetByID(ns.handoverRoaming.FrequencyPlanID)
+	if err != nil {
+		return nil, err
+	}
+	if bandID, ok := ans.DeviceProfile.RFRegion.BandID(); !ok || bandID != fp.BandID {
+		return nil, errHandoverRoamingRFRegion.WithAttributes("rf_region", ans.DeviceProfile.RFRegion)
+	}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: ns.handoverRoaming.ApplicationID},
+		DeviceId:       "eui-" + strings.ToLower(devEUI.String()),
+		JoinEui:        joinEUI.Bytes(),
+		DevEui:         devEUI.Bytes(),
+	}
+	dev := endDeviceFromProfile(ids, ns.handoverRoaming.FrequencyPlanID, ans.DeviceProfile)
+	dev.HandoverRoaming = &ttnpb.EndDeviceHandoverRoaming{
+		Serving:      true,
+		PartnerNetId: homeNetID.Bytes(),
+		PartnerNsId:  nsIDBytes(homeNS.HNSID),
+	}
+	return dev, nil
+}
+
+// sendHandoverRoamingJoinRequest sends the join-request to the home Network Server of the roaming partner, which
+// handles the join-request with the Join Server of the device.
+func (ns *NetworkServer) sendHandoverRoamingJoinRequest(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	roaming *ttnpb.EndDeviceHandoverRoaming,
+	up *ttnpb.UplinkMessage,
+	phy *band.Band,
+	req *ttnpb.JoinRequest,
+) (*ttnpb.JoinResponse, []events.Event, error) {
+	queuedEvents := []events.Event{
+		evtHandoverJoinAttempt.NewWithIdentifiersAndData(ctx, ids, req),
+	}
+	resp, err := ns.handoverRoamingJoinRequest(ctx, roaming, up, phy, req)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Home Network Server did not accept join-request")
+		return nil, append(queuedEvents, evtHandoverJoinFail.NewWithIdentifiersAndData(ctx, ids, err)), err
+	}
+	queuedEvents = append(queuedEvents,
+		evtHandoverJoinSuccess.NewWithIdentifiersAndData(ctx, ids, joinResponseWithoutKeys(resp)),
+	)
+	return resp, queuedEvents, nil
+}
+
+func (ns *NetworkServer) handoverRoamingJoinRequest(
+	ctx context.Context,
+	roaming *ttnpb.EndDeviceHandoverRoaming,
+	up *ttnpb.UplinkMessage,
+	phy *band.Band,
+	req *ttnpb.JoinRequest,
+) (*ttnpb.JoinResponse, error) {
+	rfRegion, ok := interop.RFRegionFromBandID(phy.ID)
+	if !ok {
+		return nil, errHandoverRoamingBand.WithAttributes("band_id", phy.ID)
+	}
+	ulMetaData, err := newULMetaData(up, phy, rfRegion)
+	if err != nil {
+		return nil, err
+	}
+	devEUI := interop.EUI64(types.MustEUI64(req.Payload.GetJoinRequestPayload().GetDevEui()).OrZero())
+	ulMetaData.DevEUI = &devEUI
+	dlSettings, err := lorawan.MarshalDLSettings(req.DownlinkSettings)
+	if err != nil {
+		return nil, err
+	}
+	var cfList []byte
+	if req.CfList != nil {
+		if cfList, err = lorawan.MarshalCFList(req.CfList); err != nil {
+			return nil, err
+		}
+	}
+	ans, err := ns.handoverRoamingClient.HRStartRequest(ctx, &interop.HRStartReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:     interop.NetID(ns.netID(ctx)),
+			SenderNSID:   (*interop.EUI64)(ns.nsID(ctx)),
+			ReceiverID:   interop.NetID(types.MustNetID(roaming.PartnerNetId).OrZero()),
+			ReceiverNSID: (*interop.EUI64)(types.MustEUI64(roaming.PartnerNsId)),
+		},
+		PHYPayload: interop.Buffer(req.RawPayload),
+		ULMetaData: *ulMetaData,
+		DevAddr:    interop.DevAddr(types.MustDevAddr(req.DevAddr).OrZero()),
+		DLSettings: interop.Buffer(dlSettings),
+		RxDelay:    req.RxDelay,
+		CFList:     interop.Buffer(cfList),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &ttnpb.JoinResponse{
+		RawPayload: ans.PHYPayload,
+		SessionKeys: &ttnpb.SessionKeys{
+			SessionKeyId: ans.SessionKeyID,
+		},
+		CorrelationIds: req.CorrelationIds,
+	}
+	if ans.Lifetime != nil && *ans.Lifetime > 0 {
+		resp.Lifetime = durationpb.New(time.Duration(*ans.Lifetime) * time.Second)
+	}
+	if macspec.UseNwkKey(req.SelectedMacVersion) {
+		if ans.FNwkSIntKey == nil || ans.SNwkSIntKey == nil || ans.NwkSEncKey == nil {
+			return nil, errHandoverRoamingSessionKeys.New()
+		}
+		resp.SessionKeys.FNwkSIntKey = (*ttnpb.KeyEnvelope)(ans.FNwkSIntKey)
+		resp.SessionKeys.SNwkSIntKey = (*ttnpb.KeyEnvelope)(ans.SNwkSIntKey)
+		resp.SessionKeys.NwkSEncKey = (*ttnpb.KeyEnvelope)(ans.NwkSEncKey)
+	} else {
+		if ans.NwkSKey == nil {
+			return nil, errHandoverRoamingSessionKeys.New()
+		}
+		resp.SessionKeys.FNwkSIntKey = (*ttnpb.KeyEnvelope)(ans.NwkSKey)
+	}
+	return resp, nil
+}
+
+// forwardHandoverRoamingUplink forwards the application payload frmPayload of the data uplink message up of the device
+// dev, which this Network Server serves on behalf of a roaming partner, to the home Network Server of the device.
+// The home Network Server forwards the application payload to the Application Server of the device.
+func (ns *NetworkServer) forwardHandoverRoamingUplink(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	roaming *ttnpb.EndDeviceHandoverRoaming,
+	up *ttnpb.UplinkMessage,
+	frmPayload []byte,
+	phy *band.Band,
+) error {
+	rfRegion, ok := interop.RFRegionFromBandID(phy.ID)
+	if !ok {
+		return errHandoverRoamingBand.WithAttributes("band_id", phy.ID)
+	}
+	ulMetaData, err := newULMetaData(up, phy, rfRegion)
+	if err != nil {
+		return err
+	}
+	pld := up.Payload.GetMacPayload()
+	devEUI := interop.EUI64(types.MustEUI64(dev.Ids.DevEui).OrZero())
+	devAddr := interop.DevAddr(types.MustDevAddr(pld.FHdr.DevAddr).OrZero())
+	fPort, fCnt := uint8(pld.FPort), pld.FullFCnt
+	ulMetaData.DevEUI = &devEUI
+	ulMetaData.DevAddr = &devAddr
+	ulMetaData.FPort = &fPort
+	ulMetaData.FCntUp = &fCnt
+	ulMetaData.Confirmed = up.Payload.MHdr.MType == ttnpb.MType_CONFIRMED_UP
+	_, err = ns.handoverRoamingClient.XmitDataRequest(ctx, &interop.TTIXmitDataReq{
+		XmitDataReq: interop.XmitDataReq{
+			NsNsMessageHeader: interop.NsNsMessageHeader{
+				SenderID:     interop.NetID(ns.netID(ctx)),
+				SenderNSID:   (*interop.EUI64)(ns.nsID(ctx)),
+				ReceiverID:   interop.NetID(types.MustNetID(roaming.PartnerNetId).OrZero()),
+				ReceiverNSID: (*interop.EUI64)(types.MustEUI64(roaming.PartnerNsId)),
+			},
+			FRMPayload: interop.Buffer(frmPayload),
+			ULMetaData: ulMetaData,
+		},
+		JoinEUI: (*interop.EUI64)(types.MustEUI64(dev.Ids.JoinEui)),
+	})
+	return err
+}
+
+// handleHandoverRoamingUplink handles the application payload of a data uplink message that the serving Network Server
+// of a roaming partner received from a device of this home Network Server. The application payload is forwarded to the
+// Application Server of the device with the session that was handed over to the roaming partner.
+func (ns *NetworkServer) handleHandoverRoamingUplink(ctx context.Context, req *interop.TTIXmitDataReq) error {
+	ulMetaData := req.ULMetaData
+	if ulMetaData == nil || ulMetaData.DevEUI == nil || ulMetaData.FPort == nil || ulMetaData.FCntUp == nil {
+		return interop.ErrMalformedMessage.New()
+	}
+	if req.JoinEUI == nil {
+		// The device registry is indexed by JoinEUI and DevEUI, so the device cannot be found without JoinEUI.
+		return interop.ErrUnknownDevEUI.New()
+	}
+	dev, ctx, err := ns.devices.GetByEUI(ctx, types.EUI64(*req.JoinEUI), types.EUI64(*ulMetaData.DevEUI), []string{
+		"handover_roaming",
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return err
+	}
+	roaming := dev.HandoverRoaming
+	if roaming == nil || roaming.Serving ||
+		!types.MustNetID(roaming.PartnerNetId).OrZero().Equal(types.NetID(req.SenderID)) {
+		return interop.ErrUnknownDevEUI.New()
+	}
+	up, err := uplinkFromULMetaData(req.NsNsMessageHeader, ulMetaData)
+	if err != nil {
+		return interop.ErrMalformedMessage.WithCause(err)
+	}
+	var receivedAt *timestamppb.Timestamp
+	if !ulMetaData.RecvTime.IsZero() {
+		receivedAt = timestamppb.New(ulMetaData.RecvTime)
+	}
+	ns.submitApplicationUplinks(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: dev.Ids.ApplicationIds,
+			DeviceId:       dev.Ids.DeviceId,
+			DevEui:         dev.Ids.DevEui,
+			JoinEui:        dev.Ids.JoinEui,
+			DevAddr:        roaming.DevAddr,
+		},
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				Confirmed:    ulMetaData.Confirmed,
+				FCnt:         *ulMetaData.FCntUp,
+				FPort:        uint32(*ulMetaData.FPort),
+				FrmPayload:   req.FRMPayload,
+				RxMetadata:   up.RxMetadata,
+				SessionKeyId: roaming.SessionKeyId,
+				Settings:     up.Settings,
+				ReceivedAt:   receivedAt,
+				NetworkIds:   ns.networkIdentifiers(ctx),
+			},
+		},
+	})
+	publishEvents(ctx, evtReceiveHandoverRoamingUplink.NewWithIdentifiersAndData(ctx, dev.Ids, nil))
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestEndDeviceFromProfile(t *testing.T) {
+	t.Parallel()
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "roaming"},
+		DeviceId:       "eui-4242424242424242",
+		JoinEui:        []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x00},
+		DevEui:         []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+	}
+	for _, tc := range []struct {
+		Name     string
+		Profile  *interop.DeviceProfile
+		Expected *ttnpb.EndDevice
+	}{
+		{
+			Name: "Class A",
+			Profile: &interop.DeviceProfile{
+				MACVersion:        interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+				RegParamsRevision: interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_3_REV_A),
+				SupportsJoin:      true,
+				RXDelay1:          1,
+				RXDataRate2:       0,
+				RXFreq2:           869.525,
+				RFRegion:          "EU868",
+			},
+			Expected: &ttnpb.EndDevice{
+				Ids:               ids,
+				FrequencyPlanId:   "EU_863_870",
+				LorawanVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+				LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+				SupportsJoin:      true,
+				MacSettings: &ttnpb.MACSettings{
+					Rx1Delay:           &ttnpb.RxDelayValue{Value: ttnpb.RxDelay_RX_DELAY_1},
+					Rx1DataRateOffset:  &ttnpb.DataRateOffsetValue{Value: ttnpb.DataRateOffset_DATA_RATE_OFFSET_0},
+					Rx2DataRateIndex:   &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex_DATA_RATE_0},
+					Rx2Frequency:       &ttnpb.FrequencyValue{Value: 869525000},
+					Supports_32BitFCnt: &ttnpb.BoolValue{Value: false},
+				},
+			},
+		},
+		{
+			Name: "Class B and C",
+			Profile: &interop.DeviceProfile{
+				SupportsClassB:     true,
+				ClassBTimeout:      60,
+				PingSlotPeriod:     32,
+				PingSlotDR:         func(v uint8) *uint8 { return &v }(3),
+				PingSlotFreq:       func(v float64) *float64 { return &v }(869.525),
+				SupportsClassC:     true,
+				ClassCTimeout:      120,
+				MACVersion:         interop.MACVersion(ttnpb.MACVersion_MAC_V1_1),
+				RegParamsRevision:  interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_1_REV_B),
+				SupportsJoin:       true,
+				RXDelay1:           5,
+				RXDROffset1:        2,
+				RXDataRate2:        3,
+				RXFreq2:            869.525,
+				FactoryPresetFreqs: []float64{868.1, 868.3, 868.5},
+				RFRegion:           "EU868",
+				Supports32bitFCnt:  true,
+			},
+			Expected: &ttnpb.EndDevice{
+				Ids:               ids,
+				FrequencyPlanId:   "EU_863_870",
+				LorawanVersion:    ttnpb.MACVersion_MAC_V1_1,
+				LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_1_REV_B,
+				SupportsJoin:      true,
+				SupportsClassB:    true,
+				SupportsClassC:    true,
+				MacSettings: &ttnpb.MACSettings{
+					ClassBTimeout:            durationpb.New(time.Minute),
+					PingSlotPeriodicity:      &ttnpb.PingSlotPeriodValue{Value: ttnpb.PingSlotPeriod_PING_EVERY_32S},
+					PingSlotDataRateIndex:    &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex_DATA_RATE_3},
+					PingSlotFrequency:        &ttnpb.ZeroableFrequencyValue{Value: 869525000},
+					ClassCTimeout:            durationpb.New(2 * time.Minute),
+					Rx1Delay:                 &ttnpb.RxDelayValue{Value: ttnpb.RxDelay_RX_DELAY_5},
+					Rx1DataRateOffset:        &ttnpb.DataRateOffsetValue{Value: ttnpb.DataRateOffset_DATA_RATE_OFFSET_2},
+					Rx2DataRateIndex:         &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex_DATA_RATE_3},
+					Rx2Frequency:             &ttnpb.FrequencyValue{Value: 869525000},
+					FactoryPresetFrequencies: []uint64{868100000, 868300000, 868500000},
+					Supports_32BitFCnt:       &ttnpb.BoolValue{Value: true},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			a.So(endDeviceFromProfile(ids, "EU_863_870", tc.Profile), should.Resemble, tc.Expected)
+		})
+	}
+}
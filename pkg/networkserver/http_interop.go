@@ -0,0 +1,310 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type interopServer struct {
+	NS *NetworkServer
+}
+
+// RegisterInterop registers the NS-NS interop services.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterNS(interopServer{NS: ns})
+}
+
+// requireRoamingPartner returns an error if the sender is not an authenticated roaming partner or if this Network
+// Server is not the receiver.
+func (srv interopServer) requireRoamingPartner(
+	ctx context.Context, header interop.NsNsMessageHeader, partners interface{ HasNetworkServer(types.NetID) bool },
+) error {
+	senderID := types.NetID(header.SenderID)
+	if err := (interop.Authorizer{}).RequireNetID(ctx, senderID); err != nil {
+		return err
+	}
+	if !types.NetID(header.ReceiverID).Equal(srv.NS.netID(ctx)) {
+		return interop.ErrUnknownReceiver.New()
+	}
+	if !partners.HasNetworkServer(senderID) {
+		return interop.ErrNoRoamingAgreement.New()
+	}
+	return nil
+}
+
+// handleRoamingUplink handles the uplink message received from a forwarding Network Server.
+func (srv interopServer) handleRoamingUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
+	switch up.Payload.MHdr.MType {
+	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
+		return srv.NS.handleDataUplink(ctx, up)
+	default:
+		return interop.ErrRoamingActivation.New()
+	}
+}
+
+// PRStartRequest handles the passive roaming start request of a forwarding Network Server.
+// Passive roaming is stateless: the serving Network Server handles the uplink message and answers with zero lifetime.
+func (srv interopServer) PRStartRequest(ctx context.Context, in *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if !srv.NS.passiveRoaming.Serve {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if err := srv.requireRoamingPartner(ctx, in.NsNsMessageHeader, srv.NS.passiveRoamingClient); err != nil {
+		return nil, err
+	}
+	up, err := uplinkFromPRStartReq(in)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if err := srv.NS.handleUplink(ctx, up, srv.handleRoamingUplink); err != nil {
+		switch {
+		case errors.Resemble(err, errDecodePayload),
+			errors.Resemble(err, errDataRateNotFound),
+			errors.Resemble(err, errRawPayloadTooShort):
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		case errors.Resemble(err, errDuplicateUplink):
+			return nil, interop.ErrNoAction.WithCause(err)
+		case errors.Resemble(err, errDeviceNotFound):
+			return nil, interop.ErrUnknownDevAddr.WithCause(err)
+		}
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	var lifetime uint32
+	return &interop.PRStartAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime: &lifetime,
+	}, nil
+}
+
+// PRStopRequest handles the passive roaming stop request of a forwarding Network Server.
+// As passive roaming is stateless, there is no state to stop.
+func (srv interopServer) PRStopRequest(ctx context.Context, in *interop.PRStopReq) (*interop.PRStopAns, error) {
+	if !srv.NS.passiveRoaming.Serve {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if err := srv.requireRoamingPartner(ctx, in.NsNsMessageHeader, srv.NS.passiveRoamingClient); err != nil {
+		return nil, err
+	}
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.PRStopAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// XmitDataRequest handles the data transmission request of a roaming partner.
+// In handover roaming, the serving Network Server forwards the application payload of uplink messages to this home
+// Network Server. In passive roaming, the serving Network Server requests downlink transmission.
+func (srv interopServer) XmitDataRequest(
+	ctx context.Context, in *interop.TTIXmitDataReq,
+) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if in.ULMetaData != nil {
+		return srv.handoverRoamingXmitDataRequest(ctx, in)
+	}
+	if !srv.NS.passiveRoaming.Forward {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if err := srv.requireRoamingPartner(ctx, in.NsNsMessageHeader, srv.NS.passiveRoamingClient); err != nil {
+		return nil, err
+	}
+	if len(in.PHYPayload) == 0 || in.DLMetaData == nil {
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	if err := srv.NS.transmitRoamingDownlink(ctx, &in.XmitDataReq); err != nil {
+		if errors.IsInvalidArgument(err) || errors.IsNotFound(err) {
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		}
+		return nil, interop.ErrTransmitFailed.WithCause(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DLFreq1: in.DLMetaData.DLFreq1,
+		DLFreq2: in.DLMetaData.DLFreq2,
+	}, nil
+}
+
+// handoverRoamingXmitDataRequest handles the uplink transmission request of a serving Network Server for a device of
+// this home Network Server.
+func (srv interopServer) handoverRoamingXmitDataRequest(
+	ctx context.Context, in *interop.TTIXmitDataReq,
+) (*interop.XmitDataAns, error) {
+	if !srv.NS.handoverRoaming.HandOver {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if err := srv.requireRoamingPartner(ctx, in.NsNsMessageHeader, srv.NS.handoverRoamingClient); err != nil {
+		return nil, err
+	}
+	if err := srv.NS.handleHandoverRoamingUplink(ctx, in); err != nil {
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// ProfileRequest handles the device profile request of a roaming partner that wants to serve a device of this home
+// Network Server.
+func (srv interopServer) ProfileRequest(ctx context.Context, in *interop.TTIProfileReq) (*interop.ProfileAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if !srv.NS.handoverRoaming.HandOver {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if err := srv.requireRoamingPartner(ctx, in.NsNsMessageHeader, srv.NS.handoverRoamingClient); err != nil {
+		return nil, err
+	}
+	if in.JoinEUI == nil {
+		// The device registry is indexed by JoinEUI and DevEUI, so the device cannot be found without JoinEUI.
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+	dev, ctx, err := srv.NS.devices.GetByEUI(ctx, types.EUI64(*in.JoinEUI), types.EUI64(in.DevEUI), deviceProfilePaths)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return nil, err
+	}
+	if !dev.SupportsJoin {
+		return nil, interop.ErrRoamingActivation.New()
+	}
+	profile, err := srv.NS.deviceProfile(ctx, dev)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.ProfileAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DeviceProfile:          profile,
+		DeviceProfileTimestamp: ttnpb.StdTime(dev.UpdatedAt),
+		RoamingActivationType:  interop.RoamingActivationHandover,
+	}, nil
+}
+
+// HRStartRequest handles the handover roaming start request of a serving Network Server for a device of this home
+// Network Server.
+func (srv interopServer) HRStartRequest(ctx context.Context, in *interop.HRStartReq) (*interop.HRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if !srv.NS.handoverRoaming.HandOver {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if err := srv.requireRoamingPartner(ctx, in.NsNsMessageHeader, srv.NS.handoverRoamingClient); err != nil {
+		return nil, err
+	}
+	ans, err := srv.NS.handOverDevice(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	ans.NsNsMessageHeader = header
+	ans.Result = interop.Result{
+		ResultCode: interop.ResultSuccess,
+	}
+	return ans, nil
+}
+
+// HRStopRequest handles the handover roaming stop request of a roaming partner.
+// If this Network Server serves the device, the device is deleted.
+func (srv interopServer) HRStopRequest(ctx context.Context, in *interop.TTIHRStopReq) (*interop.HRStopAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if !srv.NS.handoverRoaming.Enabled() {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if err := srv.requireRoamingPartner(ctx, in.NsNsMessageHeader, srv.NS.handoverRoamingClient); err != nil {
+		return nil, err
+	}
+	if in.JoinEUI == nil {
+		// The device registry is indexed by JoinEUI and DevEUI, so the device cannot be found without JoinEUI.
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+	dev, ctx, err := srv.NS.devices.GetByEUI(ctx, types.EUI64(*in.JoinEUI), types.EUI64(in.DevEUI), []string{
+		"handover_roaming",
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return nil, err
+	}
+	if dev.HandoverRoaming == nil ||
+		!types.MustNetID(dev.HandoverRoaming.PartnerNetId).OrZero().Equal(types.NetID(in.SenderID)) {
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+	if err := srv.NS.handleStopHandoverRoaming(ctx, dev); err != nil {
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.HRStopAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
@@ -0,0 +1,268 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package uplinkmatch provides the encoding of the device sessions that the device registries store for matching
+// uplinks to devices.
+// The encoding is msgpack, so that the sessions can be decoded in Redis Lua scripts.
+package uplinkmatch
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Session is the session of a device that is stored for matching uplinks to the device.
+type Session struct {
+	FNwkSIntKey       *ttnpb.KeyEnvelope
+	ResetsFCnt        *ttnpb.BoolValue
+	Supports32BitFCnt *ttnpb.BoolValue
+	LoRaWANVersion    ttnpb.MACVersion
+	LastFCnt          uint32
+}
+
+// PendingSession is the pending session of a device that is stored for matching uplinks to the device.
+type PendingSession struct {
+	FNwkSIntKey    *ttnpb.KeyEnvelope
+	LoRaWANVersion ttnpb.MACVersion
+}
+
+func encodeStruct(enc *msgpack.Encoder, fs ...func(enc *msgpack.Encoder) error) error {
+	if err := enc.EncodeMapLen(len(fs)); err != nil {
+		return err
+	}
+	for _, f := range fs {
+		if err := f(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func makeEncodeCustomEncoderField(name string, v msgpack.CustomEncoder) func(enc *msgpack.Encoder) error {
+	return func(enc *msgpack.Encoder) error {
+		if err := enc.EncodeString(name); err != nil {
+			return err
+		}
+		return v.EncodeMsgpack(enc)
+	}
+}
+
+func makeEncodeFNwkSIntField(v *ttnpb.KeyEnvelope) func(enc *msgpack.Encoder) error {
+	return makeEncodeCustomEncoderField("f_nwk_s_int_key", v)
+}
+
+func makeEncodeLoRaWANVersionField(v ttnpb.MACVersion) func(enc *msgpack.Encoder) error {
+	return makeEncodeCustomEncoderField("lorawan_version", v)
+}
+
+func makeEncodeBoolValueField(name string, v *ttnpb.BoolValue) func(enc *msgpack.Encoder) error {
+	return func(enc *msgpack.Encoder) error {
+		if err := enc.EncodeString(name); err != nil {
+			return err
+		}
+		if err := enc.EncodeMapLen(1); err != nil {
+			return err
+		}
+		if err := enc.EncodeString("value"); err != nil {
+			return err
+		}
+		return enc.EncodeBool(v.Value)
+	}
+}
+
+func makeEncodeResetsFCntField(v *ttnpb.BoolValue) func(enc *msgpack.Encoder) error {
+	return makeEncodeBoolValueField("resets_f_cnt", v)
+}
+
+func makeEncodeSupports32BitFCntField(v *ttnpb.BoolValue) func(enc *msgpack.Encoder) error {
+	return makeEncodeBoolValueField("supports_32_bit_f_cnt", v)
+}
+
+func makeEncodeLastFCntField(v uint32) func(enc *msgpack.Encoder) error {
+	return func(enc *msgpack.Encoder) error {
+		if err := enc.EncodeString("last_f_cnt"); err != nil {
+			return err
+		}
+		return enc.EncodeUint32(v)
+	}
+}
+
+var errInvalidFieldCount = errors.DefineCorruption("field_count", "invalid field count '{count}'")
+
+func decodeBoolValue(dec *msgpack.Decoder) (*ttnpb.BoolValue, error) {
+	n, err := dec.DecodeMapLen()
+	if err != nil {
+		return nil, err
+	}
+	if n != 1 {
+		return nil, errInvalidFieldCount.WithAttributes("count", n)
+	}
+
+	s, err := dec.DecodeString()
+	if err != nil {
+		return nil, err
+	}
+	if s != "value" {
+		return nil, errInvalidField.WithAttributes("field", s)
+	}
+
+	v, err := dec.DecodeBool()
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.BoolValue{
+		Value: v,
+	}, nil
+}
+
+var errInvalidField = errors.DefineInvalidArgument("field", "invalid field `{field}`")
+
+// EncodeMsgpack implements msgpack.CustomEncoder interface.
+func (v Session) EncodeMsgpack(enc *msgpack.Encoder) error {
+	fs := []func(enc *msgpack.Encoder) error{
+		makeEncodeFNwkSIntField(v.FNwkSIntKey),
+		makeEncodeLoRaWANVersionField(v.LoRaWANVersion),
+	}
+	if v.LastFCnt > 0 {
+		fs = append(fs, makeEncodeLastFCntField(v.LastFCnt))
+	}
+	if v.ResetsFCnt != nil {
+		fs = append(fs, makeEncodeResetsFCntField(v.ResetsFCnt))
+	}
+	if v.Supports32BitFCnt != nil {
+		fs = append(fs, makeEncodeSupports32BitFCntField(v.Supports32BitFCnt))
+	}
+	return encodeStruct(enc, fs...)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder interface.
+func (v *Session) DecodeMsgpack(dec *msgpack.Decoder) error {
+	n, err := dec.DecodeMapLen()
+	if err != nil {
+		return err
+	}
+	if n > 5 {
+		return errInvalidFieldCount.WithAttributes("count", n)
+	}
+	for i := 0; i < n; i++ {
+		s, err := dec.DecodeString()
+		if err != nil {
+			return err
+		}
+		switch s {
+		case "f_nwk_s_int_key":
+			fv := &ttnpb.KeyEnvelope{}
+			if err := fv.DecodeMsgpack(dec); err != nil {
+				return err
+			}
+			v.FNwkSIntKey = fv
+
+		case "lorawan_version":
+			var fv ttnpb.MACVersion
+			if err := fv.DecodeMsgpack(dec); err != nil {
+				return err
+			}
+			v.LoRaWANVersion = fv
+
+		case "resets_f_cnt":
+			fv, err := decodeBoolValue(dec)
+			if err != nil {
+				return err
+			}
+			v.ResetsFCnt = fv
+
+		case "supports_32_bit_f_cnt":
+			fv, err := decodeBoolValue(dec)
+			if err != nil {
+				return err
+			}
+			v.Supports32BitFCnt = fv
+
+		case "last_f_cnt":
+			fv, err := dec.DecodeUint32()
+			if err != nil {
+				return err
+			}
+			v.LastFCnt = fv
+
+		default:
+			return errInvalidField.WithAttributes("field", s)
+		}
+	}
+	return nil
+}
+
+// EncodeMsgpack implements msgpack.CustomEncoder interface.
+func (v PendingSession) EncodeMsgpack(enc *msgpack.Encoder) error {
+	return encodeStruct(enc,
+		makeEncodeFNwkSIntField(v.FNwkSIntKey),
+		makeEncodeLoRaWANVersionField(v.LoRaWANVersion),
+	)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder interface.
+func (v *PendingSession) DecodeMsgpack(dec *msgpack.Decoder) error {
+	n, err := dec.DecodeMapLen()
+	if err != nil {
+		return err
+	}
+	if n > 2 {
+		return errInvalidFieldCount.WithAttributes("count", n)
+	}
+	for i := 0; i < n; i++ {
+		s, err := dec.DecodeString()
+		if err != nil {
+			return err
+		}
+		switch s {
+		case "f_nwk_s_int_key":
+			fv := &ttnpb.KeyEnvelope{}
+			if err := fv.DecodeMsgpack(dec); err != nil {
+				return err
+			}
+			v.FNwkSIntKey = fv
+
+		case "lorawan_version":
+			var fv ttnpb.MACVersion
+			if err := fv.DecodeMsgpack(dec); err != nil {
+				return err
+			}
+			v.LoRaWANVersion = fv
+
+		default:
+			return errInvalidField.WithAttributes("field", s)
+		}
+	}
+	return nil
+}
+
+// MarshalCurrentSession returns the encoded Session of the current session of dev.
+func MarshalCurrentSession(dev *ttnpb.EndDevice) ([]byte, error) {
+	return msgpack.Marshal(Session{
+		LoRaWANVersion:    dev.GetMacState().GetLorawanVersion(),
+		FNwkSIntKey:       dev.GetSession().GetKeys().GetFNwkSIntKey(),
+		LastFCnt:          dev.GetSession().GetLastFCntUp(),
+		ResetsFCnt:        dev.GetMacSettings().GetResetsFCnt(),
+		Supports32BitFCnt: dev.GetMacSettings().GetSupports_32BitFCnt(),
+	})
+}
+
+// MarshalPendingSession returns the encoded Session of the pending session of dev.
+func MarshalPendingSession(dev *ttnpb.EndDevice) ([]byte, error) {
+	return msgpack.Marshal(Session{
+		LoRaWANVersion: dev.GetPendingMacState().GetLorawanVersion(),
+		FNwkSIntKey:    dev.GetPendingSession().GetKeys().GetFNwkSIntKey(),
+	})
+}
@@ -672,6 +672,10 @@ func adrAdaptNbTrans(
 }
 
 func adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings) error {
+	algorithm, err := DeviceADRAlgorithm(dev, defaults)
+	if err != nil {
+		return err
+	}
 	macState := dev.MacState
 	adrUplinks := adrUplinks(macState, phy)
 	if len(adrUplinks) == 0 {
@@ -685,27 +689,30 @@ func adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	if !ok {
 		return nil
 	}
-	margin, optimal, ok, err := adrMargin(ctx, dev, defaults, adrUplinks...)
-	if err != nil || !ok {
+	params, err := algorithm.AdaptDataRate(ctx, &ADRInput{
+		Device:                 dev,
+		Band:                   phy,
+		Defaults:               defaults,
+		Uplinks:                adrUplinks,
+		MinDataRateIndex:       minDataRateIndex,
+		MaxDataRateIndex:       maxDataRateIndex,
+		AllowedDataRateIndices: allowedDataRateIndices,
+		MinTxPowerIndex:        minTxPowerIndex,
+		MaxTxPowerIndex:        maxTxPowerIndex,
+		RejectedTxPowerIndices: rejectedTxPowerIndices,
+	})
+	if err != nil || params == nil {
 		return err
 	}
-	margin, ok = adrSteerDeviceChannels(
-		ctx, dev, defaults, phy, minDataRateIndex, maxDataRateIndex, allowedDataRateIndices, margin,
-	)
-	if !ok {
-		margin = adrAdaptDataRate(
-			macState, phy, minDataRateIndex, maxDataRateIndex, allowedDataRateIndices, minTxPowerIndex, margin,
-		)
-	}
-	margin = adrAdaptTxPowerIndex(
-		macState, phy, minTxPowerIndex, maxTxPowerIndex, rejectedTxPowerIndices, margin, optimal,
-	)
-	_ = margin
-	adrAdaptNbTrans(dev, defaults, adrUplinks)
+	desiredParameters := macState.DesiredParameters
+	desiredParameters.AdrDataRateIndex = params.DataRateIndex
+	desiredParameters.AdrTxPowerIndex = params.TxPowerIndex
+	desiredParameters.AdrNbTrans = clampNbTrans(dev, defaults, params.NbTrans, params.DataRateIndex)
 	return nil
 }
 
 // AdaptDataRate adapts the end device desired ADR parameters based on previous transmissions and device settings.
+// The ADR algorithm is selected by DeviceADRAlgorithm.
 func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings) error {
 	if dev.MacState == nil {
 		return nil
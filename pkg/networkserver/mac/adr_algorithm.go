@@ -0,0 +1,236 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// DefaultADRAlgorithm is the name of the default ADR algorithm.
+	DefaultADRAlgorithm = "default"
+	// LossAwareADRAlgorithm is the name of the loss-aware ADR algorithm.
+	LossAwareADRAlgorithm = "loss-aware"
+)
+
+// ADRInput is the input of an ADR algorithm.
+type ADRInput struct {
+	// Device is the end device. The MAC state contains the current ADR parameters.
+	// ADR algorithms must not modify the end device.
+	Device *ttnpb.EndDevice
+	// Band is the band of the end device.
+	Band *band.Band
+	// Defaults are the default MAC settings of the Network Server.
+	Defaults *ttnpb.MACSettings
+	// Uplinks are the recent uplinks transmitted with the current data rate since the last ADR parameter change.
+	Uplinks []*ttnpb.MACState_UplinkMessage
+
+	// MinDataRateIndex is the minimum data rate index that may be used.
+	MinDataRateIndex ttnpb.DataRateIndex
+	// MaxDataRateIndex is the maximum data rate index that may be used.
+	MaxDataRateIndex ttnpb.DataRateIndex
+	// AllowedDataRateIndices are the data rate indices between the minimum and maximum that may be used.
+	AllowedDataRateIndices map[ttnpb.DataRateIndex]struct{}
+	// MinTxPowerIndex is the minimum TX power index that may be used.
+	MinTxPowerIndex uint32
+	// MaxTxPowerIndex is the maximum TX power index that may be used.
+	MaxTxPowerIndex uint32
+	// RejectedTxPowerIndices are the TX power indices that were rejected by the end device.
+	RejectedTxPowerIndices map[uint32]struct{}
+}
+
+// ADRParameters are the ADR parameters computed by an ADR algorithm.
+type ADRParameters struct {
+	DataRateIndex ttnpb.DataRateIndex
+	TxPowerIndex  uint32
+	NbTrans       uint32
+}
+
+// ADRAlgorithm computes the desired ADR parameters of end devices.
+type ADRAlgorithm interface {
+	// AdaptDataRate returns the desired ADR parameters of the end device.
+	// If the returned parameters are nil, the desired ADR parameters are not changed.
+	AdaptDataRate(ctx context.Context, in *ADRInput) (*ADRParameters, error)
+}
+
+// ADRAlgorithmFunc is a function that implements ADRAlgorithm.
+type ADRAlgorithmFunc func(context.Context, *ADRInput) (*ADRParameters, error)
+
+// AdaptDataRate implements ADRAlgorithm.
+func (f ADRAlgorithmFunc) AdaptDataRate(ctx context.Context, in *ADRInput) (*ADRParameters, error) {
+	return f(ctx, in)
+}
+
+var adrAlgorithms = map[string]ADRAlgorithm{
+	DefaultADRAlgorithm:   ADRAlgorithmFunc(defaultADRAlgorithm),
+	LossAwareADRAlgorithm: ADRAlgorithmFunc(lossAwareADRAlgorithm),
+}
+
+// RegisterADRAlgorithm registers an ADR algorithm with the given name.
+// This should only be called from init funcs.
+func RegisterADRAlgorithm(name string, algorithm ADRAlgorithm) {
+	adrAlgorithms[name] = algorithm
+}
+
+// GetADRAlgorithm returns the ADR algorithm with the given name.
+func GetADRAlgorithm(name string) (ADRAlgorithm, bool) {
+	algorithm, ok := adrAlgorithms[name]
+	return algorithm, ok
+}
+
+// DeviceADRAlgorithm returns the ADR algorithm of the end device.
+// The algorithm is selected by the dynamic ADR settings of the end device, then by the default dynamic ADR settings,
+// and falls back to DefaultADRAlgorithm.
+func DeviceADRAlgorithm(dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings) (ADRAlgorithm, error) {
+	var name string
+	switch {
+	case dev.GetMacSettings().GetAdr().GetDynamic().GetAlgorithm() != "":
+		name = dev.MacSettings.Adr.GetDynamic().Algorithm
+
+	case defaults.GetAdr().GetDynamic().GetAlgorithm() != "":
+		name = defaults.Adr.GetDynamic().Algorithm
+
+	default:
+		name = DefaultADRAlgorithm
+	}
+	algorithm, ok := adrAlgorithms[name]
+	if !ok {
+		return nil, errUnknownADRAlgorithm.WithAttributes("name", name)
+	}
+	return algorithm, nil
+}
+
+// ADRAlgorithms returns the names of the registered ADR algorithms.
+func ADRAlgorithms() []string {
+	names := make([]string, 0, len(adrAlgorithms))
+	for name := range adrAlgorithms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// adrDevice returns a copy of the end device that ADR algorithms can adapt the desired ADR parameters of.
+func adrDevice(dev *ttnpb.EndDevice) *ttnpb.EndDevice {
+	desiredParameters := dev.MacState.DesiredParameters
+	return &ttnpb.EndDevice{
+		MacSettings: dev.MacSettings,
+		MacState: &ttnpb.MACState{
+			CurrentParameters: dev.MacState.CurrentParameters,
+			DesiredParameters: &ttnpb.MACParameters{
+				AdrDataRateIndex: desiredParameters.AdrDataRateIndex,
+				AdrTxPowerIndex:  desiredParameters.AdrTxPowerIndex,
+				AdrNbTrans:       desiredParameters.AdrNbTrans,
+			},
+		},
+	}
+}
+
+func adrParametersOf(dev *ttnpb.EndDevice) *ADRParameters {
+	desiredParameters := dev.MacState.DesiredParameters
+	return &ADRParameters{
+		DataRateIndex: desiredParameters.AdrDataRateIndex,
+		TxPowerIndex:  desiredParameters.AdrTxPowerIndex,
+		NbTrans:       desiredParameters.AdrNbTrans,
+	}
+}
+
+// defaultADRAlgorithm increases the data rate and decreases the TX power as long as the link margin allows it,
+// and adapts NbTrans to the loss rate.
+func defaultADRAlgorithm(ctx context.Context, in *ADRInput) (*ADRParameters, error) {
+	dev := adrDevice(in.Device)
+	margin, optimal, ok, err := adrMargin(ctx, dev, in.Defaults, in.Uplinks...)
+	if err != nil || !ok {
+		return nil, err
+	}
+	margin, ok = adrSteerDeviceChannels(
+		ctx, dev, in.Defaults, in.Band, in.MinDataRateIndex, in.MaxDataRateIndex, in.AllowedDataRateIndices, margin,
+	)
+	if !ok {
+		margin = adrAdaptDataRate(
+			dev.MacState, in.Band, in.MinDataRateIndex, in.MaxDataRateIndex, in.AllowedDataRateIndices,
+			in.MinTxPowerIndex, margin,
+		)
+	}
+	margin = adrAdaptTxPowerIndex(
+		dev.MacState, in.Band, in.MinTxPowerIndex, in.MaxTxPowerIndex, in.RejectedTxPowerIndices, margin, optimal,
+	)
+	_ = margin
+	adrAdaptNbTrans(dev, in.Defaults, in.Uplinks)
+	return adrParametersOf(dev), nil
+}
+
+const (
+	// lossMarginFactor is the link margin in dB that the loss-aware algorithm reserves per unit of loss rate.
+	lossMarginFactor = 25
+	// highLossRate is the loss rate above which the loss-aware algorithm decreases the data rate.
+	highLossRate = 0.3
+)
+
+// lossAwareADRAlgorithm is an ADR algorithm for dense deployments, where uplink loss is mostly caused by collisions.
+// It reserves link margin in proportion to the loss rate, which avoids increasing the data rate of lossy links.
+// If the loss rate is high, the data rate is decreased instead of increasing NbTrans, which would increase
+// the airtime and collisions of all end devices.
+func lossAwareADRAlgorithm(ctx context.Context, in *ADRInput) (*ADRParameters, error) {
+	dev := adrDevice(in.Device)
+	margin, optimal, ok, err := adrMargin(ctx, dev, in.Defaults, in.Uplinks...)
+	if err != nil || !ok {
+		return nil, err
+	}
+	macState := dev.MacState
+	currentParameters, desiredParameters := macState.CurrentParameters, macState.DesiredParameters
+	enoughUplinks := len(in.Uplinks) >= OptimalADRUplinkCount/2
+	lossRate := adrLossRate(in.Uplinks...)
+	margin -= lossRate * lossMarginFactor
+
+	decreased := false
+	if enoughUplinks && lossRate >= highLossRate {
+		for drIdx := currentParameters.AdrDataRateIndex; drIdx > in.MinDataRateIndex; {
+			drIdx--
+			if _, ok := in.AllowedDataRateIndices[drIdx]; !ok {
+				continue
+			}
+			desiredParameters.AdrDataRateIndex = drIdx
+			desiredParameters.AdrTxPowerIndex = in.MinTxPowerIndex
+			decreased = true
+			break
+		}
+	}
+	if !decreased {
+		margin = adrAdaptDataRate(
+			macState, in.Band, in.MinDataRateIndex, in.MaxDataRateIndex, in.AllowedDataRateIndices,
+			in.MinTxPowerIndex, margin,
+		)
+		margin = adrAdaptTxPowerIndex(
+			macState, in.Band, in.MinTxPowerIndex, in.MaxTxPowerIndex, in.RejectedTxPowerIndices, margin, optimal,
+		)
+	}
+	_ = margin
+
+	nbTrans := currentParameters.AdrNbTrans
+	switch {
+	case !enoughUplinks:
+	case lossRate < highLossRate, decreased:
+		nbTrans = 1
+	default:
+		nbTrans = maxNbTrans
+	}
+	desiredParameters.AdrNbTrans = clampNbTrans(dev, in.Defaults, nbTrans, desiredParameters.AdrDataRateIndex)
+	return adrParametersOf(dev), nil
+}
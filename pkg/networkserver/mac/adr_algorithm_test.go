@@ -0,0 +1,247 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	testADRAlgorithm         = "test"
+	testNoChangeADRAlgorithm = "test-no-change"
+)
+
+func init() {
+	RegisterADRAlgorithm(testADRAlgorithm, ADRAlgorithmFunc(
+		func(context.Context, *ADRInput) (*ADRParameters, error) {
+			return &ADRParameters{
+				DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_4,
+				TxPowerIndex:  3,
+				NbTrans:       2,
+			}, nil
+		},
+	))
+	RegisterADRAlgorithm(testNoChangeADRAlgorithm, ADRAlgorithmFunc(
+		func(context.Context, *ADRInput) (*ADRParameters, error) {
+			return nil, nil
+		},
+	))
+}
+
+func TestADRAlgorithms(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	a.So(ADRAlgorithms(), should.Contain, DefaultADRAlgorithm)
+	a.So(ADRAlgorithms(), should.Contain, LossAwareADRAlgorithm)
+	_, ok := GetADRAlgorithm("unknown")
+	a.So(ok, should.BeFalse)
+}
+
+func TestAdaptDataRateAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	// makeUplinks returns 20 uplinks at DR3 with the given maximum SNR, of which every step-th frame is received.
+	makeUplinks := func(maxSNR float32, step uint32) []*ttnpb.MACState_UplinkMessage {
+		rows := make([]ADRMatrixRow, 0, 20)
+		for i := uint32(0); i < 20; i++ {
+			rows = append(rows, ADRMatrixRow{
+				FCnt:         10 + i*step,
+				MaxSNR:       maxSNR,
+				GtwDiversity: 1,
+				TxSettings: &ttnpb.TxSettings{
+					DataRate: &ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_Lora{
+							Lora: &ttnpb.LoRaDataRate{
+								SpreadingFactor: 9,
+								Bandwidth:       125000,
+								CodingRate:      band.Cr4_5,
+							},
+						},
+					},
+				},
+			})
+		}
+		return ADRMatrixToUplinks(rows)
+	}
+	makeDevice := func(algorithm string, ups []*ttnpb.MACState_UplinkMessage) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			FrequencyPlanId:   test.EUFrequencyPlanID,
+			LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_0_2_REV_B,
+			MacState: &ttnpb.MACState{
+				CurrentParameters: &ttnpb.MACParameters{
+					AdrDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_3,
+					AdrNbTrans:       1,
+					AdrTxPowerIndex:  1,
+					Channels:         MakeDefaultEU868CurrentChannels(),
+				},
+				DesiredParameters: &ttnpb.MACParameters{
+					AdrDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_3,
+					AdrNbTrans:       1,
+					AdrTxPowerIndex:  1,
+					Channels:         MakeDefaultEU868CurrentChannels(),
+				},
+				RecentUplinks: ups,
+			},
+			MacSettings: &ttnpb.MACSettings{
+				Adr: &ttnpb.ADRSettings{
+					Mode: &ttnpb.ADRSettings_Dynamic{
+						Dynamic: &ttnpb.ADRSettings_DynamicMode{
+							Margin: &wrapperspb.FloatValue{
+								Value: 2,
+							},
+							Algorithm: algorithm,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name             string
+		Algorithm        string
+		DefaultAlgorithm string
+		Uplinks          []*ttnpb.MACState_UplinkMessage
+		DeviceDiff       func(*ttnpb.EndDevice)
+		ErrorAssertion   func(error) bool
+	}{
+		{
+			Name:    "unset/no loss",
+			Uplinks: makeUplinks(-1, 1),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_5
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 2
+			},
+		},
+		{
+			Name:      "default/no loss",
+			Algorithm: DefaultADRAlgorithm,
+			Uplinks:   makeUplinks(-1, 1),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_5
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 2
+			},
+		},
+		{
+			Name:      "default/high loss",
+			Algorithm: DefaultADRAlgorithm,
+			Uplinks:   makeUplinks(-1, 2),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_5
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 2
+				dev.MacState.DesiredParameters.AdrNbTrans = 3
+			},
+		},
+		{
+			Name:      "loss-aware/no loss",
+			Algorithm: LossAwareADRAlgorithm,
+			Uplinks:   makeUplinks(-1, 1),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_5
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 2
+			},
+		},
+		{
+			Name:      "loss-aware/high loss",
+			Algorithm: LossAwareADRAlgorithm,
+			Uplinks:   makeUplinks(-1, 2),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_2
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 0
+				dev.MacState.DesiredParameters.AdrNbTrans = 1
+			},
+		},
+		{
+			Name:             "loss-aware from defaults/high loss",
+			DefaultAlgorithm: LossAwareADRAlgorithm,
+			Uplinks:          makeUplinks(-1, 2),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_2
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 0
+				dev.MacState.DesiredParameters.AdrNbTrans = 1
+			},
+		},
+		{
+			Name:             "custom overrides defaults",
+			Algorithm:        testADRAlgorithm,
+			DefaultAlgorithm: LossAwareADRAlgorithm,
+			Uplinks:          makeUplinks(-1, 1),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_4
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 3
+				dev.MacState.DesiredParameters.AdrNbTrans = 2
+			},
+		},
+		{
+			Name:      "custom/no change",
+			Algorithm: testNoChangeADRAlgorithm,
+			Uplinks:   makeUplinks(-1, 1),
+		},
+		{
+			Name:           "unknown",
+			Algorithm:      "unknown",
+			Uplinks:        makeUplinks(-1, 1),
+			ErrorAssertion: errors.IsNotFound,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := makeDevice(tc.Algorithm, tc.Uplinks)
+				fp := test.FrequencyPlan(dev.FrequencyPlanId)
+				var defaults *ttnpb.MACSettings
+				if tc.DefaultAlgorithm != "" {
+					defaults = &ttnpb.MACSettings{
+						Adr: &ttnpb.ADRSettings{
+							Mode: &ttnpb.ADRSettings_Dynamic{
+								Dynamic: &ttnpb.ADRSettings_DynamicMode{
+									Algorithm: tc.DefaultAlgorithm,
+								},
+							},
+						},
+					}
+				}
+				err := AdaptDataRate(ctx, dev, LoRaWANBands[fp.BandID][dev.LorawanPhyVersion], defaults)
+				if tc.ErrorAssertion != nil {
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					return
+				}
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				expected := makeDevice(tc.Algorithm, tc.Uplinks)
+				if tc.DeviceDiff != nil {
+					tc.DeviceDiff(expected)
+				}
+				a.So(dev, should.Resemble, expected)
+			},
+		})
+	}
+}
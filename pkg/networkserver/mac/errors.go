@@ -23,4 +23,6 @@ var (
 		"request_not_found", "MAC response received, but corresponding request not found", "cid",
 	)
 	ErrNoPayload = errors.DefineInvalidArgument("no_payload", "no message payload specified")
+
+	errUnknownADRAlgorithm = errors.DefineNotFound("unknown_adr_algorithm", "unknown ADR algorithm `{name}`")
 )
@@ -180,6 +180,14 @@ type NetworkServer struct {
 
 	interopClient InteropClient
 
+	passiveRoaming       PassiveRoamingConfig
+	passiveRoamingClient PassiveRoamingClient
+
+	handoverRoaming       HandoverRoamingConfig
+	handoverRoamingClient HandoverRoamingClient
+
+	gatewayAirtime GatewayAirtimeConfig
+
 	uplinkDeduplicator UplinkDeduplicator
 
 	deviceKEKLabel        string
@@ -258,15 +266,43 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		return nil, err
 	}
 
-	var interopCl InteropClient
+	var (
+		interopCl         InteropClient
+		passiveRoamingCl  PassiveRoamingClient
+		handoverRoamingCl HandoverRoamingClient
+	)
 	if !conf.Interop.IsZero() {
 		interopConf := conf.Interop.InteropClient
 		interopConf.BlobConfig = c.GetBaseConfig(ctx).Blob
 
-		interopCl, err = interop.NewClient(ctx, interopConf, c, interop.SelectorNetworkServer)
+		cl, err := interop.NewClient(ctx, interopConf, c, interop.SelectorNetworkServer)
 		if err != nil {
 			return nil, err
 		}
+		interopCl = cl
+		if conf.PassiveRoaming.Enabled() {
+			passiveRoamingCl = cl
+		}
+		if conf.HandoverRoaming.Enabled() {
+			handoverRoamingCl = cl
+		}
+	}
+	if conf.PassiveRoaming.Enabled() && passiveRoamingCl == nil {
+		return nil, errInteropClientRequired.WithAttributes("feature", "passive roaming")
+	}
+	switch {
+	case conf.HandoverRoaming.Enabled() && handoverRoamingCl == nil:
+		return nil, errInteropClientRequired.WithAttributes("feature", "handover roaming")
+	case conf.HandoverRoaming.Accept && (conf.HandoverRoaming.ApplicationID == "" || conf.HandoverRoaming.FrequencyPlanID == ""):
+		return nil, errInvalidConfiguration.WithCause(
+			errors.New("Accepting handover roaming devices requires application ID and frequency plan ID"),
+		)
+	}
+	switch {
+	case conf.GatewayAirtime.Prefer && conf.GatewayAirtime.Registry == nil:
+		panic(errInvalidConfiguration.WithCause(errors.New("GatewayAirtime.Registry is not specified")))
+	case conf.GatewayAirtime.MinRemaining < 0 || conf.GatewayAirtime.MinRemaining > 1:
+		return nil, errInvalidConfiguration.WithCause(errors.New("GatewayAirtime.MinRemaining must be between 0 and 1"))
 	}
 
 	defaultMACSettings, err := conf.DefaultMACSettings.Parse()
@@ -292,6 +328,11 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		downlinkPriorities:       downlinkPriorities,
 		defaultMACSettings:       defaultMACSettings,
 		interopClient:            interopCl,
+		passiveRoaming:           conf.PassiveRoaming,
+		passiveRoamingClient:     passiveRoamingCl,
+		handoverRoaming:          conf.HandoverRoaming,
+		handoverRoamingClient:    handoverRoamingCl,
+		gatewayAirtime:           conf.GatewayAirtime,
 		uplinkDeduplicator:       conf.UplinkDeduplicator,
 		deviceKEKLabel:           conf.DeviceKEKLabel,
 		downlinkQueueCapacity:    conf.DownlinkQueueCapacity,
@@ -382,6 +423,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		})
 	}
 	c.RegisterGRPC(ns)
+	if conf.PassiveRoaming.Enabled() || conf.HandoverRoaming.Enabled() {
+		c.RegisterInterop(ns)
+	}
 	return ns, nil
 }
 
@@ -130,6 +130,38 @@ var (
 		events.WithErrorDataType(),
 		events.WithPropagateToParent(),
 	)
+	evtHandoverJoinAttempt = events.Define(
+		"ns.up.join.handover.attempt", "forward join-request to home Network Server of roaming partner",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.JoinRequest{}),
+	)
+	evtHandoverJoinSuccess = events.Define(
+		"ns.up.join.handover.success", "join-request to home Network Server of roaming partner succeeded",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.JoinResponse{}),
+	)
+	evtHandoverJoinFail = events.Define(
+		"ns.up.join.handover.fail", "join-request to home Network Server of roaming partner failed",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+		events.WithPropagateToParent(),
+	)
+	evtHandOverDevice = events.Define(
+		"ns.roaming.handover.start", "hand over device to roaming partner",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	)
+	evtStopHandoverRoaming = events.Define(
+		"ns.roaming.handover.stop", "stop handover roaming",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	)
+	evtForwardHandoverRoamingUplink = events.Define(
+		"ns.roaming.handover.up.forward", "forward data uplink to home Network Server",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	)
+	evtReceiveHandoverRoamingUplink = events.Define(
+		"ns.roaming.handover.up.receive", "receive data uplink from serving Network Server",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	)
 	evtForwardJoinAccept = events.Define(
 		"ns.up.join.accept.forward", "forward join-accept to Application Server",
 		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
@@ -355,6 +387,10 @@ func registerForwardJoinRequest(ctx context.Context, msg *ttnpb.UplinkMessage) {
 	nsMetrics.uplinkForwarded.WithLabelValues(ctx, mTypeLabel(msg.Payload.MHdr.MType)).Inc()
 }
 
+func registerForwardRoamingUplink(ctx context.Context, msg *ttnpb.UplinkMessage) {
+	nsMetrics.uplinkForwarded.WithLabelValues(ctx, mTypeLabel(msg.Payload.MHdr.MType)).Inc()
+}
+
 func registerDropUplink(ctx context.Context, msg *ttnpb.UplinkMessage, err error) {
 	cause := unknown
 	if ttnErr, ok := errors.From(err); ok {
@@ -26,6 +26,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/uplinkmatch"
 	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -191,229 +192,6 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI
 	return pb, ctx, nil
 }
 
-type UplinkMatchSession struct {
-	FNwkSIntKey       *ttnpb.KeyEnvelope
-	ResetsFCnt        *ttnpb.BoolValue
-	Supports32BitFCnt *ttnpb.BoolValue
-	LoRaWANVersion    ttnpb.MACVersion
-	LastFCnt          uint32
-}
-
-type UplinkMatchPendingSession struct {
-	FNwkSIntKey    *ttnpb.KeyEnvelope
-	LoRaWANVersion ttnpb.MACVersion
-}
-
-func encodeStruct(enc *msgpack.Encoder, fs ...func(enc *msgpack.Encoder) error) error {
-	if err := enc.EncodeMapLen(len(fs)); err != nil {
-		return err
-	}
-	for _, f := range fs {
-		if err := f(enc); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func makeEncodeCustomEncoderField(name string, v msgpack.CustomEncoder) func(enc *msgpack.Encoder) error {
-	return func(enc *msgpack.Encoder) error {
-		if err := enc.EncodeString(name); err != nil {
-			return err
-		}
-		return v.EncodeMsgpack(enc)
-	}
-}
-
-func makeEncodeFNwkSIntField(v *ttnpb.KeyEnvelope) func(enc *msgpack.Encoder) error {
-	return makeEncodeCustomEncoderField("f_nwk_s_int_key", v)
-}
-
-func makeEncodeLoRaWANVersionField(v ttnpb.MACVersion) func(enc *msgpack.Encoder) error {
-	return makeEncodeCustomEncoderField("lorawan_version", v)
-}
-
-func makeEncodeBoolValueField(name string, v *ttnpb.BoolValue) func(enc *msgpack.Encoder) error {
-	return func(enc *msgpack.Encoder) error {
-		if err := enc.EncodeString(name); err != nil {
-			return err
-		}
-		if err := enc.EncodeMapLen(1); err != nil {
-			return err
-		}
-		if err := enc.EncodeString("value"); err != nil {
-			return err
-		}
-		return enc.EncodeBool(v.Value)
-	}
-}
-
-func makeEncodeResetsFCntField(v *ttnpb.BoolValue) func(enc *msgpack.Encoder) error {
-	return makeEncodeBoolValueField("resets_f_cnt", v)
-}
-
-func makeEncodeSupports32BitFCntField(v *ttnpb.BoolValue) func(enc *msgpack.Encoder) error {
-	return makeEncodeBoolValueField("supports_32_bit_f_cnt", v)
-}
-
-func makeEncodeLastFCntField(v uint32) func(enc *msgpack.Encoder) error {
-	return func(enc *msgpack.Encoder) error {
-		if err := enc.EncodeString("last_f_cnt"); err != nil {
-			return err
-		}
-		return enc.EncodeUint32(v)
-	}
-}
-
-var errInvalidFieldCount = errors.DefineCorruption("field_count", "invalid field count '{count}'")
-
-func decodeBoolValue(dec *msgpack.Decoder) (*ttnpb.BoolValue, error) {
-	n, err := dec.DecodeMapLen()
-	if err != nil {
-		return nil, err
-	}
-	if n != 1 {
-		return nil, errInvalidFieldCount.WithAttributes("count", n)
-	}
-
-	s, err := dec.DecodeString()
-	if err != nil {
-		return nil, err
-	}
-	if s != "value" {
-		return nil, errInvalidField.WithAttributes("field", s)
-	}
-
-	v, err := dec.DecodeBool()
-	if err != nil {
-		return nil, err
-	}
-	return &ttnpb.BoolValue{
-		Value: v,
-	}, nil
-}
-
-var errInvalidField = errors.DefineInvalidArgument("field", "invalid field `{field}`")
-
-// EncodeMsgpack implements msgpack.CustomEncoder interface.
-func (v UplinkMatchSession) EncodeMsgpack(enc *msgpack.Encoder) error {
-	fs := []func(enc *msgpack.Encoder) error{
-		makeEncodeFNwkSIntField(v.FNwkSIntKey),
-		makeEncodeLoRaWANVersionField(v.LoRaWANVersion),
-	}
-	if v.LastFCnt > 0 {
-		fs = append(fs, makeEncodeLastFCntField(v.LastFCnt))
-	}
-	if v.ResetsFCnt != nil {
-		fs = append(fs, makeEncodeResetsFCntField(v.ResetsFCnt))
-	}
-	if v.Supports32BitFCnt != nil {
-		fs = append(fs, makeEncodeSupports32BitFCntField(v.Supports32BitFCnt))
-	}
-	return encodeStruct(enc, fs...)
-}
-
-// DecodeMsgpack implements msgpack.CustomDecoder interface.
-func (v *UplinkMatchSession) DecodeMsgpack(dec *msgpack.Decoder) error {
-	n, err := dec.DecodeMapLen()
-	if err != nil {
-		return err
-	}
-	if n > 5 {
-		return errInvalidFieldCount.WithAttributes("count", n)
-	}
-	for i := 0; i < n; i++ {
-		s, err := dec.DecodeString()
-		if err != nil {
-			return err
-		}
-		switch s {
-		case "f_nwk_s_int_key":
-			fv := &ttnpb.KeyEnvelope{}
-			if err := fv.DecodeMsgpack(dec); err != nil {
-				return err
-			}
-			v.FNwkSIntKey = fv
-
-		case "lorawan_version":
-			var fv ttnpb.MACVersion
-			if err := fv.DecodeMsgpack(dec); err != nil {
-				return err
-			}
-			v.LoRaWANVersion = fv
-
-		case "resets_f_cnt":
-			fv, err := decodeBoolValue(dec)
-			if err != nil {
-				return err
-			}
-			v.ResetsFCnt = fv
-
-		case "supports_32_bit_f_cnt":
-			fv, err := decodeBoolValue(dec)
-			if err != nil {
-				return err
-			}
-			v.Supports32BitFCnt = fv
-
-		case "last_f_cnt":
-			fv, err := dec.DecodeUint32()
-			if err != nil {
-				return err
-			}
-			v.LastFCnt = fv
-
-		default:
-			return errInvalidField.WithAttributes("field", s)
-		}
-	}
-	return nil
-}
-
-// EncodeMsgpack implements msgpack.CustomEncoder interface.
-func (v UplinkMatchPendingSession) EncodeMsgpack(enc *msgpack.Encoder) error {
-	return encodeStruct(enc,
-		makeEncodeFNwkSIntField(v.FNwkSIntKey),
-		makeEncodeLoRaWANVersionField(v.LoRaWANVersion),
-	)
-}
-
-// DecodeMsgpack implements msgpack.CustomDecoder interface.
-func (v *UplinkMatchPendingSession) DecodeMsgpack(dec *msgpack.Decoder) error {
-	n, err := dec.DecodeMapLen()
-	if err != nil {
-		return err
-	}
-	if n > 2 {
-		return errInvalidFieldCount.WithAttributes("count", n)
-	}
-	for i := 0; i < n; i++ {
-		s, err := dec.DecodeString()
-		if err != nil {
-			return err
-		}
-		switch s {
-		case "f_nwk_s_int_key":
-			fv := &ttnpb.KeyEnvelope{}
-			if err := fv.DecodeMsgpack(dec); err != nil {
-				return err
-			}
-			v.FNwkSIntKey = fv
-
-		case "lorawan_version":
-			var fv ttnpb.MACVersion
-			if err := fv.DecodeMsgpack(dec); err != nil {
-				return err
-			}
-			v.LoRaWANVersion = fv
-
-		default:
-			return errInvalidField.WithAttributes("field", s)
-		}
-	}
-	return nil
-}
-
 func CurrentAddrKey(addrKey string) string {
 	return ttnredis.Key(addrKey, "current")
 }
@@ -559,7 +337,7 @@ func (r *DeviceRegistry) RangeByUplinkMatches(ctx context.Context, up *ttnpb.Upl
 			continue
 		}
 
-		ses := &UplinkMatchSession{}
+		ses := &uplinkmatch.Session{}
 		err = msgpack.Unmarshal([]byte(session.Session), ses)
 		if err != nil {
 			continue
@@ -593,7 +371,7 @@ func (r *DeviceRegistry) RangeByUplinkMatches(ctx context.Context, up *ttnpb.Upl
 			continue
 		}
 
-		ses := &UplinkMatchPendingSession{}
+		ses := &uplinkmatch.PendingSession{}
 		err = msgpack.Unmarshal([]byte(session.Session), ses)
 		if err != nil {
 			continue
@@ -617,23 +395,6 @@ func removeAddrMapping(ctx context.Context, r redis.Cmdable, addrKey, uid string
 	return r.ZRem(ctx, addrKey, uid), r.HDel(ctx, FieldKey(addrKey), uid)
 }
 
-func MarshalDeviceCurrentSession(dev *ttnpb.EndDevice) ([]byte, error) {
-	return msgpack.Marshal(UplinkMatchSession{
-		LoRaWANVersion:    dev.GetMacState().GetLorawanVersion(),
-		FNwkSIntKey:       dev.GetSession().GetKeys().GetFNwkSIntKey(),
-		LastFCnt:          dev.GetSession().GetLastFCntUp(),
-		ResetsFCnt:        dev.GetMacSettings().GetResetsFCnt(),
-		Supports32BitFCnt: dev.GetMacSettings().GetSupports_32BitFCnt(),
-	})
-}
-
-func MarshalDevicePendingSession(dev *ttnpb.EndDevice) ([]byte, error) {
-	return msgpack.Marshal(UplinkMatchSession{
-		LoRaWANVersion: dev.GetPendingMacState().GetLorawanVersion(),
-		FNwkSIntKey:    dev.GetPendingSession().GetKeys().GetFNwkSIntKey(),
-	})
-}
-
 var errInvalidDevice = errors.DefineInvalidArgument("invalid_device", "device is invalid")
 
 // SetByID sets device by appID, devID.
@@ -868,7 +629,7 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationId
 				}
 				if setFields {
 					devAddr := types.MustDevAddr(updated.PendingSession.DevAddr).OrZero()
-					b, err := MarshalDevicePendingSession(updated)
+					b, err := uplinkmatch.MarshalPendingSession(updated)
 					if err != nil {
 						return err
 					}
@@ -931,7 +692,7 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationId
 				}
 				if setFields {
 					devAddr := types.MustDevAddr(updated.Session.DevAddr).OrZero()
-					b, err := MarshalDeviceCurrentSession(updated)
+					b, err := uplinkmatch.MarshalCurrentSession(updated)
 					if err != nil {
 						return err
 					}
@@ -25,6 +25,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/smarty/assertions"
 	"github.com/vmihailenco/msgpack/v5"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/uplinkmatch"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -94,14 +95,14 @@ func TestMsgpackCompatibility(t *testing.T) {
 		LuaExpr string
 	}{
 		{
-			Value: UplinkMatchPendingSession{
+			Value: uplinkmatch.PendingSession{
 				FNwkSIntKey:    test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion: test.DefaultMACVersion,
 			},
 			LuaExpr: makeExprWithDefaults(),
 		},
 		{
-			Value: UplinkMatchPendingSession{
+			Value: uplinkmatch.PendingSession{
 				FNwkSIntKey:    test.DefaultFNwkSIntKeyEnvelope,
 				LoRaWANVersion: test.DefaultMACVersion,
 			},
@@ -112,14 +113,14 @@ func TestMsgpackCompatibility(t *testing.T) {
 		},
 
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:    test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion: test.DefaultMACVersion,
 			},
 			LuaExpr: makeExprWithDefaults(),
 		},
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:    test.DefaultFNwkSIntKeyEnvelope,
 				LoRaWANVersion: test.DefaultMACVersion,
 			},
@@ -129,7 +130,7 @@ func TestMsgpackCompatibility(t *testing.T) {
 			),
 		},
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:       test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion:    test.DefaultMACVersion,
 				Supports32BitFCnt: &ttnpb.BoolValue{Value: false},
@@ -139,7 +140,7 @@ func TestMsgpackCompatibility(t *testing.T) {
 			),
 		},
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:       test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion:    test.DefaultMACVersion,
 				Supports32BitFCnt: &ttnpb.BoolValue{Value: true},
@@ -149,7 +150,7 @@ func TestMsgpackCompatibility(t *testing.T) {
 			),
 		},
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:    test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion: test.DefaultMACVersion,
 				ResetsFCnt:     &ttnpb.BoolValue{Value: true},
@@ -159,7 +160,7 @@ func TestMsgpackCompatibility(t *testing.T) {
 			),
 		},
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:    test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion: test.DefaultMACVersion,
 				ResetsFCnt:     &ttnpb.BoolValue{Value: false},
@@ -169,7 +170,7 @@ func TestMsgpackCompatibility(t *testing.T) {
 			),
 		},
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:    test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion: test.DefaultMACVersion,
 				LastFCnt:       42,
@@ -179,7 +180,7 @@ func TestMsgpackCompatibility(t *testing.T) {
 			),
 		},
 		{
-			Value: UplinkMatchSession{
+			Value: uplinkmatch.Session{
 				FNwkSIntKey:       test.DefaultFNwkSIntKeyEnvelopeWrapped,
 				LoRaWANVersion:    test.DefaultMACVersion,
 				ResetsFCnt:        &ttnpb.BoolValue{Value: true},
@@ -0,0 +1,519 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PassiveRoamingClient is a client, which Network Server can use for passive roaming.
+type PassiveRoamingClient interface {
+	HasNetworkServer(netID types.NetID) bool
+	PRStartRequest(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(ctx context.Context, req *interop.TTIXmitDataReq) (*interop.XmitDataAns, error)
+}
+
+// passiveRoamingGatewayIdentifiers are the proxy gateway identifiers of gateways of forwarding Network Servers.
+var passiveRoamingGatewayIdentifiers = &ttnpb.GatewayIdentifiers{GatewayId: "passive-roaming"}
+
+// forwardingUplinkToken is the FNSULToken that the forwarding Network Server passes to the serving Network Server.
+// The serving Network Server returns the token in XmitDataReq.
+type forwardingUplinkToken struct {
+	BandID string `json:"band_id"`
+}
+
+// servingUplinkToken is the uplink token of the metadata of uplink messages received from a forwarding Network
+// Server. The token is used as downlink path to the forwarding Network Server.
+type servingUplinkToken struct {
+	NetID      types.NetID  `json:"net_id"`
+	NSID       *types.EUI64 `json:"ns_id,omitempty"`
+	ULToken    []byte       `json:"ul_token"`
+	FNSULToken []byte       `json:"fns_ul_token,omitempty"`
+}
+
+var classModes = map[ttnpb.Class]string{
+	ttnpb.Class_CLASS_A: "A",
+	ttnpb.Class_CLASS_B: "B",
+	ttnpb.Class_CLASS_C: "C",
+}
+
+func mhz(hz uint64) float64 {
+	return float64(hz) / 1e6
+}
+
+func hz(mhz float64) uint64 {
+	return uint64(math.Round(mhz * 1e6))
+}
+
+// passiveRoamingNetID returns the NetID of the roaming partner that up should be forwarded to, if any.
+// Only data uplink messages with a DevAddr outside of the DevAddr prefixes of this Network Server are forwarded.
+func (ns *NetworkServer) passiveRoamingNetID(ctx context.Context, up *ttnpb.UplinkMessage) (types.NetID, bool) {
+	if !ns.passiveRoaming.Forward {
+		return types.NetID{}, false
+	}
+	devAddr := types.MustDevAddr(up.Payload.GetMacPayload().GetFHdr().GetDevAddr()).OrZero()
+	for _, prefix := range ns.devAddrPrefixes(ctx) {
+		if devAddr.HasPrefix(prefix) {
+			return types.NetID{}, false
+		}
+	}
+	netID, ok := devAddr.NetID()
+	if !ok || netID.Equal(ns.netID(ctx)) || !ns.passiveRoamingClient.HasNetworkServer(netID) {
+		return types.NetID{}, false
+	}
+	return netID, true
+}
+
+// passiveRoamingBand returns the band of the configured passive roaming bands that comprises the uplink settings.
+func (ns *NetworkServer) passiveRoamingBand(settings *ttnpb.TxSettings) (*band.Band, error) {
+	for _, id := range ns.passiveRoaming.BandIDs {
+		phy, err := band.GetLatest(id)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := phy.FindSubBand(settings.GetFrequency()); !ok {
+			continue
+		}
+		if _, _, ok := phy.FindUplinkDataRate(settings.GetDataRate()); !ok {
+			continue
+		}
+		return &phy, nil
+	}
+	return nil, errPassiveRoamingBandNotFound.WithAttributes("frequency", settings.GetFrequency())
+}
+
+// newULMetaData returns the uplink metadata of the uplink message received in the band phy.
+func newULMetaData(up *ttnpb.UplinkMessage, phy *band.Band, rfRegion interop.RFRegion) (*interop.ULMetaData, error) {
+	drIdx, _, ok := phy.FindUplinkDataRate(up.Settings.DataRate)
+	if !ok {
+		return nil, errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
+	}
+	gwInfo := make([]interop.GWInfoElement, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		if md.PacketBroker != nil || md.Relay != nil {
+			continue
+		}
+		gw := interop.GWInfoElement{
+			RFRegion: rfRegion,
+			RSSI:     int32(md.Rssi),
+			SNR:      md.Snr,
+			ULToken:  md.UplinkToken,
+			DLAllowed: len(md.UplinkToken) > 0 &&
+				md.DownlinkPathConstraint != ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if eui := types.MustEUI64(md.GatewayIds.GetEui()); eui != nil {
+			gw.ID = interop.Buffer(eui.Bytes())
+		}
+		if loc := md.Location; loc != nil {
+			gw.Lat, gw.Lon = &loc.Latitude, &loc.Longitude
+		}
+		gwInfo = append(gwInfo, gw)
+	}
+	return &interop.ULMetaData{
+		DataRate: uint8(drIdx),
+		ULFreq:   mhz(up.Settings.Frequency),
+		RecvTime: ttnpb.StdTime(up.ReceivedAt).UTC(),
+		RFRegion: rfRegion,
+		GWCnt:    len(gwInfo),
+		GWInfo:   gwInfo,
+	}, nil
+}
+
+// newPRStartReq returns the PRStartReq for the given uplink message.
+func (ns *NetworkServer) newPRStartReq(
+	ctx context.Context, up *ttnpb.UplinkMessage, receiverID types.NetID, phy *band.Band,
+) (*interop.PRStartReq, error) {
+	rfRegion, ok := interop.RFRegionFromBandID(phy.ID)
+	if !ok {
+		return nil, errPassiveRoamingBand.WithAttributes("band_id", phy.ID)
+	}
+	ulMetaData, err := newULMetaData(up, phy, rfRegion)
+	if err != nil {
+		return nil, err
+	}
+	fnsULToken, err := json.Marshal(forwardingUplinkToken{BandID: phy.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	pld := up.Payload.GetMacPayload()
+	devAddr := interop.DevAddr(types.MustDevAddr(pld.FHdr.DevAddr).OrZero())
+	fCnt := pld.FHdr.FCnt
+	ulMetaData.DevAddr = &devAddr
+	ulMetaData.FCntUp = &fCnt
+	ulMetaData.Confirmed = up.Payload.MHdr.MType == ttnpb.MType_CONFIRMED_UP
+	ulMetaData.FNSULToken = interop.Buffer(fnsULToken)
+	if len(pld.FrmPayload) > 0 || pld.FPort > 0 {
+		fPort := uint8(pld.FPort)
+		ulMetaData.FPort = &fPort
+	}
+	return &interop.PRStartReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:   interop.NetID(ns.netID(ctx)),
+			SenderNSID: (*interop.EUI64)(ns.nsID(ctx)),
+			ReceiverID: interop.NetID(receiverID),
+		},
+		PHYPayload: interop.Buffer(up.RawPayload),
+		ULMetaData: *ulMetaData,
+	}, nil
+}
+
+// forwardDataUplink forwards the data uplink message to the serving Network Server of the roaming partner.
+func (ns *NetworkServer) forwardDataUplink(ctx context.Context, up *ttnpb.UplinkMessage, netID types.NetID) error {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_addr", types.MustDevAddr(up.Payload.GetMacPayload().FHdr.DevAddr).OrZero(),
+		"serving_net_id", netID,
+	))
+	phy, err := ns.passiveRoamingBand(up.Settings)
+	if err != nil {
+		return err
+	}
+
+	ok, err := ns.deduplicateUplink(ctx, up, ns.collectionWindow(ctx), deduplicationLimit, initialDeduplicationRound)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errDuplicateUplink.New()
+	}
+
+	up = ttnpb.Clone(up)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+	ns.mergeMetadata(ctx, up, initialDeduplicationRound)
+	ns.filterMetadata(ctx, up)
+
+	req, err := ns.newPRStartReq(ctx, up, netID, phy)
+	if err != nil {
+		return err
+	}
+	if _, err := ns.passiveRoamingClient.PRStartRequest(ctx, req); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to forward uplink to roaming partner")
+		return errForwardRoamingUplink.WithCause(err)
+	}
+	registerForwardRoamingUplink(ctx, up)
+	log.FromContext(ctx).WithField("metadata_count", len(req.ULMetaData.GWInfo)).Debug("Forwarded uplink to roaming partner")
+	return nil
+}
+
+// uplinkFromPRStartReq returns the uplink message of the PRStartReq received from a forwarding Network Server.
+func uplinkFromPRStartReq(req *interop.PRStartReq) (*ttnpb.UplinkMessage, error) {
+	up, err := uplinkFromULMetaData(req.NsNsMessageHeader, &req.ULMetaData)
+	if err != nil {
+		return nil, err
+	}
+	up.RawPayload = req.PHYPayload
+	return up, nil
+}
+
+// uplinkFromULMetaData returns the uplink message without payload of the uplink metadata received from the Network
+// Server in header.
+func uplinkFromULMetaData(
+	header interop.NsNsMessageHeader, ulMetaData *interop.ULMetaData,
+) (*ttnpb.UplinkMessage, error) {
+	bandID, ok := ulMetaData.RFRegion.BandID()
+	if !ok {
+		return nil, errPassiveRoamingRFRegion.WithAttributes("rf_region", ulMetaData.RFRegion)
+	}
+	phy, err := band.GetLatest(bandID)
+	if err != nil {
+		return nil, err
+	}
+	dr, ok := phy.DataRates[ttnpb.DataRateIndex(ulMetaData.DataRate)]
+	if !ok {
+		return nil, errDataRateIndexNotFound.WithAttributes("index", ulMetaData.DataRate)
+	}
+
+	var recvTime *timestamppb.Timestamp
+	if !ulMetaData.RecvTime.IsZero() {
+		recvTime = timestamppb.New(ulMetaData.RecvTime)
+	}
+	mds := make([]*ttnpb.RxMetadata, 0, len(ulMetaData.GWInfo))
+	for _, gw := range ulMetaData.GWInfo {
+		md := &ttnpb.RxMetadata{
+			GatewayIds:  passiveRoamingGatewayIdentifiers,
+			Rssi:        float32(gw.RSSI),
+			ChannelRssi: float32(gw.RSSI),
+			Snr:         gw.SNR,
+			ReceivedAt:  recvTime,
+		}
+		if gw.Lat != nil && gw.Lon != nil {
+			md.Location = &ttnpb.Location{
+				Latitude:  *gw.Lat,
+				Longitude: *gw.Lon,
+				Source:    ttnpb.LocationSource_SOURCE_REGISTRY,
+			}
+		}
+		if gw.DLAllowed && len(gw.ULToken) > 0 {
+			token, err := json.Marshal(servingUplinkToken{
+				NetID:      types.NetID(header.SenderID),
+				NSID:       (*types.EUI64)(header.SenderNSID),
+				ULToken:    gw.ULToken,
+				FNSULToken: ulMetaData.FNSULToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			md.UplinkToken = token
+		} else {
+			md.DownlinkPathConstraint = ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER
+		}
+		mds = append(mds, md)
+	}
+	return &ttnpb.UplinkMessage{
+		Settings: &ttnpb.TxSettings{
+			DataRate:  dr.Rate,
+			Frequency: hz(ulMetaData.ULFreq),
+		},
+		RxMetadata: mds,
+	}, nil
+}
+
+// passiveRoamingDownlinkTarget schedules downlink messages through the forwarding Network Server of a roaming partner.
+type passiveRoamingDownlinkTarget struct {
+	client       PassiveRoamingClient
+	bandID       func(frequencyPlanID string) (string, error)
+	senderID     types.NetID
+	senderNSID   *types.EUI64
+	receiverID   types.NetID
+	receiverNSID *types.EUI64
+}
+
+func (t *passiveRoamingDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*passiveRoamingDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.receiverID.Equal(t.receiverID)
+}
+
+func parseServingUplinkToken(b []byte) (*servingUplinkToken, error) {
+	var token servingUplinkToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, errPassiveRoamingUplinkToken.WithCause(err)
+	}
+	return &token, nil
+}
+
+func (t *passiveRoamingDownlinkTarget) Schedule(
+	ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
+	req := msg.GetRequest()
+	bandID, err := t.bandID(req.GetFrequencyPlanId())
+	if err != nil {
+		return nil, err
+	}
+	phy, err := band.GetLatest(bandID)
+	if err != nil {
+		return nil, err
+	}
+	classMode, ok := classModes[req.Class]
+	if !ok {
+		return nil, errInvalidFieldValue.WithAttributes("field", "class")
+	}
+	dlMetaData := &interop.DLMetaData{
+		ClassMode:      classMode,
+		RXDelay1:       uint8(req.Rx1Delay.Duration() / time.Second),
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	for _, path := range req.DownlinkPaths {
+		token, err := parseServingUplinkToken(path.GetUplinkToken())
+		if err != nil {
+			return nil, err
+		}
+		dlMetaData.FNSULToken = token.FNSULToken
+		dlMetaData.GWInfo = append(dlMetaData.GWInfo, interop.GWInfoElement{
+			ULToken:   token.ULToken,
+			DLAllowed: true,
+		})
+	}
+	for _, rx := range []struct {
+		dataRate  *ttnpb.DataRate
+		frequency uint64
+		dlFreq    **float64
+		drIdx     **uint8
+	}{
+		{req.Rx1DataRate, req.Rx1Frequency, &dlMetaData.DLFreq1, &dlMetaData.DataRate1},
+		{req.Rx2DataRate, req.Rx2Frequency, &dlMetaData.DLFreq2, &dlMetaData.DataRate2},
+	} {
+		if rx.frequency == 0 {
+			continue
+		}
+		idx, _, ok := phy.FindDownlinkDataRate(rx.dataRate)
+		if !ok {
+			return nil, errDataRateNotFound.WithAttributes("data_rate", rx.dataRate)
+		}
+		freq, drIdx := mhz(rx.frequency), uint8(idx)
+		*rx.dlFreq, *rx.drIdx = &freq, &drIdx
+	}
+	if _, err := t.client.XmitDataRequest(ctx, &interop.TTIXmitDataReq{
+		XmitDataReq: interop.XmitDataReq{
+			NsNsMessageHeader: interop.NsNsMessageHeader{
+				SenderID:     interop.NetID(t.senderID),
+				SenderNSID:   (*interop.EUI64)(t.senderNSID),
+				ReceiverID:   interop.NetID(t.receiverID),
+				ReceiverNSID: (*interop.EUI64)(t.receiverNSID),
+			},
+			PHYPayload: interop.Buffer(msg.RawPayload),
+			DLMetaData: dlMetaData,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return &ttnpb.ScheduleDownlinkResponse{
+		Delay: durationpb.New(peeringScheduleDelay),
+		DownlinkPath: &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_Fixed{
+				Fixed: &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIds: passiveRoamingGatewayIdentifiers,
+				},
+			},
+		},
+	}, nil
+}
+
+// newPassiveRoamingDownlinkTarget returns the downlink target for the given passive roaming downlink path.
+func (ns *NetworkServer) newPassiveRoamingDownlinkTarget(
+	ctx context.Context, path *ttnpb.DownlinkPath,
+) (*passiveRoamingDownlinkTarget, error) {
+	if ns.passiveRoamingClient == nil {
+		return nil, errPassiveRoamingDisabled.New()
+	}
+	token, err := parseServingUplinkToken(path.GetUplinkToken())
+	if err != nil {
+		return nil, err
+	}
+	return &passiveRoamingDownlinkTarget{
+		client: ns.passiveRoamingClient,
+		bandID: func(frequencyPlanID string) (string, error) {
+			fps, err := ns.FrequencyPlansStore(ctx)
+			if err != nil {
+				return "", err
+			}
+			fp, err := fps.GetByID(frequencyPlanID)
+			if err != nil {
+				return "", err
+			}
+			return fp.BandID, nil
+		},
+		senderID:     ns.netID(ctx),
+		senderNSID:   ns.nsID(ctx),
+		receiverID:   token.NetID,
+		receiverNSID: token.NSID,
+	}, nil
+}
+
+// transmitRoamingDownlink schedules the downlink message requested by the serving Network Server of a roaming partner
+// through the Gateway Servers of the gateways that received the uplink message.
+func (ns *NetworkServer) transmitRoamingDownlink(ctx context.Context, req *interop.XmitDataReq) error {
+	dl := req.DLMetaData
+	var fnsULToken forwardingUplinkToken
+	if err := json.Unmarshal(dl.FNSULToken, &fnsULToken); err != nil {
+		return errPassiveRoamingUplinkToken.WithCause(err)
+	}
+	phy, err := band.GetLatest(fnsULToken.BandID)
+	if err != nil {
+		return err
+	}
+
+	txReq := &ttnpb.TxRequest{
+		Class:    ttnpb.Class_CLASS_A,
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+		Rx1Delay: ttnpb.RxDelay(dl.RXDelay1),
+	}
+	for class, mode := range classModes {
+		if mode == dl.ClassMode {
+			txReq.Class = class
+		}
+	}
+	if dl.HiPriorityFlag {
+		txReq.Priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	for _, rx := range []struct {
+		dlFreq    *float64
+		drIdx     *uint8
+		dataRate  **ttnpb.DataRate
+		frequency *uint64
+	}{
+		{dl.DLFreq1, dl.DataRate1, &txReq.Rx1DataRate, &txReq.Rx1Frequency},
+		{dl.DLFreq2, dl.DataRate2, &txReq.Rx2DataRate, &txReq.Rx2Frequency},
+	} {
+		if rx.dlFreq == nil || rx.drIdx == nil {
+			continue
+		}
+		dr, ok := phy.DataRates[ttnpb.DataRateIndex(*rx.drIdx)]
+		if !ok {
+			return errDataRateIndexNotFound.WithAttributes("index", *rx.drIdx)
+		}
+		*rx.dataRate, *rx.frequency = dr.Rate, hz(*rx.dlFreq)
+	}
+
+	var errs []error
+	for _, gw := range dl.GWInfo {
+		if !gw.DLAllowed || len(gw.ULToken) == 0 {
+			continue
+		}
+		var token ttnpb.UplinkToken
+		if err := proto.Unmarshal(gw.ULToken, &token); err != nil {
+			errs = append(errs, errPassiveRoamingUplinkToken.WithCause(err))
+			continue
+		}
+		ids := token.GetIds().GetGatewayIds()
+		conn, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		txReq := ttnpb.Clone(txReq)
+		txReq.DownlinkPaths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: gw.ULToken,
+				},
+			},
+		}
+		if _, err := ttnpb.NewNsGsClient(conn).ScheduleDownlink(ctx, &ttnpb.DownlinkMessage{
+			RawPayload: req.PHYPayload,
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: txReq,
+			},
+		}, ns.WithClusterAuth()); err != nil {
+			log.FromContext(ctx).WithError(err).Debug("Failed to schedule roaming downlink")
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return errNoPath.New()
+	}
+	return errSchedule.WithCause(errs[len(errs)-1])
+}
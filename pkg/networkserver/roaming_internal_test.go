@@ -0,0 +1,192 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestUplinkFromPRStartReq(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	lat, lon := 52.3676, 4.9041
+	recvTime := time.Unix(1700000000, 0).UTC()
+	senderNSID := interop.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	req := &interop.PRStartReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:   interop.NetID{0x00, 0x00, 0x13},
+			SenderNSID: &senderNSID,
+			ReceiverID: interop.NetID{0x00, 0x00, 0x42},
+		},
+		PHYPayload: interop.Buffer{0x40, 0x01, 0x02, 0x03, 0x04},
+		ULMetaData: interop.ULMetaData{
+			DataRate:   5,
+			ULFreq:     868.1,
+			RecvTime:   recvTime,
+			RFRegion:   "EU868",
+			FNSULToken: interop.Buffer(`{"band_id":"EU_863_870"}`),
+			GWInfo: []interop.GWInfoElement{
+				{
+					RSSI:      -42,
+					SNR:       7.5,
+					Lat:       &lat,
+					Lon:       &lon,
+					ULToken:   interop.Buffer{0x01, 0x02},
+					DLAllowed: true,
+				},
+				{
+					RSSI: -100,
+					SNR:  -2,
+				},
+			},
+		},
+	}
+
+	up, err := uplinkFromPRStartReq(req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up.RawPayload, should.Resemble, []byte(req.PHYPayload))
+	a.So(up.Settings.Frequency, should.Equal, 868100000)
+	a.So(up.Settings.DataRate, should.Resemble, band.EU_863_870_RP2_V1_0_4.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate)
+	if !a.So(up.RxMetadata, should.HaveLength, 2) {
+		t.FailNow()
+	}
+
+	md := up.RxMetadata[0]
+	a.So(md.GatewayIds, should.Resemble, passiveRoamingGatewayIdentifiers)
+	a.So(md.Rssi, should.Equal, -42)
+	a.So(md.Snr, should.Equal, 7.5)
+	a.So(md.ReceivedAt.AsTime(), should.Equal, recvTime)
+	a.So(md.Location.GetLatitude(), should.Equal, lat)
+	a.So(md.Location.GetLongitude(), should.Equal, lon)
+	token, err := parseServingUplinkToken(md.UplinkToken)
+	if a.So(err, should.BeNil) {
+		a.So(token, should.Resemble, &servingUplinkToken{
+			NetID:      types.NetID{0x00, 0x00, 0x13},
+			NSID:       (*types.EUI64)(&senderNSID),
+			ULToken:    []byte{0x01, 0x02},
+			FNSULToken: []byte(`{"band_id":"EU_863_870"}`),
+		})
+	}
+
+	md = up.RxMetadata[1]
+	a.So(md.UplinkToken, should.BeEmpty)
+	a.So(md.DownlinkPathConstraint, should.Equal, ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER)
+
+	req.ULMetaData.RFRegion = "Unknown"
+	_, err = uplinkFromPRStartReq(req)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+type mockPassiveRoamingClient struct {
+	xmitDataReqs []*interop.TTIXmitDataReq
+}
+
+func (*mockPassiveRoamingClient) HasNetworkServer(types.NetID) bool {
+	return true
+}
+
+func (*mockPassiveRoamingClient) PRStartRequest(context.Context, *interop.PRStartReq) (*interop.PRStartAns, error) {
+	return &interop.PRStartAns{}, nil
+}
+
+func (c *mockPassiveRoamingClient) XmitDataRequest(
+	_ context.Context, req *interop.TTIXmitDataReq,
+) (*interop.XmitDataAns, error) {
+	c.xmitDataReqs = append(c.xmitDataReqs, req)
+	return &interop.XmitDataAns{}, nil
+}
+
+func TestPassiveRoamingDownlinkTarget(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	client := &mockPassiveRoamingClient{}
+	target := &passiveRoamingDownlinkTarget{
+		client: client,
+		bandID: func(string) (string, error) {
+			return band.EU_863_870, nil
+		},
+		senderID:   types.NetID{0x00, 0x00, 0x42},
+		receiverID: types.NetID{0x00, 0x00, 0x13},
+	}
+	a.So(target.Equal(&passiveRoamingDownlinkTarget{receiverID: types.NetID{0x00, 0x00, 0x13}}), should.BeTrue)
+	a.So(target.Equal(&passiveRoamingDownlinkTarget{receiverID: types.NetID{0x00, 0x00, 0x14}}), should.BeFalse)
+
+	uplinkToken := []byte(`{"net_id":"000013","ul_token":"AQI=","fns_ul_token":"eyJiYW5kX2lkIjoiRVVfODYzXzg3MCJ9"}`)
+	phy := band.EU_863_870_RP2_V1_0_4
+	res, err := target.Schedule(ctx, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class: ttnpb.Class_CLASS_A,
+				DownlinkPaths: []*ttnpb.DownlinkPath{
+					{
+						Path: &ttnpb.DownlinkPath_UplinkToken{
+							UplinkToken: uplinkToken,
+						},
+					},
+				},
+				Rx1Delay:        ttnpb.RxDelay_RX_DELAY_1,
+				Rx1DataRate:     phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+				Rx1Frequency:    868100000,
+				Rx2DataRate:     phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+				Rx2Frequency:    869525000,
+				Priority:        ttnpb.TxSchedulePriority_HIGHEST,
+				FrequencyPlanId: test.EUFrequencyPlanID,
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.DownlinkPath.GetFixed().GetGatewayIds(), should.Resemble, passiveRoamingGatewayIdentifiers)
+	if !a.So(client.xmitDataReqs, should.HaveLength, 1) {
+		t.FailNow()
+	}
+
+	req := client.xmitDataReqs[0]
+	a.So(req.SenderID, should.Equal, interop.NetID{0x00, 0x00, 0x42})
+	a.So(req.ReceiverID, should.Equal, interop.NetID{0x00, 0x00, 0x13})
+	a.So(req.PHYPayload, should.Resemble, interop.Buffer{0x60, 0x01, 0x02, 0x03, 0x04})
+	dl := req.DLMetaData
+	a.So(dl.ClassMode, should.Equal, "A")
+	a.So(dl.RXDelay1, should.Equal, 1)
+	a.So(dl.HiPriorityFlag, should.BeTrue)
+	a.So(*dl.DLFreq1, should.Equal, 868.1)
+	a.So(*dl.DataRate1, should.Equal, 5)
+	a.So(*dl.DLFreq2, should.Equal, 869.525)
+	a.So(*dl.DataRate2, should.Equal, 0)
+	a.So(dl.FNSULToken, should.Resemble, interop.Buffer(`{"band_id":"EU_863_870"}`))
+	a.So(dl.GWInfo, should.Resemble, []interop.GWInfoElement{
+		{
+			ULToken:   interop.Buffer{0x01, 0x02},
+			DLAllowed: true,
+		},
+	})
+}
@@ -35,13 +35,49 @@ type FrontendConfig struct {
 	StatusPage             string `json:"status_page_base_url" name:"status-page-base-url" description:"The base URL for generating status page links"`
 	Language               string `json:"language" name:"-"`
 	StackConfig            `json:"stack_config" name:",squash"`
-	EnableUserRegistration bool   `json:"enable_user_registration" name:"-"`
-	ConsoleURL             string `json:"console_url" name:"console-url" description:"The URL that points to the root of the Console"`
+	EnableUserRegistration bool           `json:"enable_user_registration" name:"-"`
+	ConsoleURL             string         `json:"console_url" name:"console-url" description:"The URL that points to the root of the Console"`
+	OIDCProviders          []OIDCProvider `json:"oidc_providers,omitempty" name:"-"`
+}
+
+// OIDCProvider is an OpenID Connect provider that users can log in with.
+type OIDCProvider struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// OIDCUserRegistrationConfig is the user registration configuration of the Identity Server that applies to users
+// that log in with an OpenID Connect provider for the first time.
+type OIDCUserRegistrationConfig struct {
+	Enabled               bool
+	InvitationRequired    bool
+	AdminApprovalRequired bool
+}
+
+// OIDCConfig is the configuration for logging in with external OpenID Connect providers.
+// The providers are configured by provider ID.
+type OIDCConfig struct {
+	Issuers          map[string]string          `name:"issuers" description:"Issuer URL of the OpenID Connect providers by provider ID"`
+	Names            map[string]string          `name:"names" description:"Display name of the OpenID Connect providers by provider ID"`
+	ClientIDs        map[string]string          `name:"client-ids" description:"OAuth client ID at the OpenID Connect providers by provider ID"`
+	ClientSecrets    map[string]string          `name:"client-secrets" description:"OAuth client secret at the OpenID Connect providers by provider ID"`
+	Scopes           map[string]string          `name:"scopes" description:"Space separated scopes to request from the OpenID Connect providers by provider ID"`
+	Provision        bool                       `name:"provision" description:"Create users that log in with an OpenID Connect provider for the first time, if user registration is enabled"`
+	UserRegistration OIDCUserRegistrationConfig `name:"-"`
+}
+
+// MFAConfig is the multi-factor authentication configuration of the Identity Server.
+type MFAConfig struct {
+	Issuer           string
+	EncryptionKeyID  string
+	RequireForAdmins bool
 }
 
 // Config is the configuration for the OAuth server.
 type Config struct {
-	Mount       string   `name:"mount" description:"Path on the server where the Account application and OAuth services will be served"`
-	UI          UIConfig `name:"ui"`
-	CSRFAuthKey []byte   `name:"-"`
+	Mount       string     `name:"mount" description:"Path on the server where the Account application and OAuth services will be served"`
+	UI          UIConfig   `name:"ui"`
+	CSRFAuthKey []byte     `name:"-"`
+	OIDC        OIDCConfig `name:"oidc"`
+	MFA         MFAConfig  `name:"-"`
 }
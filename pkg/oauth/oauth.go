@@ -71,8 +71,34 @@ var (
 	errClientNotApproved  = errors.DefinePermissionDenied("client_not_approved", "OAuth client was not approved")
 	errClientRejected     = errors.DefinePermissionDenied("client_rejected", "OAuth client was rejected")
 	errClientSuspended    = errors.DefinePermissionDenied("client_suspended", "OAuth client was suspended")
+	errAdminMFARequired   = errors.DefinePermissionDenied(
+		"admin_mfa_required", "admins must enable multi-factor authentication in the Account app",
+	)
 )
 
+// requireAdminMFA returns an error if admins are required to enable multi-factor authentication,
+// and the user is an admin that did not enable it.
+func (s *server) requireAdminMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	if !s.configFromContext(ctx).MFA.RequireForAdmins {
+		return nil
+	}
+	user, err := s.store.GetUser(ctx, userIDs, []string{"admin"})
+	if err != nil {
+		return err
+	}
+	if !user.Admin {
+		return nil
+	}
+	enabled, err := s.session.MFAEnabled(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return errAdminMFARequired.New()
+	}
+	return nil
+}
+
 func (s *server) Authorize(authorizePage http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		r, session, err := s.session.Get(w, r)
@@ -80,6 +106,10 @@ func (s *server) Authorize(authorizePage http.Handler) http.HandlerFunc {
 			webhandlers.Error(w, r, err)
 			return
 		}
+		if err := s.requireAdminMFA(r.Context(), session.GetUserIds()); err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
 		oauth2 := s.oauth2(r.Context())
 		resp := oauth2.NewResponse()
 		defer resp.Close()
@@ -284,7 +314,11 @@ func (s *server) Token(w http.ResponseWriter, r *http.Request) {
 		ar.Authorized = clientHasGrant(client, ttnpb.GrantType_GRANT_REFRESH_TOKEN)
 	case osin.PASSWORD:
 		if clientHasGrant(client, ttnpb.GrantType_GRANT_PASSWORD) {
-			if err := s.session.DoLogin(r.Context(), ar.Username, ar.Password); err != nil {
+			if err := s.session.DoLogin(r.Context(), ar.Username, ar.Password, ""); err != nil {
+				webhandlers.Error(w, r, err)
+				return
+			}
+			if err := s.requireAdminMFA(r.Context(), &ttnpb.UserIdentifiers{UserId: ar.Username}); err != nil {
 				webhandlers.Error(w, r, err)
 				return
 			}
@@ -34,6 +34,20 @@ var (
 		events.WithAuthFromContext(),
 		events.WithClientInfoFromContext(),
 	)
+	// EvtUserMFAEnable indicates that a user enabled multi-factor authentication.
+	EvtUserMFAEnable = events.Define(
+		"oauth.user.mfa.enable", "enable multi-factor authentication",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_ALL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	// EvtUserMFADisable indicates that a user disabled multi-factor authentication.
+	EvtUserMFADisable = events.Define(
+		"oauth.user.mfa.disable", "disable multi-factor authentication",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_ALL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
 
 	evtAuthorize = events.Define(
 		"oauth.authorize", "authorize OAuth client",
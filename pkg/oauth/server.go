@@ -69,7 +69,7 @@ func NewServer(c *component.Component, store oauth_store.TransactionalInterface,
 		c:             c,
 		config:        config,
 		store:         store,
-		session:       session.Session{Store: &sessionStore{store}},
+		session:       session.Session{Store: &sessionStore{store}, KeyService: c.KeyService()},
 		generateCSP:   cspFunc,
 		schemaDecoder: schema.NewDecoder(),
 	}
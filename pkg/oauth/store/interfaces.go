@@ -24,6 +24,7 @@ import (
 type Interface interface {
 	store.UserStore
 	store.UserSessionStore
+	store.UserMFAStore
 
 	store.ClientStore
 	store.OAuthStore
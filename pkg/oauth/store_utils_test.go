@@ -39,6 +39,7 @@ type mockStoreContents struct {
 		token             *ttnpb.OAuthAccessToken
 		previousID        string
 		tokenID           string
+		mfa               *store.UserMFA
 	}
 	res struct {
 		session           *ttnpb.UserSession
@@ -47,6 +48,7 @@ type mockStoreContents struct {
 		authorization     *ttnpb.OAuthClientAuthorization
 		authorizationCode *ttnpb.OAuthAuthorizationCode
 		accessToken       *ttnpb.OAuthAccessToken
+		mfa               *store.UserMFA
 	}
 	err struct {
 		getUser                 error
@@ -61,6 +63,7 @@ type mockStoreContents struct {
 		createAccessToken       error
 		getAccessToken          error
 		deleteAccessToken       error
+		getMFA                  error
 	}
 }
 
@@ -88,6 +91,24 @@ func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fiel
 	return s.res.user, s.err.getUser
 }
 
+func (s *mockStore) GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*store.UserMFA, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "GetUserMFA")
+	return s.res.mfa, s.err.getMFA
+}
+
+func (s *mockStore) SetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers, mfa *store.UserMFA) (*store.UserMFA, error) {
+	s.req.ctx, s.req.userIDs, s.req.mfa = ctx, userIDs, mfa
+	s.calls = append(s.calls, "SetUserMFA")
+	return mfa, nil
+}
+
+func (s *mockStore) DeleteUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "DeleteUserMFA")
+	return nil
+}
+
 func (s *mockStore) GetSession(ctx context.Context, userIDs *ttnpb.UserIdentifiers, sessionID string) (*ttnpb.UserSession, error) {
 	s.req.ctx, s.req.userIDs, s.req.sessionID = ctx, userIDs, sessionID
 	s.calls = append(s.calls, "GetSession")
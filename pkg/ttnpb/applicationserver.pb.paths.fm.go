@@ -63,6 +63,8 @@ var ApplicationLinkStatsFieldPathsTopLevel = []string{
 var AsConfigurationFieldPathsNested = []string{
 	"pubsub",
 	"pubsub.providers",
+	"pubsub.providers.aws_iot",
+	"pubsub.providers.kafka",
 	"pubsub.providers.mqtt",
 	"pubsub.providers.nats",
 	"webhooks",
@@ -80,6 +82,8 @@ var GetAsConfigurationResponseFieldPathsNested = []string{
 	"configuration",
 	"configuration.pubsub",
 	"configuration.pubsub.providers",
+	"configuration.pubsub.providers.aws_iot",
+	"configuration.pubsub.providers.kafka",
 	"configuration.pubsub.providers.mqtt",
 	"configuration.pubsub.providers.nats",
 	"configuration.webhooks",
@@ -366,6 +370,8 @@ var DecodeDownlinkResponseFieldPathsTopLevel = []string{
 }
 var AsConfiguration_PubSubFieldPathsNested = []string{
 	"providers",
+	"providers.aws_iot",
+	"providers.kafka",
 	"providers.mqtt",
 	"providers.nats",
 }
@@ -383,11 +389,15 @@ var AsConfiguration_WebhooksFieldPathsTopLevel = []string{
 	"unhealthy_retry_interval",
 }
 var AsConfiguration_PubSub_ProvidersFieldPathsNested = []string{
+	"aws_iot",
+	"kafka",
 	"mqtt",
 	"nats",
 }
 
 var AsConfiguration_PubSub_ProvidersFieldPathsTopLevel = []string{
+	"aws_iot",
+	"kafka",
 	"mqtt",
 	"nats",
 }
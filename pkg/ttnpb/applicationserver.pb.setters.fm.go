@@ -866,6 +866,24 @@ func (dst *AsConfiguration_PubSub_Providers) SetFields(src *AsConfiguration_PubS
 			} else {
 				dst.Nats = 0
 			}
+		case "kafka":
+			if len(subs) > 0 {
+				return fmt.Errorf("'kafka' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Kafka = src.Kafka
+			} else {
+				dst.Kafka = 0
+			}
+		case "aws_iot":
+			if len(subs) > 0 {
+				return fmt.Errorf("'aws_iot' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AwsIot = src.AwsIot
+			} else {
+				dst.AwsIot = 0
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
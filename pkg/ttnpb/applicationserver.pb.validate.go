@@ -1794,6 +1794,10 @@ func (m *AsConfiguration_PubSub_Providers) ValidateFields(paths ...string) error
 			// no validation rules for Mqtt
 		case "nats":
 			// no validation rules for Nats
+		case "kafka":
+			// no validation rules for Kafka
+		case "aws_iot":
+			// no validation rules for AwsIot
 		default:
 			return AsConfiguration_PubSub_ProvidersValidationError{
 				field:  name,
@@ -288,6 +288,16 @@ func (x *AsConfiguration_PubSub_Providers) MarshalProtoJSON(s *jsonplugin.Marsha
 		s.WriteObjectField("nats")
 		x.Nats.MarshalProtoJSON(s)
 	}
+	if x.Kafka != 0 || s.HasField("kafka") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("kafka")
+		x.Kafka.MarshalProtoJSON(s)
+	}
+	if x.AwsIot != 0 || s.HasField("aws_iot") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("aws_iot")
+		x.AwsIot.MarshalProtoJSON(s)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -311,6 +321,12 @@ func (x *AsConfiguration_PubSub_Providers) UnmarshalProtoJSON(s *jsonplugin.Unma
 		case "nats":
 			s.AddField("nats")
 			x.Nats.UnmarshalProtoJSON(s)
+		case "kafka":
+			s.AddField("kafka")
+			x.Kafka.UnmarshalProtoJSON(s)
+		case "aws_iot", "awsIot":
+			s.AddField("aws_iot")
+			x.AwsIot.UnmarshalProtoJSON(s)
 		}
 	})
 }
@@ -92,6 +92,55 @@ func (ApplicationPubSub_MQTTProvider_QoS) EnumDescriptor() ([]byte, []int) {
 	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescGZIP(), []int{1, 1, 0}
 }
 
+type ApplicationPubSub_KafkaProvider_SASL_Mechanism int32
+
+const (
+	ApplicationPubSub_KafkaProvider_SASL_PLAIN         ApplicationPubSub_KafkaProvider_SASL_Mechanism = 0
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 1
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 2
+)
+
+// Enum value maps for ApplicationPubSub_KafkaProvider_SASL_Mechanism.
+var (
+	ApplicationPubSub_KafkaProvider_SASL_Mechanism_name = map[int32]string{
+		0: "PLAIN",
+		1: "SCRAM_SHA_256",
+		2: "SCRAM_SHA_512",
+	}
+	ApplicationPubSub_KafkaProvider_SASL_Mechanism_value = map[string]int32{
+		"PLAIN":         0,
+		"SCRAM_SHA_256": 1,
+		"SCRAM_SHA_512": 2,
+	}
+)
+
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) Enum() *ApplicationPubSub_KafkaProvider_SASL_Mechanism {
+	p := new(ApplicationPubSub_KafkaProvider_SASL_Mechanism)
+	*p = x
+	return p
+}
+
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationPubSub_KafkaProvider_SASL_Mechanism) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_enumTypes[1].Descriptor()
+}
+
+func (ApplicationPubSub_KafkaProvider_SASL_Mechanism) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_applicationserver_pubsub_proto_enumTypes[1]
+}
+
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationPubSub_KafkaProvider_SASL_Mechanism.Descriptor instead.
+func (ApplicationPubSub_KafkaProvider_SASL_Mechanism) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescGZIP(), []int{1, 3, 0, 0}
+}
+
 type ApplicationPubSubIdentifiers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -164,6 +213,7 @@ type ApplicationPubSub struct {
 	//	*ApplicationPubSub_Nats
 	//	*ApplicationPubSub_Mqtt
 	//	*ApplicationPubSub_AwsIot
+	//	*ApplicationPubSub_Kafka
 	Provider isApplicationPubSub_Provider `protobuf_oneof:"provider"`
 	// Base topic name to which the messages topic is appended.
 	BaseTopic string `protobuf:"bytes,6,opt,name=base_topic,json=baseTopic,proto3" json:"base_topic,omitempty"`
@@ -272,6 +322,13 @@ func (x *ApplicationPubSub) GetAwsIot() *ApplicationPubSub_AWSIoTProvider {
 	return nil
 }
 
+func (x *ApplicationPubSub) GetKafka() *ApplicationPubSub_KafkaProvider {
+	if x, ok := x.GetProvider().(*ApplicationPubSub_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
 func (x *ApplicationPubSub) GetBaseTopic() string {
 	if x != nil {
 		return x.BaseTopic
@@ -386,12 +443,18 @@ type ApplicationPubSub_AwsIot struct {
 	AwsIot *ApplicationPubSub_AWSIoTProvider `protobuf:"bytes,101,opt,name=aws_iot,json=awsIot,proto3,oneof"`
 }
 
+type ApplicationPubSub_Kafka struct {
+	Kafka *ApplicationPubSub_KafkaProvider `protobuf:"bytes,21,opt,name=kafka,proto3,oneof"`
+}
+
 func (*ApplicationPubSub_Nats) isApplicationPubSub_Provider() {}
 
 func (*ApplicationPubSub_Mqtt) isApplicationPubSub_Provider() {}
 
 func (*ApplicationPubSub_AwsIot) isApplicationPubSub_Provider() {}
 
+func (*ApplicationPubSub_Kafka) isApplicationPubSub_Provider() {}
+
 type ApplicationPubSubs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -937,6 +1000,118 @@ type ApplicationPubSub_AWSIoTProvider_Default struct {
 
 func (*ApplicationPubSub_AWSIoTProvider_Default) isApplicationPubSub_AWSIoTProvider_Deployment() {}
 
+// The Kafka provider settings.
+type ApplicationPubSub_KafkaProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The addresses of the bootstrap brokers.
+	Brokers []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	// The client ID used in the requests to the brokers.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The consumer group ID used to consume the downlink queue operations.
+	// Required if the downlink push or replace topics are set.
+	GroupId string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UseTls  bool   `protobuf:"varint,4,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TlsCa []byte `protobuf:"bytes,5,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TlsClientCert []byte `protobuf:"bytes,6,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TlsClientKey []byte `protobuf:"bytes,7,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// If set, the client authenticates to the brokers using SASL.
+	Sasl *ApplicationPubSub_KafkaProvider_SASL `protobuf:"bytes,8,opt,name=sasl,proto3" json:"sasl,omitempty"`
+}
+
+func (x *ApplicationPubSub_KafkaProvider) Reset() {
+	*x = ApplicationPubSub_KafkaProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationPubSub_KafkaProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationPubSub_KafkaProvider) ProtoMessage() {}
+
+func (x *ApplicationPubSub_KafkaProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationPubSub_KafkaProvider.ProtoReflect.Descriptor instead.
+func (*ApplicationPubSub_KafkaProvider) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetTlsCa() []byte {
+	if x != nil {
+		return x.TlsCa
+	}
+	return nil
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetTlsClientCert() []byte {
+	if x != nil {
+		return x.TlsClientCert
+	}
+	return nil
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetTlsClientKey() []byte {
+	if x != nil {
+		return x.TlsClientKey
+	}
+	return nil
+}
+
+func (x *ApplicationPubSub_KafkaProvider) GetSasl() *ApplicationPubSub_KafkaProvider_SASL {
+	if x != nil {
+		return x.Sasl
+	}
+	return nil
+}
+
 type ApplicationPubSub_Message struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -949,7 +1124,7 @@ type ApplicationPubSub_Message struct {
 func (x *ApplicationPubSub_Message) Reset() {
 	*x = ApplicationPubSub_Message{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[10]
+		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -962,7 +1137,7 @@ func (x *ApplicationPubSub_Message) String() string {
 func (*ApplicationPubSub_Message) ProtoMessage() {}
 
 func (x *ApplicationPubSub_Message) ProtoReflect() protoreflect.Message {
-	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[10]
+	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1150,7 @@ func (x *ApplicationPubSub_Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplicationPubSub_Message.ProtoReflect.Descriptor instead.
 func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
-	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescGZIP(), []int{1, 3}
+	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescGZIP(), []int{1, 4}
 }
 
 func (x *ApplicationPubSub_Message) GetTopic() string {
@@ -998,7 +1173,7 @@ type ApplicationPubSub_AWSIoTProvider_AccessKey struct {
 func (x *ApplicationPubSub_AWSIoTProvider_AccessKey) Reset() {
 	*x = ApplicationPubSub_AWSIoTProvider_AccessKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[12]
+		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1011,7 +1186,7 @@ func (x *ApplicationPubSub_AWSIoTProvider_AccessKey) String() string {
 func (*ApplicationPubSub_AWSIoTProvider_AccessKey) ProtoMessage() {}
 
 func (x *ApplicationPubSub_AWSIoTProvider_AccessKey) ProtoReflect() protoreflect.Message {
-	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[12]
+	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,7 +1236,7 @@ type ApplicationPubSub_AWSIoTProvider_AssumeRole struct {
 func (x *ApplicationPubSub_AWSIoTProvider_AssumeRole) Reset() {
 	*x = ApplicationPubSub_AWSIoTProvider_AssumeRole{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[13]
+		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1074,7 +1249,7 @@ func (x *ApplicationPubSub_AWSIoTProvider_AssumeRole) String() string {
 func (*ApplicationPubSub_AWSIoTProvider_AssumeRole) ProtoMessage() {}
 
 func (x *ApplicationPubSub_AWSIoTProvider_AssumeRole) ProtoReflect() protoreflect.Message {
-	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[13]
+	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,7 +1298,7 @@ type ApplicationPubSub_AWSIoTProvider_DefaultIntegration struct {
 func (x *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Reset() {
 	*x = ApplicationPubSub_AWSIoTProvider_DefaultIntegration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[14]
+		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1136,7 +1311,7 @@ func (x *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) String() string {
 func (*ApplicationPubSub_AWSIoTProvider_DefaultIntegration) ProtoMessage() {}
 
 func (x *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[14]
+	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,6 +1334,69 @@ func (x *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) GetStackName() str
 	return ""
 }
 
+type ApplicationPubSub_KafkaProvider_SASL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mechanism ApplicationPubSub_KafkaProvider_SASL_Mechanism `protobuf:"varint,1,opt,name=mechanism,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism" json:"mechanism,omitempty"`
+	Username  string                                         `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string                                         `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ApplicationPubSub_KafkaProvider_SASL) Reset() {
+	*x = ApplicationPubSub_KafkaProvider_SASL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationPubSub_KafkaProvider_SASL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationPubSub_KafkaProvider_SASL) ProtoMessage() {}
+
+func (x *ApplicationPubSub_KafkaProvider_SASL) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationPubSub_KafkaProvider_SASL.ProtoReflect.Descriptor instead.
+func (*ApplicationPubSub_KafkaProvider_SASL) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescGZIP(), []int{1, 3, 0}
+}
+
+func (x *ApplicationPubSub_KafkaProvider_SASL) GetMechanism() ApplicationPubSub_KafkaProvider_SASL_Mechanism {
+	if x != nil {
+		return x.Mechanism
+	}
+	return ApplicationPubSub_KafkaProvider_SASL_PLAIN
+}
+
+func (x *ApplicationPubSub_KafkaProvider_SASL) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ApplicationPubSub_KafkaProvider_SASL) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 var File_ttn_lorawan_v3_applicationserver_pubsub_proto protoreflect.FileDescriptor
 
 var file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDesc = []byte{
@@ -1199,7 +1437,7 @@ var file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDesc = []byte{
 	0x42, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
 	0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
 	0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x52, 0x08, 0x70, 0x75, 0x62, 0x53, 0x75, 0x62, 0x49, 0x64,
-	0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x00, 0x10, 0x01, 0x22, 0xc1, 0x21, 0x0a, 0x11, 0x41,
+	0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x00, 0x10, 0x01, 0x22, 0x81, 0x2b, 0x0a, 0x11, 0x41,
 	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62,
 	0x12, 0x50, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
 	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41,
@@ -1232,237 +1470,313 @@ var file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDesc = []byte{
 	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c,
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x41, 0x57,
 	0x53, 0x49, 0x6f, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06,
-	0x61, 0x77, 0x73, 0x49, 0x6f, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x18, 0x64, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x4e,
-	0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x61, 0x77, 0x73, 0x49, 0x6f, 0x74, 0x12, 0x47, 0x0a, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x12,
+	0x26, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x09, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x4e, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x50, 0x75, 0x73, 0x68, 0x12, 0x54, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75,
+	0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x50, 0x0a,
+	0x0e, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
 	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x75, 0x73, 0x68, 0x12, 0x54,
-	0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x52, 0x0d, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x56, 0x0a, 0x11, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x10, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x12, 0x4c, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x61, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x63,
+	0x6b, 0x12, 0x4e, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61,
+	0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
 	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74,
+	0x61, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x63,
+	0x6b, 0x12, 0x4e, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x65,
+	0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x65, 0x6e,
+	0x74, 0x12, 0x52, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x67, 0x0a, 0x1a, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x18, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x52, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74,
 	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70,
 	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
-	0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
-	0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75,
-	0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x10, 0x75, 0x70,
-	0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x4a,
-	0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
-	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a,
-	0x6a, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x4c, 0x0a, 0x0c, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62,
-	0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x64, 0x6f, 0x77,
-	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53,
-	0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53,
-	0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
-	0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75,
-	0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x0f,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
-	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
-	0x12, 0x67, 0x0a, 0x1a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
-	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x18, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x0f, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
-	0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x4c, 0x0a,
-	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
-	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x41, 0x0a, 0x0c, 0x4e,
-	0x41, 0x54, 0x53, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0a, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x55, 0x72, 0x6c, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x1a, 0xb4,
-	0x09, 0x0a, 0x0c, 0x4d, 0x51, 0x54, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x27, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x52, 0x09, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x18, 0x17, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x57, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x71, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x1a, 0x41, 0x0a, 0x0c, 0x4e, 0x41, 0x54, 0x53, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88,
+	0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x3a, 0x08, 0xf2,
+	0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x1a, 0xb4, 0x09, 0x0a, 0x0c, 0x4d, 0x51, 0x54, 0x54,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72,
+	0x6c, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x17, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x18, 0x64, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x57, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x71,
+	0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x51, 0x54, 0x54,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x51, 0x6f, 0x53, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x6f, 0x73, 0x12, 0x53, 0x0a, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x32, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
 	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53,
 	0x75, 0x62, 0x2e, 0x4d, 0x51, 0x54, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
-	0x51, 0x6f, 0x53, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x51, 0x6f,
-	0x73, 0x12, 0x53, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x6f, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
-	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x51, 0x54, 0x54, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x51, 0x6f, 0x53, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x51, 0x6f, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x6c,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x12,
-	0xbe, 0x01, 0x0a, 0x06, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0xa6, 0x01, 0xfa, 0x42, 0x05, 0x7a, 0x03, 0x18, 0x80, 0x40, 0xf2, 0xaa, 0x19, 0x99, 0x01,
-	0x1a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65,
-	0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x2e, 0x4e, 0x65, 0x77, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67,
-	0x22, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65,
-	0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x05, 0x74, 0x6c, 0x73, 0x43, 0x61,
-	0x12, 0xcf, 0x01, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x63, 0x65, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xa6, 0x01, 0xfa, 0x42, 0x05,
-	0x7a, 0x03, 0x18, 0x80, 0x40, 0xf2, 0xaa, 0x19, 0x99, 0x01, 0x1a, 0x4e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73,
-	0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x48, 0x65,
-	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x47, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73,
-	0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65,
-	0x72, 0x74, 0x12, 0xcd, 0x01, 0x0a, 0x0e, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xa6, 0x01, 0xfa, 0x42,
-	0x05, 0x7a, 0x03, 0x18, 0x80, 0x40, 0xf2, 0xaa, 0x19, 0x99, 0x01, 0x1a, 0x4e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67,
-	0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x48,
-	0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x47, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67,
-	0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x52, 0x0c, 0x74, 0x6c, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b,
-	0x65, 0x79, 0x12, 0x55, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x51, 0x6f, 0x53, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x51, 0x6f, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x06, 0x74, 0x6c, 0x73,
+	0x5f, 0x63, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xa6, 0x01, 0xfa, 0x42, 0x05, 0x7a,
+	0x03, 0x18, 0x80, 0x40, 0xf2, 0xaa, 0x19, 0x99, 0x01, 0x1a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49,
+	0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x48, 0x65, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49,
+	0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x05, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x12, 0xcf, 0x01, 0x0a, 0x0f, 0x74, 0x6c,
+	0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0xa6, 0x01, 0xfa, 0x42, 0x05, 0x7a, 0x03, 0x18, 0x80, 0x40, 0xf2, 0xaa,
+	0x19, 0x99, 0x01, 0x1a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67,
+	0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46,
+	0x6c, 0x61, 0x67, 0x22, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67,
+	0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x74, 0x6c,
+	0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0xcd, 0x01, 0x0a, 0x0e,
+	0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0xa6, 0x01, 0xfa, 0x42, 0x05, 0x7a, 0x03, 0x18, 0x80, 0x40, 0xf2,
+	0xaa, 0x19, 0x99, 0x01, 0x1a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x46, 0x6c, 0x61, 0x67, 0x22, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x0c, 0x74,
+	0x6c, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x55, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e,
+	0x4d, 0x51, 0x54, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44,
+	0x0a, 0x03, 0x51, 0x6f, 0x53, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x53, 0x54,
+	0x5f, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x54, 0x5f, 0x4c, 0x45,
+	0x41, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x58,
+	0x41, 0x43, 0x54, 0x4c, 0x59, 0x5f, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x1a, 0x06, 0xea, 0xaa,
+	0x19, 0x02, 0x18, 0x01, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x1a, 0x87,
+	0x0a, 0x0a, 0x0e, 0x41, 0x57, 0x53, 0x49, 0x6f, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x9b, 0x02, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x82, 0x02, 0xfa, 0x42, 0xfe, 0x01, 0x72, 0xfb, 0x01, 0x52, 0x0a, 0x61, 0x66,
+	0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x2d, 0x31, 0x52, 0x09, 0x61, 0x70, 0x2d, 0x65, 0x61, 0x73,
+	0x74, 0x2d, 0x31, 0x52, 0x0e, 0x61, 0x70, 0x2d, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73,
+	0x74, 0x2d, 0x31, 0x52, 0x0e, 0x61, 0x70, 0x2d, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73,
+	0x74, 0x2d, 0x32, 0x52, 0x0a, 0x61, 0x70, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x2d, 0x31, 0x52,
+	0x0e, 0x61, 0x70, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x31, 0x52,
+	0x0e, 0x61, 0x70, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x32, 0x52,
+	0x0c, 0x63, 0x61, 0x2d, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x2d, 0x31, 0x52, 0x0c, 0x65,
+	0x75, 0x2d, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x2d, 0x31, 0x52, 0x0a, 0x65, 0x75, 0x2d,
+	0x6e, 0x6f, 0x72, 0x74, 0x68, 0x2d, 0x31, 0x52, 0x0a, 0x65, 0x75, 0x2d, 0x73, 0x6f, 0x75, 0x74,
+	0x68, 0x2d, 0x31, 0x52, 0x09, 0x65, 0x75, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x09,
+	0x65, 0x75, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x2d, 0x32, 0x52, 0x09, 0x65, 0x75, 0x2d, 0x77, 0x65,
+	0x73, 0x74, 0x2d, 0x33, 0x52, 0x0a, 0x6d, 0x65, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x2d, 0x31,
+	0x52, 0x09, 0x73, 0x61, 0x2d, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x09, 0x75, 0x73, 0x2d,
+	0x65, 0x61, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x09, 0x75, 0x73, 0x2d, 0x65, 0x61, 0x73, 0x74, 0x2d,
+	0x32, 0x52, 0x09, 0x75, 0x73, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x09, 0x75, 0x73,
+	0x2d, 0x77, 0x65, 0x73, 0x74, 0x2d, 0x32, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
+	0x59, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
 	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4d, 0x51, 0x54, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x03, 0x51, 0x6f, 0x53, 0x12, 0x10, 0x0a, 0x0c,
-	0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x00, 0x12, 0x11,
-	0x0a, 0x0d, 0x41, 0x54, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x43, 0x45, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x58, 0x41, 0x43, 0x54, 0x4c, 0x59, 0x5f, 0x4f, 0x4e, 0x43,
-	0x45, 0x10, 0x02, 0x1a, 0x06, 0xea, 0xaa, 0x19, 0x02, 0x18, 0x01, 0x3a, 0x08, 0xf2, 0xaa, 0x19,
-	0x04, 0x08, 0x01, 0x10, 0x01, 0x1a, 0x87, 0x0a, 0x0a, 0x0e, 0x41, 0x57, 0x53, 0x49, 0x6f, 0x54,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x9b, 0x02, 0x0a, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x82, 0x02, 0xfa, 0x42, 0xfe, 0x01,
-	0x72, 0xfb, 0x01, 0x52, 0x0a, 0x61, 0x66, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x2d, 0x31, 0x52,
-	0x09, 0x61, 0x70, 0x2d, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x0e, 0x61, 0x70, 0x2d, 0x6e,
-	0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x0e, 0x61, 0x70, 0x2d, 0x6e,
-	0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x32, 0x52, 0x0a, 0x61, 0x70, 0x2d, 0x73,
-	0x6f, 0x75, 0x74, 0x68, 0x2d, 0x31, 0x52, 0x0e, 0x61, 0x70, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68,
-	0x65, 0x61, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x0e, 0x61, 0x70, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68,
-	0x65, 0x61, 0x73, 0x74, 0x2d, 0x32, 0x52, 0x0c, 0x63, 0x61, 0x2d, 0x63, 0x65, 0x6e, 0x74, 0x72,
-	0x61, 0x6c, 0x2d, 0x31, 0x52, 0x0c, 0x65, 0x75, 0x2d, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c,
-	0x2d, 0x31, 0x52, 0x0a, 0x65, 0x75, 0x2d, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x2d, 0x31, 0x52, 0x0a,
-	0x65, 0x75, 0x2d, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x2d, 0x31, 0x52, 0x09, 0x65, 0x75, 0x2d, 0x77,
-	0x65, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x09, 0x65, 0x75, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x2d, 0x32,
-	0x52, 0x09, 0x65, 0x75, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x2d, 0x33, 0x52, 0x0a, 0x6d, 0x65, 0x2d,
-	0x73, 0x6f, 0x75, 0x74, 0x68, 0x2d, 0x31, 0x52, 0x09, 0x73, 0x61, 0x2d, 0x65, 0x61, 0x73, 0x74,
-	0x2d, 0x31, 0x52, 0x09, 0x75, 0x73, 0x2d, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x31, 0x52, 0x09, 0x75,
-	0x73, 0x2d, 0x65, 0x61, 0x73, 0x74, 0x2d, 0x32, 0x52, 0x09, 0x75, 0x73, 0x2d, 0x77, 0x65, 0x73,
-	0x74, 0x2d, 0x31, 0x52, 0x09, 0x75, 0x73, 0x2d, 0x77, 0x65, 0x73, 0x74, 0x2d, 0x32, 0x52, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x74, 0x6e,
-	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x41, 0x57,
-	0x53, 0x49, 0x6f, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
-	0x79, 0x12, 0x5c, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
-	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x41, 0x57, 0x53, 0x49, 0x6f, 0x54,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0xa3, 0x01, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xfa, 0x42, 0x75, 0x72,
-	0x73, 0x18, 0x80, 0x01, 0x32, 0x6e, 0x5e, 0x28, 0x28, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
-	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5c, 0x2d, 0x5d, 0x2a, 0x5b,
-	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x5c, 0x2e, 0x29, 0x2a, 0x28,
-	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x41, 0x2d, 0x5a,
-	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
-	0x39, 0x5c, 0x2d, 0x5d, 0x2a, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
-	0x29, 0x7c, 0x29, 0x24, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x41, 0x57, 0x53, 0x49, 0x6f, 0x54, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x5c, 0x0a, 0x0b, 0x61, 0x73,
+	0x73, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53,
+	0x75, 0x62, 0x2e, 0x41, 0x57, 0x53, 0x49, 0x6f, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0a, 0x61, 0x73,
+	0x73, 0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x78, 0xfa, 0x42, 0x75, 0x72, 0x73, 0x18, 0x80, 0x01, 0x32, 0x6e, 0x5e,
+	0x28, 0x28, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7c, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5c, 0x2d, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x29, 0x5c, 0x2e, 0x29, 0x2a, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7c, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
+	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5c, 0x2d, 0x5d, 0x2a, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7c, 0x29, 0x24, 0x52, 0x0f, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5f,
+	0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x43, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53,
+	0x75, 0x62, 0x2e, 0x41, 0x57, 0x53, 0x49, 0x6f, 0x54, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x1a,
+	0xb2, 0x01, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xfa, 0x42, 0x10, 0x72, 0x0e, 0x10, 0x10, 0x18, 0x80, 0x01,
+	0x32, 0x07, 0x5e, 0x5b, 0x5c, 0x77, 0x5d, 0x2a, 0x24, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x28, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04,
+	0x08, 0x01, 0x10, 0x01, 0x1a, 0xe6, 0x01, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x03, 0x61, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x39, 0xfa, 0x42, 0x36, 0x72, 0x34, 0x32, 0x32, 0x5e, 0x61, 0x72, 0x6e, 0x3a, 0x61, 0x77,
+	0x73, 0x3a, 0x69, 0x61, 0x6d, 0x3a, 0x3a, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x32, 0x7d,
+	0x3a, 0x72, 0x6f, 0x6c, 0x65, 0x5c, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2b, 0x3d, 0x2c, 0x2e, 0x40, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x61, 0x72, 0x6e,
+	0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x18, 0xc8, 0x09, 0x32,
+	0x10, 0x5e, 0x5b, 0x5c, 0x77, 0x2b, 0x3d, 0x2c, 0x2e, 0x40, 0x3a, 0x5c, 0x2f, 0x2d, 0x5d, 0x2a,
+	0x24, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x44, 0x0a,
+	0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x1a, 0x61, 0x0a,
+	0x12, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xfa, 0x42, 0x1f, 0x72, 0x1d, 0x18, 0x80,
+	0x01, 0x32, 0x18, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5c, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01,
+	0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0xf4, 0x08, 0x0a, 0x0d, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x62, 0x72,
+	0x6f, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0xfa, 0x42, 0x0e,
+	0x92, 0x01, 0x0b, 0x08, 0x01, 0x10, 0x10, 0x22, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x07,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x18, 0x64, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x18, 0x64, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x54, 0x6c, 0x73, 0x12, 0xbe, 0x01,
+	0x0a, 0x06, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xa6,
+	0x01, 0xfa, 0x42, 0x05, 0x7a, 0x03, 0x18, 0x80, 0x40, 0xf2, 0xaa, 0x19, 0x99, 0x01, 0x1a, 0x4e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68,
+	0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4e,
+	0x65, 0x77, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x47,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68,
+	0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x05, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x12, 0xcf,
+	0x01, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xa6, 0x01, 0xfa, 0x42, 0x05, 0x7a, 0x03,
+	0x18, 0x80, 0x40, 0xf2, 0xaa, 0x19, 0x99, 0x01, 0x1a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e,
+	0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x48, 0x65, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e,
+	0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x12, 0xcd, 0x01, 0x0a, 0x0e, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xa6, 0x01, 0xfa, 0x42, 0x05, 0x7a,
+	0x03, 0x18, 0x80, 0x40, 0xf2, 0xaa, 0x19, 0x99, 0x01, 0x1a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49,
+	0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x48, 0x65, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x68, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x49,
+	0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x0c, 0x74, 0x6c, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x48, 0x0a, 0x04, 0x73, 0x61, 0x73, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e,
+	0x53, 0x41, 0x53, 0x4c, 0x52, 0x04, 0x73, 0x61, 0x73, 0x6c, 0x1a, 0x88, 0x02, 0x0a, 0x04, 0x53,
+	0x41, 0x53, 0x4c, 0x12, 0x66, 0x0a, 0x09, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
 	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x41, 0x57, 0x53, 0x49, 0x6f, 0x54,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x1a, 0xb2, 0x01, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xfa, 0x42, 0x10,
-	0x72, 0x0e, 0x10, 0x10, 0x18, 0x80, 0x01, 0x32, 0x07, 0x5e, 0x5b, 0x5c, 0x77, 0x5d, 0x2a, 0x24,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x33, 0x0a,
-	0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18,
-	0x28, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x12, 0x2d, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
-	0x18, 0x80, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x1a, 0xe6, 0x01, 0x0a, 0x0a,
-	0x41, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x03, 0x61, 0x72,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xfa, 0x42, 0x36, 0x72, 0x34, 0x32, 0x32,
-	0x5e, 0x61, 0x72, 0x6e, 0x3a, 0x61, 0x77, 0x73, 0x3a, 0x69, 0x61, 0x6d, 0x3a, 0x3a, 0x5b, 0x30,
-	0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x32, 0x7d, 0x3a, 0x72, 0x6f, 0x6c, 0x65, 0x5c, 0x2f, 0x5b, 0x41,
-	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2b, 0x3d, 0x2c, 0x2e, 0x40, 0x2d, 0x5d,
-	0x2b, 0x24, 0x52, 0x03, 0x61, 0x72, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42,
-	0x17, 0x72, 0x15, 0x18, 0xc8, 0x09, 0x32, 0x10, 0x5e, 0x5b, 0x5c, 0x77, 0x2b, 0x3d, 0x2c, 0x2e,
-	0x40, 0x3a, 0x5c, 0x2f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04,
-	0x08, 0x01, 0x10, 0x01, 0x1a, 0x61, 0x0a, 0x12, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x74,
-	0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22,
-	0xfa, 0x42, 0x1f, 0x72, 0x1d, 0x18, 0x80, 0x01, 0x32, 0x18, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
-	0x2d, 0x7a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5c, 0x2d, 0x5d,
-	0x2a, 0x24, 0x52, 0x09, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x08, 0xf2,
-	0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10,
-	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x41, 0x53, 0x4c, 0x2e, 0x4d, 0x65, 0x63,
+	0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x09, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x23, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x44, 0x0a, 0x09, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69,
+	0x73, 0x6d, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x53, 0x43, 0x52, 0x41, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x43, 0x52, 0x41, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x5f, 0x35, 0x31,
+	0x32, 0x10, 0x02, 0x1a, 0x06, 0xea, 0xaa, 0x19, 0x02, 0x18, 0x01, 0x3a, 0x08, 0xf2, 0xaa, 0x19,
+	0x04, 0x08, 0x01, 0x10, 0x01, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x01, 0x10, 0x01, 0x1a,
 	0x32, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
 	0x18, 0x64, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08,
@@ -1589,83 +1903,89 @@ func file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescGZIP() []byte {
 	return file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDescData
 }
 
-var file_ttn_lorawan_v3_applicationserver_pubsub_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_ttn_lorawan_v3_applicationserver_pubsub_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_ttn_lorawan_v3_applicationserver_pubsub_proto_goTypes = []interface{}{
 	(ApplicationPubSub_MQTTProvider_QoS)(0),                     // 0: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.QoS
-	(*ApplicationPubSubIdentifiers)(nil),                        // 1: ttn.lorawan.v3.ApplicationPubSubIdentifiers
-	(*ApplicationPubSub)(nil),                                   // 2: ttn.lorawan.v3.ApplicationPubSub
-	(*ApplicationPubSubs)(nil),                                  // 3: ttn.lorawan.v3.ApplicationPubSubs
-	(*ApplicationPubSubFormats)(nil),                            // 4: ttn.lorawan.v3.ApplicationPubSubFormats
-	(*GetApplicationPubSubRequest)(nil),                         // 5: ttn.lorawan.v3.GetApplicationPubSubRequest
-	(*ListApplicationPubSubsRequest)(nil),                       // 6: ttn.lorawan.v3.ListApplicationPubSubsRequest
-	(*SetApplicationPubSubRequest)(nil),                         // 7: ttn.lorawan.v3.SetApplicationPubSubRequest
-	(*ApplicationPubSub_NATSProvider)(nil),                      // 8: ttn.lorawan.v3.ApplicationPubSub.NATSProvider
-	(*ApplicationPubSub_MQTTProvider)(nil),                      // 9: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider
-	(*ApplicationPubSub_AWSIoTProvider)(nil),                    // 10: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider
-	(*ApplicationPubSub_Message)(nil),                           // 11: ttn.lorawan.v3.ApplicationPubSub.Message
-	nil,                                                         // 12: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.HeadersEntry
-	(*ApplicationPubSub_AWSIoTProvider_AccessKey)(nil),          // 13: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AccessKey
-	(*ApplicationPubSub_AWSIoTProvider_AssumeRole)(nil),         // 14: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole
-	(*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), // 15: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration
-	nil,                            // 16: ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry
-	(*ApplicationIdentifiers)(nil), // 17: ttn.lorawan.v3.ApplicationIdentifiers
-	(*timestamppb.Timestamp)(nil),  // 18: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),  // 19: google.protobuf.FieldMask
-	(*durationpb.Duration)(nil),    // 20: google.protobuf.Duration
-	(*emptypb.Empty)(nil),          // 21: google.protobuf.Empty
+	(ApplicationPubSub_KafkaProvider_SASL_Mechanism)(0),         // 1: ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL.Mechanism
+	(*ApplicationPubSubIdentifiers)(nil),                        // 2: ttn.lorawan.v3.ApplicationPubSubIdentifiers
+	(*ApplicationPubSub)(nil),                                   // 3: ttn.lorawan.v3.ApplicationPubSub
+	(*ApplicationPubSubs)(nil),                                  // 4: ttn.lorawan.v3.ApplicationPubSubs
+	(*ApplicationPubSubFormats)(nil),                            // 5: ttn.lorawan.v3.ApplicationPubSubFormats
+	(*GetApplicationPubSubRequest)(nil),                         // 6: ttn.lorawan.v3.GetApplicationPubSubRequest
+	(*ListApplicationPubSubsRequest)(nil),                       // 7: ttn.lorawan.v3.ListApplicationPubSubsRequest
+	(*SetApplicationPubSubRequest)(nil),                         // 8: ttn.lorawan.v3.SetApplicationPubSubRequest
+	(*ApplicationPubSub_NATSProvider)(nil),                      // 9: ttn.lorawan.v3.ApplicationPubSub.NATSProvider
+	(*ApplicationPubSub_MQTTProvider)(nil),                      // 10: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider
+	(*ApplicationPubSub_AWSIoTProvider)(nil),                    // 11: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider
+	(*ApplicationPubSub_KafkaProvider)(nil),                     // 12: ttn.lorawan.v3.ApplicationPubSub.KafkaProvider
+	(*ApplicationPubSub_Message)(nil),                           // 13: ttn.lorawan.v3.ApplicationPubSub.Message
+	nil,                                                         // 14: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.HeadersEntry
+	(*ApplicationPubSub_AWSIoTProvider_AccessKey)(nil),          // 15: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AccessKey
+	(*ApplicationPubSub_AWSIoTProvider_AssumeRole)(nil),         // 16: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole
+	(*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), // 17: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration
+	(*ApplicationPubSub_KafkaProvider_SASL)(nil),                // 18: ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL
+	nil,                            // 19: ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry
+	(*ApplicationIdentifiers)(nil), // 20: ttn.lorawan.v3.ApplicationIdentifiers
+	(*timestamppb.Timestamp)(nil),  // 21: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),  // 22: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),    // 23: google.protobuf.Duration
+	(*emptypb.Empty)(nil),          // 24: google.protobuf.Empty
 }
 var file_ttn_lorawan_v3_applicationserver_pubsub_proto_depIdxs = []int32{
-	17, // 0: ttn.lorawan.v3.ApplicationPubSubIdentifiers.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
-	1,  // 1: ttn.lorawan.v3.ApplicationPubSub.ids:type_name -> ttn.lorawan.v3.ApplicationPubSubIdentifiers
-	18, // 2: ttn.lorawan.v3.ApplicationPubSub.created_at:type_name -> google.protobuf.Timestamp
-	18, // 3: ttn.lorawan.v3.ApplicationPubSub.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 4: ttn.lorawan.v3.ApplicationPubSub.nats:type_name -> ttn.lorawan.v3.ApplicationPubSub.NATSProvider
-	9,  // 5: ttn.lorawan.v3.ApplicationPubSub.mqtt:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider
-	10, // 6: ttn.lorawan.v3.ApplicationPubSub.aws_iot:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider
-	11, // 7: ttn.lorawan.v3.ApplicationPubSub.downlink_push:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 8: ttn.lorawan.v3.ApplicationPubSub.downlink_replace:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 9: ttn.lorawan.v3.ApplicationPubSub.uplink_message:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 10: ttn.lorawan.v3.ApplicationPubSub.uplink_normalized:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 11: ttn.lorawan.v3.ApplicationPubSub.join_accept:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 12: ttn.lorawan.v3.ApplicationPubSub.downlink_ack:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 13: ttn.lorawan.v3.ApplicationPubSub.downlink_nack:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 14: ttn.lorawan.v3.ApplicationPubSub.downlink_sent:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 15: ttn.lorawan.v3.ApplicationPubSub.downlink_failed:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 16: ttn.lorawan.v3.ApplicationPubSub.downlink_queued:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 17: ttn.lorawan.v3.ApplicationPubSub.downlink_queue_invalidated:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 18: ttn.lorawan.v3.ApplicationPubSub.location_solved:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	11, // 19: ttn.lorawan.v3.ApplicationPubSub.service_data:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
-	2,  // 20: ttn.lorawan.v3.ApplicationPubSubs.pubsubs:type_name -> ttn.lorawan.v3.ApplicationPubSub
-	16, // 21: ttn.lorawan.v3.ApplicationPubSubFormats.formats:type_name -> ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry
-	1,  // 22: ttn.lorawan.v3.GetApplicationPubSubRequest.ids:type_name -> ttn.lorawan.v3.ApplicationPubSubIdentifiers
-	19, // 23: ttn.lorawan.v3.GetApplicationPubSubRequest.field_mask:type_name -> google.protobuf.FieldMask
-	17, // 24: ttn.lorawan.v3.ListApplicationPubSubsRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
-	19, // 25: ttn.lorawan.v3.ListApplicationPubSubsRequest.field_mask:type_name -> google.protobuf.FieldMask
-	2,  // 26: ttn.lorawan.v3.SetApplicationPubSubRequest.pubsub:type_name -> ttn.lorawan.v3.ApplicationPubSub
-	19, // 27: ttn.lorawan.v3.SetApplicationPubSubRequest.field_mask:type_name -> google.protobuf.FieldMask
-	0,  // 28: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.subscribe_qos:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.QoS
-	0,  // 29: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.publish_qos:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.QoS
-	12, // 30: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.headers:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.HeadersEntry
-	13, // 31: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.access_key:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AccessKey
-	14, // 32: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.assume_role:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole
-	15, // 33: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.default:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration
-	20, // 34: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole.session_duration:type_name -> google.protobuf.Duration
-	21, // 35: ttn.lorawan.v3.ApplicationPubSubRegistry.GetFormats:input_type -> google.protobuf.Empty
-	5,  // 36: ttn.lorawan.v3.ApplicationPubSubRegistry.Get:input_type -> ttn.lorawan.v3.GetApplicationPubSubRequest
-	6,  // 37: ttn.lorawan.v3.ApplicationPubSubRegistry.List:input_type -> ttn.lorawan.v3.ListApplicationPubSubsRequest
-	7,  // 38: ttn.lorawan.v3.ApplicationPubSubRegistry.Set:input_type -> ttn.lorawan.v3.SetApplicationPubSubRequest
-	1,  // 39: ttn.lorawan.v3.ApplicationPubSubRegistry.Delete:input_type -> ttn.lorawan.v3.ApplicationPubSubIdentifiers
-	4,  // 40: ttn.lorawan.v3.ApplicationPubSubRegistry.GetFormats:output_type -> ttn.lorawan.v3.ApplicationPubSubFormats
-	2,  // 41: ttn.lorawan.v3.ApplicationPubSubRegistry.Get:output_type -> ttn.lorawan.v3.ApplicationPubSub
-	3,  // 42: ttn.lorawan.v3.ApplicationPubSubRegistry.List:output_type -> ttn.lorawan.v3.ApplicationPubSubs
-	2,  // 43: ttn.lorawan.v3.ApplicationPubSubRegistry.Set:output_type -> ttn.lorawan.v3.ApplicationPubSub
-	21, // 44: ttn.lorawan.v3.ApplicationPubSubRegistry.Delete:output_type -> google.protobuf.Empty
-	40, // [40:45] is the sub-list for method output_type
-	35, // [35:40] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	20, // 0: ttn.lorawan.v3.ApplicationPubSubIdentifiers.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	2,  // 1: ttn.lorawan.v3.ApplicationPubSub.ids:type_name -> ttn.lorawan.v3.ApplicationPubSubIdentifiers
+	21, // 2: ttn.lorawan.v3.ApplicationPubSub.created_at:type_name -> google.protobuf.Timestamp
+	21, // 3: ttn.lorawan.v3.ApplicationPubSub.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: ttn.lorawan.v3.ApplicationPubSub.nats:type_name -> ttn.lorawan.v3.ApplicationPubSub.NATSProvider
+	10, // 5: ttn.lorawan.v3.ApplicationPubSub.mqtt:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider
+	11, // 6: ttn.lorawan.v3.ApplicationPubSub.aws_iot:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider
+	12, // 7: ttn.lorawan.v3.ApplicationPubSub.kafka:type_name -> ttn.lorawan.v3.ApplicationPubSub.KafkaProvider
+	13, // 8: ttn.lorawan.v3.ApplicationPubSub.downlink_push:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 9: ttn.lorawan.v3.ApplicationPubSub.downlink_replace:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 10: ttn.lorawan.v3.ApplicationPubSub.uplink_message:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 11: ttn.lorawan.v3.ApplicationPubSub.uplink_normalized:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 12: ttn.lorawan.v3.ApplicationPubSub.join_accept:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 13: ttn.lorawan.v3.ApplicationPubSub.downlink_ack:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 14: ttn.lorawan.v3.ApplicationPubSub.downlink_nack:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 15: ttn.lorawan.v3.ApplicationPubSub.downlink_sent:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 16: ttn.lorawan.v3.ApplicationPubSub.downlink_failed:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 17: ttn.lorawan.v3.ApplicationPubSub.downlink_queued:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 18: ttn.lorawan.v3.ApplicationPubSub.downlink_queue_invalidated:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 19: ttn.lorawan.v3.ApplicationPubSub.location_solved:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	13, // 20: ttn.lorawan.v3.ApplicationPubSub.service_data:type_name -> ttn.lorawan.v3.ApplicationPubSub.Message
+	3,  // 21: ttn.lorawan.v3.ApplicationPubSubs.pubsubs:type_name -> ttn.lorawan.v3.ApplicationPubSub
+	19, // 22: ttn.lorawan.v3.ApplicationPubSubFormats.formats:type_name -> ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry
+	2,  // 23: ttn.lorawan.v3.GetApplicationPubSubRequest.ids:type_name -> ttn.lorawan.v3.ApplicationPubSubIdentifiers
+	22, // 24: ttn.lorawan.v3.GetApplicationPubSubRequest.field_mask:type_name -> google.protobuf.FieldMask
+	20, // 25: ttn.lorawan.v3.ListApplicationPubSubsRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	22, // 26: ttn.lorawan.v3.ListApplicationPubSubsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	3,  // 27: ttn.lorawan.v3.SetApplicationPubSubRequest.pubsub:type_name -> ttn.lorawan.v3.ApplicationPubSub
+	22, // 28: ttn.lorawan.v3.SetApplicationPubSubRequest.field_mask:type_name -> google.protobuf.FieldMask
+	0,  // 29: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.subscribe_qos:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.QoS
+	0,  // 30: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.publish_qos:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.QoS
+	14, // 31: ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.headers:type_name -> ttn.lorawan.v3.ApplicationPubSub.MQTTProvider.HeadersEntry
+	15, // 32: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.access_key:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AccessKey
+	16, // 33: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.assume_role:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole
+	17, // 34: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.default:type_name -> ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration
+	18, // 35: ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.sasl:type_name -> ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL
+	23, // 36: ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole.session_duration:type_name -> google.protobuf.Duration
+	1,  // 37: ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL.mechanism:type_name -> ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL.Mechanism
+	24, // 38: ttn.lorawan.v3.ApplicationPubSubRegistry.GetFormats:input_type -> google.protobuf.Empty
+	6,  // 39: ttn.lorawan.v3.ApplicationPubSubRegistry.Get:input_type -> ttn.lorawan.v3.GetApplicationPubSubRequest
+	7,  // 40: ttn.lorawan.v3.ApplicationPubSubRegistry.List:input_type -> ttn.lorawan.v3.ListApplicationPubSubsRequest
+	8,  // 41: ttn.lorawan.v3.ApplicationPubSubRegistry.Set:input_type -> ttn.lorawan.v3.SetApplicationPubSubRequest
+	2,  // 42: ttn.lorawan.v3.ApplicationPubSubRegistry.Delete:input_type -> ttn.lorawan.v3.ApplicationPubSubIdentifiers
+	5,  // 43: ttn.lorawan.v3.ApplicationPubSubRegistry.GetFormats:output_type -> ttn.lorawan.v3.ApplicationPubSubFormats
+	3,  // 44: ttn.lorawan.v3.ApplicationPubSubRegistry.Get:output_type -> ttn.lorawan.v3.ApplicationPubSub
+	4,  // 45: ttn.lorawan.v3.ApplicationPubSubRegistry.List:output_type -> ttn.lorawan.v3.ApplicationPubSubs
+	3,  // 46: ttn.lorawan.v3.ApplicationPubSubRegistry.Set:output_type -> ttn.lorawan.v3.ApplicationPubSub
+	24, // 47: ttn.lorawan.v3.ApplicationPubSubRegistry.Delete:output_type -> google.protobuf.Empty
+	43, // [43:48] is the sub-list for method output_type
+	38, // [38:43] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_ttn_lorawan_v3_applicationserver_pubsub_proto_init() }
@@ -1796,6 +2116,18 @@ func file_ttn_lorawan_v3_applicationserver_pubsub_proto_init() {
 			}
 		}
 		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationPubSub_KafkaProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplicationPubSub_Message); i {
 			case 0:
 				return &v.state
@@ -1807,7 +2139,7 @@ func file_ttn_lorawan_v3_applicationserver_pubsub_proto_init() {
 				return nil
 			}
 		}
-		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplicationPubSub_AWSIoTProvider_AccessKey); i {
 			case 0:
 				return &v.state
@@ -1819,7 +2151,7 @@ func file_ttn_lorawan_v3_applicationserver_pubsub_proto_init() {
 				return nil
 			}
 		}
-		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplicationPubSub_AWSIoTProvider_AssumeRole); i {
 			case 0:
 				return &v.state
@@ -1831,7 +2163,7 @@ func file_ttn_lorawan_v3_applicationserver_pubsub_proto_init() {
 				return nil
 			}
 		}
-		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplicationPubSub_AWSIoTProvider_DefaultIntegration); i {
 			case 0:
 				return &v.state
@@ -1843,11 +2175,24 @@ func file_ttn_lorawan_v3_applicationserver_pubsub_proto_init() {
 				return nil
 			}
 		}
+		file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationPubSub_KafkaProvider_SASL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*ApplicationPubSub_Nats)(nil),
 		(*ApplicationPubSub_Mqtt)(nil),
 		(*ApplicationPubSub_AwsIot)(nil),
+		(*ApplicationPubSub_Kafka)(nil),
 	}
 	file_ttn_lorawan_v3_applicationserver_pubsub_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*ApplicationPubSub_AWSIoTProvider_Default)(nil),
@@ -1857,8 +2202,8 @@ func file_ttn_lorawan_v3_applicationserver_pubsub_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ttn_lorawan_v3_applicationserver_pubsub_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -565,6 +565,42 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 							dst.Provider = nil
 						}
 					}
+				case "kafka":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Provider.(*ApplicationPubSub_Kafka)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Provider == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Provider.(*ApplicationPubSub_Kafka)
+					if dstValid := dstTypeOk || dst.Provider == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'kafka', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationPubSub_KafkaProvider
+						if srcTypeOk {
+							newSrc = src.Provider.(*ApplicationPubSub_Kafka).Kafka
+						}
+						if dstTypeOk {
+							newDst = dst.Provider.(*ApplicationPubSub_Kafka).Kafka
+						} else if srcTypeOk {
+							newDst = &ApplicationPubSub_KafkaProvider{}
+							dst.Provider = &ApplicationPubSub_Kafka{Kafka: newDst}
+						} else {
+							dst.Provider = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Provider = src.Provider
+						} else {
+							dst.Provider = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
@@ -1027,6 +1063,108 @@ func (dst *ApplicationPubSub_AWSIoTProvider) SetFields(src *ApplicationPubSub_AW
 	return nil
 }
 
+func (dst *ApplicationPubSub_KafkaProvider) SetFields(src *ApplicationPubSub_KafkaProvider, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "brokers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'brokers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Brokers = src.Brokers
+			} else {
+				dst.Brokers = nil
+			}
+		case "client_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientId = src.ClientId
+			} else {
+				var zero string
+				dst.ClientId = zero
+			}
+		case "group_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'group_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GroupId = src.GroupId
+			} else {
+				var zero string
+				dst.GroupId = zero
+			}
+		case "use_tls":
+			if len(subs) > 0 {
+				return fmt.Errorf("'use_tls' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UseTls = src.UseTls
+			} else {
+				var zero bool
+				dst.UseTls = zero
+			}
+		case "tls_ca":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_ca' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TlsCa = src.TlsCa
+			} else {
+				dst.TlsCa = nil
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TlsClientCert = src.TlsClientCert
+			} else {
+				dst.TlsClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TlsClientKey = src.TlsClientKey
+			} else {
+				dst.TlsClientKey = nil
+			}
+		case "sasl":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationPubSub_KafkaProvider_SASL
+				if (src == nil || src.Sasl == nil) && dst.Sasl == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Sasl
+				}
+				if dst.Sasl != nil {
+					newDst = dst.Sasl
+				} else {
+					newDst = &ApplicationPubSub_KafkaProvider_SASL{}
+					dst.Sasl = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Sasl = src.Sasl
+				} else {
+					dst.Sasl = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationPubSub_Message) SetFields(src *ApplicationPubSub_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1149,3 +1287,43 @@ func (dst *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) SetFields(src *A
 	}
 	return nil
 }
+
+func (dst *ApplicationPubSub_KafkaProvider_SASL) SetFields(src *ApplicationPubSub_KafkaProvider_SASL, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "mechanism":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mechanism' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mechanism = src.Mechanism
+			} else {
+				dst.Mechanism = 0
+			}
+		case "username":
+			if len(subs) > 0 {
+				return fmt.Errorf("'username' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Username = src.Username
+			} else {
+				var zero string
+				dst.Username = zero
+			}
+		case "password":
+			if len(subs) > 0 {
+				return fmt.Errorf("'password' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Password = src.Password
+			} else {
+				var zero string
+				dst.Password = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -399,7 +399,7 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 			}
 			if len(subs) == 0 {
 				subs = []string{
-					"nats", "mqtt", "aws_iot",
+					"nats", "mqtt", "aws_iot", "kafka",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -453,6 +453,22 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "kafka":
+					w, ok := m.Provider.(*ApplicationPubSub_Kafka)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetKafka()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "kafka",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				}
 			}
 		default:
@@ -1483,6 +1499,177 @@ var _ApplicationPubSub_AWSIoTProvider_Region_InLookup = map[string]struct{}{
 
 var _ApplicationPubSub_AWSIoTProvider_EndpointAddress_Pattern = regexp.MustCompile("^((([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)*([A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9\\-]*[A-Za-z0-9])|)$")
 
+// ValidateFields checks the field values on ApplicationPubSub_KafkaProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_KafkaProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_KafkaProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "brokers":
+
+			if l := len(m.GetBrokers()); l < 1 || l > 16 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "brokers",
+					reason: "value must contain between 1 and 16 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetBrokers() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 256 {
+					return ApplicationPubSub_KafkaProviderValidationError{
+						field:  fmt.Sprintf("brokers[%v]", idx),
+						reason: "value length must be at most 256 runes",
+					}
+				}
+
+			}
+
+		case "client_id":
+
+			if utf8.RuneCountInString(m.GetClientId()) > 100 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "client_id",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "group_id":
+
+			if utf8.RuneCountInString(m.GetGroupId()) > 100 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "group_id",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+			if !_ApplicationPubSub_KafkaProvider_GroupId_Pattern.MatchString(m.GetGroupId()) {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "group_id",
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9._-]*$\"",
+				}
+			}
+
+		case "use_tls":
+			// no validation rules for UseTls
+		case "tls_ca":
+
+			if len(m.GetTlsCa()) > 8192 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "tls_ca",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "tls_client_cert":
+
+			if len(m.GetTlsClientCert()) > 8192 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "tls_client_cert",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "tls_client_key":
+
+			if len(m.GetTlsClientKey()) > 8192 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "tls_client_key",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "sasl":
+
+			if v, ok := interface{}(m.GetSasl()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSub_KafkaProviderValidationError{
+						field:  "sasl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationPubSub_KafkaProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_KafkaProviderValidationError is the validation error
+// returned by ApplicationPubSub_KafkaProvider.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_KafkaProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_KafkaProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_KafkaProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_KafkaProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_KafkaProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_KafkaProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_KafkaProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_KafkaProviderValidationError{}
+
+var _ApplicationPubSub_KafkaProvider_GroupId_Pattern = regexp.MustCompile("^[a-zA-Z0-9._-]*$")
+
 // ValidateFields checks the field values on ApplicationPubSub_Message with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1923,3 +2110,112 @@ var _ interface {
 } = ApplicationPubSub_AWSIoTProvider_DefaultIntegrationValidationError{}
 
 var _ApplicationPubSub_AWSIoTProvider_DefaultIntegration_StackName_Pattern = regexp.MustCompile("^[A-Za-z][A-Za-z0-9\\-]*$")
+
+// ValidateFields checks the field values on
+// ApplicationPubSub_KafkaProvider_SASL with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ApplicationPubSub_KafkaProvider_SASL) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_KafkaProvider_SASLFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mechanism":
+
+			if _, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_name[int32(m.GetMechanism())]; !ok {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "mechanism",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "username":
+
+			if utf8.RuneCountInString(m.GetUsername()) > 100 {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "username",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "password":
+
+			if utf8.RuneCountInString(m.GetPassword()) > 100 {
+				return ApplicationPubSub_KafkaProvider_SASLValidationError{
+					field:  "password",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_KafkaProvider_SASLValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_KafkaProvider_SASLValidationError is the validation error
+// returned by ApplicationPubSub_KafkaProvider_SASL.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_KafkaProvider_SASLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) ErrorName() string {
+	return "ApplicationPubSub_KafkaProvider_SASLValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_KafkaProvider_SASLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_KafkaProvider_SASL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_KafkaProvider_SASLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_KafkaProvider_SASLValidationError{}
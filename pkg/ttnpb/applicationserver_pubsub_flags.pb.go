@@ -499,6 +499,199 @@ func (m *ApplicationPubSub_AWSIoTProvider) SetFromFlags(flags *pflag.FlagSet, pr
 	return paths, nil
 }
 
+// AddSelectFlagsForApplicationPubSub_KafkaProvider_SASL adds flags to select fields in ApplicationPubSub_KafkaProvider_SASL.
+func AddSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("mechanism", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("mechanism", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("username", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("username", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("password", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("password", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationPubSub_KafkaProvider_SASL message from select flags.
+func PathsFromSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("mechanism", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("mechanism", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("username", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("username", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("password", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("password", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationPubSub_KafkaProvider_SASL adds flags to select fields in ApplicationPubSub_KafkaProvider_SASL.
+func AddSetFlagsForApplicationPubSub_KafkaProvider_SASL(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("mechanism", prefix), flagsplugin.EnumValueDesc(ApplicationPubSub_KafkaProvider_SASL_Mechanism_value), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("username", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("password", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationPubSub_KafkaProvider_SASL message from flags.
+func (m *ApplicationPubSub_KafkaProvider_SASL) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("mechanism", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		enumValue, err := flagsplugin.SetEnumString(val, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+		if err != nil {
+			return nil, err
+		}
+		m.Mechanism = ApplicationPubSub_KafkaProvider_SASL_Mechanism(enumValue)
+		paths = append(paths, flagsplugin.Prefix("mechanism", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("username", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Username = val
+		paths = append(paths, flagsplugin.Prefix("username", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("password", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Password = val
+		paths = append(paths, flagsplugin.Prefix("password", prefix))
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationPubSub_KafkaProvider adds flags to select fields in ApplicationPubSub_KafkaProvider.
+func AddSelectFlagsForApplicationPubSub_KafkaProvider(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("brokers", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("brokers", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("client-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("client-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("group-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("group-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("use-tls", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("use-tls", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("tls-ca", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("tls-ca", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("tls-client-cert", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("tls-client-cert", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("tls-client-key", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("tls-client-key", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("sasl", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("sasl", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags, flagsplugin.Prefix("sasl", prefix), hidden)
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationPubSub_KafkaProvider message from select flags.
+func PathsFromSelectFlagsForApplicationPubSub_KafkaProvider(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("brokers", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("brokers", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("client_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("client_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("group_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("group_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("use_tls", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("use_tls", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("tls_ca", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("tls_ca", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("tls_client_cert", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("tls_client_cert", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("tls_client_key", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("tls_client_key", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("sasl", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("sasl", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationPubSub_KafkaProvider_SASL(flags, flagsplugin.Prefix("sasl", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationPubSub_KafkaProvider adds flags to select fields in ApplicationPubSub_KafkaProvider.
+func AddSetFlagsForApplicationPubSub_KafkaProvider(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("brokers", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("client-id", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("group-id", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("use-tls", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewHexBytesFlag(flagsplugin.Prefix("tls-ca", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewHexBytesFlag(flagsplugin.Prefix("tls-client-cert", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewHexBytesFlag(flagsplugin.Prefix("tls-client-key", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForApplicationPubSub_KafkaProvider_SASL(flags, flagsplugin.Prefix("sasl", prefix), hidden)
+}
+
+// SetFromFlags sets the ApplicationPubSub_KafkaProvider message from flags.
+func (m *ApplicationPubSub_KafkaProvider) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("brokers", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Brokers = val
+		paths = append(paths, flagsplugin.Prefix("brokers", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("client_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.ClientId = val
+		paths = append(paths, flagsplugin.Prefix("client_id", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("group_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.GroupId = val
+		paths = append(paths, flagsplugin.Prefix("group_id", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("use_tls", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.UseTls = val
+		paths = append(paths, flagsplugin.Prefix("use_tls", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBytes(flags, flagsplugin.Prefix("tls_ca", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.TlsCa = val
+		paths = append(paths, flagsplugin.Prefix("tls_ca", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBytes(flags, flagsplugin.Prefix("tls_client_cert", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.TlsClientCert = val
+		paths = append(paths, flagsplugin.Prefix("tls_client_cert", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBytes(flags, flagsplugin.Prefix("tls_client_key", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.TlsClientKey = val
+		paths = append(paths, flagsplugin.Prefix("tls_client_key", prefix))
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("sasl", prefix)); changed {
+		if m.Sasl == nil {
+			m.Sasl = &ApplicationPubSub_KafkaProvider_SASL{}
+		}
+		if setPaths, err := m.Sasl.SetFromFlags(flags, flagsplugin.Prefix("sasl", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	return paths, nil
+}
+
 // AddSelectFlagsForApplicationPubSub_Message adds flags to select fields in ApplicationPubSub_Message.
 func AddSelectFlagsForApplicationPubSub_Message(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("topic", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("topic", prefix), false), flagsplugin.WithHidden(hidden)))
@@ -539,6 +732,8 @@ func AddSelectFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix string, hid
 	AddSelectFlagsForApplicationPubSub_MQTTProvider(flags, flagsplugin.Prefix("provider.mqtt", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("provider.aws-iot", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("provider.aws-iot", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationPubSub_AWSIoTProvider(flags, flagsplugin.Prefix("provider.aws-iot", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("provider.kafka", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("provider.kafka", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationPubSub_KafkaProvider(flags, flagsplugin.Prefix("provider.kafka", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("base-topic", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("base-topic", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("downlink-push", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("downlink-push", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("downlink-push", prefix), hidden)
@@ -605,6 +800,16 @@ func PathsFromSelectFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix strin
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("provider.kafka", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("provider.kafka", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationPubSub_KafkaProvider(flags, flagsplugin.Prefix("provider.kafka", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("base_topic", prefix)); err != nil {
 		return nil, err
 	} else if selected && val {
@@ -750,6 +955,7 @@ func AddSetFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix string, hidden
 	AddSetFlagsForApplicationPubSub_NATSProvider(flags, flagsplugin.Prefix("provider.nats", prefix), hidden)
 	AddSetFlagsForApplicationPubSub_MQTTProvider(flags, flagsplugin.Prefix("provider.mqtt", prefix), hidden)
 	AddSetFlagsForApplicationPubSub_AWSIoTProvider(flags, flagsplugin.Prefix("provider.aws-iot", prefix), hidden)
+	AddSetFlagsForApplicationPubSub_KafkaProvider(flags, flagsplugin.Prefix("provider.kafka", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("base-topic", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("downlink-push", prefix), hidden)
 	AddSetFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("downlink-replace", prefix), hidden)
@@ -820,6 +1026,18 @@ func (m *ApplicationPubSub) SetFromFlags(flags *pflag.FlagSet, prefix string) (p
 		}
 		m.Provider = ov
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("provider.kafka", prefix)); changed {
+		ov := &ApplicationPubSub_Kafka{}
+		if ov.Kafka == nil {
+			ov.Kafka = &ApplicationPubSub_KafkaProvider{}
+		}
+		if setPaths, err := ov.Kafka.SetFromFlags(flags, flagsplugin.Prefix("provider.kafka", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+		m.Provider = ov
+	}
 	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("base_topic", prefix)); err != nil {
 		return nil, err
 	} else if changed {
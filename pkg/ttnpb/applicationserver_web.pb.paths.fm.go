@@ -180,6 +180,9 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"location_solved.path",
 	"service_data",
 	"service_data.path",
+	"signing_secret",
+	"signing_secret.key_id",
+	"signing_secret.value",
 	"template_fields",
 	"template_ids",
 	"template_ids.template_id",
@@ -208,6 +211,7 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"join_accept",
 	"location_solved",
 	"service_data",
+	"signing_secret",
 	"template_fields",
 	"template_ids",
 	"updated_at",
@@ -301,6 +305,9 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.location_solved.path",
 	"webhook.service_data",
 	"webhook.service_data.path",
+	"webhook.signing_secret",
+	"webhook.signing_secret.key_id",
+	"webhook.signing_secret.value",
 	"webhook.template_fields",
 	"webhook.template_ids",
 	"webhook.template_ids.template_id",
@@ -332,6 +339,59 @@ var ListApplicationWebhookTemplatesRequestFieldPathsNested = []string{
 var ListApplicationWebhookTemplatesRequestFieldPathsTopLevel = []string{
 	"field_mask",
 }
+var ApplicationWebhookDeadLetterFieldPathsNested = []string{
+	"attempts",
+	"created_at",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"id",
+	"last_attempt_at",
+	"last_error",
+	"message_type",
+}
+
+var ApplicationWebhookDeadLetterFieldPathsTopLevel = []string{
+	"attempts",
+	"created_at",
+	"end_device_ids",
+	"id",
+	"last_attempt_at",
+	"last_error",
+	"message_type",
+}
+var ApplicationWebhookDeadLettersFieldPathsNested = []string{
+	"dead_letters",
+}
+
+var ApplicationWebhookDeadLettersFieldPathsTopLevel = []string{
+	"dead_letters",
+}
+var ListApplicationWebhookDeadLettersRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"limit",
+	"page",
+}
+
+var ListApplicationWebhookDeadLettersRequestFieldPathsTopLevel = []string{
+	"ids",
+	"limit",
+	"page",
+}
+var ReplayApplicationWebhookDeadLettersResponseFieldPathsNested = []string{
+	"replayed",
+}
+
+var ReplayApplicationWebhookDeadLettersResponseFieldPathsTopLevel = []string{
+	"replayed",
+}
 var ApplicationWebhookTemplate_MessageFieldPathsNested = []string{
 	"path",
 }
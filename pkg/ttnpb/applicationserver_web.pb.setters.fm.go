@@ -803,6 +803,31 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 				var zero string
 				dst.DownlinkApiKey = zero
 			}
+		case "signing_secret":
+			if len(subs) > 0 {
+				var newDst, newSrc *Secret
+				if (src == nil || src.SigningSecret == nil) && dst.SigningSecret == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SigningSecret
+				}
+				if dst.SigningSecret != nil {
+					newDst = dst.SigningSecret
+				} else {
+					newDst = &Secret{}
+					dst.SigningSecret = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SigningSecret = src.SigningSecret
+				} else {
+					dst.SigningSecret = nil
+				}
+			}
 		case "uplink_message":
 			if len(subs) > 0 {
 				var newDst, newSrc *ApplicationWebhook_Message
@@ -1360,6 +1385,197 @@ func (dst *ListApplicationWebhookTemplatesRequest) SetFields(src *ListApplicatio
 	return nil
 }
 
+func (dst *ApplicationWebhookDeadLetter) SetFields(src *ApplicationWebhookDeadLetter, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "message_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'message_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MessageType = src.MessageType
+			} else {
+				var zero string
+				dst.MessageType = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+		case "last_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastAttemptAt = src.LastAttemptAt
+			} else {
+				dst.LastAttemptAt = nil
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastError = src.LastError
+			} else {
+				var zero string
+				dst.LastError = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeadLetters) SetFields(src *ApplicationWebhookDeadLetters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "dead_letters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dead_letters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeadLetters = src.DeadLetters
+			} else {
+				dst.DeadLetters = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListApplicationWebhookDeadLettersRequest) SetFields(src *ListApplicationWebhookDeadLettersRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if (src == nil || src.Ids == nil) && dst.Ids == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Ids
+				}
+				if dst.Ids != nil {
+					newDst = dst.Ids
+				} else {
+					newDst = &ApplicationWebhookIdentifiers{}
+					dst.Ids = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Ids = src.Ids
+				} else {
+					dst.Ids = nil
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ReplayApplicationWebhookDeadLettersResponse) SetFields(src *ReplayApplicationWebhookDeadLettersResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "replayed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'replayed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Replayed = src.Replayed
+			} else {
+				var zero uint32
+				dst.Replayed = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhookTemplate_Message) SetFields(src *ApplicationWebhookTemplate_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1134,6 +1134,18 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "signing_secret":
+
+			if v, ok := interface{}(m.GetSigningSecret()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "signing_secret",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "uplink_message":
 
 			if v, ok := interface{}(m.GetUplinkMessage()).(interface{ ValidateFields(...string) error }); ok {
@@ -2086,6 +2098,428 @@ var _ interface {
 	ErrorName() string
 } = ListApplicationWebhookTemplatesRequestValidationError{}
 
+// ValidateFields checks the field values on ApplicationWebhookDeadLetter with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhookDeadLetter) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeadLetterFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id":
+			// no validation rules for Id
+		case "end_device_ids":
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeadLetterValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "message_type":
+			// no validation rules for MessageType
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeadLetterValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "attempts":
+			// no validation rules for Attempts
+		case "last_attempt_at":
+
+			if v, ok := interface{}(m.GetLastAttemptAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeadLetterValidationError{
+						field:  "last_attempt_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error":
+			// no validation rules for LastError
+		default:
+			return ApplicationWebhookDeadLetterValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeadLetterValidationError is the validation error returned
+// by ApplicationWebhookDeadLetter.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationWebhookDeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeadLetterValidationError) ErrorName() string {
+	return "ApplicationWebhookDeadLetterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeadLetterValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookDeadLetters with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhookDeadLetters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeadLettersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "dead_letters":
+
+			for idx, item := range m.GetDeadLetters() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookDeadLettersValidationError{
+							field:  fmt.Sprintf("dead_letters[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhookDeadLettersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeadLettersValidationError is the validation error
+// returned by ApplicationWebhookDeadLetters.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationWebhookDeadLettersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeadLettersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeadLettersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeadLettersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeadLettersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeadLettersValidationError) ErrorName() string {
+	return "ApplicationWebhookDeadLettersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeadLettersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeadLetters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeadLettersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeadLettersValidationError{}
+
+// ValidateFields checks the field values on
+// ListApplicationWebhookDeadLettersRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ListApplicationWebhookDeadLettersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListApplicationWebhookDeadLettersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if m.GetIds() == nil {
+				return ListApplicationWebhookDeadLettersRequestValidationError{
+					field:  "ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationWebhookDeadLettersRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListApplicationWebhookDeadLettersRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListApplicationWebhookDeadLettersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListApplicationWebhookDeadLettersRequestValidationError is the validation
+// error returned by ListApplicationWebhookDeadLettersRequest.ValidateFields
+// if the designated constraints aren't met.
+type ListApplicationWebhookDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) ErrorName() string {
+	return "ListApplicationWebhookDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationWebhookDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationWebhookDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationWebhookDeadLettersRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ReplayApplicationWebhookDeadLettersResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ReplayApplicationWebhookDeadLettersResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ReplayApplicationWebhookDeadLettersResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "replayed":
+			// no validation rules for Replayed
+		default:
+			return ReplayApplicationWebhookDeadLettersResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ReplayApplicationWebhookDeadLettersResponseValidationError is the validation
+// error returned by
+// ReplayApplicationWebhookDeadLettersResponse.ValidateFields if the
+// designated constraints aren't met.
+type ReplayApplicationWebhookDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayApplicationWebhookDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayApplicationWebhookDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayApplicationWebhookDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayApplicationWebhookDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayApplicationWebhookDeadLettersResponseValidationError) ErrorName() string {
+	return "ReplayApplicationWebhookDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayApplicationWebhookDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayApplicationWebhookDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayApplicationWebhookDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayApplicationWebhookDeadLettersResponseValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhookTemplate_Message
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
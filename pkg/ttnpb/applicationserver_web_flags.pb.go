@@ -208,6 +208,8 @@ func AddSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix string, hi
 	AddSelectFlagsForApplicationWebhookTemplateIdentifiers(flags, flagsplugin.Prefix("template-ids", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("template-fields", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("template-fields", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("downlink-api-key", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("downlink-api-key", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("signing-secret", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("signing-secret", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForSecret(flags, flagsplugin.Prefix("signing-secret", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("uplink-message", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("uplink-message", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("uplink-message", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("uplink-normalized", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("uplink-normalized", prefix), true), flagsplugin.WithHidden(hidden)))
@@ -272,6 +274,16 @@ func PathsFromSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix stri
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("downlink_api_key", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("signing_secret", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("signing_secret", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForSecret(flags, flagsplugin.Prefix("signing_secret", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("uplink_message", prefix)); err != nil {
 		return nil, err
 	} else if selected && val {
@@ -409,6 +421,7 @@ func AddSetFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix string, hidde
 	AddSetFlagsForApplicationWebhookTemplateIdentifiers(flags, flagsplugin.Prefix("template-ids", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewStringStringMapFlag(flagsplugin.Prefix("template-fields", prefix), "", flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("downlink-api-key", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForSecret(flags, flagsplugin.Prefix("signing-secret", prefix), hidden)
 	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("uplink-message", prefix), hidden)
 	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("uplink-normalized", prefix), hidden)
 	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("join-accept", prefix), hidden)
@@ -476,6 +489,16 @@ func (m *ApplicationWebhook) SetFromFlags(flags *pflag.FlagSet, prefix string) (
 		m.DownlinkApiKey = val
 		paths = append(paths, flagsplugin.Prefix("downlink_api_key", prefix))
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("signing_secret", prefix)); changed {
+		if m.SigningSecret == nil {
+			m.SigningSecret = &Secret{}
+		}
+		if setPaths, err := m.SigningSecret.SetFromFlags(flags, flagsplugin.Prefix("signing_secret", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("uplink_message", prefix)); changed {
 		if m.UplinkMessage == nil {
 			m.UplinkMessage = &ApplicationWebhook_Message{}
@@ -34,13 +34,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ApplicationWebhookRegistry_GetFormats_FullMethodName    = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats"
-	ApplicationWebhookRegistry_GetTemplate_FullMethodName   = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetTemplate"
-	ApplicationWebhookRegistry_ListTemplates_FullMethodName = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListTemplates"
-	ApplicationWebhookRegistry_Get_FullMethodName           = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get"
-	ApplicationWebhookRegistry_List_FullMethodName          = "/ttn.lorawan.v3.ApplicationWebhookRegistry/List"
-	ApplicationWebhookRegistry_Set_FullMethodName           = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set"
-	ApplicationWebhookRegistry_Delete_FullMethodName        = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete"
+	ApplicationWebhookRegistry_GetFormats_FullMethodName        = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats"
+	ApplicationWebhookRegistry_GetTemplate_FullMethodName       = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetTemplate"
+	ApplicationWebhookRegistry_ListTemplates_FullMethodName     = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListTemplates"
+	ApplicationWebhookRegistry_Get_FullMethodName               = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get"
+	ApplicationWebhookRegistry_List_FullMethodName              = "/ttn.lorawan.v3.ApplicationWebhookRegistry/List"
+	ApplicationWebhookRegistry_Set_FullMethodName               = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set"
+	ApplicationWebhookRegistry_Delete_FullMethodName            = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete"
+	ApplicationWebhookRegistry_ListDeadLetters_FullMethodName   = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListDeadLetters"
+	ApplicationWebhookRegistry_ReplayDeadLetters_FullMethodName = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayDeadLetters"
 )
 
 // ApplicationWebhookRegistryClient is the client API for ApplicationWebhookRegistry service.
@@ -54,6 +56,12 @@ type ApplicationWebhookRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the dead letters of the webhook, oldest first.
+	// This requires the durable delivery queue to be enabled in the Application Server.
+	ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeadLetters, error)
+	// Move the dead letters of the webhook back to the delivery queue.
+	// This requires the durable delivery queue to be enabled in the Application Server.
+	ReplayDeadLetters(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*ReplayApplicationWebhookDeadLettersResponse, error)
 }
 
 type applicationWebhookRegistryClient struct {
@@ -127,6 +135,24 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeadLetters, error) {
+	out := new(ApplicationWebhookDeadLetters)
+	err := c.cc.Invoke(ctx, ApplicationWebhookRegistry_ListDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ReplayDeadLetters(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*ReplayApplicationWebhookDeadLettersResponse, error) {
+	out := new(ReplayApplicationWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, ApplicationWebhookRegistry_ReplayDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 // All implementations must embed UnimplementedApplicationWebhookRegistryServer
 // for forward compatibility
@@ -138,6 +164,12 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*emptypb.Empty, error)
+	// List the dead letters of the webhook, oldest first.
+	// This requires the durable delivery queue to be enabled in the Application Server.
+	ListDeadLetters(context.Context, *ListApplicationWebhookDeadLettersRequest) (*ApplicationWebhookDeadLetters, error)
+	// Move the dead letters of the webhook back to the delivery queue.
+	// This requires the durable delivery queue to be enabled in the Application Server.
+	ReplayDeadLetters(context.Context, *ApplicationWebhookIdentifiers) (*ReplayApplicationWebhookDeadLettersResponse, error)
 	mustEmbedUnimplementedApplicationWebhookRegistryServer()
 }
 
@@ -166,6 +198,12 @@ func (UnimplementedApplicationWebhookRegistryServer) Set(context.Context, *SetAp
 func (UnimplementedApplicationWebhookRegistryServer) Delete(context.Context, *ApplicationWebhookIdentifiers) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedApplicationWebhookRegistryServer) ListDeadLetters(context.Context, *ListApplicationWebhookDeadLettersRequest) (*ApplicationWebhookDeadLetters, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedApplicationWebhookRegistryServer) ReplayDeadLetters(context.Context, *ApplicationWebhookIdentifiers) (*ReplayApplicationWebhookDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
 func (UnimplementedApplicationWebhookRegistryServer) mustEmbedUnimplementedApplicationWebhookRegistryServer() {
 }
 
@@ -306,6 +344,42 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationWebhookRegistry_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, req.(*ListApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationWebhookRegistry_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, req.(*ApplicationWebhookIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApplicationWebhookRegistry_ServiceDesc is the grpc.ServiceDesc for ApplicationWebhookRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +415,14 @@ var ApplicationWebhookRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ReplayDeadLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/applicationserver_web.proto",
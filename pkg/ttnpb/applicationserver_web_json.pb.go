@@ -507,6 +507,12 @@ func (x *ApplicationWebhook) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("downlink_api_key")
 		s.WriteString(x.DownlinkApiKey)
 	}
+	if x.SigningSecret != nil || s.HasField("signing_secret") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("signing_secret")
+		// NOTE: Secret does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.SigningSecret)
+	}
 	if x.UplinkMessage != nil || s.HasField("uplink_message") {
 		s.WriteMoreIf(&wroteField)
 		s.WriteObjectField("uplink_message")
@@ -676,6 +682,16 @@ func (x *ApplicationWebhook) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 		case "downlink_api_key", "downlinkApiKey":
 			s.AddField("downlink_api_key")
 			x.DownlinkApiKey = s.ReadString()
+		case "signing_secret", "signingSecret":
+			s.AddField("signing_secret")
+			if s.ReadNil() {
+				x.SigningSecret = nil
+				return
+			}
+			// NOTE: Secret does not seem to implement UnmarshalProtoJSON.
+			var v Secret
+			golang.UnmarshalMessage(s, &v)
+			x.SigningSecret = &v
 		case "uplink_message", "uplinkMessage":
 			s.AddField("uplink_message")
 			if s.ReadNil() {
@@ -1209,3 +1225,184 @@ func (x *ListApplicationWebhookTemplatesRequest) UnmarshalProtoJSON(s *jsonplugi
 func (x *ListApplicationWebhookTemplatesRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the ApplicationWebhookDeadLetter message to JSON.
+func (x *ApplicationWebhookDeadLetter) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Id != "" || s.HasField("id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("id")
+		s.WriteString(x.Id)
+	}
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.MessageType != "" || s.HasField("message_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("message_type")
+		s.WriteString(x.MessageType)
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	if x.Attempts != 0 || s.HasField("attempts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("attempts")
+		s.WriteUint32(x.Attempts)
+	}
+	if x.LastAttemptAt != nil || s.HasField("last_attempt_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_attempt_at")
+		if x.LastAttemptAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.LastAttemptAt)
+		}
+	}
+	if x.LastError != "" || s.HasField("last_error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_error")
+		s.WriteString(x.LastError)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationWebhookDeadLetter to JSON.
+func (x *ApplicationWebhookDeadLetter) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationWebhookDeadLetter message from JSON.
+func (x *ApplicationWebhookDeadLetter) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "id":
+			s.AddField("id")
+			x.Id = s.ReadString()
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "message_type", "messageType":
+			s.AddField("message_type")
+			x.MessageType = s.ReadString()
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "attempts":
+			s.AddField("attempts")
+			x.Attempts = s.ReadUint32()
+		case "last_attempt_at", "lastAttemptAt":
+			s.AddField("last_attempt_at")
+			if s.ReadNil() {
+				x.LastAttemptAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.LastAttemptAt = v
+		case "last_error", "lastError":
+			s.AddField("last_error")
+			x.LastError = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationWebhookDeadLetter from JSON.
+func (x *ApplicationWebhookDeadLetter) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationWebhookDeadLetters message to JSON.
+func (x *ApplicationWebhookDeadLetters) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.DeadLetters) > 0 || s.HasField("dead_letters") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dead_letters")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.DeadLetters {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("dead_letters"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationWebhookDeadLetters to JSON.
+func (x *ApplicationWebhookDeadLetters) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationWebhookDeadLetters message from JSON.
+func (x *ApplicationWebhookDeadLetters) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "dead_letters", "deadLetters":
+			s.AddField("dead_letters")
+			if s.ReadNil() {
+				x.DeadLetters = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.DeadLetters = append(x.DeadLetters, nil)
+					return
+				}
+				v := &ApplicationWebhookDeadLetter{}
+				v.UnmarshalProtoJSON(s.WithField("dead_letters", false))
+				if s.Err() != nil {
+					return
+				}
+				x.DeadLetters = append(x.DeadLetters, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationWebhookDeadLetters from JSON.
+func (x *ApplicationWebhookDeadLetters) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
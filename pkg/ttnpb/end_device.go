@@ -457,6 +457,28 @@ func (v *EndDeviceAuthenticationCode) FieldIsZero(p string) bool {
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
+// FieldIsZero returns whether path p is zero.
+func (v *EndDeviceHandoverRoaming) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "dev_addr":
+		return v.DevAddr == nil
+	case "partner_net_id":
+		return v.PartnerNetId == nil
+	case "partner_ns_id":
+		return v.PartnerNsId == nil
+	case "serving":
+		return !v.Serving
+	case "session_key_id":
+		return v.SessionKeyId == nil
+	case "started_at":
+		return v.StartedAt == nil
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
 // FieldIsZero returns whether path p is zero.
 func (v *ADRSettings_StaticMode) FieldIsZero(p string) bool {
 	if v == nil {
@@ -739,6 +761,8 @@ func (v *ADRSettings_DynamicMode) FieldIsZero(p string) bool {
 		return v.Overrides.FieldIsZero("data_rate_15.min_nb_trans")
 	case "overrides.data_rate_15.max_nb_trans":
 		return v.Overrides.FieldIsZero("data_rate_15.max_nb_trans")
+	case "algorithm":
+		return v.Algorithm == ""
 	}
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
@@ -885,6 +909,8 @@ func (v *ADRSettings) FieldIsZero(p string) bool {
 		return v.GetDynamic().FieldIsZero("overrides.data_rate_15.min_nb_trans")
 	case "mode.dynamic.overrides.data_rate_15.max_nb_trans":
 		return v.GetDynamic().FieldIsZero("overrides.data_rate_15.max_nb_trans")
+	case "mode.dynamic.algorithm":
+		return v.GetDynamic().FieldIsZero("algorithm")
 	case "mode.disabled":
 		return v.GetDisabled() == nil
 	}
@@ -1035,6 +1061,8 @@ func (v *MACSettings) FieldIsZero(p string) bool {
 		return v.Adr.FieldIsZero("mode.dynamic.overrides.data_rate_15.min_nb_trans")
 	case "adr.mode.dynamic.overrides.data_rate_15.max_nb_trans":
 		return v.Adr.FieldIsZero("mode.dynamic.overrides.data_rate_15.max_nb_trans")
+	case "adr.mode.dynamic.algorithm":
+		return v.Adr.FieldIsZero("mode.dynamic.algorithm")
 	case "adr.mode.disabled":
 		return v.Adr.FieldIsZero("mode.disabled")
 	case "adr_margin":
@@ -2217,6 +2245,20 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.Formatters.FieldIsZero("up_formatter_parameter")
 	case "frequency_plan_id":
 		return v.FrequencyPlanId == ""
+	case "handover_roaming":
+		return v.HandoverRoaming == nil
+	case "handover_roaming.dev_addr":
+		return v.HandoverRoaming.FieldIsZero("dev_addr")
+	case "handover_roaming.partner_net_id":
+		return v.HandoverRoaming.FieldIsZero("partner_net_id")
+	case "handover_roaming.partner_ns_id":
+		return v.HandoverRoaming.FieldIsZero("partner_ns_id")
+	case "handover_roaming.serving":
+		return v.HandoverRoaming.FieldIsZero("serving")
+	case "handover_roaming.session_key_id":
+		return v.HandoverRoaming.FieldIsZero("session_key_id")
+	case "handover_roaming.started_at":
+		return v.HandoverRoaming.FieldIsZero("started_at")
 	case "ids":
 		return v.Ids == nil
 	case "ids.application_ids":
@@ -2391,6 +2433,8 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.MacSettings.FieldIsZero("adr.mode.dynamic.overrides.data_rate_15.min_nb_trans")
 	case "mac_settings.adr.mode.dynamic.overrides.data_rate_15.max_nb_trans":
 		return v.MacSettings.FieldIsZero("adr.mode.dynamic.overrides.data_rate_15.max_nb_trans")
+	case "mac_settings.adr.mode.dynamic.algorithm":
+		return v.MacSettings.FieldIsZero("adr.mode.dynamic.algorithm")
 	case "mac_settings.adr.mode.disabled":
 		return v.MacSettings.FieldIsZero("adr.mode.disabled")
 	case "mac_settings.adr_margin":
@@ -371,6 +371,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.adr.mode",
 	"default_mac_settings.adr.mode.disabled",
 	"default_mac_settings.adr.mode.dynamic",
+	"default_mac_settings.adr.mode.dynamic.algorithm",
 	"default_mac_settings.adr.mode.dynamic.channel_steering",
 	"default_mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"default_mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -605,6 +606,7 @@ var ADRSettingsFieldPathsNested = []string{
 	"mode",
 	"mode.disabled",
 	"mode.dynamic",
+	"mode.dynamic.algorithm",
 	"mode.dynamic.channel_steering",
 	"mode.dynamic.channel_steering.mode",
 	"mode.dynamic.channel_steering.mode.disabled",
@@ -681,6 +683,7 @@ var MACSettingsFieldPathsNested = []string{
 	"adr.mode",
 	"adr.mode.disabled",
 	"adr.mode.dynamic",
+	"adr.mode.dynamic.algorithm",
 	"adr.mode.dynamic.channel_steering",
 	"adr.mode.dynamic.channel_steering.mode",
 	"adr.mode.dynamic.channel_steering.mode.disabled",
@@ -1166,6 +1169,23 @@ var EndDeviceAuthenticationCodeFieldPathsTopLevel = []string{
 	"valid_to",
 	"value",
 }
+var EndDeviceHandoverRoamingFieldPathsNested = []string{
+	"dev_addr",
+	"partner_net_id",
+	"partner_ns_id",
+	"serving",
+	"session_key_id",
+	"started_at",
+}
+
+var EndDeviceHandoverRoamingFieldPathsTopLevel = []string{
+	"dev_addr",
+	"partner_net_id",
+	"partner_ns_id",
+	"serving",
+	"session_key_id",
+	"started_at",
+}
 var EndDeviceFieldPathsNested = []string{
 	"activated_at",
 	"application_server_address",
@@ -1186,6 +1206,13 @@ var EndDeviceFieldPathsNested = []string{
 	"formatters.up_formatter",
 	"formatters.up_formatter_parameter",
 	"frequency_plan_id",
+	"handover_roaming",
+	"handover_roaming.dev_addr",
+	"handover_roaming.partner_net_id",
+	"handover_roaming.partner_ns_id",
+	"handover_roaming.serving",
+	"handover_roaming.session_key_id",
+	"handover_roaming.started_at",
 	"ids",
 	"ids.application_ids",
 	"ids.application_ids.application_id",
@@ -1211,6 +1238,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.adr.mode",
 	"mac_settings.adr.mode.disabled",
 	"mac_settings.adr.mode.dynamic",
+	"mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -1935,6 +1963,7 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"downlink_margin",
 	"formatters",
 	"frequency_plan_id",
+	"handover_roaming",
 	"ids",
 	"join_server_address",
 	"last_dev_nonce",
@@ -2014,6 +2043,13 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
+	"end_device.handover_roaming",
+	"end_device.handover_roaming.dev_addr",
+	"end_device.handover_roaming.partner_net_id",
+	"end_device.handover_roaming.partner_ns_id",
+	"end_device.handover_roaming.serving",
+	"end_device.handover_roaming.session_key_id",
+	"end_device.handover_roaming.started_at",
 	"end_device.ids",
 	"end_device.ids.application_ids",
 	"end_device.ids.application_ids.application_id",
@@ -2039,6 +2075,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -2774,6 +2811,13 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
+	"end_device.handover_roaming",
+	"end_device.handover_roaming.dev_addr",
+	"end_device.handover_roaming.partner_net_id",
+	"end_device.handover_roaming.partner_ns_id",
+	"end_device.handover_roaming.serving",
+	"end_device.handover_roaming.session_key_id",
+	"end_device.handover_roaming.started_at",
 	"end_device.ids",
 	"end_device.ids.application_ids",
 	"end_device.ids.application_ids.application_id",
@@ -2799,6 +2843,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -3585,6 +3630,13 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
+	"end_device.handover_roaming",
+	"end_device.handover_roaming.dev_addr",
+	"end_device.handover_roaming.partner_net_id",
+	"end_device.handover_roaming.partner_ns_id",
+	"end_device.handover_roaming.serving",
+	"end_device.handover_roaming.session_key_id",
+	"end_device.handover_roaming.started_at",
 	"end_device.ids",
 	"end_device.ids.application_ids",
 	"end_device.ids.application_ids.application_id",
@@ -3610,6 +3662,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -4362,6 +4415,13 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
+	"end_device.handover_roaming",
+	"end_device.handover_roaming.dev_addr",
+	"end_device.handover_roaming.partner_net_id",
+	"end_device.handover_roaming.partner_ns_id",
+	"end_device.handover_roaming.serving",
+	"end_device.handover_roaming.session_key_id",
+	"end_device.handover_roaming.started_at",
 	"end_device.ids",
 	"end_device.ids.application_ids",
 	"end_device.ids.application_ids.application_id",
@@ -4387,6 +4447,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -5188,6 +5249,7 @@ var ADRSettings_StaticModeFieldPathsTopLevel = []string{
 	"tx_power_index",
 }
 var ADRSettings_DynamicModeFieldPathsNested = []string{
+	"algorithm",
 	"channel_steering",
 	"channel_steering.mode",
 	"channel_steering.mode.disabled",
@@ -5253,6 +5315,7 @@ var ADRSettings_DynamicModeFieldPathsNested = []string{
 }
 
 var ADRSettings_DynamicModeFieldPathsTopLevel = []string{
+	"algorithm",
 	"channel_steering",
 	"margin",
 	"max_data_rate_index",
@@ -2892,6 +2892,72 @@ func (dst *EndDeviceAuthenticationCode) SetFields(src *EndDeviceAuthenticationCo
 	return nil
 }
 
+func (dst *EndDeviceHandoverRoaming) SetFields(src *EndDeviceHandoverRoaming, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "serving":
+			if len(subs) > 0 {
+				return fmt.Errorf("'serving' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Serving = src.Serving
+			} else {
+				var zero bool
+				dst.Serving = zero
+			}
+		case "partner_net_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'partner_net_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PartnerNetId = src.PartnerNetId
+			} else {
+				dst.PartnerNetId = nil
+			}
+		case "partner_ns_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'partner_ns_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PartnerNsId = src.PartnerNsId
+			} else {
+				dst.PartnerNsId = nil
+			}
+		case "started_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedAt = src.StartedAt
+			} else {
+				dst.StartedAt = nil
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddr = src.DevAddr
+			} else {
+				dst.DevAddr = nil
+			}
+		case "session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyId = src.SessionKeyId
+			} else {
+				dst.SessionKeyId = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -3589,6 +3655,31 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 					dst.LoraAllianceProfileIds = nil
 				}
 			}
+		case "handover_roaming":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceHandoverRoaming
+				if (src == nil || src.HandoverRoaming == nil) && dst.HandoverRoaming == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.HandoverRoaming
+				}
+				if dst.HandoverRoaming != nil {
+					newDst = dst.HandoverRoaming
+				} else {
+					newDst = &EndDeviceHandoverRoaming{}
+					dst.HandoverRoaming = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.HandoverRoaming = src.HandoverRoaming
+				} else {
+					dst.HandoverRoaming = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -4512,6 +4603,16 @@ func (dst *ADRSettings_DynamicMode) SetFields(src *ADRSettings_DynamicMode, path
 					dst.Overrides = nil
 				}
 			}
+		case "algorithm":
+			if len(subs) > 0 {
+				return fmt.Errorf("'algorithm' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Algorithm = src.Algorithm
+			} else {
+				var zero string
+				dst.Algorithm = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -3054,6 +3054,146 @@ var _ interface {
 
 var _EndDeviceAuthenticationCode_Value_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{1,32}$")
 
+// ValidateFields checks the field values on EndDeviceHandoverRoaming with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceHandoverRoaming) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceHandoverRoamingFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "serving":
+			// no validation rules for Serving
+		case "partner_net_id":
+
+			if len(m.GetPartnerNetId()) != 3 {
+				return EndDeviceHandoverRoamingValidationError{
+					field:  "partner_net_id",
+					reason: "value length must be 3 bytes",
+				}
+			}
+
+		case "partner_ns_id":
+
+			if len(m.GetPartnerNsId()) > 0 {
+
+				if len(m.GetPartnerNsId()) != 8 {
+					return EndDeviceHandoverRoamingValidationError{
+						field:  "partner_ns_id",
+						reason: "value length must be 8 bytes",
+					}
+				}
+
+			}
+
+		case "started_at":
+
+			if v, ok := interface{}(m.GetStartedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceHandoverRoamingValidationError{
+						field:  "started_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "dev_addr":
+
+			if len(m.GetDevAddr()) > 0 {
+
+				if len(m.GetDevAddr()) != 4 {
+					return EndDeviceHandoverRoamingValidationError{
+						field:  "dev_addr",
+						reason: "value length must be 4 bytes",
+					}
+				}
+
+			}
+
+		case "session_key_id":
+
+			if len(m.GetSessionKeyId()) > 2048 {
+				return EndDeviceHandoverRoamingValidationError{
+					field:  "session_key_id",
+					reason: "value length must be at most 2048 bytes",
+				}
+			}
+
+		default:
+			return EndDeviceHandoverRoamingValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceHandoverRoamingValidationError is the validation error returned by
+// EndDeviceHandoverRoaming.ValidateFields if the designated constraints
+// aren't met.
+type EndDeviceHandoverRoamingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceHandoverRoamingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceHandoverRoamingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceHandoverRoamingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceHandoverRoamingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceHandoverRoamingValidationError) ErrorName() string {
+	return "EndDeviceHandoverRoamingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceHandoverRoamingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceHandoverRoaming.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceHandoverRoamingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceHandoverRoamingValidationError{}
+
 // ValidateFields checks the field values on EndDevice with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
@@ -3594,6 +3734,18 @@ func (m *EndDevice) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "handover_roaming":
+
+			if v, ok := interface{}(m.GetHandoverRoaming()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceValidationError{
+						field:  "handover_roaming",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return EndDeviceValidationError{
 				field:  name,
@@ -5889,6 +6041,26 @@ func (m *ADRSettings_DynamicMode) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "algorithm":
+
+			if m.GetAlgorithm() != "" {
+
+				if utf8.RuneCountInString(m.GetAlgorithm()) > 36 {
+					return ADRSettings_DynamicModeValidationError{
+						field:  "algorithm",
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_ADRSettings_DynamicMode_Algorithm_Pattern.MatchString(m.GetAlgorithm()) {
+					return ADRSettings_DynamicModeValidationError{
+						field:  "algorithm",
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
 		default:
 			return ADRSettings_DynamicModeValidationError{
 				field:  name,
@@ -5955,6 +6127,8 @@ var _ interface {
 	ErrorName() string
 } = ADRSettings_DynamicModeValidationError{}
 
+var _ADRSettings_DynamicMode_Algorithm_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
 // ValidateFields checks the field values on ADRSettings_DisabledMode with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -2155,6 +2155,7 @@ func AddSelectFlagsForADRSettings_DynamicMode(flags *pflag.FlagSet, prefix strin
 	AddSelectFlagsForADRSettings_DynamicMode_ChannelSteeringSettings(flags, flagsplugin.Prefix("channel-steering", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("overrides", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("overrides", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForADRSettings_DynamicMode_Overrides(flags, flagsplugin.Prefix("overrides", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("algorithm", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("algorithm", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forADRSettings_DynamicMode message from select flags.
@@ -2224,6 +2225,11 @@ func PathsFromSelectFlagsForADRSettings_DynamicMode(flags *pflag.FlagSet, prefix
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("algorithm", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("algorithm", prefix))
+	}
 	return paths, nil
 }
 
@@ -2240,6 +2246,7 @@ func AddSetFlagsForADRSettings_DynamicMode(flags *pflag.FlagSet, prefix string,
 	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("max-nb-trans", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForADRSettings_DynamicMode_ChannelSteeringSettings(flags, flagsplugin.Prefix("channel-steering", prefix), hidden)
 	AddSetFlagsForADRSettings_DynamicMode_Overrides(flags, flagsplugin.Prefix("overrides", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("algorithm", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the ADRSettings_DynamicMode message from flags.
@@ -2314,6 +2321,12 @@ func (m *ADRSettings_DynamicMode) SetFromFlags(flags *pflag.FlagSet, prefix stri
 			paths = append(paths, setPaths...)
 		}
 	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("algorithm", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Algorithm = val
+		paths = append(paths, flagsplugin.Prefix("algorithm", prefix))
+	}
 	return paths, nil
 }
 
@@ -3969,6 +3982,102 @@ func (m *EndDeviceAuthenticationCode) SetFromFlags(flags *pflag.FlagSet, prefix
 	return paths, nil
 }
 
+// AddSelectFlagsForEndDeviceHandoverRoaming adds flags to select fields in EndDeviceHandoverRoaming.
+func AddSelectFlagsForEndDeviceHandoverRoaming(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("serving", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("serving", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("partner-net-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("partner-net-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("partner-ns-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("partner-ns-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("started-at", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("started-at", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("dev-addr", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("dev-addr", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("session-key-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("session-key-id", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forEndDeviceHandoverRoaming message from select flags.
+func PathsFromSelectFlagsForEndDeviceHandoverRoaming(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("serving", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("serving", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("partner_net_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("partner_net_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("partner_ns_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("partner_ns_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("started_at", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("started_at", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("dev_addr", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("dev_addr", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("session_key_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("session_key_id", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForEndDeviceHandoverRoaming adds flags to select fields in EndDeviceHandoverRoaming.
+func AddSetFlagsForEndDeviceHandoverRoaming(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("serving", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(customflags.New3BytesFlag(flagsplugin.Prefix("partner-net-id", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(customflags.New8BytesFlag(flagsplugin.Prefix("partner-ns-id", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewTimestampFlag(flagsplugin.Prefix("started-at", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(customflags.New4BytesFlag(flagsplugin.Prefix("dev-addr", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBytesFlag(flagsplugin.Prefix("session-key-id", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the EndDeviceHandoverRoaming message from flags.
+func (m *EndDeviceHandoverRoaming) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("serving", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Serving = val
+		paths = append(paths, flagsplugin.Prefix("serving", prefix))
+	}
+	if val, changed, err := customflags.GetExactBytes(flags, flagsplugin.Prefix("partner_net_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.PartnerNetId = val
+		paths = append(paths, flagsplugin.Prefix("partner_net_id", prefix))
+	}
+	if val, changed, err := customflags.GetExactBytes(flags, flagsplugin.Prefix("partner_ns_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.PartnerNsId = val
+		paths = append(paths, flagsplugin.Prefix("partner_ns_id", prefix))
+	}
+	if val, changed, err := flagsplugin.GetTimestamp(flags, flagsplugin.Prefix("started_at", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.StartedAt = golang.SetTimestamp(val)
+		paths = append(paths, flagsplugin.Prefix("started_at", prefix))
+	}
+	if val, changed, err := customflags.GetExactBytes(flags, flagsplugin.Prefix("dev_addr", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.DevAddr = val
+		paths = append(paths, flagsplugin.Prefix("dev_addr", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBytes(flags, flagsplugin.Prefix("session_key_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.SessionKeyId = val
+		paths = append(paths, flagsplugin.Prefix("session_key_id", prefix))
+	}
+	return paths, nil
+}
+
 // AddSelectFlagsForEndDevice adds flags to select fields in EndDevice.
 func AddSelectFlagsForEndDevice(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("name", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("name", prefix), false), flagsplugin.WithHidden(hidden)))
@@ -4032,6 +4141,8 @@ func AddSelectFlagsForEndDevice(flags *pflag.FlagSet, prefix string, hidden bool
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("serial-number", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("serial-number", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("lora-alliance-profile-ids", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("lora-alliance-profile-ids", prefix), true), flagsplugin.WithHidden(hidden)))
 	// NOTE: lora_alliance_profile_ids (LoRaAllianceProfileIdentifiers) does not seem to have select flags.
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("handover-roaming", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("handover-roaming", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForEndDeviceHandoverRoaming(flags, flagsplugin.Prefix("handover-roaming", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forEndDevice message from select flags.
@@ -4333,6 +4444,16 @@ func PathsFromSelectFlagsForEndDevice(flags *pflag.FlagSet, prefix string) (path
 		paths = append(paths, flagsplugin.Prefix("lora_alliance_profile_ids", prefix))
 	}
 	// NOTE: lora_alliance_profile_ids (LoRaAllianceProfileIdentifiers) does not seem to have select flags.
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("handover_roaming", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("handover_roaming", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForEndDeviceHandoverRoaming(flags, flagsplugin.Prefix("handover_roaming", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -4388,6 +4509,7 @@ func AddSetFlagsForEndDevice(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewTimestampFlag(flagsplugin.Prefix("last-seen-at", prefix), "", flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("serial-number", prefix), "", flagsplugin.WithHidden(hidden)))
 	// FIXME: Skipping LoraAllianceProfileIds because it does not seem to implement AddSetFlags.
+	AddSetFlagsForEndDeviceHandoverRoaming(flags, flagsplugin.Prefix("handover-roaming", prefix), hidden)
 }
 
 // SetFromFlags sets the EndDevice message from flags.
@@ -4715,5 +4837,15 @@ func (m *EndDevice) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []s
 		paths = append(paths, flagsplugin.Prefix("serial_number", prefix))
 	}
 	// FIXME: Skipping LoraAllianceProfileIds because it does not seem to implement AddSetFlags.
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("handover_roaming", prefix)); changed {
+		if m.HandoverRoaming == nil {
+			m.HandoverRoaming = &EndDeviceHandoverRoaming{}
+		}
+		if setPaths, err := m.HandoverRoaming.SetFromFlags(flags, flagsplugin.Prefix("handover_roaming", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	return paths, nil
 }
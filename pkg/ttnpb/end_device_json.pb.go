@@ -1601,6 +1601,11 @@ func (x *ADRSettings_DynamicMode) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: ADRSettings_DynamicMode_Overrides does not seem to implement MarshalProtoJSON.
 		golang.MarshalMessage(s, x.Overrides)
 	}
+	if x.Algorithm != "" || s.HasField("algorithm") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("algorithm")
+		s.WriteString(x.Algorithm)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -1709,6 +1714,9 @@ func (x *ADRSettings_DynamicMode) UnmarshalProtoJSON(s *jsonplugin.UnmarshalStat
 			var v ADRSettings_DynamicMode_Overrides
 			golang.UnmarshalMessage(s, &v)
 			x.Overrides = &v
+		case "algorithm":
+			s.AddField("algorithm")
+			x.Algorithm = s.ReadString()
 		}
 	})
 }
@@ -3446,6 +3454,100 @@ func (x *MACState) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the EndDeviceHandoverRoaming message to JSON.
+func (x *EndDeviceHandoverRoaming) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Serving || s.HasField("serving") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("serving")
+		s.WriteBool(x.Serving)
+	}
+	if len(x.PartnerNetId) > 0 || s.HasField("partner_net_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("partner_net_id")
+		types.MarshalHEXBytes(s.WithField("partner_net_id"), x.PartnerNetId)
+	}
+	if len(x.PartnerNsId) > 0 || s.HasField("partner_ns_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("partner_ns_id")
+		types.MarshalHEXBytes(s.WithField("partner_ns_id"), x.PartnerNsId)
+	}
+	if x.StartedAt != nil || s.HasField("started_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("started_at")
+		if x.StartedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.StartedAt)
+		}
+	}
+	if len(x.DevAddr) > 0 || s.HasField("dev_addr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr")
+		types.MarshalHEXBytes(s.WithField("dev_addr"), x.DevAddr)
+	}
+	if len(x.SessionKeyId) > 0 || s.HasField("session_key_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("session_key_id")
+		s.WriteBytes(x.SessionKeyId)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceHandoverRoaming to JSON.
+func (x *EndDeviceHandoverRoaming) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceHandoverRoaming message from JSON.
+func (x *EndDeviceHandoverRoaming) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "serving":
+			s.AddField("serving")
+			x.Serving = s.ReadBool()
+		case "partner_net_id", "partnerNetId":
+			s.AddField("partner_net_id")
+			x.PartnerNetId = types.Unmarshal3Bytes(s.WithField("partner_net_id", false))
+		case "partner_ns_id", "partnerNsId":
+			s.AddField("partner_ns_id")
+			x.PartnerNsId = types.Unmarshal8Bytes(s.WithField("partner_ns_id", false))
+		case "started_at", "startedAt":
+			s.AddField("started_at")
+			if s.ReadNil() {
+				x.StartedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.StartedAt = v
+		case "dev_addr", "devAddr":
+			s.AddField("dev_addr")
+			x.DevAddr = types.Unmarshal4Bytes(s.WithField("dev_addr", false))
+		case "session_key_id", "sessionKeyId":
+			s.AddField("session_key_id")
+			x.SessionKeyId = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceHandoverRoaming from JSON.
+func (x *EndDeviceHandoverRoaming) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the EndDevice message to JSON.
 func (x *EndDevice) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -3775,6 +3877,11 @@ func (x *EndDevice) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: LoRaAllianceProfileIdentifiers does not seem to implement MarshalProtoJSON.
 		golang.MarshalMessage(s, x.LoraAllianceProfileIds)
 	}
+	if x.HandoverRoaming != nil || s.HasField("handover_roaming") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("handover_roaming")
+		x.HandoverRoaming.MarshalProtoJSON(s.WithField("handover_roaming"))
+	}
 	s.WriteObjectEnd()
 }
 
@@ -4110,6 +4217,13 @@ func (x *EndDevice) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			var v LoRaAllianceProfileIdentifiers
 			golang.UnmarshalMessage(s, &v)
 			x.LoraAllianceProfileIds = &v
+		case "handover_roaming", "handoverRoaming":
+			if s.ReadNil() {
+				x.HandoverRoaming = nil
+				return
+			}
+			x.HandoverRoaming = &EndDeviceHandoverRoaming{}
+			x.HandoverRoaming.UnmarshalProtoJSON(s.WithField("handover_roaming", true))
 		}
 	})
 }
@@ -96,6 +96,13 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"created_at",
 	"downlink_margin",
 	"frequency_plan_id",
+	"handover_roaming",
+	"handover_roaming.dev_addr",
+	"handover_roaming.partner_net_id",
+	"handover_roaming.partner_ns_id",
+	"handover_roaming.serving",
+	"handover_roaming.session_key_id",
+	"handover_roaming.started_at",
 	"ids",
 	"ids.application_ids",
 	"ids.application_ids.application_id",
@@ -111,6 +118,7 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_settings.adr.mode",
 	"mac_settings.adr.mode.disabled",
 	"mac_settings.adr.mode.dynamic",
+	"mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -1022,6 +1030,7 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_settings.adr.mode",
 			"mac_settings.adr.mode.disabled",
 			"mac_settings.adr.mode.dynamic",
+			"mac_settings.adr.mode.dynamic.algorithm",
 			"mac_settings.adr.mode.dynamic.channel_steering",
 			"mac_settings.adr.mode.dynamic.channel_steering.mode",
 			"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
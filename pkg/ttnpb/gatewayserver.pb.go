@@ -29,6 +29,7 @@ import (
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -386,6 +387,423 @@ func (x *BatchGetGatewayConnectionStatsResponse) GetEntries() map[string]*Gatewa
 	return nil
 }
 
+type GatewayTrafficCaptureRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time when the Gateway Server recorded the message.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are assignable to Message:
+	//	*GatewayTrafficCaptureRecord_UplinkMessage
+	//	*GatewayTrafficCaptureRecord_DownlinkMessage
+	//	*GatewayTrafficCaptureRecord_TxAcknowledgment
+	Message isGatewayTrafficCaptureRecord_Message `protobuf_oneof:"message"`
+}
+
+func (x *GatewayTrafficCaptureRecord) Reset() {
+	*x = GatewayTrafficCaptureRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayTrafficCaptureRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayTrafficCaptureRecord) ProtoMessage() {}
+
+func (x *GatewayTrafficCaptureRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayTrafficCaptureRecord.ProtoReflect.Descriptor instead.
+func (*GatewayTrafficCaptureRecord) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GatewayTrafficCaptureRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCaptureRecord) GetMessage() isGatewayTrafficCaptureRecord_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *GatewayTrafficCaptureRecord) GetUplinkMessage() *UplinkMessage {
+	if x, ok := x.GetMessage().(*GatewayTrafficCaptureRecord_UplinkMessage); ok {
+		return x.UplinkMessage
+	}
+	return nil
+}
+
+func (x *GatewayTrafficCaptureRecord) GetDownlinkMessage() *DownlinkMessage {
+	if x, ok := x.GetMessage().(*GatewayTrafficCaptureRecord_DownlinkMessage); ok {
+		return x.DownlinkMessage
+	}
+	return nil
+}
+
+func (x *GatewayTrafficCaptureRecord) GetTxAcknowledgment() *TxAcknowledgment {
+	if x, ok := x.GetMessage().(*GatewayTrafficCaptureRecord_TxAcknowledgment); ok {
+		return x.TxAcknowledgment
+	}
+	return nil
+}
+
+type isGatewayTrafficCaptureRecord_Message interface {
+	isGatewayTrafficCaptureRecord_Message()
+}
+
+type GatewayTrafficCaptureRecord_UplinkMessage struct {
+	UplinkMessage *UplinkMessage `protobuf:"bytes,2,opt,name=uplink_message,json=uplinkMessage,proto3,oneof"`
+}
+
+type GatewayTrafficCaptureRecord_DownlinkMessage struct {
+	DownlinkMessage *DownlinkMessage `protobuf:"bytes,3,opt,name=downlink_message,json=downlinkMessage,proto3,oneof"`
+}
+
+type GatewayTrafficCaptureRecord_TxAcknowledgment struct {
+	TxAcknowledgment *TxAcknowledgment `protobuf:"bytes,4,opt,name=tx_acknowledgment,json=txAcknowledgment,proto3,oneof"`
+}
+
+func (*GatewayTrafficCaptureRecord_UplinkMessage) isGatewayTrafficCaptureRecord_Message() {}
+
+func (*GatewayTrafficCaptureRecord_DownlinkMessage) isGatewayTrafficCaptureRecord_Message() {}
+
+func (*GatewayTrafficCaptureRecord_TxAcknowledgment) isGatewayTrafficCaptureRecord_Message() {}
+
+// A past connection session of a gateway.
+type GatewayConnectionSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectedAt           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	DisconnectedAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=disconnected_at,json=disconnectedAt,proto3" json:"disconnected_at,omitempty"`
+	Protocol              string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"` // Protocol used to connect (for example, udp, mqtt, grpc)
+	GatewayRemoteAddress  *GatewayRemoteAddress  `protobuf:"bytes,4,opt,name=gateway_remote_address,json=gatewayRemoteAddress,proto3" json:"gateway_remote_address,omitempty"`
+	UplinkCount           uint64                 `protobuf:"varint,5,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	DownlinkCount         uint64                 `protobuf:"varint,6,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	TxAcknowledgmentCount uint64                 `protobuf:"varint,7,opt,name=tx_acknowledgment_count,json=txAcknowledgmentCount,proto3" json:"tx_acknowledgment_count,omitempty"`
+	// Round-trip times recorded at the end of the session.
+	RoundTripTimes *GatewayConnectionSession_RoundTripTimes `protobuf:"bytes,8,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
+}
+
+func (x *GatewayConnectionSession) Reset() {
+	*x = GatewayConnectionSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayConnectionSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayConnectionSession) ProtoMessage() {}
+
+func (x *GatewayConnectionSession) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayConnectionSession.ProtoReflect.Descriptor instead.
+func (*GatewayConnectionSession) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GatewayConnectionSession) GetConnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession) GetDisconnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisconnectedAt
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *GatewayConnectionSession) GetGatewayRemoteAddress() *GatewayRemoteAddress {
+	if x != nil {
+		return x.GatewayRemoteAddress
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession) GetUplinkCount() uint64 {
+	if x != nil {
+		return x.UplinkCount
+	}
+	return 0
+}
+
+func (x *GatewayConnectionSession) GetDownlinkCount() uint64 {
+	if x != nil {
+		return x.DownlinkCount
+	}
+	return 0
+}
+
+func (x *GatewayConnectionSession) GetTxAcknowledgmentCount() uint64 {
+	if x != nil {
+		return x.TxAcknowledgmentCount
+	}
+	return 0
+}
+
+func (x *GatewayConnectionSession) GetRoundTripTimes() *GatewayConnectionSession_RoundTripTimes {
+	if x != nil {
+		return x.RoundTripTimes
+	}
+	return nil
+}
+
+type ListGatewayConnectionSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Only list the sessions that ended after this time.
+	DisconnectedAfter *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=disconnected_after,json=disconnectedAfter,proto3" json:"disconnected_after,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page uint32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListGatewayConnectionSessionsRequest) Reset() {
+	*x = ListGatewayConnectionSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGatewayConnectionSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGatewayConnectionSessionsRequest) ProtoMessage() {}
+
+func (x *ListGatewayConnectionSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGatewayConnectionSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListGatewayConnectionSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListGatewayConnectionSessionsRequest) GetGatewayIds() *GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *ListGatewayConnectionSessionsRequest) GetDisconnectedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisconnectedAfter
+	}
+	return nil
+}
+
+func (x *ListGatewayConnectionSessionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListGatewayConnectionSessionsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type GatewayConnectionSessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sessions, from the most recent to the oldest.
+	Sessions []*GatewayConnectionSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *GatewayConnectionSessions) Reset() {
+	*x = GatewayConnectionSessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayConnectionSessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayConnectionSessions) ProtoMessage() {}
+
+func (x *GatewayConnectionSessions) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayConnectionSessions.ProtoReflect.Descriptor instead.
+func (*GatewayConnectionSessions) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GatewayConnectionSessions) GetSessions() []*GatewayConnectionSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GatewayConnectionSession_RoundTripTimes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min    *durationpb.Duration `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max    *durationpb.Duration `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	Median *durationpb.Duration `protobuf:"bytes,3,opt,name=median,proto3" json:"median,omitempty"`
+	P90    *durationpb.Duration `protobuf:"bytes,4,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99    *durationpb.Duration `protobuf:"bytes,5,opt,name=p99,proto3" json:"p99,omitempty"`
+	Count  uint32               `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) Reset() {
+	*x = GatewayConnectionSession_RoundTripTimes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayConnectionSession_RoundTripTimes) ProtoMessage() {}
+
+func (x *GatewayConnectionSession_RoundTripTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayConnectionSession_RoundTripTimes.ProtoReflect.Descriptor instead.
+func (*GatewayConnectionSession_RoundTripTimes) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) GetMin() *durationpb.Duration {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) GetMax() *durationpb.Duration {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) GetMedian() *durationpb.Duration {
+	if x != nil {
+		return x.Median
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) GetP90() *durationpb.Duration {
+	if x != nil {
+		return x.P90
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) GetP99() *durationpb.Duration {
+	if x != nil {
+		return x.P99
+	}
+	return nil
+}
+
+func (x *GatewayConnectionSession_RoundTripTimes) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_ttn_lorawan_v3_gatewayserver_proto protoreflect.FileDescriptor
 
 var file_ttn_lorawan_v3_gatewayserver_proto_rawDesc = []byte{
@@ -400,89 +818,182 @@ var file_ttn_lorawan_v3_gatewayserver_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70,
-	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1a, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76,
-	0x33, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74,
-	0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x74, 0x6e,
-	0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74,
-	0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x74, 0x6e,
-	0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x74, 0x74, 0x6e, 0x2f,
-	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6d, 0x71, 0x74, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77,
-	0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x01,
-	0x0a, 0x09, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x55, 0x70, 0x12, 0x46, 0x0a, 0x0f, 0x75,
-	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
-	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x78, 0x5f,
-	0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
-	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x74, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x4a, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
-	0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x18, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x39, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa,
-	0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
-	0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10,
-	0x0a, 0x03, 0x72, 0x78, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x78, 0x31,
-	0x12, 0x10, 0x0a, 0x03, 0x72, 0x78, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72,
-	0x78, 0x32, 0x22, 0x5d, 0x0a, 0x1c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x22, 0xb3, 0x01, 0x0a, 0x25, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x47, 0x61,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f,
+	0x76, 0x33, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x74,
+	0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x74,
+	0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x74, 0x74, 0x6e,
+	0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6d, 0x71, 0x74, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8,
+	0x01, 0x0a, 0x09, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x55, 0x70, 0x12, 0x46, 0x0a, 0x0f,
+	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x78,
+	0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x74, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x0b, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x4a, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x18, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x41, 0x0a, 0x0d,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x78, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x78,
+	0x31, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x78, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x72, 0x78, 0x32, 0x22, 0x5d, 0x0a, 0x1c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x25, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0b,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10,
+	0x64, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xeb, 0x01, 0x0a, 0x26, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x47, 0x61,
 	0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0b, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64,
-	0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x1a, 0x62, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x02, 0x0a, 0x1b, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0d, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c,
+	0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x11,
+	0x74, 0x78, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x74, 0x78, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x8b, 0x06,
+	0x0a, 0x18, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xeb, 0x01, 0x0a, 0x26, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
-	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x1a, 0x62, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x5a, 0x0a, 0x16, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x14, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x36, 0x0a, 0x17, 0x74, 0x78, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x74, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x61, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
 	0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xa2, 0x04, 0x0a, 0x05, 0x47, 0x74, 0x77, 0x47, 0x73, 0x12,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x0e, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x1a, 0x8d, 0x02, 0x0a, 0x0e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x03, 0x6d,
+	0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x2b, 0x0a, 0x03, 0x70,
+	0x39, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x70, 0x39, 0x30, 0x12, 0x2b, 0x0a, 0x03, 0x70, 0x39, 0x39, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x03, 0x70, 0x39, 0x39, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x24,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x49, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x2a, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x22, 0x61, 0x0a, 0x19, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x44, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xa2, 0x04, 0x0a, 0x05, 0x47, 0x74, 0x77, 0x47, 0x73, 0x12,
 	0x49, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x19,
 	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
 	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x55, 0x70, 0x1a, 0x1b, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
@@ -557,10 +1068,31 @@ var file_ttn_lorawan_v3_gatewayserver_proto_rawDesc = []byte{
 	0x74, 0x73, 0x1a, 0x2c, 0x92, 0x41, 0x29, 0x12, 0x27, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
 	0x65, 0x20, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
-	0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74,
-	0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x32, 0x77, 0x0a, 0x09, 0x47, 0x73, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x6a, 0x0a,
+	0x15, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x2b, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x30, 0x01, 0x32, 0xd2, 0x01, 0x0a, 0x0a, 0x47, 0x73,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc3, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x41, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x67, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x73, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -575,60 +1107,87 @@ func file_ttn_lorawan_v3_gatewayserver_proto_rawDescGZIP() []byte {
 	return file_ttn_lorawan_v3_gatewayserver_proto_rawDescData
 }
 
-var file_ttn_lorawan_v3_gatewayserver_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_ttn_lorawan_v3_gatewayserver_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_ttn_lorawan_v3_gatewayserver_proto_goTypes = []interface{}{
-	(*GatewayUp)(nil),                              // 0: ttn.lorawan.v3.GatewayUp
-	(*GatewayDown)(nil),                            // 1: ttn.lorawan.v3.GatewayDown
-	(*ScheduleDownlinkResponse)(nil),               // 2: ttn.lorawan.v3.ScheduleDownlinkResponse
-	(*ScheduleDownlinkErrorDetails)(nil),           // 3: ttn.lorawan.v3.ScheduleDownlinkErrorDetails
-	(*BatchGetGatewayConnectionStatsRequest)(nil),  // 4: ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest
-	(*BatchGetGatewayConnectionStatsResponse)(nil), // 5: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse
-	nil,                            // 6: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry
-	(*UplinkMessage)(nil),          // 7: ttn.lorawan.v3.UplinkMessage
-	(*GatewayStatus)(nil),          // 8: ttn.lorawan.v3.GatewayStatus
-	(*TxAcknowledgment)(nil),       // 9: ttn.lorawan.v3.TxAcknowledgment
-	(*DownlinkMessage)(nil),        // 10: ttn.lorawan.v3.DownlinkMessage
-	(*durationpb.Duration)(nil),    // 11: google.protobuf.Duration
-	(*DownlinkPath)(nil),           // 12: ttn.lorawan.v3.DownlinkPath
-	(*ErrorDetails)(nil),           // 13: ttn.lorawan.v3.ErrorDetails
-	(*GatewayIdentifiers)(nil),     // 14: ttn.lorawan.v3.GatewayIdentifiers
-	(*fieldmaskpb.FieldMask)(nil),  // 15: google.protobuf.FieldMask
-	(*GatewayConnectionStats)(nil), // 16: ttn.lorawan.v3.GatewayConnectionStats
-	(*emptypb.Empty)(nil),          // 17: google.protobuf.Empty
-	(*ConcentratorConfig)(nil),     // 18: ttn.lorawan.v3.ConcentratorConfig
-	(*MQTTConnectionInfo)(nil),     // 19: ttn.lorawan.v3.MQTTConnectionInfo
+	(*GatewayUp)(nil),                               // 0: ttn.lorawan.v3.GatewayUp
+	(*GatewayDown)(nil),                             // 1: ttn.lorawan.v3.GatewayDown
+	(*ScheduleDownlinkResponse)(nil),                // 2: ttn.lorawan.v3.ScheduleDownlinkResponse
+	(*ScheduleDownlinkErrorDetails)(nil),            // 3: ttn.lorawan.v3.ScheduleDownlinkErrorDetails
+	(*BatchGetGatewayConnectionStatsRequest)(nil),   // 4: ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest
+	(*BatchGetGatewayConnectionStatsResponse)(nil),  // 5: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse
+	(*GatewayTrafficCaptureRecord)(nil),             // 6: ttn.lorawan.v3.GatewayTrafficCaptureRecord
+	(*GatewayConnectionSession)(nil),                // 7: ttn.lorawan.v3.GatewayConnectionSession
+	(*ListGatewayConnectionSessionsRequest)(nil),    // 8: ttn.lorawan.v3.ListGatewayConnectionSessionsRequest
+	(*GatewayConnectionSessions)(nil),               // 9: ttn.lorawan.v3.GatewayConnectionSessions
+	nil,                                             // 10: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry
+	(*GatewayConnectionSession_RoundTripTimes)(nil), // 11: ttn.lorawan.v3.GatewayConnectionSession.RoundTripTimes
+	(*UplinkMessage)(nil),                           // 12: ttn.lorawan.v3.UplinkMessage
+	(*GatewayStatus)(nil),                           // 13: ttn.lorawan.v3.GatewayStatus
+	(*TxAcknowledgment)(nil),                        // 14: ttn.lorawan.v3.TxAcknowledgment
+	(*DownlinkMessage)(nil),                         // 15: ttn.lorawan.v3.DownlinkMessage
+	(*durationpb.Duration)(nil),                     // 16: google.protobuf.Duration
+	(*DownlinkPath)(nil),                            // 17: ttn.lorawan.v3.DownlinkPath
+	(*ErrorDetails)(nil),                            // 18: ttn.lorawan.v3.ErrorDetails
+	(*GatewayIdentifiers)(nil),                      // 19: ttn.lorawan.v3.GatewayIdentifiers
+	(*fieldmaskpb.FieldMask)(nil),                   // 20: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                   // 21: google.protobuf.Timestamp
+	(*GatewayRemoteAddress)(nil),                    // 22: ttn.lorawan.v3.GatewayRemoteAddress
+	(*GatewayConnectionStats)(nil),                  // 23: ttn.lorawan.v3.GatewayConnectionStats
+	(*emptypb.Empty)(nil),                           // 24: google.protobuf.Empty
+	(*ConcentratorConfig)(nil),                      // 25: ttn.lorawan.v3.ConcentratorConfig
+	(*MQTTConnectionInfo)(nil),                      // 26: ttn.lorawan.v3.MQTTConnectionInfo
 }
 var file_ttn_lorawan_v3_gatewayserver_proto_depIdxs = []int32{
-	7,  // 0: ttn.lorawan.v3.GatewayUp.uplink_messages:type_name -> ttn.lorawan.v3.UplinkMessage
-	8,  // 1: ttn.lorawan.v3.GatewayUp.gateway_status:type_name -> ttn.lorawan.v3.GatewayStatus
-	9,  // 2: ttn.lorawan.v3.GatewayUp.tx_acknowledgment:type_name -> ttn.lorawan.v3.TxAcknowledgment
-	10, // 3: ttn.lorawan.v3.GatewayDown.downlink_message:type_name -> ttn.lorawan.v3.DownlinkMessage
-	11, // 4: ttn.lorawan.v3.ScheduleDownlinkResponse.delay:type_name -> google.protobuf.Duration
-	12, // 5: ttn.lorawan.v3.ScheduleDownlinkResponse.downlink_path:type_name -> ttn.lorawan.v3.DownlinkPath
-	13, // 6: ttn.lorawan.v3.ScheduleDownlinkErrorDetails.path_errors:type_name -> ttn.lorawan.v3.ErrorDetails
-	14, // 7: ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
-	15, // 8: ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest.field_mask:type_name -> google.protobuf.FieldMask
-	6,  // 9: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.entries:type_name -> ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry
-	16, // 10: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry.value:type_name -> ttn.lorawan.v3.GatewayConnectionStats
-	0,  // 11: ttn.lorawan.v3.GtwGs.LinkGateway:input_type -> ttn.lorawan.v3.GatewayUp
-	17, // 12: ttn.lorawan.v3.GtwGs.GetConcentratorConfig:input_type -> google.protobuf.Empty
-	14, // 13: ttn.lorawan.v3.GtwGs.GetMQTTConnectionInfo:input_type -> ttn.lorawan.v3.GatewayIdentifiers
-	14, // 14: ttn.lorawan.v3.GtwGs.GetMQTTV2ConnectionInfo:input_type -> ttn.lorawan.v3.GatewayIdentifiers
-	10, // 15: ttn.lorawan.v3.NsGs.ScheduleDownlink:input_type -> ttn.lorawan.v3.DownlinkMessage
-	14, // 16: ttn.lorawan.v3.Gs.GetGatewayConnectionStats:input_type -> ttn.lorawan.v3.GatewayIdentifiers
-	4,  // 17: ttn.lorawan.v3.Gs.BatchGetGatewayConnectionStats:input_type -> ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest
-	1,  // 18: ttn.lorawan.v3.GtwGs.LinkGateway:output_type -> ttn.lorawan.v3.GatewayDown
-	18, // 19: ttn.lorawan.v3.GtwGs.GetConcentratorConfig:output_type -> ttn.lorawan.v3.ConcentratorConfig
-	19, // 20: ttn.lorawan.v3.GtwGs.GetMQTTConnectionInfo:output_type -> ttn.lorawan.v3.MQTTConnectionInfo
-	19, // 21: ttn.lorawan.v3.GtwGs.GetMQTTV2ConnectionInfo:output_type -> ttn.lorawan.v3.MQTTConnectionInfo
-	2,  // 22: ttn.lorawan.v3.NsGs.ScheduleDownlink:output_type -> ttn.lorawan.v3.ScheduleDownlinkResponse
-	16, // 23: ttn.lorawan.v3.Gs.GetGatewayConnectionStats:output_type -> ttn.lorawan.v3.GatewayConnectionStats
-	5,  // 24: ttn.lorawan.v3.Gs.BatchGetGatewayConnectionStats:output_type -> ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	12, // 0: ttn.lorawan.v3.GatewayUp.uplink_messages:type_name -> ttn.lorawan.v3.UplinkMessage
+	13, // 1: ttn.lorawan.v3.GatewayUp.gateway_status:type_name -> ttn.lorawan.v3.GatewayStatus
+	14, // 2: ttn.lorawan.v3.GatewayUp.tx_acknowledgment:type_name -> ttn.lorawan.v3.TxAcknowledgment
+	15, // 3: ttn.lorawan.v3.GatewayDown.downlink_message:type_name -> ttn.lorawan.v3.DownlinkMessage
+	16, // 4: ttn.lorawan.v3.ScheduleDownlinkResponse.delay:type_name -> google.protobuf.Duration
+	17, // 5: ttn.lorawan.v3.ScheduleDownlinkResponse.downlink_path:type_name -> ttn.lorawan.v3.DownlinkPath
+	18, // 6: ttn.lorawan.v3.ScheduleDownlinkErrorDetails.path_errors:type_name -> ttn.lorawan.v3.ErrorDetails
+	19, // 7: ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	20, // 8: ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	10, // 9: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.entries:type_name -> ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry
+	21, // 10: ttn.lorawan.v3.GatewayTrafficCaptureRecord.time:type_name -> google.protobuf.Timestamp
+	12, // 11: ttn.lorawan.v3.GatewayTrafficCaptureRecord.uplink_message:type_name -> ttn.lorawan.v3.UplinkMessage
+	15, // 12: ttn.lorawan.v3.GatewayTrafficCaptureRecord.downlink_message:type_name -> ttn.lorawan.v3.DownlinkMessage
+	14, // 13: ttn.lorawan.v3.GatewayTrafficCaptureRecord.tx_acknowledgment:type_name -> ttn.lorawan.v3.TxAcknowledgment
+	21, // 14: ttn.lorawan.v3.GatewayConnectionSession.connected_at:type_name -> google.protobuf.Timestamp
+	21, // 15: ttn.lorawan.v3.GatewayConnectionSession.disconnected_at:type_name -> google.protobuf.Timestamp
+	22, // 16: ttn.lorawan.v3.GatewayConnectionSession.gateway_remote_address:type_name -> ttn.lorawan.v3.GatewayRemoteAddress
+	11, // 17: ttn.lorawan.v3.GatewayConnectionSession.round_trip_times:type_name -> ttn.lorawan.v3.GatewayConnectionSession.RoundTripTimes
+	19, // 18: ttn.lorawan.v3.ListGatewayConnectionSessionsRequest.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	21, // 19: ttn.lorawan.v3.ListGatewayConnectionSessionsRequest.disconnected_after:type_name -> google.protobuf.Timestamp
+	7,  // 20: ttn.lorawan.v3.GatewayConnectionSessions.sessions:type_name -> ttn.lorawan.v3.GatewayConnectionSession
+	23, // 21: ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry.value:type_name -> ttn.lorawan.v3.GatewayConnectionStats
+	16, // 22: ttn.lorawan.v3.GatewayConnectionSession.RoundTripTimes.min:type_name -> google.protobuf.Duration
+	16, // 23: ttn.lorawan.v3.GatewayConnectionSession.RoundTripTimes.max:type_name -> google.protobuf.Duration
+	16, // 24: ttn.lorawan.v3.GatewayConnectionSession.RoundTripTimes.median:type_name -> google.protobuf.Duration
+	16, // 25: ttn.lorawan.v3.GatewayConnectionSession.RoundTripTimes.p90:type_name -> google.protobuf.Duration
+	16, // 26: ttn.lorawan.v3.GatewayConnectionSession.RoundTripTimes.p99:type_name -> google.protobuf.Duration
+	0,  // 27: ttn.lorawan.v3.GtwGs.LinkGateway:input_type -> ttn.lorawan.v3.GatewayUp
+	24, // 28: ttn.lorawan.v3.GtwGs.GetConcentratorConfig:input_type -> google.protobuf.Empty
+	19, // 29: ttn.lorawan.v3.GtwGs.GetMQTTConnectionInfo:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	19, // 30: ttn.lorawan.v3.GtwGs.GetMQTTV2ConnectionInfo:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	15, // 31: ttn.lorawan.v3.NsGs.ScheduleDownlink:input_type -> ttn.lorawan.v3.DownlinkMessage
+	19, // 32: ttn.lorawan.v3.Gs.GetGatewayConnectionStats:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	4,  // 33: ttn.lorawan.v3.Gs.BatchGetGatewayConnectionStats:input_type -> ttn.lorawan.v3.BatchGetGatewayConnectionStatsRequest
+	19, // 34: ttn.lorawan.v3.GsCapture.CaptureGatewayTraffic:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	8,  // 35: ttn.lorawan.v3.GsSessions.ListGatewayConnectionSessions:input_type -> ttn.lorawan.v3.ListGatewayConnectionSessionsRequest
+	1,  // 36: ttn.lorawan.v3.GtwGs.LinkGateway:output_type -> ttn.lorawan.v3.GatewayDown
+	25, // 37: ttn.lorawan.v3.GtwGs.GetConcentratorConfig:output_type -> ttn.lorawan.v3.ConcentratorConfig
+	26, // 38: ttn.lorawan.v3.GtwGs.GetMQTTConnectionInfo:output_type -> ttn.lorawan.v3.MQTTConnectionInfo
+	26, // 39: ttn.lorawan.v3.GtwGs.GetMQTTV2ConnectionInfo:output_type -> ttn.lorawan.v3.MQTTConnectionInfo
+	2,  // 40: ttn.lorawan.v3.NsGs.ScheduleDownlink:output_type -> ttn.lorawan.v3.ScheduleDownlinkResponse
+	23, // 41: ttn.lorawan.v3.Gs.GetGatewayConnectionStats:output_type -> ttn.lorawan.v3.GatewayConnectionStats
+	5,  // 42: ttn.lorawan.v3.Gs.BatchGetGatewayConnectionStats:output_type -> ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse
+	6,  // 43: ttn.lorawan.v3.GsCapture.CaptureGatewayTraffic:output_type -> ttn.lorawan.v3.GatewayTrafficCaptureRecord
+	9,  // 44: ttn.lorawan.v3.GsSessions.ListGatewayConnectionSessions:output_type -> ttn.lorawan.v3.GatewayConnectionSessions
+	36, // [36:45] is the sub-list for method output_type
+	27, // [27:36] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_ttn_lorawan_v3_gatewayserver_proto_init() }
@@ -716,6 +1275,71 @@ func file_ttn_lorawan_v3_gatewayserver_proto_init() {
 				return nil
 			}
 		}
+		file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayTrafficCaptureRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayConnectionSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGatewayConnectionSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayConnectionSessions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayConnectionSession_RoundTripTimes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ttn_lorawan_v3_gatewayserver_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*GatewayTrafficCaptureRecord_UplinkMessage)(nil),
+		(*GatewayTrafficCaptureRecord_DownlinkMessage)(nil),
+		(*GatewayTrafficCaptureRecord_TxAcknowledgment)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -723,9 +1347,9 @@ func file_ttn_lorawan_v3_gatewayserver_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ttn_lorawan_v3_gatewayserver_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   12,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   5,
 		},
 		GoTypes:           file_ttn_lorawan_v3_gatewayserver_proto_goTypes,
 		DependencyIndexes: file_ttn_lorawan_v3_gatewayserver_proto_depIdxs,
@@ -267,6 +267,76 @@ func local_request_Gs_BatchGetGatewayConnectionStats_0(ctx context.Context, mars
 
 }
 
+var (
+	filter_GsSessions_ListGatewayConnectionSessions_0 = &utilities.DoubleArray{Encoding: map[string]int{"gateway_ids": 0, "gateway_id": 1}, Base: []int{1, 1, 1, 0}, Check: []int{0, 1, 2, 3}}
+)
+
+func request_GsSessions_ListGatewayConnectionSessions_0(ctx context.Context, marshaler runtime.Marshaler, client GsSessionsClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListGatewayConnectionSessionsRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["gateway_ids.gateway_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "gateway_ids.gateway_id")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "gateway_ids.gateway_id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "gateway_ids.gateway_id", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_GsSessions_ListGatewayConnectionSessions_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.ListGatewayConnectionSessions(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_GsSessions_ListGatewayConnectionSessions_0(ctx context.Context, marshaler runtime.Marshaler, server GsSessionsServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListGatewayConnectionSessionsRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["gateway_ids.gateway_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "gateway_ids.gateway_id")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "gateway_ids.gateway_id", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "gateway_ids.gateway_id", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_GsSessions_ListGatewayConnectionSessions_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.ListGatewayConnectionSessions(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 // RegisterGtwGsHandlerServer registers the http handlers for service GtwGs to "mux".
 // UnaryRPC     :call GtwGsServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -385,6 +455,40 @@ func RegisterGsHandlerServer(ctx context.Context, mux *runtime.ServeMux, server
 	return nil
 }
 
+// RegisterGsSessionsHandlerServer registers the http handlers for service GsSessions to "mux".
+// UnaryRPC     :call GsSessionsServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterGsSessionsHandlerFromEndpoint instead.
+func RegisterGsSessionsHandlerServer(ctx context.Context, mux *runtime.ServeMux, server GsSessionsServer) error {
+
+	mux.Handle("GET", pattern_GsSessions_ListGatewayConnectionSessions_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/ttn.lorawan.v3.GsSessions/ListGatewayConnectionSessions", runtime.WithHTTPPathPattern("/gs/gateways/{gateway_ids.gateway_id}/connection/sessions"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_GsSessions_ListGatewayConnectionSessions_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_GsSessions_ListGatewayConnectionSessions_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
 // RegisterGtwGsHandlerFromEndpoint is same as RegisterGtwGsHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterGtwGsHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -578,3 +682,74 @@ var (
 
 	forward_Gs_BatchGetGatewayConnectionStats_0 = runtime.ForwardResponseMessage
 )
+
+// RegisterGsSessionsHandlerFromEndpoint is same as RegisterGsSessionsHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterGsSessionsHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterGsSessionsHandler(ctx, mux, conn)
+}
+
+// RegisterGsSessionsHandler registers the http handlers for service GsSessions to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterGsSessionsHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterGsSessionsHandlerClient(ctx, mux, NewGsSessionsClient(conn))
+}
+
+// RegisterGsSessionsHandlerClient registers the http handlers for service GsSessions
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "GsSessionsClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "GsSessionsClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "GsSessionsClient" to call the correct interceptors.
+func RegisterGsSessionsHandlerClient(ctx context.Context, mux *runtime.ServeMux, client GsSessionsClient) error {
+
+	mux.Handle("GET", pattern_GsSessions_ListGatewayConnectionSessions_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/ttn.lorawan.v3.GsSessions/ListGatewayConnectionSessions", runtime.WithHTTPPathPattern("/gs/gateways/{gateway_ids.gateway_id}/connection/sessions"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_GsSessions_ListGatewayConnectionSessions_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_GsSessions_ListGatewayConnectionSessions_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_GsSessions_ListGatewayConnectionSessions_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 2, 4}, []string{"gs", "gateways", "gateway_ids.gateway_id", "connection", "sessions"}, ""))
+)
+
+var (
+	forward_GsSessions_ListGatewayConnectionSessions_0 = runtime.ForwardResponseMessage
+)
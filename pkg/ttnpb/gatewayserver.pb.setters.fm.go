@@ -243,3 +243,422 @@ func (dst *BatchGetGatewayConnectionStatsResponse) SetFields(src *BatchGetGatewa
 	}
 	return nil
 }
+
+func (dst *GatewayTrafficCaptureRecord) SetFields(src *GatewayTrafficCaptureRecord, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				dst.Time = nil
+			}
+
+		case "message":
+			if len(subs) == 0 && src == nil {
+				dst.Message = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Message = src.Message
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "uplink_message":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayTrafficCaptureRecord_UplinkMessage)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'uplink_message', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayTrafficCaptureRecord_UplinkMessage)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'uplink_message', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *UplinkMessage
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayTrafficCaptureRecord_UplinkMessage).UplinkMessage
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayTrafficCaptureRecord_UplinkMessage).UplinkMessage
+						} else if srcTypeOk {
+							newDst = &UplinkMessage{}
+							dst.Message = &GatewayTrafficCaptureRecord_UplinkMessage{UplinkMessage: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "downlink_message":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayTrafficCaptureRecord_DownlinkMessage)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'downlink_message', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayTrafficCaptureRecord_DownlinkMessage)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'downlink_message', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *DownlinkMessage
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayTrafficCaptureRecord_DownlinkMessage).DownlinkMessage
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayTrafficCaptureRecord_DownlinkMessage).DownlinkMessage
+						} else if srcTypeOk {
+							newDst = &DownlinkMessage{}
+							dst.Message = &GatewayTrafficCaptureRecord_DownlinkMessage{DownlinkMessage: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "tx_acknowledgment":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayTrafficCaptureRecord_TxAcknowledgment)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'tx_acknowledgment', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayTrafficCaptureRecord_TxAcknowledgment)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'tx_acknowledgment', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *TxAcknowledgment
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayTrafficCaptureRecord_TxAcknowledgment).TxAcknowledgment
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayTrafficCaptureRecord_TxAcknowledgment).TxAcknowledgment
+						} else if srcTypeOk {
+							newDst = &TxAcknowledgment{}
+							dst.Message = &GatewayTrafficCaptureRecord_TxAcknowledgment{TxAcknowledgment: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionSession) SetFields(src *GatewayConnectionSession, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "connected_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'connected_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConnectedAt = src.ConnectedAt
+			} else {
+				dst.ConnectedAt = nil
+			}
+		case "disconnected_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disconnected_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DisconnectedAt = src.DisconnectedAt
+			} else {
+				dst.DisconnectedAt = nil
+			}
+		case "protocol":
+			if len(subs) > 0 {
+				return fmt.Errorf("'protocol' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Protocol = src.Protocol
+			} else {
+				var zero string
+				dst.Protocol = zero
+			}
+		case "gateway_remote_address":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayRemoteAddress
+				if (src == nil || src.GatewayRemoteAddress == nil) && dst.GatewayRemoteAddress == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayRemoteAddress
+				}
+				if dst.GatewayRemoteAddress != nil {
+					newDst = dst.GatewayRemoteAddress
+				} else {
+					newDst = &GatewayRemoteAddress{}
+					dst.GatewayRemoteAddress = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayRemoteAddress = src.GatewayRemoteAddress
+				} else {
+					dst.GatewayRemoteAddress = nil
+				}
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "tx_acknowledgment_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_acknowledgment_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxAcknowledgmentCount = src.TxAcknowledgmentCount
+			} else {
+				var zero uint64
+				dst.TxAcknowledgmentCount = zero
+			}
+		case "round_trip_times":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionSession_RoundTripTimes
+				if (src == nil || src.RoundTripTimes == nil) && dst.RoundTripTimes == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RoundTripTimes
+				}
+				if dst.RoundTripTimes != nil {
+					newDst = dst.RoundTripTimes
+				} else {
+					newDst = &GatewayConnectionSession_RoundTripTimes{}
+					dst.RoundTripTimes = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RoundTripTimes = src.RoundTripTimes
+				} else {
+					dst.RoundTripTimes = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListGatewayConnectionSessionsRequest) SetFields(src *ListGatewayConnectionSessionsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "disconnected_after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disconnected_after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DisconnectedAfter = src.DisconnectedAfter
+			} else {
+				dst.DisconnectedAfter = nil
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionSessions) SetFields(src *GatewayConnectionSessions, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "sessions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sessions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Sessions = src.Sessions
+			} else {
+				dst.Sessions = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionSession_RoundTripTimes) SetFields(src *GatewayConnectionSession_RoundTripTimes, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				dst.Min = nil
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				dst.Max = nil
+			}
+		case "median":
+			if len(subs) > 0 {
+				return fmt.Errorf("'median' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Median = src.Median
+			} else {
+				dst.Median = nil
+			}
+		case "p90":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p90' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P90 = src.P90
+			} else {
+				dst.P90 = nil
+			}
+		case "p99":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p99' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P99 = src.P99
+			} else {
+				dst.P99 = nil
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint32
+				dst.Count = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
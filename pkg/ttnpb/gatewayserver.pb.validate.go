@@ -667,3 +667,667 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BatchGetGatewayConnectionStatsResponseValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficCaptureRecord with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayTrafficCaptureRecord) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficCaptureRecordFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(m.GetTime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureRecordValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "message":
+			if m.Message == nil {
+				return GatewayTrafficCaptureRecordValidationError{
+					field:  "message",
+					reason: "value is required",
+				}
+			}
+			if len(subs) == 0 {
+				subs = []string{
+					"uplink_message", "downlink_message", "tx_acknowledgment",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "uplink_message":
+					w, ok := m.Message.(*GatewayTrafficCaptureRecord_UplinkMessage)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetUplinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficCaptureRecordValidationError{
+								field:  "uplink_message",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "downlink_message":
+					w, ok := m.Message.(*GatewayTrafficCaptureRecord_DownlinkMessage)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetDownlinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficCaptureRecordValidationError{
+								field:  "downlink_message",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "tx_acknowledgment":
+					w, ok := m.Message.(*GatewayTrafficCaptureRecord_TxAcknowledgment)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetTxAcknowledgment()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficCaptureRecordValidationError{
+								field:  "tx_acknowledgment",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return GatewayTrafficCaptureRecordValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureRecordValidationError is the validation error returned
+// by GatewayTrafficCaptureRecord.ValidateFields if the designated constraints
+// aren't met.
+type GatewayTrafficCaptureRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficCaptureRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficCaptureRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficCaptureRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficCaptureRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficCaptureRecordValidationError) ErrorName() string {
+	return "GatewayTrafficCaptureRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficCaptureRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficCaptureRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficCaptureRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficCaptureRecordValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionSession with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayConnectionSession) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionSessionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "connected_at":
+
+			if v, ok := interface{}(m.GetConnectedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSessionValidationError{
+						field:  "connected_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "disconnected_at":
+
+			if v, ok := interface{}(m.GetDisconnectedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSessionValidationError{
+						field:  "disconnected_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "protocol":
+			// no validation rules for Protocol
+		case "gateway_remote_address":
+
+			if v, ok := interface{}(m.GetGatewayRemoteAddress()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSessionValidationError{
+						field:  "gateway_remote_address",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "tx_acknowledgment_count":
+			// no validation rules for TxAcknowledgmentCount
+		case "round_trip_times":
+
+			if v, ok := interface{}(m.GetRoundTripTimes()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSessionValidationError{
+						field:  "round_trip_times",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayConnectionSessionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionSessionValidationError is the validation error returned by
+// GatewayConnectionSession.ValidateFields if the designated constraints
+// aren't met.
+type GatewayConnectionSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionSessionValidationError) ErrorName() string {
+	return "GatewayConnectionSessionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionSessionValidationError{}
+
+// ValidateFields checks the field values on
+// ListGatewayConnectionSessionsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ListGatewayConnectionSessionsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListGatewayConnectionSessionsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return ListGatewayConnectionSessionsRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListGatewayConnectionSessionsRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "disconnected_after":
+
+			if v, ok := interface{}(m.GetDisconnectedAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListGatewayConnectionSessionsRequestValidationError{
+						field:  "disconnected_after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListGatewayConnectionSessionsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListGatewayConnectionSessionsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListGatewayConnectionSessionsRequestValidationError is the validation error
+// returned by ListGatewayConnectionSessionsRequest.ValidateFields if the
+// designated constraints aren't met.
+type ListGatewayConnectionSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGatewayConnectionSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGatewayConnectionSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGatewayConnectionSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGatewayConnectionSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGatewayConnectionSessionsRequestValidationError) ErrorName() string {
+	return "ListGatewayConnectionSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGatewayConnectionSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGatewayConnectionSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGatewayConnectionSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGatewayConnectionSessionsRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionSessions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayConnectionSessions) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionSessionsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "sessions":
+
+			for idx, item := range m.GetSessions() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionSessionsValidationError{
+							field:  fmt.Sprintf("sessions[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionSessionsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionSessionsValidationError is the validation error returned by
+// GatewayConnectionSessions.ValidateFields if the designated constraints
+// aren't met.
+type GatewayConnectionSessionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionSessionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionSessionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionSessionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionSessionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionSessionsValidationError) ErrorName() string {
+	return "GatewayConnectionSessionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionSessionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionSessions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionSessionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionSessionsValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConnectionSession_RoundTripTimes with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GatewayConnectionSession_RoundTripTimes) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionSession_RoundTripTimesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min":
+
+			if v, ok := interface{}(m.GetMin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSession_RoundTripTimesValidationError{
+						field:  "min",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max":
+
+			if v, ok := interface{}(m.GetMax()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSession_RoundTripTimesValidationError{
+						field:  "max",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "median":
+
+			if v, ok := interface{}(m.GetMedian()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSession_RoundTripTimesValidationError{
+						field:  "median",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "p90":
+
+			if v, ok := interface{}(m.GetP90()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSession_RoundTripTimesValidationError{
+						field:  "p90",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "p99":
+
+			if v, ok := interface{}(m.GetP99()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionSession_RoundTripTimesValidationError{
+						field:  "p99",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "count":
+			// no validation rules for Count
+		default:
+			return GatewayConnectionSession_RoundTripTimesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionSession_RoundTripTimesValidationError is the validation
+// error returned by GatewayConnectionSession_RoundTripTimes.ValidateFields if
+// the designated constraints aren't met.
+type GatewayConnectionSession_RoundTripTimesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionSession_RoundTripTimesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionSession_RoundTripTimesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionSession_RoundTripTimesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionSession_RoundTripTimesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionSession_RoundTripTimesValidationError) ErrorName() string {
+	return "GatewayConnectionSession_RoundTripTimesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionSession_RoundTripTimesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionSession_RoundTripTimes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionSession_RoundTripTimesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionSession_RoundTripTimesValidationError{}
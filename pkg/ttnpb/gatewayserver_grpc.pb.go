@@ -511,3 +511,220 @@ var Gs_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/gatewayserver.proto",
 }
+
+const (
+	GsCapture_CaptureGatewayTraffic_FullMethodName = "/ttn.lorawan.v3.GsCapture/CaptureGatewayTraffic"
+)
+
+// GsCaptureClient is the client API for GsCapture service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GsCaptureClient interface {
+	// Stream the uplink, downlink and transmission acknowledgment traffic of the gateway.
+	// The stream starts with the traffic recorded by the Gateway Server and continues until the client cancels.
+	// Traffic capture must be enabled in the Gateway Server.
+	CaptureGatewayTraffic(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (GsCapture_CaptureGatewayTrafficClient, error)
+}
+
+type gsCaptureClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGsCaptureClient(cc grpc.ClientConnInterface) GsCaptureClient {
+	return &gsCaptureClient{cc}
+}
+
+func (c *gsCaptureClient) CaptureGatewayTraffic(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (GsCapture_CaptureGatewayTrafficClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GsCapture_ServiceDesc.Streams[0], GsCapture_CaptureGatewayTraffic_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsCaptureCaptureGatewayTrafficClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GsCapture_CaptureGatewayTrafficClient interface {
+	Recv() (*GatewayTrafficCaptureRecord, error)
+	grpc.ClientStream
+}
+
+type gsCaptureCaptureGatewayTrafficClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsCaptureCaptureGatewayTrafficClient) Recv() (*GatewayTrafficCaptureRecord, error) {
+	m := new(GatewayTrafficCaptureRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GsCaptureServer is the server API for GsCapture service.
+// All implementations must embed UnimplementedGsCaptureServer
+// for forward compatibility
+type GsCaptureServer interface {
+	// Stream the uplink, downlink and transmission acknowledgment traffic of the gateway.
+	// The stream starts with the traffic recorded by the Gateway Server and continues until the client cancels.
+	// Traffic capture must be enabled in the Gateway Server.
+	CaptureGatewayTraffic(*GatewayIdentifiers, GsCapture_CaptureGatewayTrafficServer) error
+	mustEmbedUnimplementedGsCaptureServer()
+}
+
+// UnimplementedGsCaptureServer must be embedded to have forward compatible implementations.
+type UnimplementedGsCaptureServer struct {
+}
+
+func (UnimplementedGsCaptureServer) CaptureGatewayTraffic(*GatewayIdentifiers, GsCapture_CaptureGatewayTrafficServer) error {
+	return status.Errorf(codes.Unimplemented, "method CaptureGatewayTraffic not implemented")
+}
+func (UnimplementedGsCaptureServer) mustEmbedUnimplementedGsCaptureServer() {}
+
+// UnsafeGsCaptureServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GsCaptureServer will
+// result in compilation errors.
+type UnsafeGsCaptureServer interface {
+	mustEmbedUnimplementedGsCaptureServer()
+}
+
+func RegisterGsCaptureServer(s grpc.ServiceRegistrar, srv GsCaptureServer) {
+	s.RegisterService(&GsCapture_ServiceDesc, srv)
+}
+
+func _GsCapture_CaptureGatewayTraffic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GatewayIdentifiers)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsCaptureServer).CaptureGatewayTraffic(m, &gsCaptureCaptureGatewayTrafficServer{stream})
+}
+
+type GsCapture_CaptureGatewayTrafficServer interface {
+	Send(*GatewayTrafficCaptureRecord) error
+	grpc.ServerStream
+}
+
+type gsCaptureCaptureGatewayTrafficServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsCaptureCaptureGatewayTrafficServer) Send(m *GatewayTrafficCaptureRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GsCapture_ServiceDesc is the grpc.ServiceDesc for GsCapture service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GsCapture_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GsCapture",
+	HandlerType: (*GsCaptureServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CaptureGatewayTraffic",
+			Handler:       _GsCapture_CaptureGatewayTraffic_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ttn/lorawan/v3/gatewayserver.proto",
+}
+
+const (
+	GsSessions_ListGatewayConnectionSessions_FullMethodName = "/ttn.lorawan.v3.GsSessions/ListGatewayConnectionSessions"
+)
+
+// GsSessionsClient is the client API for GsSessions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GsSessionsClient interface {
+	// List the past connection sessions of the gateway, from the most recent to the oldest.
+	// Storing connection sessions must be enabled in the Gateway Server.
+	ListGatewayConnectionSessions(ctx context.Context, in *ListGatewayConnectionSessionsRequest, opts ...grpc.CallOption) (*GatewayConnectionSessions, error)
+}
+
+type gsSessionsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGsSessionsClient(cc grpc.ClientConnInterface) GsSessionsClient {
+	return &gsSessionsClient{cc}
+}
+
+func (c *gsSessionsClient) ListGatewayConnectionSessions(ctx context.Context, in *ListGatewayConnectionSessionsRequest, opts ...grpc.CallOption) (*GatewayConnectionSessions, error) {
+	out := new(GatewayConnectionSessions)
+	err := c.cc.Invoke(ctx, GsSessions_ListGatewayConnectionSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GsSessionsServer is the server API for GsSessions service.
+// All implementations must embed UnimplementedGsSessionsServer
+// for forward compatibility
+type GsSessionsServer interface {
+	// List the past connection sessions of the gateway, from the most recent to the oldest.
+	// Storing connection sessions must be enabled in the Gateway Server.
+	ListGatewayConnectionSessions(context.Context, *ListGatewayConnectionSessionsRequest) (*GatewayConnectionSessions, error)
+	mustEmbedUnimplementedGsSessionsServer()
+}
+
+// UnimplementedGsSessionsServer must be embedded to have forward compatible implementations.
+type UnimplementedGsSessionsServer struct {
+}
+
+func (UnimplementedGsSessionsServer) ListGatewayConnectionSessions(context.Context, *ListGatewayConnectionSessionsRequest) (*GatewayConnectionSessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGatewayConnectionSessions not implemented")
+}
+func (UnimplementedGsSessionsServer) mustEmbedUnimplementedGsSessionsServer() {}
+
+// UnsafeGsSessionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GsSessionsServer will
+// result in compilation errors.
+type UnsafeGsSessionsServer interface {
+	mustEmbedUnimplementedGsSessionsServer()
+}
+
+func RegisterGsSessionsServer(s grpc.ServiceRegistrar, srv GsSessionsServer) {
+	s.RegisterService(&GsSessions_ServiceDesc, srv)
+}
+
+func _GsSessions_ListGatewayConnectionSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayConnectionSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsSessionsServer).ListGatewayConnectionSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GsSessions_ListGatewayConnectionSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsSessionsServer).ListGatewayConnectionSessions(ctx, req.(*ListGatewayConnectionSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GsSessions_ServiceDesc is the grpc.ServiceDesc for GsSessions service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GsSessions_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GsSessions",
+	HandlerType: (*GsSessionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListGatewayConnectionSessions",
+			Handler:    _GsSessions_ListGatewayConnectionSessions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ttn/lorawan/v3/gatewayserver.proto",
+}
@@ -308,3 +308,182 @@ func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalProtoJSON(s *jsonplugin
 func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the GatewayTrafficCaptureRecord message to JSON.
+func (x *GatewayTrafficCaptureRecord) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Time != nil || s.HasField("time") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("time")
+		if x.Time == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.Time)
+		}
+	}
+	if x.Message != nil {
+		switch ov := x.Message.(type) {
+		case *GatewayTrafficCaptureRecord_UplinkMessage:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("uplink_message")
+			ov.UplinkMessage.MarshalProtoJSON(s.WithField("uplink_message"))
+		case *GatewayTrafficCaptureRecord_DownlinkMessage:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("downlink_message")
+			ov.DownlinkMessage.MarshalProtoJSON(s.WithField("downlink_message"))
+		case *GatewayTrafficCaptureRecord_TxAcknowledgment:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("tx_acknowledgment")
+			ov.TxAcknowledgment.MarshalProtoJSON(s.WithField("tx_acknowledgment"))
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayTrafficCaptureRecord to JSON.
+func (x *GatewayTrafficCaptureRecord) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayTrafficCaptureRecord message from JSON.
+func (x *GatewayTrafficCaptureRecord) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "time":
+			s.AddField("time")
+			if s.ReadNil() {
+				x.Time = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Time = v
+		case "uplink_message", "uplinkMessage":
+			ov := &GatewayTrafficCaptureRecord_UplinkMessage{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.UplinkMessage = nil
+				return
+			}
+			ov.UplinkMessage = &UplinkMessage{}
+			ov.UplinkMessage.UnmarshalProtoJSON(s.WithField("uplink_message", true))
+		case "downlink_message", "downlinkMessage":
+			ov := &GatewayTrafficCaptureRecord_DownlinkMessage{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.DownlinkMessage = nil
+				return
+			}
+			ov.DownlinkMessage = &DownlinkMessage{}
+			ov.DownlinkMessage.UnmarshalProtoJSON(s.WithField("downlink_message", true))
+		case "tx_acknowledgment", "txAcknowledgment":
+			ov := &GatewayTrafficCaptureRecord_TxAcknowledgment{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.TxAcknowledgment = nil
+				return
+			}
+			ov.TxAcknowledgment = &TxAcknowledgment{}
+			ov.TxAcknowledgment.UnmarshalProtoJSON(s.WithField("tx_acknowledgment", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayTrafficCaptureRecord from JSON.
+func (x *GatewayTrafficCaptureRecord) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ListGatewayConnectionSessionsRequest message to JSON.
+func (x *ListGatewayConnectionSessionsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.DisconnectedAfter != nil || s.HasField("disconnected_after") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("disconnected_after")
+		if x.DisconnectedAfter == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.DisconnectedAfter)
+		}
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	if x.Page != 0 || s.HasField("page") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("page")
+		s.WriteUint32(x.Page)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ListGatewayConnectionSessionsRequest to JSON.
+func (x *ListGatewayConnectionSessionsRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ListGatewayConnectionSessionsRequest message from JSON.
+func (x *ListGatewayConnectionSessionsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "disconnected_after", "disconnectedAfter":
+			s.AddField("disconnected_after")
+			if s.ReadNil() {
+				x.DisconnectedAfter = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.DisconnectedAfter = v
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		case "page":
+			s.AddField("page")
+			x.Page = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ListGatewayConnectionSessionsRequest from JSON.
+func (x *ListGatewayConnectionSessionsRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -48,6 +48,13 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
+	"end_device.handover_roaming",
+	"end_device.handover_roaming.dev_addr",
+	"end_device.handover_roaming.partner_net_id",
+	"end_device.handover_roaming.partner_ns_id",
+	"end_device.handover_roaming.serving",
+	"end_device.handover_roaming.session_key_id",
+	"end_device.handover_roaming.started_at",
 	"end_device.ids",
 	"end_device.ids.application_ids",
 	"end_device.ids.application_ids.application_id",
@@ -73,6 +80,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -836,6 +844,13 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.formatters.up_formatter",
 	"end_device_template.end_device.formatters.up_formatter_parameter",
 	"end_device_template.end_device.frequency_plan_id",
+	"end_device_template.end_device.handover_roaming",
+	"end_device_template.end_device.handover_roaming.dev_addr",
+	"end_device_template.end_device.handover_roaming.partner_net_id",
+	"end_device_template.end_device.handover_roaming.partner_ns_id",
+	"end_device_template.end_device.handover_roaming.serving",
+	"end_device_template.end_device.handover_roaming.session_key_id",
+	"end_device_template.end_device.handover_roaming.started_at",
 	"end_device_template.end_device.ids",
 	"end_device_template.end_device.ids.application_ids",
 	"end_device_template.end_device.ids.application_ids.application_id",
@@ -861,6 +876,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_settings.adr.mode",
 	"end_device_template.end_device.mac_settings.adr.mode.disabled",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic",
+	"end_device_template.end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
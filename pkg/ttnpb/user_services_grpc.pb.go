@@ -40,6 +40,7 @@ const (
 	UserRegistry_Update_FullMethodName                  = "/ttn.lorawan.v3.UserRegistry/Update"
 	UserRegistry_CreateTemporaryPassword_FullMethodName = "/ttn.lorawan.v3.UserRegistry/CreateTemporaryPassword"
 	UserRegistry_UpdatePassword_FullMethodName          = "/ttn.lorawan.v3.UserRegistry/UpdatePassword"
+	UserRegistry_ResetMFA_FullMethodName                = "/ttn.lorawan.v3.UserRegistry/ResetMFA"
 	UserRegistry_Delete_FullMethodName                  = "/ttn.lorawan.v3.UserRegistry/Delete"
 	UserRegistry_Restore_FullMethodName                 = "/ttn.lorawan.v3.UserRegistry/Restore"
 	UserRegistry_Purge_FullMethodName                   = "/ttn.lorawan.v3.UserRegistry/Purge"
@@ -65,6 +66,10 @@ type UserRegistryClient interface {
 	CreateTemporaryPassword(ctx context.Context, in *CreateTemporaryPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Update the password of the user.
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reset the multi-factor authentication of the user, for example when the user
+	// lost access to the authenticator app and the recovery codes.
+	// This method is restricted to admins.
+	ResetMFA(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete the user. This may not release the user ID for reuse.
 	Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Restore a recently deleted user.
@@ -140,6 +145,15 @@ func (c *userRegistryClient) UpdatePassword(ctx context.Context, in *UpdateUserP
 	return out, nil
 }
 
+func (c *userRegistryClient) ResetMFA(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserRegistry_ResetMFA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userRegistryClient) Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, UserRegistry_Delete_FullMethodName, in, out, opts...)
@@ -187,6 +201,10 @@ type UserRegistryServer interface {
 	CreateTemporaryPassword(context.Context, *CreateTemporaryPasswordRequest) (*emptypb.Empty, error)
 	// Update the password of the user.
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*emptypb.Empty, error)
+	// Reset the multi-factor authentication of the user, for example when the user
+	// lost access to the authenticator app and the recovery codes.
+	// This method is restricted to admins.
+	ResetMFA(context.Context, *UserIdentifiers) (*emptypb.Empty, error)
 	// Delete the user. This may not release the user ID for reuse.
 	Delete(context.Context, *UserIdentifiers) (*emptypb.Empty, error)
 	// Restore a recently deleted user.
@@ -223,6 +241,9 @@ func (UnimplementedUserRegistryServer) CreateTemporaryPassword(context.Context,
 func (UnimplementedUserRegistryServer) UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
 }
+func (UnimplementedUserRegistryServer) ResetMFA(context.Context, *UserIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMFA not implemented")
+}
 func (UnimplementedUserRegistryServer) Delete(context.Context, *UserIdentifiers) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -353,6 +374,24 @@ func _UserRegistry_UpdatePassword_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRegistry_ResetMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).ResetMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRegistry_ResetMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).ResetMFA(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserIdentifiers)
 	if err := dec(in); err != nil {
@@ -438,6 +477,10 @@ var UserRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _UserRegistry_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "ResetMFA",
+			Handler:    _UserRegistry_ResetMFA_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _UserRegistry_Delete_Handler,
@@ -61,6 +61,8 @@ func TestFieldIsZero(t *testing.T) {
 		(*DLSettings)(nil):                                       DLSettingsFieldPathsNested,
 		&EndDeviceAuthenticationCode{}:                           EndDeviceAuthenticationCodeFieldPathsNested,
 		(*EndDeviceAuthenticationCode)(nil):                      EndDeviceAuthenticationCodeFieldPathsNested,
+		&EndDeviceHandoverRoaming{}:                              EndDeviceHandoverRoamingFieldPathsNested,
+		(*EndDeviceHandoverRoaming)(nil):                         EndDeviceHandoverRoamingFieldPathsNested,
 		&EndDeviceIdentifiers{}:                                  EndDeviceIdentifiersFieldPathsNested,
 		(*EndDeviceIdentifiers)(nil):                             EndDeviceIdentifiersFieldPathsNested,
 		&EndDeviceVersionIdentifiers{}:                           EndDeviceVersionIdentifiersFieldPathsNested,
@@ -0,0 +1,43 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"path/filepath"
+
+	ttnbolt "go.thethings.network/lorawan-stack/v3/pkg/bolt"
+)
+
+// NewBolt returns a new namespaced *bolt.Client backed by a database in a temporary directory
+// and a close function, which should be called after the client is not needed anymore.
+func NewBolt(ctx context.Context, namespace ...string) (*ttnbolt.Client, func()) {
+	t := MustTBFromContext(ctx)
+	db, err := ttnbolt.Open(ttnbolt.Config{
+		Path: filepath.Join(t.TempDir(), "bolt.db"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to open bolt database: %s", FormatError(err))
+	}
+	cl := ttnbolt.New(db, namespace...)
+	if err := cl.Init(ctx); err != nil {
+		t.Fatalf("Failed to initialize bolt client: %s", FormatError(err))
+	}
+	return cl, func() {
+		if err := db.Close(); err != nil {
+			t.Errorf("Failed to close bolt database: %s", FormatError(err))
+		}
+	}
+}
@@ -51,7 +51,14 @@ export default {
   account: {
     login: credentials => instance.post(`${appRoot}/api/auth/login`, credentials),
     tokenLogin: credentials => instance.post(`${appRoot}/api/auth/token-login`, credentials),
+    oidcMFA: code => instance.post(`${appRoot}/api/auth/oidc/mfa`, { code }),
     logout: () => instance.post(`${appRoot}/api/auth/logout`),
     me: () => instance.get(`${appRoot}/api/me`),
+    mfa: {
+      get: () => instance.get(`${appRoot}/api/mfa`),
+      enrollTOTP: () => instance.post(`${appRoot}/api/mfa/totp`),
+      confirmTOTP: code => instance.post(`${appRoot}/api/mfa/totp/confirm`, { code }),
+      disable: code => instance.post(`${appRoot}/api/mfa/disable`, { code }),
+    },
   },
 }
@@ -16,6 +16,7 @@ import Overview from '@account/views/overview'
 import ProfileSettings from '@account/views/profile-settings'
 import Code from '@account/views/code'
 import SessionManagement from '@account/views/session-management'
+import MultiFactorAuthentication from '@account/views/multi-factor-authentication'
 import { ValidateWithAuth } from '@account/views/validate'
 import OAuthClients from '@account/views/oauth-clients'
 import OAuthClientAuthorizations from '@account/views/oauth-client-authorizations'
@@ -37,6 +38,10 @@ export default [
     path: '/session-management',
     Component: SessionManagement,
   },
+  {
+    path: '/multi-factor-authentication',
+    Component: MultiFactorAuthentication,
+  },
   {
     path: '/validate',
     Component: ValidateWithAuth,
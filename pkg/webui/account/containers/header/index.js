@@ -54,6 +54,11 @@ const Header = ({ handleSearchRequest, searchable }) => {
       icon: 'vpn_key',
       path: '/session-management',
     },
+    {
+      title: sharedMessages.multiFactorAuthentication,
+      icon: 'lock',
+      path: '/multi-factor-authentication',
+    },
     {
       title: sharedMessages.oauthClients,
       icon: 'oauth_clients',
@@ -17,3 +17,5 @@ import { selectApplicationConfig } from '@ttn-lw/lib/selectors/env'
 export const selectEnableUserRegistration = () => selectApplicationConfig().enable_user_registration
 
 export const selectConsoleUrl = () => selectApplicationConfig().console_url
+
+export const selectOIDCProviders = () => selectApplicationConfig().oidc_providers || []
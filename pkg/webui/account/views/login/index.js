@@ -37,19 +37,28 @@ import {
 } from '@ttn-lw/lib/selectors/env'
 import sharedMessages from '@ttn-lw/lib/shared-messages'
 import { userId as userIdRegexp } from '@ttn-lw/lib/regexp'
+import { getBackendErrorId, isBackend } from '@ttn-lw/lib/errors/utils'
 
-import { selectEnableUserRegistration } from '@account/lib/selectors/app-config'
+import {
+  selectEnableUserRegistration,
+  selectOIDCProviders,
+} from '@account/lib/selectors/app-config'
 
 const m = defineMessages({
   createAccount: 'Create an account',
   forgotPassword: 'Forgot password?',
   loginToContinue: 'Please login to continue',
+  loginWith: 'Login with {provider}',
+  mfaCode: 'Authentication code',
+  mfaCodeDescription: 'Enter the code from your authenticator app, or one of your recovery codes',
+  loginWithPassword: 'Login with password',
 })
 
 const appRoot = selectApplicationRootPath()
 const siteName = selectApplicationSiteName()
 const siteTitle = selectApplicationSiteTitle()
 const enableUserRegistration = selectEnableUserRegistration()
+const oidcProviders = selectOIDCProviders()
 
 const validationSchema = Yup.object().shape({
   user_id: Yup.string()
@@ -59,8 +68,16 @@ const validationSchema = Yup.object().shape({
     .required(sharedMessages.validateRequired)
     .trim(),
   password: Yup.string().required(sharedMessages.validateRequired),
+  mfa_code: Yup.string().trim(),
+})
+
+const oidcMFAValidationSchema = Yup.object().shape({
+  mfa_code: Yup.string().required(sharedMessages.validateRequired).trim(),
 })
 
+const isMFARequiredError = error =>
+  isBackend(error) && getBackendErrorId(error) === 'error:pkg/account/session:mfa_required'
+
 const url = (location, omitQuery = false) => {
   const query = Query.parse(location.search)
 
@@ -80,7 +97,27 @@ const url = (location, omitQuery = false) => {
 
 const Login = () => {
   const [error, setError] = useState(undefined)
+  const [mfaRequired, setMFARequired] = useState(false)
   const location = useLocation()
+  // The OpenID Connect login redirects here when the authentication code is still required.
+  const oidcMFA = Query.parse(location.search).mfa === 'oidc'
+
+  const handleOIDCMFASubmit = useCallback(
+    async (values, { setSubmitting }) => {
+      try {
+        setError(undefined)
+
+        const { mfa_code } = oidcMFAValidationSchema.cast(values)
+        await api.account.oidcMFA(mfa_code)
+
+        window.location = url(location)
+      } catch (error) {
+        setError(error)
+        setSubmitting(false)
+      }
+    },
+    [location],
+  )
 
   const handleSubmit = useCallback(
     async (values, { setSubmitting }) => {
@@ -92,16 +129,22 @@ const Login = () => {
 
         window.location = url(location)
       } catch (error) {
-        setError(error)
+        if (isMFARequiredError(error) && !mfaRequired) {
+          // Show the authentication code field instead of an error on the first attempt.
+          setMFARequired(true)
+        } else {
+          setError(error)
+        }
         setSubmitting(false)
       }
     },
-    [location],
+    [location, mfaRequired],
   )
 
   const initialValues = {
     user_id: '',
     password: '',
+    mfa_code: '',
   }
 
   let info
@@ -124,42 +167,102 @@ const Login = () => {
         <span className={style.subTitle}>{siteTitle}</span>
       </h1>
       <hr className={style.hRule} />
-      <Form
-        onSubmit={handleSubmit}
-        initialValues={initialValues}
-        error={error}
-        errorTitle={sharedMessages.loginFailed}
-        info={info}
-        validationSchema={validationSchema}
-        horizontal={false}
-      >
-        <Form.Field
-          title={sharedMessages.userId}
-          name="user_id"
-          component={Input}
-          autoFocus
-          required
-        />
-        <Form.Field
-          title={sharedMessages.password}
-          component={Input}
-          name="password"
-          type="password"
-          required
-        />
-        <ButtonGroup>
-          <Form.Submit
-            component={SubmitButton}
-            message={sharedMessages.login}
-            className={style.submitButton}
-            error={Boolean(error)}
+      {oidcMFA ? (
+        <Form
+          onSubmit={handleOIDCMFASubmit}
+          initialValues={{ mfa_code: '' }}
+          error={error}
+          errorTitle={sharedMessages.loginFailed}
+          validationSchema={oidcMFAValidationSchema}
+          horizontal={false}
+        >
+          <Form.Field
+            title={m.mfaCode}
+            description={m.mfaCodeDescription}
+            component={Input}
+            name="mfa_code"
+            autoComplete="one-time-code"
+            autoFocus
+            required
+          />
+          <ButtonGroup>
+            <Form.Submit
+              component={SubmitButton}
+              message={sharedMessages.login}
+              className={style.submitButton}
+              error={Boolean(error)}
+            />
+            <Button.Link
+              naked
+              message={m.loginWithPassword}
+              to={`/login?${Query.stringify({ n: next })}`}
+            />
+          </ButtonGroup>
+        </Form>
+      ) : (
+        <Form
+          onSubmit={handleSubmit}
+          initialValues={initialValues}
+          error={error}
+          errorTitle={sharedMessages.loginFailed}
+          info={info}
+          validationSchema={validationSchema}
+          horizontal={false}
+        >
+          <Form.Field
+            title={sharedMessages.userId}
+            name="user_id"
+            component={Input}
+            autoFocus
+            required
           />
-          {enableUserRegistration && (
-            <Button.Link to={`/register${location.search}`} message={m.createAccount} />
+          <Form.Field
+            title={sharedMessages.password}
+            component={Input}
+            name="password"
+            type="password"
+            required
+          />
+          {mfaRequired && (
+            <Form.Field
+              title={m.mfaCode}
+              description={m.mfaCodeDescription}
+              component={Input}
+              name="mfa_code"
+              autoComplete="one-time-code"
+              autoFocus
+              required
+            />
+          )}
+          <ButtonGroup>
+            <Form.Submit
+              component={SubmitButton}
+              message={sharedMessages.login}
+              className={style.submitButton}
+              error={Boolean(error)}
+            />
+            {enableUserRegistration && (
+              <Button.Link to={`/register${location.search}`} message={m.createAccount} />
+            )}
+            <Button.Link
+              naked
+              message={m.forgotPassword}
+              to={`/forgot-password${location.search}`}
+            />
+          </ButtonGroup>
+          {oidcProviders.length > 0 && (
+            <ButtonGroup>
+              {oidcProviders.map(({ id, name }) => (
+                <Button.AnchorLink
+                  key={id}
+                  href={`${appRoot}/oidc/${id}/login?${Query.stringify({ n: next })}`}
+                  message={{ ...m.loginWith, values: { provider: name } }}
+                />
+              ))}
+            </ButtonGroup>
           )}
-          <Button.Link naked message={m.forgotPassword} to={`/forgot-password${location.search}`} />
-        </ButtonGroup>
-      </Form>
+        </Form>
+      )}
     </div>
   )
 }
@@ -0,0 +1,201 @@
+// Copyright © 2024 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React, { useCallback, useEffect, useState } from 'react'
+import { Container, Col, Row } from 'react-grid-system'
+import { defineMessages } from 'react-intl'
+
+import api from '@account/api'
+
+import Form from '@ttn-lw/components/form'
+import Input from '@ttn-lw/components/input'
+import Notification from '@ttn-lw/components/notification'
+import PageTitle from '@ttn-lw/components/page-title'
+import Spinner from '@ttn-lw/components/spinner'
+import SubmitButton from '@ttn-lw/components/submit-button'
+import SubmitBar from '@ttn-lw/components/submit-bar'
+
+import Message from '@ttn-lw/lib/components/message'
+
+import Yup from '@ttn-lw/lib/yup'
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+
+const m = defineMessages({
+  enabled: 'Multi-factor authentication is enabled. {remaining} recovery codes remaining.',
+  disabled: 'Multi-factor authentication is disabled',
+  enable: 'Enable multi-factor authentication',
+  disable: 'Disable multi-factor authentication',
+  scanQRCode:
+    'Scan the QR code with your authenticator app, or enter the secret manually, and confirm with the code from the app',
+  secret: 'Secret',
+  code: 'Authentication code',
+  confirm: 'Confirm',
+  recoveryCodes: 'Recovery codes',
+  recoveryCodesDescription:
+    'Store these recovery codes in a safe place. Each code can be used once to login if you lose access to your authenticator app. They will not be shown again.',
+})
+
+const validationSchema = Yup.object().shape({
+  code: Yup.string().trim().required(sharedMessages.validateRequired),
+})
+
+const initialValues = { code: '' }
+
+const MultiFactorAuthentication = () => {
+  const [status, setStatus] = useState(undefined)
+  const [enrollment, setEnrollment] = useState(undefined)
+  const [recoveryCodes, setRecoveryCodes] = useState(undefined)
+  const [error, setError] = useState(undefined)
+
+  const fetchStatus = useCallback(async () => {
+    try {
+      const { data } = await api.account.mfa.get()
+      setStatus(data)
+    } catch (error) {
+      setError(error)
+    }
+  }, [])
+
+  useEffect(() => {
+    fetchStatus()
+  }, [fetchStatus])
+
+  const handleEnroll = useCallback(async (values, { setSubmitting }) => {
+    try {
+      setError(undefined)
+      const { data } = await api.account.mfa.enrollTOTP()
+      setEnrollment(data)
+    } catch (error) {
+      setError(error)
+    }
+    setSubmitting(false)
+  }, [])
+
+  const handleConfirm = useCallback(
+    async ({ code }, { setSubmitting, resetForm }) => {
+      try {
+        setError(undefined)
+        const { data } = await api.account.mfa.confirmTOTP(code.trim())
+        setEnrollment(undefined)
+        setRecoveryCodes(data.recovery_codes)
+        await fetchStatus()
+      } catch (error) {
+        setError(error)
+        resetForm({ values: initialValues })
+      }
+      setSubmitting(false)
+    },
+    [fetchStatus],
+  )
+
+  const handleDisable = useCallback(
+    async ({ code }, { setSubmitting, resetForm }) => {
+      try {
+        setError(undefined)
+        await api.account.mfa.disable(code.trim())
+        setRecoveryCodes(undefined)
+        await fetchStatus()
+      } catch (error) {
+        setError(error)
+      }
+      resetForm({ values: initialValues })
+      setSubmitting(false)
+    },
+    [fetchStatus],
+  )
+
+  if (!status && !error) {
+    return (
+      <Spinner center>
+        <Message content={sharedMessages.fetching} />
+      </Spinner>
+    )
+  }
+
+  let content
+  if (enrollment) {
+    content = (
+      <Form
+        onSubmit={handleConfirm}
+        initialValues={initialValues}
+        validationSchema={validationSchema}
+        error={error}
+        info={m.scanQRCode}
+      >
+        <img src={enrollment.qr_code} alt={enrollment.uri} />
+        <p>
+          <Message content={m.secret} component="strong" />: <code>{enrollment.secret}</code>
+        </p>
+        <Form.Field
+          title={m.code}
+          name="code"
+          component={Input}
+          autoComplete="one-time-code"
+          autoFocus
+          required
+        />
+        <SubmitBar>
+          <Form.Submit component={SubmitButton} message={m.confirm} />
+        </SubmitBar>
+      </Form>
+    )
+  } else if (status && status.enabled) {
+    content = (
+      <Form
+        onSubmit={handleDisable}
+        initialValues={initialValues}
+        validationSchema={validationSchema}
+        error={error}
+        info={{ ...m.enabled, values: { remaining: status.recovery_codes_remaining } }}
+      >
+        <Form.Field
+          title={m.code}
+          name="code"
+          component={Input}
+          autoComplete="one-time-code"
+          required
+        />
+        <SubmitBar>
+          <Form.Submit component={SubmitButton} message={m.disable} danger />
+        </SubmitBar>
+      </Form>
+    )
+  } else {
+    content = (
+      <Form onSubmit={handleEnroll} initialValues={{}} error={error} info={m.disabled}>
+        <SubmitBar>
+          <Form.Submit component={SubmitButton} message={m.enable} />
+        </SubmitBar>
+      </Form>
+    )
+  }
+
+  return (
+    <Container>
+      <Row>
+        <Col lg={8} md={12}>
+          <PageTitle title={sharedMessages.multiFactorAuthentication} />
+          {recoveryCodes && (
+            <Notification info title={m.recoveryCodes} content={m.recoveryCodesDescription}>
+              <pre>{recoveryCodes.join('\n')}</pre>
+            </Notification>
+          )}
+          {content}
+        </Col>
+      </Row>
+    </Container>
+  )
+}
+
+export default MultiFactorAuthentication
@@ -34,9 +34,12 @@ import {
   selectApplicationSiteTitle,
 } from '@ttn-lw/lib/selectors/env'
 import sharedMessages from '@ttn-lw/lib/shared-messages'
+import { getBackendErrorId, isBackend } from '@ttn-lw/lib/errors/utils'
 
 const m = defineMessages({
   loginToken: 'Login Token',
+  mfaCode: 'Authentication code',
+  mfaCodeDescription: 'Enter the code from your authenticator app, or one of your recovery codes',
 })
 
 const appRoot = selectApplicationRootPath()
@@ -45,8 +48,12 @@ const siteTitle = selectApplicationSiteTitle()
 
 const validationSchema = Yup.object().shape({
   token: Yup.string().required(sharedMessages.validateRequired),
+  mfa_code: Yup.string().trim(),
 })
 
+const isMFARequiredError = error =>
+  isBackend(error) && getBackendErrorId(error) === 'error:pkg/account/session:mfa_required'
+
 const url = (location, omitQuery = false) => {
   const query = Query.parse(location.search)
 
@@ -61,6 +68,7 @@ const url = (location, omitQuery = false) => {
 
 const TokenLogin = () => {
   const [error, setError] = useState(undefined)
+  const [mfaRequired, setMFARequired] = useState(false)
   const location = useLocation()
   const { token: tokenParam } = Query.parse(location.search)
 
@@ -68,19 +76,26 @@ const TokenLogin = () => {
     async (values, { setSubmitting }) => {
       try {
         setError(undefined)
-        await api.account.tokenLogin(values)
+        const castedValues = validationSchema.cast(values)
+        await api.account.tokenLogin(castedValues)
 
         window.location = url(location)
       } catch (error) {
-        setError(error)
+        if (isMFARequiredError(error) && !mfaRequired) {
+          // Show the authentication code field instead of an error on the first attempt.
+          setMFARequired(true)
+        } else {
+          setError(error)
+        }
         setSubmitting(false)
       }
     },
-    [location],
+    [location, mfaRequired],
   )
 
   const initialValues = {
     token: tokenParam ? tokenParam : '',
+    mfa_code: '',
   }
 
   return (
@@ -101,6 +116,17 @@ const TokenLogin = () => {
         horizontal={false}
       >
         <Form.Field title={m.loginToken} component={Input} name="token" type="password" required />
+        {mfaRequired && (
+          <Form.Field
+            title={m.mfaCode}
+            description={m.mfaCodeDescription}
+            component={Input}
+            name="mfa_code"
+            autoComplete="one-time-code"
+            autoFocus
+            required
+          />
+        )}
         <div className={style.buttons}>
           <Form.Submit
             component={SubmitButton}
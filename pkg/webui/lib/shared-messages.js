@@ -358,6 +358,7 @@ export default defineMessages({
   moreInformation: 'More information',
   mqtt: 'MQTT',
   multicast: 'Define multicast group (ABP & Multicast)',
+  multiFactorAuthentication: 'Multi-factor authentication',
   name: 'Name',
   netId: 'Net ID',
   networkInformation: 'Network information',